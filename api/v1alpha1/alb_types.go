@@ -22,14 +22,23 @@ type ALBSpec struct {
 
 	// Subnets is the list of subnet IDs where the ALB will be deployed
 	// Must be at least 2 subnets in different AZs
-	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=2
-	Subnets []string `json:"subnets"`
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs references Subnets in the same namespace; mutually exclusive with Subnets
+	// +kubebuilder:validation:MinItems=2
+	// +optional
+	SubnetRefs []ResourceReference `json:"subnetRefs,omitempty"`
 
 	// SecurityGroups is the list of security group IDs
 	// +kubebuilder:validation:MinItems=1
 	SecurityGroups []string `json:"securityGroups,omitempty"`
 
+	// SecurityGroupRefs references SecurityGroups in the same namespace; mutually exclusive with SecurityGroups
+	// +optional
+	SecurityGroupRefs []ResourceReference `json:"securityGroupRefs,omitempty"`
+
 	// IPAddressType defines the IP address type (ipv4, dualstack)
 	// +kubebuilder:validation:Enum=ipv4;dualstack
 	// +kubebuilder:default=ipv4
@@ -55,9 +64,13 @@ type ALBSpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens when the CR is deleted
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // ALBStatus defines the observed state of ALB
@@ -82,6 +95,9 @@ type ALBStatus struct {
 
 	// LastSyncTime is the last time the resource was synced
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -50,17 +50,27 @@ func (r *ALB) validateALB() (admission.Warnings, error) {
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 2. Validar Tags (não podem ter prefixo aws:)
+	// 2. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("subnets", len(r.Spec.Subnets) > 0, "subnetRefs", r.Spec.SubnetRefs, true); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("securityGroups", len(r.Spec.SecurityGroups) > 0, "securityGroupRefs", r.Spec.SecurityGroupRefs, false); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar Tags (não podem ter prefixo aws:)
 	for key := range r.Spec.Tags {
 		if regexp.MustCompile(`^aws:`).MatchString(key) {
 			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 4. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
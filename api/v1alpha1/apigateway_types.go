@@ -39,6 +39,15 @@ type APIGatewaySpec struct {
 	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // CorsConfiguration defines CORS settings for HTTP APIs
@@ -78,6 +87,9 @@ type APIGatewayStatus struct {
 
 	// LastSyncTime is the last time the resource was synced
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,301 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AutoScalingGroupSpec defines the desired state of AutoScalingGroup
+type AutoScalingGroupSpec struct {
+	// ProviderRef references the AWSProvider for credentials
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// AutoScalingGroupName is the name of the group
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	AutoScalingGroupName string `json:"autoScalingGroupName"`
+
+	// MinSize is the minimum number of instances
+	// +kubebuilder:validation:Minimum=0
+	MinSize int32 `json:"minSize"`
+
+	// MaxSize is the maximum number of instances
+	// +kubebuilder:validation:Minimum=0
+	MaxSize int32 `json:"maxSize"`
+
+	// DesiredCapacity is the number of instances to run. When empty, the
+	// capacity is left to scaling policies and starts at minSize.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredCapacity *int32 `json:"desiredCapacity,omitempty"`
+
+	// SubnetIDs are the subnets instances are launched in
+	// +optional
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+
+	// SubnetRefs references Subnets in the same namespace; mutually exclusive with SubnetIDs
+	// +optional
+	SubnetRefs []ResourceReference `json:"subnetRefs,omitempty"`
+
+	// LaunchTemplate launches the instances
+	LaunchTemplate AutoScalingLaunchTemplate `json:"launchTemplate"`
+
+	// MixedInstancesPolicy launches several instance types and Spot Instances
+	// from the launch template
+	// +optional
+	MixedInstancesPolicy *AutoScalingMixedInstancesPolicy `json:"mixedInstancesPolicy,omitempty"`
+
+	// CapacityRebalance replaces Spot Instances at elevated risk of interruption
+	// +optional
+	CapacityRebalance bool `json:"capacityRebalance,omitempty"`
+
+	// HealthCheckType is EC2, or ELB to also replace instances failing target group health checks
+	// +kubebuilder:validation:Enum=EC2;ELB
+	// +kubebuilder:default=EC2
+	// +optional
+	HealthCheckType string `json:"healthCheckType,omitempty"`
+
+	// HealthCheckGracePeriodSeconds delays health checks of new instances
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	HealthCheckGracePeriodSeconds *int32 `json:"healthCheckGracePeriodSeconds,omitempty"`
+
+	// TargetGroupARNs are the target groups instances are registered in
+	// +optional
+	TargetGroupARNs []string `json:"targetGroupARNs,omitempty"`
+
+	// TargetGroupRefs references TargetGroups in the same namespace; mutually exclusive with TargetGroupARNs
+	// +optional
+	TargetGroupRefs []ResourceReference `json:"targetGroupRefs,omitempty"`
+
+	// LifecycleHooks pause instances while they launch or terminate. Hooks
+	// not declared here are deleted.
+	// +optional
+	LifecycleHooks []AutoScalingLifecycleHook `json:"lifecycleHooks,omitempty"`
+
+	// InstanceRefresh replaces the instances when the launch template changes.
+	// When empty, only new instances use the new launch template.
+	// +optional
+	InstanceRefresh *AutoScalingInstanceRefresh `json:"instanceRefresh,omitempty"`
+
+	// Tags are custom tags for the group, propagated to its instances
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines what happens when the CR is deleted
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// AutoScalingLaunchTemplate selects a launch template version
+type AutoScalingLaunchTemplate struct {
+	// LaunchTemplateID is the ID of the launch template
+	// +optional
+	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
+
+	// LaunchTemplateRef references a LaunchTemplate in the same namespace; mutually exclusive with LaunchTemplateID
+	// +optional
+	LaunchTemplateRef *ResourceReference `json:"launchTemplateRef,omitempty"`
+
+	// Version is a version number, $Latest or $Default. Defaults to the latest
+	// version of the referenced LaunchTemplate, so spec changes roll out, or
+	// to $Latest with LaunchTemplateID.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// AutoScalingMixedInstancesPolicy combines instance types and purchase options
+type AutoScalingMixedInstancesPolicy struct {
+	// InstanceTypes override the instance type of the launch template, in priority order
+	// +kubebuilder:validation:MinItems=1
+	InstanceTypes []AutoScalingInstanceType `json:"instanceTypes"`
+
+	// OnDemandBaseCapacity is the capacity always fulfilled by On-Demand Instances
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnDemandBaseCapacity *int32 `json:"onDemandBaseCapacity,omitempty"`
+
+	// OnDemandPercentageAboveBaseCapacity is the share of On-Demand Instances above the base capacity; the rest are Spot Instances
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	OnDemandPercentageAboveBaseCapacity *int32 `json:"onDemandPercentageAboveBaseCapacity,omitempty"`
+
+	// OnDemandAllocationStrategy is prioritized (the order of instanceTypes) or lowest-price
+	// +kubebuilder:validation:Enum=prioritized;lowest-price
+	// +optional
+	OnDemandAllocationStrategy string `json:"onDemandAllocationStrategy,omitempty"`
+
+	// SpotAllocationStrategy chooses the Spot capacity pools
+	// +kubebuilder:validation:Enum=lowest-price;capacity-optimized;capacity-optimized-prioritized;price-capacity-optimized
+	// +optional
+	SpotAllocationStrategy string `json:"spotAllocationStrategy,omitempty"`
+
+	// SpotMaxPrice is the maximum hourly price for Spot Instances. Defaults to the On-Demand price.
+	// +optional
+	SpotMaxPrice string `json:"spotMaxPrice,omitempty"`
+}
+
+// AutoScalingInstanceType is an instance type of a mixed instances policy
+type AutoScalingInstanceType struct {
+	// InstanceType (m5.large, m6i.large, etc)
+	InstanceType string `json:"instanceType"`
+
+	// WeightedCapacity is the number of capacity units the instance type counts for
+	// +optional
+	WeightedCapacity string `json:"weightedCapacity,omitempty"`
+}
+
+// AutoScalingLifecycleHook pauses instances in a lifecycle transition
+type AutoScalingLifecycleHook struct {
+	// Name of the hook
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name"`
+
+	// LifecycleTransition is the transition the hook pauses
+	// +kubebuilder:validation:Enum="autoscaling:EC2_INSTANCE_LAUNCHING";"autoscaling:EC2_INSTANCE_TERMINATING"
+	LifecycleTransition string `json:"lifecycleTransition"`
+
+	// HeartbeatTimeoutSeconds is how long the instance waits for the hook to complete
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	HeartbeatTimeoutSeconds int32 `json:"heartbeatTimeoutSeconds,omitempty"`
+
+	// DefaultResult is the action when the hook times out
+	// +kubebuilder:validation:Enum=CONTINUE;ABANDON
+	// +kubebuilder:default=ABANDON
+	// +optional
+	DefaultResult string `json:"defaultResult,omitempty"`
+
+	// NotificationTargetARN is the SQS queue or SNS topic notified of the transition
+	// +optional
+	NotificationTargetARN string `json:"notificationTargetARN,omitempty"`
+
+	// RoleARN allows Auto Scaling to publish to the notification target
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// NotificationMetadata is added to the notifications
+	// +optional
+	NotificationMetadata string `json:"notificationMetadata,omitempty"`
+}
+
+// AutoScalingInstanceRefresh configures the rolling replacement of instances
+type AutoScalingInstanceRefresh struct {
+	// MinHealthyPercentage is the share of capacity that stays in service during the refresh
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=90
+	// +optional
+	MinHealthyPercentage *int32 `json:"minHealthyPercentage,omitempty"`
+
+	// InstanceWarmupSeconds is how long a new instance warms up before the next one is replaced
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InstanceWarmupSeconds *int32 `json:"instanceWarmupSeconds,omitempty"`
+
+	// SkipMatching keeps instances that already use the launch template
+	// +kubebuilder:default=true
+	// +optional
+	SkipMatching *bool `json:"skipMatching,omitempty"`
+}
+
+// AutoScalingGroupStatus defines the observed state of AutoScalingGroup
+type AutoScalingGroupStatus struct {
+	// Ready indicates if the group exists and runs its desired capacity
+	Ready bool `json:"ready,omitempty"`
+
+	// AutoScalingGroupARN is the ARN of the group
+	AutoScalingGroupARN string `json:"autoScalingGroupARN,omitempty"`
+
+	// LaunchTemplateID is the resolved launch template
+	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
+
+	// LaunchTemplateVersion is the resolved launch template version
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// DesiredCapacity is the current desired capacity of the group
+	DesiredCapacity int32 `json:"desiredCapacity,omitempty"`
+
+	// Instances is the number of instances in the group
+	Instances int32 `json:"instances,omitempty"`
+
+	// InServiceInstances is the number of instances in service
+	InServiceInstances int32 `json:"inServiceInstances,omitempty"`
+
+	// InstanceRefresh is the progress of the latest instance refresh
+	// +optional
+	InstanceRefresh *AutoScalingInstanceRefreshStatus `json:"instanceRefresh,omitempty"`
+
+	// RefreshedLaunchTemplate is the launch template ID and version the
+	// instances were last refreshed to; a different version is rolled out
+	// once the running refresh ends
+	// +optional
+	RefreshedLaunchTemplate string `json:"refreshedLaunchTemplate,omitempty"`
+
+	// LastSyncTime is the last time the resource was synced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// AutoScalingInstanceRefreshStatus is the progress of an instance refresh
+type AutoScalingInstanceRefreshStatus struct {
+	// ID of the instance refresh
+	ID string `json:"id,omitempty"`
+
+	// Status is Pending, InProgress, Successful, Failed, Cancelling, Cancelled, RollbackInProgress, RollbackFailed, RollbackSuccessful or Baking
+	Status string `json:"status,omitempty"`
+
+	// StatusReason explains the status
+	// +optional
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// PercentageComplete is the share of instances replaced
+	PercentageComplete int32 `json:"percentageComplete,omitempty"`
+
+	// InstancesToUpdate is the number of instances left to replace
+	InstancesToUpdate int32 `json:"instancesToUpdate,omitempty"`
+
+	// StartTime is when the refresh started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the refresh ended
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=asg
+// +kubebuilder:printcolumn:name="Min",type=integer,JSONPath=`.spec.minSize`
+// +kubebuilder:printcolumn:name="Max",type=integer,JSONPath=`.spec.maxSize`
+// +kubebuilder:printcolumn:name="Desired",type=integer,JSONPath=`.status.desiredCapacity`
+// +kubebuilder:printcolumn:name="InService",type=integer,JSONPath=`.status.inServiceInstances`
+// +kubebuilder:printcolumn:name="Refresh",type=string,JSONPath=`.status.instanceRefresh.status`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AutoScalingGroup is the Schema for the autoscalinggroups API
+type AutoScalingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoScalingGroupSpec   `json:"spec,omitempty"`
+	Status AutoScalingGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoScalingGroupList contains a list of AutoScalingGroup
+type AutoScalingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoScalingGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AutoScalingGroup{}, &AutoScalingGroupList{})
+}
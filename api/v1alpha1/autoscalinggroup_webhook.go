@@ -0,0 +1,138 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var autoscalinggrouplog = logf.Log.WithName("autoscalinggroup-resource")
+
+func (r *AutoScalingGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-autoscalinggroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=autoscalinggroups,verbs=create;update,versions=v1alpha1,name=vautoscalinggroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AutoScalingGroup{}
+
+// launchTemplateVersionPattern aceita um número de versão, $Latest ou $Default
+var launchTemplateVersionPattern = regexp.MustCompile(`^([1-9][0-9]*|\$Latest|\$Default)$`)
+
+func (r *AutoScalingGroup) ValidateCreate() (admission.Warnings, error) {
+	autoscalinggrouplog.Info("validate create", "name", r.Name)
+	return r.validateAutoScalingGroup()
+}
+
+func (r *AutoScalingGroup) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	autoscalinggrouplog.Info("validate update", "name", r.Name)
+
+	oldASG := old.(*AutoScalingGroup)
+
+	// O nome identifica o grupo na AWS
+	if r.Spec.AutoScalingGroupName != oldASG.Spec.AutoScalingGroupName {
+		return nil, fmt.Errorf("spec.autoScalingGroupName is immutable")
+	}
+
+	return r.validateAutoScalingGroup()
+}
+
+func (r *AutoScalingGroup) ValidateDelete() (admission.Warnings, error) {
+	autoscalinggrouplog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *AutoScalingGroup) validateAutoScalingGroup() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar capacidade
+	if r.Spec.MaxSize < r.Spec.MinSize {
+		return nil, fmt.Errorf("spec.maxSize must be greater than or equal to spec.minSize")
+	}
+	if d := r.Spec.DesiredCapacity; d != nil && (*d < r.Spec.MinSize || *d > r.Spec.MaxSize) {
+		return nil, fmt.Errorf("spec.desiredCapacity must be between spec.minSize and spec.maxSize")
+	}
+
+	// 3. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("subnetIDs", len(r.Spec.SubnetIDs) > 0, "subnetRefs", r.Spec.SubnetRefs, true); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("targetGroupARNs", len(r.Spec.TargetGroupARNs) > 0, "targetGroupRefs", r.Spec.TargetGroupRefs, false); err != nil {
+		return nil, err
+	}
+	lt := r.Spec.LaunchTemplate
+	if err := validateIDOrRef("launchTemplate.launchTemplateID", lt.LaunchTemplateID != "", "launchTemplate.launchTemplateRef", optionalRef(lt.LaunchTemplateRef), true); err != nil {
+		return nil, err
+	}
+	if lt.Version != "" && !launchTemplateVersionPattern.MatchString(lt.Version) {
+		return nil, fmt.Errorf("spec.launchTemplate.version must be a version number, $Latest or $Default, got %q", lt.Version)
+	}
+
+	// 4. Validar mixed instances policy
+	if p := r.Spec.MixedInstancesPolicy; p != nil {
+		if len(p.InstanceTypes) == 0 {
+			return nil, fmt.Errorf("spec.mixedInstancesPolicy.instanceTypes requires at least one instance type")
+		}
+		for i, it := range p.InstanceTypes {
+			if it.InstanceType == "" {
+				return nil, fmt.Errorf("spec.mixedInstancesPolicy.instanceTypes[%d].instanceType is required", i)
+			}
+		}
+	}
+	if r.Spec.CapacityRebalance && r.Spec.MixedInstancesPolicy == nil {
+		warnings = append(warnings, "spec.capacityRebalance only affects Spot Instances, which require spec.mixedInstancesPolicy")
+	}
+
+	// 5. Validar lifecycle hooks
+	hookNames := make(map[string]bool, len(r.Spec.LifecycleHooks))
+	for i, hook := range r.Spec.LifecycleHooks {
+		if hook.Name == "" {
+			return nil, fmt.Errorf("spec.lifecycleHooks[%d].name is required", i)
+		}
+		if hookNames[hook.Name] {
+			return nil, fmt.Errorf("spec.lifecycleHooks has duplicate name %q", hook.Name)
+		}
+		hookNames[hook.Name] = true
+		if (hook.NotificationTargetARN == "") != (hook.RoleARN == "") {
+			return nil, fmt.Errorf("spec.lifecycleHooks[%d] must set both notificationTargetARN and roleARN, or neither", i)
+		}
+	}
+
+	// 6. Validar instance refresh
+	if r.Spec.InstanceRefresh != nil && strings.HasPrefix(lt.Version, "$") {
+		warnings = append(warnings, fmt.Sprintf("spec.launchTemplate.version %s does not change with the launch template, so it never triggers an instance refresh", lt.Version))
+	}
+
+	// 7. Validar Tags (não podem ter prefixo aws:)
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// 8. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
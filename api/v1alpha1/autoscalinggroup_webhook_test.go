@@ -0,0 +1,139 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("AutoScalingGroup Webhook", func() {
+	var obj *AutoScalingGroup
+
+	BeforeEach(func() {
+		obj = &AutoScalingGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-autoscalinggroup",
+				Namespace: "default",
+			},
+			Spec: AutoScalingGroupSpec{
+				ProviderRef:          ProviderReference{Name: "test-provider"},
+				AutoScalingGroupName: "web",
+				MinSize:              1,
+				MaxSize:              4,
+				SubnetIDs:            []string{"subnet-12345678", "subnet-87654321"},
+				LaunchTemplate: AutoScalingLaunchTemplate{
+					LaunchTemplateRef: &ResourceReference{Name: "web-nodes"},
+				},
+				DeletionPolicy: "Delete",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid AutoScalingGroup", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should reject a desired capacity outside the size limits", func() {
+			desired := int32(5)
+			obj.Spec.DesiredCapacity = &desired
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.MaxSize = 0
+			obj.Spec.DesiredCapacity = nil
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require exactly one of launchTemplateID and launchTemplateRef", func() {
+			obj.Spec.LaunchTemplate.LaunchTemplateID = "lt-0123456789abcdef0"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.LaunchTemplate.LaunchTemplateRef = nil
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.LaunchTemplate.LaunchTemplateID = ""
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should validate the launch template version", func() {
+			obj.Spec.LaunchTemplate.Version = "3"
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.LaunchTemplate.Version = "latest"
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require subnets", func() {
+			obj.Spec.SubnetIDs = nil
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require instance types in the mixed instances policy", func() {
+			obj.Spec.MixedInstancesPolicy = &AutoScalingMixedInstancesPolicy{}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.MixedInstancesPolicy.InstanceTypes = []AutoScalingInstanceType{{InstanceType: "m5.large"}, {InstanceType: "m6i.large"}}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject duplicate lifecycle hook names", func() {
+			obj.Spec.LifecycleHooks = []AutoScalingLifecycleHook{
+				{Name: "drain", LifecycleTransition: "autoscaling:EC2_INSTANCE_TERMINATING"},
+				{Name: "drain", LifecycleTransition: "autoscaling:EC2_INSTANCE_LAUNCHING"},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require roleARN with notificationTargetARN", func() {
+			obj.Spec.LifecycleHooks = []AutoScalingLifecycleHook{{
+				Name:                  "drain",
+				LifecycleTransition:   "autoscaling:EC2_INSTANCE_TERMINATING",
+				NotificationTargetARN: "arn:aws:sqs:us-east-1:123456789012:drain",
+			}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should warn when instance refresh uses a floating version", func() {
+			obj.Spec.LaunchTemplate.Version = "$Latest"
+			obj.Spec.InstanceRefresh = &AutoScalingInstanceRefresh{}
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing capacity and launch template", func() {
+			old := obj.DeepCopy()
+			obj.Spec.MaxSize = 10
+			obj.Spec.LaunchTemplate.LaunchTemplateRef = &ResourceReference{Name: "web-nodes-v2"}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing autoScalingGroupName", func() {
+			old := obj.DeepCopy()
+			obj.Spec.AutoScalingGroupName = "api"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
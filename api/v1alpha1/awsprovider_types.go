@@ -41,6 +41,36 @@ type AWSProviderSpec struct {
 	// Tags to apply to all resources created by this provider
 	// +optional
 	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// DriftDetection configures drift detection for resources using this provider
+	// +optional
+	DriftDetection *DriftDetectionConfig `json:"driftDetection,omitempty"`
+}
+
+// DriftDetectionConfig configures how drift between CRs and AWS is detected and handled
+type DriftDetectionConfig struct {
+	// Enabled turns drift detection on for resources using this provider
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+
+	// CheckInterval is the minimum time between drift checks (e.g. "5m")
+	// +optional
+	// +kubebuilder:default="5m"
+	CheckInterval string `json:"checkInterval,omitempty"`
+
+	// AutoHeal updates AWS to match the CR when drift is detected; otherwise drift is only reported
+	// +optional
+	AutoHeal bool `json:"autoHeal,omitempty"`
+
+	// SeverityThreshold is the minimum severity that is reported and healed
+	// +optional
+	// +kubebuilder:validation:Enum=low;medium;high
+	// +kubebuilder:default=medium
+	SeverityThreshold string `json:"severityThreshold,omitempty"`
+
+	// IgnoreFields lists field paths allowed to drift (e.g. "tags.aws:*")
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 }
 
 // CredentialsSecretRef references a Secret containing AWS credentials
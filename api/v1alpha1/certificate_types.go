@@ -28,9 +28,13 @@ type CertificateSpec struct {
 
 	// DeletionPolicy
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // CertificateStatus defines the observed state
@@ -40,6 +44,9 @@ type CertificateStatus struct {
 	Status           string                      `json:"status,omitempty"`
 	ValidationRecords []CertificateValidationRecord `json:"validationRecords,omitempty"`
 	LastSyncTime     *metav1.Time                `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 type CertificateValidationRecord struct {
@@ -58,9 +58,11 @@ func (r *Certificate) validateCertificate() (admission.Warnings, error) {
 	}
 
 	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
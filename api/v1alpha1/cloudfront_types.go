@@ -47,6 +47,10 @@ type CloudFrontSpec struct {
 	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // CloudFrontOrigin represents an origin server
@@ -158,6 +162,9 @@ type CloudFrontStatus struct {
 
 	// LastSyncTime is the last time the resource was synced
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
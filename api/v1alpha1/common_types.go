@@ -1,6 +1,10 @@
 // Package v1alpha1 contém os tipos de API compartilhados.
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // DriftDetail represents a detected difference between desired and actual state
 type DriftDetail struct {
 	// Field is the path to the drifted field
@@ -15,3 +19,73 @@ type DriftDetail struct {
 	// Severity indicates the impact level: "low", "medium", "high"
 	Severity string `json:"severity,omitempty"`
 }
+
+// DriftPolicy overrides the provider-level drift detection settings for a single resource
+type DriftPolicy struct {
+	// Action taken when drift is detected: "auto-heal", "alert-only" or "ignore"
+	// +optional
+	// +kubebuilder:validation:Enum=auto-heal;alert-only;ignore
+	Action string `json:"action,omitempty"`
+
+	// IgnoreFields lists additional field paths allowed to drift (e.g. "tags.team", "tags.backup:*")
+	// +optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
+
+	// CheckInterval is the minimum time between drift checks (e.g. "10m")
+	// +optional
+	CheckInterval string `json:"checkInterval,omitempty"`
+}
+
+// DriftStatus holds the result of the last drift check
+type DriftStatus struct {
+	// DriftDetected indicates if the last check found differences between the CR and AWS
+	// +optional
+	DriftDetected bool `json:"driftDetected,omitempty"`
+
+	// DriftDetails lists the drifted fields found by the last check
+	// +optional
+	DriftDetails []DriftDetail `json:"driftDetails,omitempty"`
+
+	// LastDriftCheck is the timestamp of the last drift check
+	// +optional
+	LastDriftCheck *metav1.Time `json:"lastDriftCheck,omitempty"`
+
+	// ObservedGeneration is the generation of the spec last applied to AWS.
+	// Drift is only checked against that generation, so spec edits are
+	// applied even when drift is only alerted on.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// ResourceReference points to another resource of this operator in the same namespace.
+// The referenced resource's status provides the AWS ID once it is Ready.
+type ResourceReference struct {
+	// Name of the referenced resource
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// AdoptAnnotation binds a new CR to an existing AWS resource instead of creating one.
+// The value is the AWS ID of the resource (e.g. "vpc-0a1b2c3d"); it is only read
+// until the ID is recorded in the CR status.
+const AdoptAnnotation = "aws-infra-operator.runner.codes/adopt"
+
+// ConnectionSecretReference names the Secret a controller writes the resource's
+// connection details to. The Secret is created in the resource's namespace and is
+// owned by the resource, so it is deleted together with it.
+type ConnectionSecretReference struct {
+	// Name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Well-known keys of connection Secrets. Each resource only writes the keys that
+// apply to it.
+const (
+	ConnectionSecretKeyHost     = "host"
+	ConnectionSecretKeyPort     = "port"
+	ConnectionSecretKeyURL      = "url"
+	ConnectionSecretKeyARN      = "arn"
+	ConnectionSecretKeyUsername = "username"
+	ConnectionSecretKeyPassword = "password"
+)
@@ -117,7 +117,7 @@ type ComputeStackSpec struct {
 	// DeletionPolicy define o comportamento ao deletar o CR
 	// +optional
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
 	// ===========================================================================
@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DBParameterGroupSpec defines the desired state of DBParameterGroup
+type DBParameterGroupSpec struct {
+	// ProviderRef references the AWSProvider to use
+	// +kubebuilder:validation:Required
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// DBParameterGroupName is the name of the DB parameter group
+	// +kubebuilder:validation:Required
+	DBParameterGroupName string `json:"dbParameterGroupName"`
+
+	// Family is the parameter group family (e.g. postgres15, mysql8.0); immutable
+	// +kubebuilder:validation:Required
+	Family string `json:"family"`
+
+	// Description of the DB parameter group; immutable
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Parameters are the parameter values of the group. Parameters not listed keep
+	// the engine default; removing a parameter resets it. Static parameters only
+	// take effect after the instances using the group are rebooted
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// Tags to apply to the DB parameter group
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// DBParameterGroupStatus defines the observed state of DBParameterGroup
+type DBParameterGroupStatus struct {
+	// Ready indicates if the DB parameter group is ready
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ARN of the DB parameter group
+	// +optional
+	ARN string `json:"arn,omitempty"`
+
+	// LastSyncTime
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=dbpg
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.dbParameterGroupName`
+// +kubebuilder:printcolumn:name="Family",type=string,JSONPath=`.spec.family`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DBParameterGroup is the Schema for the dbparametergroups API
+type DBParameterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBParameterGroupSpec   `json:"spec,omitempty"`
+	Status DBParameterGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBParameterGroupList contains a list of DBParameterGroup
+type DBParameterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBParameterGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DBParameterGroup{}, &DBParameterGroupList{})
+}
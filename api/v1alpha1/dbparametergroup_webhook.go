@@ -0,0 +1,100 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var dbparametergrouplog = logf.Log.WithName("dbparametergroup-resource")
+
+func (r *DBParameterGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-dbparametergroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=dbparametergroups,verbs=create;update,versions=v1alpha1,name=vdbparametergroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DBParameterGroup{}
+
+func (r *DBParameterGroup) ValidateCreate() (admission.Warnings, error) {
+	dbparametergrouplog.Info("validate create", "name", r.Name)
+	return r.validateDBParameterGroup()
+}
+
+func (r *DBParameterGroup) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	dbparametergrouplog.Info("validate update", "name", r.Name)
+
+	oldGroup := old.(*DBParameterGroup)
+
+	// Campos imutáveis (a AWS não altera família nem descrição de um grupo existente)
+	if r.Spec.DBParameterGroupName != oldGroup.Spec.DBParameterGroupName {
+		return nil, fmt.Errorf("spec.dbParameterGroupName is immutable")
+	}
+	if r.Spec.Family != oldGroup.Spec.Family {
+		return nil, fmt.Errorf("spec.family is immutable")
+	}
+	if r.Spec.Description != oldGroup.Spec.Description {
+		return nil, fmt.Errorf("spec.description is immutable")
+	}
+
+	return r.validateDBParameterGroup()
+}
+
+func (r *DBParameterGroup) ValidateDelete() (admission.Warnings, error) {
+	dbparametergrouplog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *DBParameterGroup) validateDBParameterGroup() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar nome (começa com letra; letras, números e hífens; até 255 caracteres)
+	if !regexp.MustCompile(`^[a-zA-Z]([a-zA-Z0-9-]{0,254})$`).MatchString(r.Spec.DBParameterGroupName) ||
+		regexp.MustCompile(`(--|-$)`).MatchString(r.Spec.DBParameterGroupName) {
+		return nil, fmt.Errorf("spec.dbParameterGroupName must start with a letter, contain only letters, digits and hyphens, and not end with or contain two consecutive hyphens")
+	}
+
+	// 3. Validar família
+	if r.Spec.Family == "" {
+		return nil, fmt.Errorf("spec.family is required")
+	}
+
+	// 4. Validar parâmetros
+	for name := range r.Spec.Parameters {
+		if name == "" {
+			return nil, fmt.Errorf("spec.parameters cannot have an empty name")
+		}
+	}
+
+	// 5. Validar Tags
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
@@ -0,0 +1,69 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DBParameterGroup Webhook", func() {
+	var obj *DBParameterGroup
+
+	BeforeEach(func() {
+		obj = &DBParameterGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-dbparametergroup",
+				Namespace: "default",
+			},
+			Spec: DBParameterGroupSpec{
+				ProviderRef:          ProviderReference{Name: "test-provider"},
+				DBParameterGroupName: "app-postgres15",
+				Family:               "postgres15",
+				Parameters:           map[string]string{"max_connections": "200"},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid DBParameterGroup", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+
+		It("should reject invalid names", func() {
+			for _, name := range []string{"1app", "app--pg", "app-", "app_pg"} {
+				obj.Spec.DBParameterGroupName = name
+				_, err := obj.ValidateCreate()
+				Expect(err).To(HaveOccurred(), name)
+			}
+		})
+
+		It("should reject empty family", func() {
+			obj.Spec.Family = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should reject family change", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Family = "postgres16"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("immutable"))
+		})
+
+		It("should accept parameter changes", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Parameters["work_mem"] = "8192"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
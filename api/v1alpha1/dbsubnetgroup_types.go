@@ -0,0 +1,99 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DBSubnetGroupSpec defines the desired state of DBSubnetGroup
+type DBSubnetGroupSpec struct {
+	// ProviderRef references the AWSProvider to use
+	// +kubebuilder:validation:Required
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// DBSubnetGroupName is the name of the DB subnet group
+	// +kubebuilder:validation:Required
+	DBSubnetGroupName string `json:"dbSubnetGroupName"`
+
+	// Description of the DB subnet group
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// SubnetIDs are the subnets of the group, in at least two availability zones
+	// +optional
+	SubnetIDs []string `json:"subnetIDs,omitempty"`
+
+	// SubnetRefs references Subnets in the same namespace; mutually exclusive with SubnetIDs
+	// +optional
+	SubnetRefs []ResourceReference `json:"subnetRefs,omitempty"`
+
+	// Tags to apply to the DB subnet group
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+}
+
+// DBSubnetGroupStatus defines the observed state of DBSubnetGroup
+type DBSubnetGroupStatus struct {
+	// Ready indicates if the DB subnet group is ready
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ARN of the DB subnet group
+	// +optional
+	ARN string `json:"arn,omitempty"`
+
+	// VpcID is the VPC of the subnets
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// Status of the DB subnet group (Complete, Incomplete, Invalid)
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// LastSyncTime
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=dbsg
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.dbSubnetGroupName`
+// +kubebuilder:printcolumn:name="VPC-ID",type=string,JSONPath=`.status.vpcID`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DBSubnetGroup is the Schema for the dbsubnetgroups API
+type DBSubnetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBSubnetGroupSpec   `json:"spec,omitempty"`
+	Status DBSubnetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBSubnetGroupList contains a list of DBSubnetGroup
+type DBSubnetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBSubnetGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DBSubnetGroup{}, &DBSubnetGroupList{})
+}
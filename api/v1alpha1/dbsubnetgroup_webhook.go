@@ -0,0 +1,97 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var dbsubnetgrouplog = logf.Log.WithName("dbsubnetgroup-resource")
+
+func (r *DBSubnetGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-dbsubnetgroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=dbsubnetgroups,verbs=create;update,versions=v1alpha1,name=vdbsubnetgroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DBSubnetGroup{}
+
+func (r *DBSubnetGroup) ValidateCreate() (admission.Warnings, error) {
+	dbsubnetgrouplog.Info("validate create", "name", r.Name)
+	return r.validateDBSubnetGroup()
+}
+
+func (r *DBSubnetGroup) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	dbsubnetgrouplog.Info("validate update", "name", r.Name)
+
+	oldGroup := old.(*DBSubnetGroup)
+
+	// Campos imutáveis
+	if r.Spec.DBSubnetGroupName != oldGroup.Spec.DBSubnetGroupName {
+		return nil, fmt.Errorf("spec.dbSubnetGroupName is immutable")
+	}
+
+	return r.validateDBSubnetGroup()
+}
+
+func (r *DBSubnetGroup) ValidateDelete() (admission.Warnings, error) {
+	dbsubnetgrouplog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *DBSubnetGroup) validateDBSubnetGroup() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar nome (letras, números, espaços, ., _ e -; até 255 caracteres)
+	if !regexp.MustCompile(`^[a-zA-Z0-9 ._-]{1,255}$`).MatchString(r.Spec.DBSubnetGroupName) {
+		return nil, fmt.Errorf("spec.dbSubnetGroupName must be 1-255 letters, digits, spaces, '.', '_' or '-'")
+	}
+	if r.Spec.DBSubnetGroupName == "default" {
+		return nil, fmt.Errorf("spec.dbSubnetGroupName cannot be 'default'")
+	}
+
+	// 3. Validar subnets ou referências (pelo menos duas, em AZs diferentes)
+	if err := validateIDOrRef("subnetIDs", len(r.Spec.SubnetIDs) > 0, "subnetRefs", r.Spec.SubnetRefs, true); err != nil {
+		return nil, err
+	}
+	if len(r.Spec.SubnetIDs)+len(r.Spec.SubnetRefs) < 2 {
+		return nil, fmt.Errorf("a DB subnet group needs at least two subnets in different availability zones")
+	}
+	for _, id := range r.Spec.SubnetIDs {
+		if !regexp.MustCompile(`^subnet-[0-9a-f]{8,17}$`).MatchString(id) {
+			return nil, fmt.Errorf("spec.subnetIDs must be in format 'subnet-xxxxxxxxx': %s", id)
+		}
+	}
+
+	// 4. Validar Tags
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
@@ -0,0 +1,80 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("DBSubnetGroup Webhook", func() {
+	var obj *DBSubnetGroup
+
+	BeforeEach(func() {
+		obj = &DBSubnetGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-dbsubnetgroup",
+				Namespace: "default",
+			},
+			Spec: DBSubnetGroupSpec{
+				ProviderRef:       ProviderReference{Name: "test-provider"},
+				DBSubnetGroupName: "app-db",
+				SubnetIDs:         []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid DBSubnetGroup", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+
+		It("should accept subnet references", func() {
+			obj.Spec.SubnetIDs = nil
+			obj.Spec.SubnetRefs = []ResourceReference{{Name: "private-a"}, {Name: "private-b"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a single subnet", func() {
+			obj.Spec.SubnetIDs = []string{"subnet-0a1b2c3d"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("at least two subnets"))
+		})
+
+		It("should reject invalid subnet IDs", func() {
+			obj.Spec.SubnetIDs = []string{"subnet-0a1b2c3d", "invalid"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject the default name", func() {
+			obj.Spec.DBSubnetGroupName = "default"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should reject name change", func() {
+			old := obj.DeepCopy()
+			obj.Spec.DBSubnetGroupName = "other-db"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("immutable"))
+		})
+
+		It("should accept subnet changes", func() {
+			old := obj.DeepCopy()
+			obj.Spec.SubnetIDs = append(obj.Spec.SubnetIDs, "subnet-8c9d0e1f")
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
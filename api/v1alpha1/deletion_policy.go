@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Deletion policies shared by every resource. Delete removes the AWS resource
+// with the CR; Retain and Orphan both keep it in AWS and only stop managing it.
+// Some kinds add their own policies, such as Snapshot or Stop.
+const (
+	DeletionPolicyDelete = "Delete"
+	DeletionPolicyRetain = "Retain"
+	DeletionPolicyOrphan = "Orphan"
+)
+
+// DeletionPolicyKeepsResource reports whether a deletion policy leaves the AWS
+// resource in place when the CR is deleted
+func DeletionPolicyKeepsResource(policy string) bool {
+	return policy == DeletionPolicyRetain || policy == DeletionPolicyOrphan
+}
+
+// validateDeletionPolicy ensures the deletion policy is Delete, Retain, Orphan
+// or one of the kind specific policies, warning when it falls back to the default
+func validateDeletionPolicy(policy, defaultPolicy string, extra ...string) (admission.Warnings, error) {
+	if policy == "" {
+		return admission.Warnings{fmt.Sprintf("spec.deletionPolicy not set, defaulting to '%s'", defaultPolicy)}, nil
+	}
+
+	allowed := append([]string{DeletionPolicyDelete, DeletionPolicyRetain, DeletionPolicyOrphan}, extra...)
+	for _, p := range allowed {
+		if policy == p {
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("spec.deletionPolicy must be one of %s, got %q", strings.Join(allowed, ", "), policy)
+}
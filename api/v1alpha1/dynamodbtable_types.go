@@ -17,6 +17,10 @@ type DynamoDBTableSpec struct {
 	// +optional
 	BillingMode string `json:"billingMode,omitempty"`
 
+	// ProvisionedThroughput is the table capacity, required when billingMode is PROVISIONED
+	// +optional
+	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+
 	// HashKey is the partition key attribute
 	HashKey AttributeDefinition `json:"hashKey"`
 
@@ -44,14 +48,27 @@ type DynamoDBTableSpec struct {
 	// +optional
 	PointInTimeRecovery bool `json:"pointInTimeRecovery,omitempty"`
 
+	// TimeToLive expires items based on a timestamp attribute
+	// +optional
+	TimeToLive *TimeToLiveSpec `json:"timeToLive,omitempty"`
+
 	// Tags for the table
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines behavior on CR deletion
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // AttributeDefinition defines a DynamoDB attribute
@@ -83,6 +100,32 @@ type GlobalSecondaryIndex struct {
 	// NonKeyAttributes to include in projection (for INCLUDE type)
 	// +optional
 	NonKeyAttributes []string `json:"nonKeyAttributes,omitempty"`
+
+	// ProvisionedThroughput is the index capacity, required when billingMode is PROVISIONED
+	// +optional
+	ProvisionedThroughput *ProvisionedThroughput `json:"provisionedThroughput,omitempty"`
+}
+
+// ProvisionedThroughput defines the capacity of a table or index in PROVISIONED billing mode
+type ProvisionedThroughput struct {
+	// ReadCapacityUnits is the number of strongly consistent reads per second
+	// +kubebuilder:validation:Minimum=1
+	ReadCapacityUnits int64 `json:"readCapacityUnits"`
+
+	// WriteCapacityUnits is the number of writes per second
+	// +kubebuilder:validation:Minimum=1
+	WriteCapacityUnits int64 `json:"writeCapacityUnits"`
+}
+
+// TimeToLiveSpec defines the TTL of a table
+type TimeToLiveSpec struct {
+	// AttributeName is the attribute holding the expiration time (epoch seconds)
+	AttributeName string `json:"attributeName"`
+
+	// Enabled turns TTL on or off
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // DynamoDBTableStatus defines the observed state of DynamoDBTable
@@ -117,6 +160,9 @@ type DynamoDBTableStatus struct {
 	// Conditions represent the latest available observations
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,10 +57,24 @@ func (r *DynamoDBTable) validateDynamoDBTable() (admission.Warnings, error) {
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 3. Validar capacidade no modo PROVISIONED
+	if r.Spec.BillingMode == "PROVISIONED" {
+		if r.Spec.ProvisionedThroughput == nil {
+			return nil, fmt.Errorf("spec.provisionedThroughput is required when billingMode is PROVISIONED")
+		}
+		for _, gsi := range r.Spec.GlobalSecondaryIndexes {
+			if gsi.ProvisionedThroughput == nil {
+				return nil, fmt.Errorf("provisionedThroughput is required for index %s when billingMode is PROVISIONED", gsi.IndexName)
+			}
+		}
+	}
+
+	// 4. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
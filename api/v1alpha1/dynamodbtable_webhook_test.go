@@ -43,5 +43,20 @@ var _ = Describe("Udynamodbtable Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should require throughput in PROVISIONED mode", func() {
+			obj.Spec.BillingMode = "PROVISIONED"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.ProvisionedThroughput = &ProvisionedThroughput{ReadCapacityUnits: 5, WriteCapacityUnits: 5}
+			obj.Spec.GlobalSecondaryIndexes = []GlobalSecondaryIndex{{IndexName: "by-email", HashKey: "email", ProjectionType: "ALL"}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.GlobalSecondaryIndexes[0].ProvisionedThroughput = &ProvisionedThroughput{ReadCapacityUnits: 1, WriteCapacityUnits: 1}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 })
@@ -30,10 +30,18 @@ type EC2InstanceSpec struct {
 	// +optional
 	SubnetID string `json:"subnetID,omitempty"`
 
+	// SubnetRef references a Subnet in the same namespace; mutually exclusive with SubnetID
+	// +optional
+	SubnetRef *ResourceReference `json:"subnetRef,omitempty"`
+
 	// SecurityGroupIDs
 	// +optional
 	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
 
+	// SecurityGroupRefs references SecurityGroups in the same namespace; mutually exclusive with SecurityGroupIDs
+	// +optional
+	SecurityGroupRefs []ResourceReference `json:"securityGroupRefs,omitempty"`
+
 	// IAMInstanceProfile
 	// +optional
 	IAMInstanceProfile string `json:"iamInstanceProfile,omitempty"`
@@ -58,13 +66,25 @@ type EC2InstanceSpec struct {
 	// +optional
 	EBSOptimized bool `json:"ebsOptimized,omitempty"`
 
+	// DesiredState starts or stops the instance
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped
+	// +kubebuilder:default=running
+	DesiredState string `json:"desiredState,omitempty"`
+
+	// AllowRestart allows the operator to stop and start a running instance
+	// to change its instanceType. Without it the change waits until the
+	// instance is stopped.
+	// +optional
+	AllowRestart bool `json:"allowRestart,omitempty"`
+
 	// Tags
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain;Stop
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan;Stop
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
@@ -72,6 +92,10 @@ type EC2InstanceSpec struct {
 	// Os logs serão armazenados no status.consoleOutput
 	// +optional
 	EnableConsoleOutput bool `json:"enableConsoleOutput,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // BlockDeviceMapping defines an EBS volume mapping
@@ -167,6 +191,9 @@ type EC2InstanceStatus struct {
 	// ConsoleOutputTimestamp é o timestamp do último console output
 	// +optional
 	ConsoleOutputTimestamp *metav1.Time `json:"consoleOutputTimestamp,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
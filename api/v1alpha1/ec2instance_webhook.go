@@ -34,7 +34,38 @@ func (r *EC2Instance) ValidateCreate() (admission.Warnings, error) {
 
 func (r *EC2Instance) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
 	ec2instancelog.Info("validate update", "name", r.Name)
-	return r.validateEC2Instance()
+
+	warnings, err := r.validateEC2Instance()
+	if err != nil {
+		return nil, err
+	}
+
+	oldInstance := old.(*EC2Instance)
+
+	// Campos que só mudam substituindo a instância não são aplicados
+	var replaced []string
+	if r.Spec.ImageID != oldInstance.Spec.ImageID {
+		replaced = append(replaced, "imageID")
+	}
+	if r.Spec.SubnetID != oldInstance.Spec.SubnetID {
+		replaced = append(replaced, "subnetID")
+	}
+	if refChanged(oldInstance.Spec.SubnetRef, r.Spec.SubnetRef) {
+		replaced = append(replaced, "subnetRef")
+	}
+	if r.Spec.KeyName != oldInstance.Spec.KeyName {
+		replaced = append(replaced, "keyName")
+	}
+	for _, field := range replaced {
+		warnings = append(warnings, fmt.Sprintf("spec.%s can't be changed on an existing instance; the change is reported in the ReplacementRequired condition until the instance is replaced", field))
+	}
+
+	// Mudar o tipo exige parar a instância
+	if r.Spec.InstanceType != oldInstance.Spec.InstanceType && !r.Spec.AllowRestart && r.Spec.DesiredState != "stopped" {
+		warnings = append(warnings, "spec.instanceType is only changed while the instance is stopped; set spec.allowRestart to restart it or spec.desiredState to stopped")
+	}
+
+	return warnings, nil
 }
 
 func (r *EC2Instance) ValidateDelete() (admission.Warnings, error) {
@@ -50,17 +81,27 @@ func (r *EC2Instance) validateEC2Instance() (admission.Warnings, error) {
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 2. Validar Tags (não podem ter prefixo aws:)
+	// 2. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("subnetID", r.Spec.SubnetID != "", "subnetRef", optionalRef(r.Spec.SubnetRef), false); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("securityGroupIDs", len(r.Spec.SecurityGroupIDs) > 0, "securityGroupRefs", r.Spec.SecurityGroupRefs, false); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar Tags (não podem ter prefixo aws:)
 	for key := range r.Spec.Tags {
 		if regexp.MustCompile(`^aws:`).MatchString(key) {
 			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 4. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete", "Stop")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
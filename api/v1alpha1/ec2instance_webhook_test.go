@@ -44,4 +44,29 @@ var _ = Describe("Uec2instance Webhook", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("ValidateUpdate", func() {
+		It("should warn when a field requiring replacement changes", func() {
+			old := obj.DeepCopy()
+			old.Spec.ImageID = "ami-old"
+			obj.Spec.ImageID = "ami-new"
+			warnings, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.imageID")))
+		})
+
+		It("should warn when the instance type changes without allowRestart", func() {
+			old := obj.DeepCopy()
+			old.Spec.InstanceType = "t3.micro"
+			obj.Spec.InstanceType = "t3.large"
+			warnings, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.allowRestart")))
+
+			obj.Spec.AllowRestart = true
+			warnings, err = obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(ContainElement(ContainSubstring("spec.allowRestart")))
+		})
+	})
 })
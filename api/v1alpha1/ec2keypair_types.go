@@ -26,7 +26,7 @@ type EC2KeyPairSpec struct {
 
 	// DeletionPolicy define o comportamento ao deletar o recurso
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
@@ -28,8 +28,14 @@ type ECRRepositorySpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens when CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 type EncryptionConfiguration struct {
@@ -69,6 +75,9 @@ type ECRRepositoryStatus struct {
 
 	// Conditions represent the latest available observations
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
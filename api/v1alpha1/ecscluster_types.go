@@ -40,11 +40,15 @@ type ECSClusterSpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens to the cluster when the CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // CapacityProviderStrategyItem represents a capacity provider strategy
@@ -155,6 +159,9 @@ type ECSClusterStatus struct {
 	// LastSyncTime is the last time the cluster was synced
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -107,13 +107,22 @@ type ElastiCacheClusterSpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain;Snapshot
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan;Snapshot
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
 	// FinalSnapshotIdentifier (required if DeletionPolicy=Snapshot)
 	// +optional
 	FinalSnapshotIdentifier string `json:"finalSnapshotIdentifier,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // ElastiCacheClusterStatus defines the observed state of ElastiCacheCluster
@@ -169,6 +178,9 @@ type ElastiCacheClusterStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // CacheEndpoint defines a cache endpoint
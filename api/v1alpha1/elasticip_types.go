@@ -33,11 +33,15 @@ type ElasticIPSpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens to the EIP when the CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // ElasticIPStatus defines the observed state of ElasticIP
@@ -77,6 +81,9 @@ type ElasticIPStatus struct {
 	// LastSyncTime is the last time the EIP was synced
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
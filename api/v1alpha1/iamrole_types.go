@@ -51,6 +51,10 @@ type IAMRoleSpec struct {
 	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // InlinePolicySpec defines an inline policy
@@ -86,6 +90,9 @@ type IAMRoleStatus struct {
 	// Message provides additional information about the role status
 	// +optional
 	Message string `json:"message,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -11,8 +11,12 @@ type InternetGatewaySpec struct {
 	ProviderRef ProviderReference `json:"providerRef"`
 
 	// VpcID is the ID of the VPC to attach to
-	// +kubebuilder:validation:Required
-	VpcID string `json:"vpcID"`
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// VpcRef references a VPC in the same namespace; mutually exclusive with VpcID
+	// +optional
+	VpcRef *ResourceReference `json:"vpcRef,omitempty"`
 
 	// Tags to apply to the internet gateway
 	// +optional
@@ -20,9 +24,13 @@ type InternetGatewaySpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // InternetGatewayStatus defines the observed state of InternetGateway
@@ -46,6 +54,9 @@ type InternetGatewayStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -50,17 +50,24 @@ func (r *InternetGateway) validateInternetGateway() (admission.Warnings, error)
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 2. Validar Tags (não podem ter prefixo aws:)
+	// 2. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("vpcID", r.Spec.VpcID != "", "vpcRef", optionalRef(r.Spec.VpcRef), true); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar Tags (não podem ter prefixo aws:)
 	for key := range r.Spec.Tags {
 		if regexp.MustCompile(`^aws:`).MatchString(key) {
 			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 4. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
@@ -48,7 +48,7 @@ type KMSKeySpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Retain
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
@@ -58,6 +58,10 @@ type KMSKeySpec struct {
 	// +kubebuilder:validation:Maximum=30
 	// +kubebuilder:default=30
 	PendingWindowInDays int32 `json:"pendingWindowInDays,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // KMSKeyStatus defines the observed state of KMSKey
@@ -97,6 +101,9 @@ type KMSKeyStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
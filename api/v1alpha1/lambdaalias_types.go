@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LambdaAliasSpec defines the desired state of LambdaAlias
+type LambdaAliasSpec struct {
+	// ProviderRef references the AWSProvider for authentication
+	// +kubebuilder:validation:Required
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// FunctionName is the name or ARN of the Lambda function
+	// +optional
+	FunctionName string `json:"functionName,omitempty"`
+
+	// FunctionRef references a LambdaFunction in the same namespace; mutually exclusive with FunctionName
+	// +optional
+	FunctionRef *ResourceReference `json:"functionRef,omitempty"`
+
+	// Name is the alias name (e.g. live)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	Name string `json:"name"`
+
+	// FunctionVersion is the version the alias points to. With FunctionRef it
+	// defaults to the latest version published by the LambdaFunction.
+	// +optional
+	FunctionVersion string `json:"functionVersion,omitempty"`
+
+	// Description of the alias
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// RoutingConfig sends a share of the traffic to a second version
+	// +optional
+	RoutingConfig *LambdaAliasRoutingConfig `json:"routingConfig,omitempty"`
+
+	// TrafficShift moves the traffic to a new FunctionVersion progressively
+	// instead of all at once; mutually exclusive with RoutingConfig
+	// +optional
+	TrafficShift *LambdaTrafficShift `json:"trafficShift,omitempty"`
+
+	// DeletionPolicy determines what happens when the CR is deleted
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// LambdaAliasRoutingConfig routes a percentage of the traffic to an additional version
+type LambdaAliasRoutingConfig struct {
+	// AdditionalVersion receives Weight percent of the traffic
+	// +kubebuilder:validation:Required
+	AdditionalVersion string `json:"additionalVersion"`
+
+	// Weight is the percentage of traffic sent to AdditionalVersion
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	Weight int32 `json:"weight"`
+}
+
+// LambdaTrafficShift shifts the traffic to a new version in steps
+type LambdaTrafficShift struct {
+	// Steps are the increasing percentages of traffic sent to the new version
+	// before it gets all of it (e.g. [10, 50])
+	// +kubebuilder:validation:MinItems=1
+	Steps []int32 `json:"steps"`
+
+	// Interval is the time spent on each step, at least 1m (e.g. 10m)
+	// +kubebuilder:validation:Required
+	Interval string `json:"interval"`
+
+	// AlarmNames are CloudWatch alarms that roll the shift back to the previous
+	// version when any of them is in ALARM
+	// +optional
+	AlarmNames []string `json:"alarmNames,omitempty"`
+}
+
+// LambdaTrafficShiftStatus is the state of the last traffic shift
+type LambdaTrafficShiftStatus struct {
+	// StableVersion is the version the traffic is shifted from
+	StableVersion string `json:"stableVersion"`
+
+	// TargetVersion is the version the traffic is shifted to
+	TargetVersion string `json:"targetVersion"`
+
+	// Step is the index of the current step
+	Step int32 `json:"step"`
+
+	// Phase is Progressing, Completed or RolledBack
+	Phase string `json:"phase"`
+
+	// Message explains the phase, e.g. the alarm that caused a rollback
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is when the shift last moved to a step or phase
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+}
+
+// LambdaAliasStatus defines the observed state of LambdaAlias
+type LambdaAliasStatus struct {
+	// Ready indicates if the alias exists
+	Ready bool `json:"ready"`
+
+	// AliasArn is the ARN of the alias
+	// +optional
+	AliasArn string `json:"aliasArn,omitempty"`
+
+	// FunctionName is the resolved function name
+	// +optional
+	FunctionName string `json:"functionName,omitempty"`
+
+	// FunctionVersion is the version the alias points to
+	// +optional
+	FunctionVersion string `json:"functionVersion,omitempty"`
+
+	// AdditionalVersion receives AdditionalVersionWeight percent of the traffic
+	// +optional
+	AdditionalVersion string `json:"additionalVersion,omitempty"`
+
+	// AdditionalVersionWeight is the percentage of traffic sent to AdditionalVersion
+	// +optional
+	AdditionalVersionWeight int32 `json:"additionalVersionWeight,omitempty"`
+
+	// TrafficShift is the state of the last traffic shift
+	// +optional
+	TrafficShift *LambdaTrafficShiftStatus `json:"trafficShift,omitempty"`
+
+	// LastSyncTime is when the alias was last synced
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lalias
+// +kubebuilder:printcolumn:name="Alias",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.functionVersion`
+// +kubebuilder:printcolumn:name="Additional",type=string,JSONPath=`.status.additionalVersion`
+// +kubebuilder:printcolumn:name="Weight",type=integer,JSONPath=`.status.additionalVersionWeight`
+// +kubebuilder:printcolumn:name="Shift",type=string,JSONPath=`.status.trafficShift.phase`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LambdaAlias is the Schema for the lambdaaliases API
+type LambdaAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LambdaAliasSpec   `json:"spec,omitempty"`
+	Status LambdaAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LambdaAliasList contains a list of LambdaAlias
+type LambdaAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LambdaAlias `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LambdaAlias{}, &LambdaAliasList{})
+}
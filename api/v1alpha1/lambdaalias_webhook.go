@@ -0,0 +1,128 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var lambdaaliaslog = logf.Log.WithName("lambdaalias-resource")
+
+func (r *LambdaAlias) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-lambdaalias,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=lambdaaliases,verbs=create;update,versions=v1alpha1,name=vlambdaalias.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &LambdaAlias{}
+
+func (r *LambdaAlias) ValidateCreate() (admission.Warnings, error) {
+	lambdaaliaslog.Info("validate create", "name", r.Name)
+	return r.validateLambdaAlias()
+}
+
+func (r *LambdaAlias) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	lambdaaliaslog.Info("validate update", "name", r.Name)
+
+	oldAlias := old.(*LambdaAlias)
+
+	// Campos imutáveis
+	if r.Spec.Name != oldAlias.Spec.Name {
+		return nil, fmt.Errorf("spec.name is immutable")
+	}
+	if r.Spec.FunctionName != oldAlias.Spec.FunctionName || refChanged(oldAlias.Spec.FunctionRef, r.Spec.FunctionRef) {
+		return nil, fmt.Errorf("spec.functionName and spec.functionRef are immutable")
+	}
+
+	return r.validateLambdaAlias()
+}
+
+func (r *LambdaAlias) ValidateDelete() (admission.Warnings, error) {
+	lambdaaliaslog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *LambdaAlias) validateLambdaAlias() (admission.Warnings, error) {
+	var warnings admission.Warnings
+	version := regexp.MustCompile(`^[0-9]+$`)
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar função ou referência
+	if err := validateIDOrRef("functionName", r.Spec.FunctionName != "", "functionRef", optionalRef(r.Spec.FunctionRef), true); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar nome (não pode ser só números nem $LATEST)
+	if !regexp.MustCompile(`^[a-zA-Z0-9_-]{1,128}$`).MatchString(r.Spec.Name) || version.MatchString(r.Spec.Name) {
+		return nil, fmt.Errorf("spec.name must have 1-128 letters, digits, hyphens or underscores and cannot be a version number")
+	}
+
+	// 4. Validar versão (sem functionRef é obrigatória)
+	switch {
+	case r.Spec.FunctionVersion == "" && r.Spec.FunctionRef == nil:
+		return nil, fmt.Errorf("spec.functionVersion is required unless spec.functionRef is set")
+	case r.Spec.FunctionVersion == "$LATEST":
+		if r.Spec.RoutingConfig != nil || r.Spec.TrafficShift != nil {
+			return nil, fmt.Errorf("an alias pointing to $LATEST cannot use spec.routingConfig or spec.trafficShift")
+		}
+	case r.Spec.FunctionVersion != "" && !version.MatchString(r.Spec.FunctionVersion):
+		return nil, fmt.Errorf("spec.functionVersion must be a version number or $LATEST")
+	}
+
+	// 5. Validar roteamento
+	if rc := r.Spec.RoutingConfig; rc != nil {
+		if r.Spec.TrafficShift != nil {
+			return nil, fmt.Errorf("spec.routingConfig and spec.trafficShift are mutually exclusive")
+		}
+		if !version.MatchString(rc.AdditionalVersion) || rc.AdditionalVersion == r.Spec.FunctionVersion {
+			return nil, fmt.Errorf("spec.routingConfig.additionalVersion must be a version number other than spec.functionVersion")
+		}
+		if rc.Weight < 1 || rc.Weight > 99 {
+			return nil, fmt.Errorf("spec.routingConfig.weight must be between 1 and 99")
+		}
+	}
+
+	// 6. Validar traffic shift
+	if ts := r.Spec.TrafficShift; ts != nil {
+		if len(ts.Steps) == 0 {
+			return nil, fmt.Errorf("spec.trafficShift.steps must have at least one step")
+		}
+		for i, step := range ts.Steps {
+			if step < 1 || step > 99 || (i > 0 && step <= ts.Steps[i-1]) {
+				return nil, fmt.Errorf("spec.trafficShift.steps must be increasing percentages between 1 and 99")
+			}
+		}
+		interval, err := time.ParseDuration(ts.Interval)
+		if err != nil || interval < time.Minute {
+			return nil, fmt.Errorf("spec.trafficShift.interval must be a duration of at least 1m (e.g. 10m)")
+		}
+		if len(ts.AlarmNames) == 0 {
+			warnings = append(warnings, "spec.trafficShift.alarmNames not set, the shift is never rolled back automatically")
+		}
+	}
+
+	// 7. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
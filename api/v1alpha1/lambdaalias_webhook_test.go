@@ -0,0 +1,102 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("LambdaAlias Webhook", func() {
+	var obj *LambdaAlias
+
+	BeforeEach(func() {
+		obj = &LambdaAlias{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-lambdaalias",
+				Namespace: "default",
+			},
+			Spec: LambdaAliasSpec{
+				ProviderRef:     ProviderReference{Name: "test-provider"},
+				FunctionName:    "api",
+				Name:            "live",
+				FunctionVersion: "3",
+				DeletionPolicy:  "Delete",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid LambdaAlias", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should require functionVersion without functionRef", func() {
+			obj.Spec.FunctionVersion = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.FunctionName = ""
+			obj.Spec.FunctionRef = &ResourceReference{Name: "api"}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject numeric alias names", func() {
+			obj.Spec.Name = "12"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject routing to the same version", func() {
+			obj.Spec.RoutingConfig = &LambdaAliasRoutingConfig{AdditionalVersion: "3", Weight: 10}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject routing together with a traffic shift", func() {
+			obj.Spec.RoutingConfig = &LambdaAliasRoutingConfig{AdditionalVersion: "4", Weight: 10}
+			obj.Spec.TrafficShift = &LambdaTrafficShift{Steps: []int32{10, 50}, Interval: "5m"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject decreasing steps and short intervals", func() {
+			obj.Spec.TrafficShift = &LambdaTrafficShift{Steps: []int32{50, 10}, Interval: "5m"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.TrafficShift = &LambdaTrafficShift{Steps: []int32{10, 50}, Interval: "30s"}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should warn when a traffic shift has no alarms", func() {
+			obj.Spec.TrafficShift = &LambdaTrafficShift{Steps: []int32{10, 50}, Interval: "5m"}
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing the version", func() {
+			old := obj.DeepCopy()
+			obj.Spec.FunctionVersion = "4"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing the alias name", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Name = "staging"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
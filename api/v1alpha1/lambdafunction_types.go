@@ -45,9 +45,28 @@ type LambdaFunctionSpec struct {
 	// Tags for the Lambda function
 	Tags map[string]string `json:"tags,omitempty"`
 
+	// Publish publishes a new version whenever the code or configuration changes,
+	// so LambdaAliases can route traffic between versions
+	// +optional
+	Publish bool `json:"publish,omitempty"`
+
+	// EventSources are queues and streams that invoke the function
+	// +optional
+	EventSources []LambdaEventSource `json:"eventSources,omitempty"`
+
+	// Permissions allow AWS services or accounts to invoke the function
+	// +optional
+	Permissions []LambdaPermission `json:"permissions,omitempty"`
+
 	// DeletionPolicy determines what happens when the CR is deleted
-	// Valid values: Delete (default), Retain
+	// Valid values: Delete (default), Retain, Orphan
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // LambdaCode defines how the function code is provided
@@ -66,6 +85,36 @@ type LambdaCode struct {
 
 	// ImageUri for container image (e.g., ECR)
 	ImageUri string `json:"imageUri,omitempty"`
+
+	// ConfigMapRef builds the deployment package from a ConfigMap in the same
+	// namespace, one file per key
+	// +optional
+	ConfigMapRef *ResourceReference `json:"configMapRef,omitempty"`
+
+	// Path builds the deployment package from a local directory; CLI only
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// OCI pulls the deployment package from an OCI artifact
+	// +optional
+	OCI *LambdaCodeOCI `json:"oci,omitempty"`
+}
+
+// LambdaCodeOCI is an OCI artifact whose layer is the deployment package,
+// as a zip, tar or tar.gz file
+type LambdaCodeOCI struct {
+	// Reference is the artifact tag or digest (e.g. ghcr.io/acme/orders:1.4.0)
+	// +kubebuilder:validation:Required
+	Reference string `json:"reference"`
+
+	// PullSecretRef references a kubernetes.io/dockerconfigjson Secret with
+	// the registry credentials
+	// +optional
+	PullSecretRef *ResourceReference `json:"pullSecretRef,omitempty"`
+
+	// PlainHTTP pulls over HTTP instead of HTTPS, for local registries
+	// +optional
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
 }
 
 // LambdaEnvironment defines environment variables
@@ -79,6 +128,120 @@ type LambdaVpcConfig struct {
 	SubnetIds        []string `json:"subnetIds"`
 }
 
+// LambdaEventSource maps an SQS queue, DynamoDB stream or Kinesis stream to the function.
+// Exactly one of EventSourceArn, SQSQueueRef and DynamoDBTableRef must be set.
+type LambdaEventSource struct {
+	// EventSourceArn is the ARN of the queue or stream
+	// +optional
+	EventSourceArn string `json:"eventSourceArn,omitempty"`
+
+	// SQSQueueRef references an SQSQueue in the same namespace
+	// +optional
+	SQSQueueRef *ResourceReference `json:"sqsQueueRef,omitempty"`
+
+	// DynamoDBTableRef references a DynamoDBTable in the same namespace whose stream is read
+	// +optional
+	DynamoDBTableRef *ResourceReference `json:"dynamoDBTableRef,omitempty"`
+
+	// BatchSize is the maximum number of records sent in one invocation
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	BatchSize int32 `json:"batchSize,omitempty"`
+
+	// MaximumBatchingWindowInSeconds is how long records are gathered before an invocation
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=300
+	MaximumBatchingWindowInSeconds int32 `json:"maximumBatchingWindowInSeconds,omitempty"`
+
+	// StartingPosition is where a stream is read from; required for streams
+	// and immutable once the mapping exists
+	// +optional
+	// +kubebuilder:validation:Enum=TRIM_HORIZON;LATEST
+	StartingPosition string `json:"startingPosition,omitempty"`
+
+	// Enabled pauses the mapping when false
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// FilterPatterns are JSON event filter patterns; records that match none are dropped
+	// +optional
+	FilterPatterns []string `json:"filterPatterns,omitempty"`
+
+	// MaximumRetryAttempts is how many times a failed stream batch is retried (-1 for no limit)
+	// +optional
+	// +kubebuilder:validation:Minimum=-1
+	// +kubebuilder:validation:Maximum=10000
+	MaximumRetryAttempts *int32 `json:"maximumRetryAttempts,omitempty"`
+
+	// OnFailure receives the records of stream batches that could not be processed.
+	// SQS queues use the dead-letter queue of their redrive policy instead.
+	// +optional
+	OnFailure *LambdaEventSourceDestination `json:"onFailure,omitempty"`
+}
+
+// LambdaEventSourceDestination is an SQS queue or SNS topic. Exactly one of
+// DestinationArn, SQSQueueRef and SNSTopicRef must be set.
+type LambdaEventSourceDestination struct {
+	// DestinationArn is the ARN of the queue or topic
+	// +optional
+	DestinationArn string `json:"destinationArn,omitempty"`
+
+	// SQSQueueRef references an SQSQueue in the same namespace
+	// +optional
+	SQSQueueRef *ResourceReference `json:"sqsQueueRef,omitempty"`
+
+	// SNSTopicRef references an SNSTopic in the same namespace
+	// +optional
+	SNSTopicRef *ResourceReference `json:"snsTopicRef,omitempty"`
+}
+
+// LambdaPermission is a statement of the function policy that allows a principal to invoke it
+type LambdaPermission struct {
+	// StatementID identifies the statement in the function policy
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_-]+$`
+	StatementID string `json:"statementId"`
+
+	// Principal is an AWS service (e.g. apigateway.amazonaws.com, sns.amazonaws.com) or account ID
+	// +kubebuilder:validation:Required
+	Principal string `json:"principal"`
+
+	// Action defaults to lambda:InvokeFunction
+	// +optional
+	Action string `json:"action,omitempty"`
+
+	// SourceArn restricts the permission to a resource, e.g. an API Gateway route or SNS topic
+	// +optional
+	SourceArn string `json:"sourceArn,omitempty"`
+
+	// SNSTopicRef sets SourceArn to the ARN of an SNSTopic in the same namespace
+	// +optional
+	SNSTopicRef *ResourceReference `json:"snsTopicRef,omitempty"`
+
+	// SourceAccount restricts the permission to resources owned by an account
+	// +optional
+	SourceAccount string `json:"sourceAccount,omitempty"`
+}
+
+// LambdaEventSourceStatus is an event source mapping managed for the function
+type LambdaEventSourceStatus struct {
+	EventSourceArn        string `json:"eventSourceArn"`
+	UUID                  string `json:"uuid,omitempty"`
+	State                 string `json:"state,omitempty"`
+	StateTransitionReason string `json:"stateTransitionReason,omitempty"`
+}
+
+// LambdaFunctionVersion is a published version of the function
+type LambdaFunctionVersion struct {
+	Version      string `json:"version"`
+	CodeSha256   string `json:"codeSha256,omitempty"`
+	Description  string `json:"description,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
 // LambdaFunctionStatus defines the observed state of LambdaFunction
 type LambdaFunctionStatus struct {
 	// Ready indicates if the function is ready
@@ -90,12 +253,29 @@ type LambdaFunctionStatus struct {
 	// Version is the published version
 	Version string `json:"version,omitempty"`
 
+	// PublishedVersion is the latest published version, with spec.publish
+	PublishedVersion string `json:"publishedVersion,omitempty"`
+
+	// Versions are the most recent published versions, newest first
+	Versions []LambdaFunctionVersion `json:"versions,omitempty"`
+
+	// EventSources are the event source mappings managed for spec.eventSources
+	EventSources []LambdaEventSourceStatus `json:"eventSources,omitempty"`
+
+	// PermissionStatementIDs are the policy statements managed for spec.permissions
+	PermissionStatementIDs []string `json:"permissionStatementIds,omitempty"`
+
 	// LastModified timestamp
 	LastModified string `json:"lastModified,omitempty"`
 
 	// CodeSize in bytes
 	CodeSize int64 `json:"codeSize,omitempty"`
 
+	// CodeHash is the SHA-256 of the deployed package (or of the OCI manifest
+	// digest, S3 object or image reference); the code is only uploaded again
+	// when it changes
+	CodeHash string `json:"codeHash,omitempty"`
+
 	// State is the current state (Pending, Active, Inactive, Failed)
 	State string `json:"state,omitempty"`
 
@@ -107,6 +287,9 @@ type LambdaFunctionStatus struct {
 
 	// Conditions represent the latest available observations
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -115,6 +298,7 @@ type LambdaFunctionStatus struct {
 // +kubebuilder:printcolumn:name="Function",type=string,JSONPath=`.spec.functionName`
 // +kubebuilder:printcolumn:name="Runtime",type=string,JSONPath=`.spec.runtime`
 // +kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+// +kubebuilder:printcolumn:name="Published",type=string,JSONPath=`.status.publishedVersion`
 // +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
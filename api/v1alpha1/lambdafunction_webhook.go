@@ -5,8 +5,10 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -57,10 +59,110 @@ func (r *LambdaFunction) validateLambdaFunction() (admission.Warnings, error) {
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 3. Validar code (uma única origem; path só existe na CLI)
+	code := r.Spec.Code
+	sourcesSet := 0
+	for _, ok := range []bool{code.ZipFile != "", code.S3Bucket != "", code.ImageUri != "", code.ConfigMapRef != nil, code.Path != "", code.OCI != nil} {
+		if ok {
+			sourcesSet++
+		}
+	}
+	if sourcesSet > 1 {
+		return nil, fmt.Errorf("spec.code accepts only one of zipFile, s3Bucket, imageUri, configMapRef, path or oci")
+	}
+	if code.Path != "" {
+		return nil, fmt.Errorf("spec.code.path is only supported by the CLI; use spec.code.configMapRef or spec.code.oci")
+	}
+	if code.OCI != nil && !regexp.MustCompile(`^[^\s@]+(:[\w][\w.-]*|@sha256:[a-f0-9]{64})$`).MatchString(code.OCI.Reference) {
+		return nil, fmt.Errorf("spec.code.oci.reference must include a tag or sha256 digest: %s", code.OCI.Reference)
+	}
+
+	// 4. Validar event sources
+	sources := map[string]bool{}
+	for i, src := range r.Spec.EventSources {
+		field := fmt.Sprintf("spec.eventSources[%d]", i)
+
+		set := 0
+		for _, ok := range []bool{src.EventSourceArn != "", src.SQSQueueRef != nil, src.DynamoDBTableRef != nil} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return nil, fmt.Errorf("%s requires exactly one of eventSourceArn, sqsQueueRef or dynamoDBTableRef", field)
+		}
+
+		// Cada origem só pode ser mapeada uma vez
+		source := src.EventSourceArn
+		switch {
+		case src.SQSQueueRef != nil:
+			source = "SQSQueue/" + src.SQSQueueRef.Name
+		case src.DynamoDBTableRef != nil:
+			source = "DynamoDBTable/" + src.DynamoDBTableRef.Name
+		}
+		if sources[source] {
+			return nil, fmt.Errorf("%s maps an event source that is already mapped", field)
+		}
+		sources[source] = true
+
+		// Streams precisam de startingPosition; filas usam a DLQ da redrive policy
+		stream := src.DynamoDBTableRef != nil || strings.Contains(src.EventSourceArn, ":dynamodb:") || strings.Contains(src.EventSourceArn, ":kinesis:")
+		if stream && src.StartingPosition == "" {
+			return nil, fmt.Errorf("%s.startingPosition is required for streams", field)
+		}
+		if !stream {
+			if src.StartingPosition != "" {
+				return nil, fmt.Errorf("%s.startingPosition is only supported for streams", field)
+			}
+			if src.OnFailure != nil || src.MaximumRetryAttempts != nil {
+				return nil, fmt.Errorf("%s.onFailure and maximumRetryAttempts are only supported for streams; use the redrive policy of the queue", field)
+			}
+		}
+
+		if dest := src.OnFailure; dest != nil {
+			set := 0
+			for _, ok := range []bool{dest.DestinationArn != "", dest.SQSQueueRef != nil, dest.SNSTopicRef != nil} {
+				if ok {
+					set++
+				}
+			}
+			if set != 1 {
+				return nil, fmt.Errorf("%s.onFailure requires exactly one of destinationArn, sqsQueueRef or snsTopicRef", field)
+			}
+		}
+
+		for j, pattern := range src.FilterPatterns {
+			if !json.Valid([]byte(pattern)) {
+				return nil, fmt.Errorf("%s.filterPatterns[%d] must be a JSON filter pattern", field, j)
+			}
+		}
+	}
+
+	// 5. Validar permissions
+	statements := map[string]bool{}
+	for i, p := range r.Spec.Permissions {
+		if p.StatementID == "" || p.Principal == "" {
+			return nil, fmt.Errorf("spec.permissions[%d] requires statementId and principal", i)
+		}
+		if statements[p.StatementID] {
+			return nil, fmt.Errorf("spec.permissions[%d].statementId %q is already used", i, p.StatementID)
+		}
+		statements[p.StatementID] = true
+
+		if err := validateIDOrRef(fmt.Sprintf("permissions[%d].sourceArn", i), p.SourceArn != "", fmt.Sprintf("permissions[%d].snsTopicRef", i), optionalRef(p.SNSTopicRef), false); err != nil {
+			return nil, err
+		}
+		if p.Principal == "*" && p.SourceArn == "" && p.SNSTopicRef == nil && p.SourceAccount == "" {
+			warnings = append(warnings, fmt.Sprintf("spec.permissions[%d] allows anyone to invoke the function; set sourceArn or sourceAccount", i))
+		}
+	}
+
+	// 6. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
@@ -43,5 +43,82 @@ var _ = Describe("Ulambdafunction Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should accept code from an OCI artifact", func() {
+			obj.Spec.Code = LambdaCode{OCI: &LambdaCodeOCI{Reference: "ghcr.io/acme/orders:1.4.0"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject an OCI reference without tag or digest", func() {
+			obj.Spec.Code = LambdaCode{OCI: &LambdaCodeOCI{Reference: "ghcr.io/acme/orders"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject more than one code source", func() {
+			obj.Spec.Code = LambdaCode{ZipFile: "UEsDBA==", ConfigMapRef: &ResourceReference{Name: "orders-code"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject code.path outside the CLI", func() {
+			obj.Spec.Code = LambdaCode{Path: "./src"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should accept event sources and permissions", func() {
+			obj.Spec.EventSources = []LambdaEventSource{
+				{SQSQueueRef: &ResourceReference{Name: "orders"}, BatchSize: 10, FilterPatterns: []string{`{"body":{"type":["order"]}}`}},
+				{DynamoDBTableRef: &ResourceReference{Name: "orders"}, StartingPosition: "LATEST", OnFailure: &LambdaEventSourceDestination{SNSTopicRef: &ResourceReference{Name: "failures"}}},
+			}
+			obj.Spec.Permissions = []LambdaPermission{
+				{StatementID: "sns", Principal: "sns.amazonaws.com", SNSTopicRef: &ResourceReference{Name: "events"}},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject an event source with both an ARN and a reference", func() {
+			obj.Spec.EventSources = []LambdaEventSource{
+				{EventSourceArn: "arn:aws:sqs:us-east-1:123456789012:orders", SQSQueueRef: &ResourceReference{Name: "orders"}},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require startingPosition for streams", func() {
+			obj.Spec.EventSources = []LambdaEventSource{
+				{EventSourceArn: "arn:aws:kinesis:us-east-1:123456789012:stream/clicks"},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject onFailure for queues", func() {
+			obj.Spec.EventSources = []LambdaEventSource{
+				{SQSQueueRef: &ResourceReference{Name: "orders"}, OnFailure: &LambdaEventSourceDestination{DestinationArn: "arn:aws:sqs:us-east-1:123456789012:dlq"}},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject invalid filter patterns", func() {
+			obj.Spec.EventSources = []LambdaEventSource{
+				{SQSQueueRef: &ResourceReference{Name: "orders"}, FilterPatterns: []string{"{body"}},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject duplicate permission statement IDs", func() {
+			obj.Spec.Permissions = []LambdaPermission{
+				{StatementID: "api", Principal: "apigateway.amazonaws.com"},
+				{StatementID: "api", Principal: "sns.amazonaws.com"},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,131 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LaunchTemplateSpec defines the desired state of LaunchTemplate
+type LaunchTemplateSpec struct {
+	// ProviderRef references the AWSProvider for credentials
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// LaunchTemplateName is the name of the launch template
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=128
+	LaunchTemplateName string `json:"launchTemplateName"`
+
+	// ImageID is the AMI of the instances
+	// +kubebuilder:validation:Required
+	ImageID string `json:"imageID"`
+
+	// InstanceType of the instances. Leave empty when Auto Scaling groups
+	// choose instance types with a mixed instances policy.
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// KeyName for SSH access
+	// +optional
+	KeyName string `json:"keyName,omitempty"`
+
+	// SecurityGroupIDs of the instances
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIDs,omitempty"`
+
+	// SecurityGroupRefs references SecurityGroups in the same namespace; mutually exclusive with SecurityGroupIDs
+	// +optional
+	SecurityGroupRefs []ResourceReference `json:"securityGroupRefs,omitempty"`
+
+	// IAMInstanceProfile is the name or ARN of the instance profile
+	// +optional
+	IAMInstanceProfile string `json:"iamInstanceProfile,omitempty"`
+
+	// UserData script (base64 encoded will be handled by controller)
+	// +optional
+	UserData string `json:"userData,omitempty"`
+
+	// BlockDeviceMappings for EBS volumes
+	// +optional
+	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
+
+	// Monitoring enables detailed CloudWatch monitoring
+	// +optional
+	Monitoring bool `json:"monitoring,omitempty"`
+
+	// EBSOptimized
+	// +optional
+	EBSOptimized bool `json:"ebsOptimized,omitempty"`
+
+	// InstanceTags are applied to the instances and volumes launched from the template
+	// +optional
+	InstanceTags map[string]string `json:"instanceTags,omitempty"`
+
+	// VersionDescription describes the versions created for spec changes
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	VersionDescription string `json:"versionDescription,omitempty"`
+
+	// UpdateDefaultVersion makes every new version the default version
+	// +kubebuilder:default=true
+	// +optional
+	UpdateDefaultVersion *bool `json:"updateDefaultVersion,omitempty"`
+
+	// Tags are custom tags for the launch template
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines what happens when the CR is deleted
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// LaunchTemplateStatus defines the observed state of LaunchTemplate
+type LaunchTemplateStatus struct {
+	// Ready indicates if the launch template exists with the latest spec
+	Ready bool `json:"ready,omitempty"`
+
+	// LaunchTemplateID is the ID of the launch template
+	LaunchTemplateID string `json:"launchTemplateID,omitempty"`
+
+	// LatestVersion is the number of the version with the current spec
+	LatestVersion int64 `json:"latestVersion,omitempty"`
+
+	// DefaultVersion is the number of the default version
+	DefaultVersion int64 `json:"defaultVersion,omitempty"`
+
+	// LastSyncTime is the last time the resource was synced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lt
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.launchTemplateName`
+// +kubebuilder:printcolumn:name="ID",type=string,JSONPath=`.status.launchTemplateID`
+// +kubebuilder:printcolumn:name="Latest",type=integer,JSONPath=`.status.latestVersion`
+// +kubebuilder:printcolumn:name="Default",type=integer,JSONPath=`.status.defaultVersion`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LaunchTemplate is the Schema for the launchtemplates API
+type LaunchTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LaunchTemplateSpec   `json:"spec,omitempty"`
+	Status LaunchTemplateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LaunchTemplateList contains a list of LaunchTemplate
+type LaunchTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LaunchTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LaunchTemplate{}, &LaunchTemplateList{})
+}
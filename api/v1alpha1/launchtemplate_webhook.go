@@ -0,0 +1,105 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var launchtemplatelog = logf.Log.WithName("launchtemplate-resource")
+
+func (r *LaunchTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-launchtemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=launchtemplates,verbs=create;update,versions=v1alpha1,name=vlaunchtemplate.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &LaunchTemplate{}
+
+func (r *LaunchTemplate) ValidateCreate() (admission.Warnings, error) {
+	launchtemplatelog.Info("validate create", "name", r.Name)
+	return r.validateLaunchTemplate()
+}
+
+func (r *LaunchTemplate) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	launchtemplatelog.Info("validate update", "name", r.Name)
+
+	oldLT := old.(*LaunchTemplate)
+
+	// O nome identifica o launch template na AWS; os demais campos geram uma nova versão
+	if r.Spec.LaunchTemplateName != oldLT.Spec.LaunchTemplateName {
+		return nil, fmt.Errorf("spec.launchTemplateName is immutable")
+	}
+
+	return r.validateLaunchTemplate()
+}
+
+func (r *LaunchTemplate) ValidateDelete() (admission.Warnings, error) {
+	launchtemplatelog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *LaunchTemplate) validateLaunchTemplate() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar nome e AMI
+	if !regexp.MustCompile(`^[a-zA-Z0-9().\-/_]{3,128}$`).MatchString(r.Spec.LaunchTemplateName) {
+		return nil, fmt.Errorf("spec.launchTemplateName must have 3-128 letters, digits or ( ) . / _ - characters")
+	}
+	if !strings.HasPrefix(r.Spec.ImageID, "ami-") {
+		return nil, fmt.Errorf("spec.imageID must be an AMI ID (ami-...), got %q", r.Spec.ImageID)
+	}
+
+	// 3. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("securityGroupIDs", len(r.Spec.SecurityGroupIDs) > 0, "securityGroupRefs", r.Spec.SecurityGroupRefs, false); err != nil {
+		return nil, err
+	}
+
+	// 4. Validar volumes
+	for i, bdm := range r.Spec.BlockDeviceMappings {
+		if bdm.DeviceName == "" {
+			return nil, fmt.Errorf("spec.blockDeviceMappings[%d].deviceName is required", i)
+		}
+		if bdm.EBS != nil && bdm.EBS.IOPS > 0 && bdm.EBS.VolumeType != "io1" && bdm.EBS.VolumeType != "io2" && bdm.EBS.VolumeType != "gp3" {
+			return nil, fmt.Errorf("spec.blockDeviceMappings[%d].ebs.iops requires volumeType io1, io2 or gp3", i)
+		}
+	}
+
+	// 5. Validar Tags (não podem ter prefixo aws:)
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+	for key := range r.Spec.InstanceTags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("instance tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// 6. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
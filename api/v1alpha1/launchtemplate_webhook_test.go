@@ -0,0 +1,94 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("LaunchTemplate Webhook", func() {
+	var obj *LaunchTemplate
+
+	BeforeEach(func() {
+		obj = &LaunchTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-launchtemplate",
+				Namespace: "default",
+			},
+			Spec: LaunchTemplateSpec{
+				ProviderRef:        ProviderReference{Name: "test-provider"},
+				LaunchTemplateName: "web-nodes",
+				ImageID:            "ami-0123456789abcdef0",
+				InstanceType:       "t3.medium",
+				DeletionPolicy:     "Delete",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid LaunchTemplate", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should reject invalid names", func() {
+			obj.Spec.LaunchTemplateName = "web nodes"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an image that is not an AMI", func() {
+			obj.Spec.ImageID = "snap-0123456789abcdef0"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject securityGroupIDs together with securityGroupRefs", func() {
+			obj.Spec.SecurityGroupIDs = []string{"sg-12345678"}
+			obj.Spec.SecurityGroupRefs = []ResourceReference{{Name: "web"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.SecurityGroupIDs = nil
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject IOPS on volume types without provisioned IOPS", func() {
+			obj.Spec.BlockDeviceMappings = []BlockDeviceMapping{{
+				DeviceName: "/dev/xvda",
+				EBS:        &EBSBlockDevice{VolumeSize: 20, VolumeType: "gp2", IOPS: 3000},
+			}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject instance tags with aws: prefix", func() {
+			obj.Spec.InstanceTags = map[string]string{"aws:reserved": "x"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing the launch template data", func() {
+			old := obj.DeepCopy()
+			obj.Spec.ImageID = "ami-0fedcba9876543210"
+			obj.Spec.InstanceType = "t3.large"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing launchTemplateName", func() {
+			old := obj.DeepCopy()
+			obj.Spec.LaunchTemplateName = "api-nodes"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,236 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListenerSpec defines the desired state of Listener
+type ListenerSpec struct {
+	// ProviderRef references the AWSProvider for credentials
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// LoadBalancerARN is the ARN of the ALB or NLB
+	// +optional
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// ALBRef references an ALB in the same namespace; mutually exclusive with LoadBalancerARN and NLBRef
+	// +optional
+	ALBRef *ResourceReference `json:"albRef,omitempty"`
+
+	// NLBRef references an NLB in the same namespace; mutually exclusive with LoadBalancerARN and ALBRef
+	// +optional
+	NLBRef *ResourceReference `json:"nlbRef,omitempty"`
+
+	// Protocol is HTTP or HTTPS for ALBs and TCP, TLS, UDP or TCP_UDP for NLBs
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// Port the listener accepts connections on
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// SslPolicy of HTTPS and TLS listeners
+	// +kubebuilder:default=ELBSecurityPolicy-TLS13-1-2-2021-06
+	// +optional
+	SslPolicy string `json:"sslPolicy,omitempty"`
+
+	// CertificateARNs are the ACM certificates of HTTPS and TLS listeners. The
+	// first one is the default certificate, the others are served by SNI.
+	// +optional
+	CertificateARNs []string `json:"certificateARNs,omitempty"`
+
+	// CertificateRefs references Certificates in the same namespace; mutually exclusive with CertificateARNs
+	// +optional
+	CertificateRefs []ResourceReference `json:"certificateRefs,omitempty"`
+
+	// DefaultActions run when no rule matches
+	// +kubebuilder:validation:MinItems=1
+	DefaultActions []ListenerAction `json:"defaultActions"`
+
+	// Rules route requests by host, path, method or source IP (ALB only).
+	// Rules on the listener that are not declared here are deleted.
+	// +optional
+	Rules []ListenerRule `json:"rules,omitempty"`
+
+	// Tags are custom tags for the listener
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines what happens when the CR is deleted
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// ListenerAction is what a listener or rule does with a request
+type ListenerAction struct {
+	// Type of the action; redirect and fixed-response are ALB only
+	// +kubebuilder:validation:Enum=forward;redirect;fixed-response
+	Type string `json:"type"`
+
+	// TargetGroups receive the requests of a forward action, by weight when
+	// there is more than one
+	// +optional
+	TargetGroups []ListenerTargetGroup `json:"targetGroups,omitempty"`
+
+	// Redirect configures a redirect action
+	// +optional
+	Redirect *ListenerRedirect `json:"redirect,omitempty"`
+
+	// FixedResponse configures a fixed-response action
+	// +optional
+	FixedResponse *ListenerFixedResponse `json:"fixedResponse,omitempty"`
+}
+
+// ListenerTargetGroup is a target group of a forward action
+type ListenerTargetGroup struct {
+	// TargetGroupARN is the ARN of the target group
+	// +optional
+	TargetGroupARN string `json:"targetGroupARN,omitempty"`
+
+	// TargetGroupRef references a TargetGroup in the same namespace; mutually exclusive with TargetGroupARN
+	// +optional
+	TargetGroupRef *ResourceReference `json:"targetGroupRef,omitempty"`
+
+	// Weight of the target group when forwarding to more than one
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// ListenerRedirect redirects requests. Empty fields keep the value of the request.
+type ListenerRedirect struct {
+	// Protocol is HTTP or HTTPS
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// Host to redirect to
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Port to redirect to
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// Path to redirect to, starting with /
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Query to redirect to, without the leading ?
+	// +optional
+	Query string `json:"query,omitempty"`
+
+	// StatusCode is HTTP_301 (permanent) or HTTP_302 (temporary)
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	StatusCode string `json:"statusCode"`
+}
+
+// ListenerFixedResponse answers requests without forwarding them
+type ListenerFixedResponse struct {
+	// StatusCode of the response (2XX, 4XX or 5XX)
+	// +kubebuilder:validation:Pattern=`^(2|4|5)\d\d$`
+	StatusCode string `json:"statusCode"`
+
+	// ContentType of the response
+	// +kubebuilder:validation:Enum=text/plain;text/css;text/html;application/javascript;application/json
+	// +optional
+	ContentType string `json:"contentType,omitempty"`
+
+	// MessageBody of the response
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	MessageBody string `json:"messageBody,omitempty"`
+}
+
+// ListenerRule routes the requests matching all its conditions
+type ListenerRule struct {
+	// Priority of the rule; lower values are evaluated first
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50000
+	Priority int32 `json:"priority"`
+
+	// Conditions the request must match. Each set condition must match one of its values.
+	Conditions ListenerRuleConditions `json:"conditions"`
+
+	// Actions run on matching requests
+	// +kubebuilder:validation:MinItems=1
+	Actions []ListenerAction `json:"actions"`
+}
+
+// ListenerRuleConditions are the conditions of a listener rule
+type ListenerRuleConditions struct {
+	// HostHeaders match the Host header; wildcards * and ? are allowed
+	// +optional
+	HostHeaders []string `json:"hostHeaders,omitempty"`
+
+	// PathPatterns match the request path; wildcards * and ? are allowed
+	// +optional
+	PathPatterns []string `json:"pathPatterns,omitempty"`
+
+	// HTTPRequestMethods match the request method (e.g. GET)
+	// +optional
+	HTTPRequestMethods []string `json:"httpRequestMethods,omitempty"`
+
+	// SourceIPs match the client address, in CIDR notation
+	// +optional
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+}
+
+// ListenerRuleStatus is a rule created on the listener
+type ListenerRuleStatus struct {
+	// Priority of the rule
+	Priority int32 `json:"priority"`
+
+	// RuleARN is the ARN of the rule
+	RuleARN string `json:"ruleARN"`
+}
+
+// ListenerStatus defines the observed state of Listener
+type ListenerStatus struct {
+	// Ready indicates if the listener exists
+	Ready bool `json:"ready,omitempty"`
+
+	// ListenerARN is the ARN of the listener
+	ListenerARN string `json:"listenerARN,omitempty"`
+
+	// LoadBalancerARN is the resolved ARN of the load balancer
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+
+	// Rules are the rules created on the listener
+	// +optional
+	Rules []ListenerRuleStatus `json:"rules,omitempty"`
+
+	// LastSyncTime is the last time the resource was synced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=lsn
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.spec.protocol`
+// +kubebuilder:printcolumn:name="Port",type=integer,JSONPath=`.spec.port`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Listener is the Schema for the listeners API
+type Listener struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ListenerSpec   `json:"spec,omitempty"`
+	Status ListenerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ListenerList contains a list of Listener
+type ListenerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Listener `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Listener{}, &ListenerList{})
+}
@@ -0,0 +1,177 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var listenerlog = logf.Log.WithName("listener-resource")
+
+func (r *Listener) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-listener,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=listeners,verbs=create;update,versions=v1alpha1,name=vlistener.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Listener{}
+
+func (r *Listener) ValidateCreate() (admission.Warnings, error) {
+	listenerlog.Info("validate create", "name", r.Name)
+	return r.validateListener()
+}
+
+func (r *Listener) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	listenerlog.Info("validate update", "name", r.Name)
+
+	oldListener := old.(*Listener)
+
+	// O load balancer é imutável
+	if r.Spec.LoadBalancerARN != oldListener.Spec.LoadBalancerARN ||
+		refChanged(oldListener.Spec.ALBRef, r.Spec.ALBRef) || refChanged(oldListener.Spec.NLBRef, r.Spec.NLBRef) {
+		return nil, fmt.Errorf("spec.loadBalancerARN, spec.albRef and spec.nlbRef are immutable")
+	}
+
+	return r.validateListener()
+}
+
+func (r *Listener) ValidateDelete() (admission.Warnings, error) {
+	listenerlog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *Listener) validateListener() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar load balancer (exatamente um entre ARN, albRef e nlbRef)
+	set := 0
+	for _, isSet := range []bool{r.Spec.LoadBalancerARN != "", r.Spec.ALBRef != nil, r.Spec.NLBRef != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("exactly one of spec.loadBalancerARN, spec.albRef or spec.nlbRef is required")
+	}
+
+	// 3. Validar protocolo conforme o tipo de load balancer
+	albProtocol := r.Spec.Protocol == "HTTP" || r.Spec.Protocol == "HTTPS"
+	if r.Spec.ALBRef != nil && !albProtocol {
+		return nil, fmt.Errorf("spec.protocol must be HTTP or HTTPS for an ALB, got %q", r.Spec.Protocol)
+	}
+	if r.Spec.NLBRef != nil && albProtocol {
+		return nil, fmt.Errorf("spec.protocol must be TCP, TLS, UDP or TCP_UDP for an NLB, got %q", r.Spec.Protocol)
+	}
+
+	// 4. Validar certificados (obrigatórios para HTTPS e TLS)
+	tls := r.Spec.Protocol == "HTTPS" || r.Spec.Protocol == "TLS"
+	if err := validateIDOrRef("certificateARNs", len(r.Spec.CertificateARNs) > 0, "certificateRefs", r.Spec.CertificateRefs, tls); err != nil {
+		return nil, err
+	}
+	if !tls && (len(r.Spec.CertificateARNs) > 0 || len(r.Spec.CertificateRefs) > 0) {
+		return nil, fmt.Errorf("certificates are only valid for HTTPS and TLS listeners")
+	}
+
+	// 5. Validar ações padrão
+	if len(r.Spec.DefaultActions) == 0 {
+		return nil, fmt.Errorf("spec.defaultActions must have at least one action")
+	}
+	if err := validateListenerActions("defaultActions", r.Spec.DefaultActions, albProtocol); err != nil {
+		return nil, err
+	}
+
+	// 6. Validar regras (somente ALB)
+	if len(r.Spec.Rules) > 0 && !albProtocol {
+		return nil, fmt.Errorf("spec.rules are only supported on HTTP and HTTPS listeners")
+	}
+	priorities := map[int32]bool{}
+	for i, rule := range r.Spec.Rules {
+		path := fmt.Sprintf("rules[%d]", i)
+		if priorities[rule.Priority] {
+			return nil, fmt.Errorf("spec.%s.priority %d is used by more than one rule", path, rule.Priority)
+		}
+		priorities[rule.Priority] = true
+
+		c := rule.Conditions
+		if len(c.HostHeaders) == 0 && len(c.PathPatterns) == 0 && len(c.HTTPRequestMethods) == 0 && len(c.SourceIPs) == 0 {
+			return nil, fmt.Errorf("spec.%s.conditions must set hostHeaders, pathPatterns, httpRequestMethods or sourceIPs", path)
+		}
+		if len(rule.Actions) == 0 {
+			return nil, fmt.Errorf("spec.%s.actions must have at least one action", path)
+		}
+		if err := validateListenerActions(path+".actions", rule.Actions, true); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Validar Tags (não podem ter prefixo aws:)
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// 8. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
+
+// validateListenerActions valida a configuração de cada ação conforme o tipo;
+// redirect e fixed-response só existem em ALB
+func validateListenerActions(field string, actions []ListenerAction, alb bool) error {
+	for i, action := range actions {
+		path := fmt.Sprintf("%s[%d]", field, i)
+
+		switch action.Type {
+		case "forward":
+			if len(action.TargetGroups) == 0 {
+				return fmt.Errorf("spec.%s.targetGroups is required for forward actions", path)
+			}
+			weighted := false
+			for j, tg := range action.TargetGroups {
+				tgPath := fmt.Sprintf("%s.targetGroups[%d]", path, j)
+				if err := validateIDOrRef(tgPath+".targetGroupARN", tg.TargetGroupARN != "", tgPath+".targetGroupRef", optionalRef(tg.TargetGroupRef), true); err != nil {
+					return err
+				}
+				weighted = weighted || tg.Weight > 0
+			}
+			if len(action.TargetGroups) > 1 && !weighted {
+				return fmt.Errorf("spec.%s.targetGroups needs a weight above 0 when forwarding to more than one target group", path)
+			}
+		case "redirect", "fixed-response":
+			if !alb {
+				return fmt.Errorf("spec.%s.type %s is only supported on HTTP and HTTPS listeners", path, action.Type)
+			}
+			if action.Type == "redirect" && action.Redirect == nil {
+				return fmt.Errorf("spec.%s.redirect is required for redirect actions", path)
+			}
+			if action.Type == "fixed-response" && action.FixedResponse == nil {
+				return fmt.Errorf("spec.%s.fixedResponse is required for fixed-response actions", path)
+			}
+		default:
+			return fmt.Errorf("spec.%s.type must be forward, redirect or fixed-response, got %q", path, action.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Listener Webhook", func() {
+	var obj *Listener
+
+	BeforeEach(func() {
+		obj = &Listener{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-listener",
+				Namespace: "default",
+			},
+			Spec: ListenerSpec{
+				ProviderRef: ProviderReference{Name: "test-provider"},
+				ALBRef:      &ResourceReference{Name: "web"},
+				Protocol:    "HTTP",
+				Port:        80,
+				DefaultActions: []ListenerAction{{
+					Type:         "forward",
+					TargetGroups: []ListenerTargetGroup{{TargetGroupRef: &ResourceReference{Name: "api"}}},
+				}},
+				DeletionPolicy: "Delete",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid Listener", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should require exactly one load balancer", func() {
+			obj.Spec.LoadBalancerARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/web/abc"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.ALBRef = nil
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject TCP listeners on an ALB", func() {
+			obj.Spec.Protocol = "TCP"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require a certificate for HTTPS", func() {
+			obj.Spec.Protocol = "HTTPS"
+			obj.Spec.Port = 443
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.CertificateRefs = []ResourceReference{{Name: "web"}}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject certificates on HTTP listeners", func() {
+			obj.Spec.CertificateARNs = []string{"arn:aws:acm:us-east-1:123456789012:certificate/abc"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require the configuration of the action type", func() {
+			obj.Spec.DefaultActions = []ListenerAction{{Type: "redirect"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.DefaultActions[0].Redirect = &ListenerRedirect{Protocol: "HTTPS", Port: "443", StatusCode: "HTTP_301"}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should require a weight when forwarding to several target groups", func() {
+			obj.Spec.DefaultActions[0].TargetGroups = append(obj.Spec.DefaultActions[0].TargetGroups,
+				ListenerTargetGroup{TargetGroupRef: &ResourceReference{Name: "canary"}})
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.DefaultActions[0].TargetGroups[0].Weight = 90
+			obj.Spec.DefaultActions[0].TargetGroups[1].Weight = 10
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject rules with duplicate priorities or no conditions", func() {
+			rule := ListenerRule{
+				Priority:   10,
+				Conditions: ListenerRuleConditions{PathPatterns: []string{"/api/*"}},
+				Actions:    obj.Spec.DefaultActions,
+			}
+			obj.Spec.Rules = []ListenerRule{rule, rule}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.Rules = []ListenerRule{{Priority: 10, Actions: obj.Spec.DefaultActions}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject rules and redirects on NLB listeners", func() {
+			obj.Spec.ALBRef = nil
+			obj.Spec.NLBRef = &ResourceReference{Name: "tcp"}
+			obj.Spec.Protocol = "TCP"
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Rules = []ListenerRule{{
+				Priority:   10,
+				Conditions: ListenerRuleConditions{PathPatterns: []string{"/api/*"}},
+				Actions:    obj.Spec.DefaultActions,
+			}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.Rules = nil
+			obj.Spec.DefaultActions = []ListenerAction{{Type: "fixed-response", FixedResponse: &ListenerFixedResponse{StatusCode: "404"}}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing the port and rules", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Port = 8080
+			obj.Spec.Rules = []ListenerRule{{
+				Priority:   10,
+				Conditions: ListenerRuleConditions{HostHeaders: []string{"api.example.com"}},
+				Actions:    obj.Spec.DefaultActions,
+			}}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing the load balancer", func() {
+			old := obj.DeepCopy()
+			obj.Spec.ALBRef = &ResourceReference{Name: "other"}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
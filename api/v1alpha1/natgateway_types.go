@@ -11,13 +11,21 @@ type NATGatewaySpec struct {
 	ProviderRef ProviderReference `json:"providerRef"`
 
 	// SubnetID is the ID of the subnet for the NAT gateway
-	// +kubebuilder:validation:Required
-	SubnetID string `json:"subnetID"`
+	// +optional
+	SubnetID string `json:"subnetID,omitempty"`
+
+	// SubnetRef references a Subnet in the same namespace; mutually exclusive with SubnetID
+	// +optional
+	SubnetRef *ResourceReference `json:"subnetRef,omitempty"`
 
 	// AllocationID is the Elastic IP allocation ID (optional, will be created if not provided)
 	// +optional
 	AllocationID string `json:"allocationID,omitempty"`
 
+	// AllocationRef references an ElasticIP in the same namespace; mutually exclusive with AllocationID
+	// +optional
+	AllocationRef *ResourceReference `json:"allocationRef,omitempty"`
+
 	// ConnectivityType specifies if the NAT gateway is public or private
 	// +optional
 	// +kubebuilder:validation:Enum=public;private
@@ -30,9 +38,13 @@ type NATGatewaySpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // NATGatewayStatus defines the observed state of NATGateway
@@ -68,6 +80,9 @@ type NATGatewayStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
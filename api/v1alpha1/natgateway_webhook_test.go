@@ -21,6 +21,7 @@ var _ = Describe("Unatgateway Webhook", func() {
 			},
 			Spec: NATGatewaySpec{
 				ProviderRef: ProviderReference{Name: "test-provider"},
+				SubnetID:    "subnet-11111111",
 			},
 		}
 	})
@@ -43,5 +44,26 @@ var _ = Describe("Unatgateway Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should accept a reference instead of an ID", func() {
+			obj.Spec.SubnetID = ""
+			obj.Spec.SubnetRef = &ResourceReference{Name: "public-a"}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject both ID and reference", func() {
+			obj.Spec.SubnetRef = &ResourceReference{Name: "public-a"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should reject missing ID and reference", func() {
+			obj.Spec.SubnetID = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("one of spec.subnetID or spec.subnetRef"))
+		})
 	})
 })
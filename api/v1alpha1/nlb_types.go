@@ -24,7 +24,13 @@ type NLBSpec struct {
 
 	// Subnets is the list of subnet IDs (minimum 1)
 	// +kubebuilder:validation:MinItems=1
-	Subnets []string `json:"subnets"`
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SubnetRefs references Subnets in the same namespace; mutually exclusive with Subnets
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	SubnetRefs []ResourceReference `json:"subnetRefs,omitempty"`
 
 	// IPAddressType is the IP address type
 	// Valid values: ipv4, dualstack
@@ -47,11 +53,15 @@ type NLBSpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens to the NLB when the CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // NLBStatus defines the observed state of NLB
@@ -83,6 +93,9 @@ type NLBStatus struct {
 	// LastSyncTime is the last time the NLB was synced
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
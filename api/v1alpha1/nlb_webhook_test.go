@@ -21,6 +21,7 @@ var _ = Describe("Unlb Webhook", func() {
 			},
 			Spec: NLBSpec{
 				ProviderRef: ProviderReference{Name: "test-provider"},
+				Subnets:     []string{"subnet-11111111", "subnet-22222222"},
 			},
 		}
 	})
@@ -43,5 +44,26 @@ var _ = Describe("Unlb Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should accept a reference instead of an ID", func() {
+			obj.Spec.Subnets = nil
+			obj.Spec.SubnetRefs = []ResourceReference{{Name: "public-a"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject both ID and reference", func() {
+			obj.Spec.SubnetRefs = []ResourceReference{{Name: "public-a"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should reject missing ID and reference", func() {
+			obj.Spec.Subnets = nil
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("one of spec.subnets or spec.subnetRefs"))
+		})
 	})
 })
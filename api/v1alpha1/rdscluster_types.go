@@ -0,0 +1,284 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RDSClusterSpec defines the desired state of RDSCluster
+type RDSClusterSpec struct {
+	// ProviderRef references the AWSProvider to use
+	// +kubebuilder:validation:Required
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// DBClusterIdentifier is the DB cluster identifier. Instances are named
+	// <dbClusterIdentifier>-1 (writer), <dbClusterIdentifier>-2, ...
+	// +kubebuilder:validation:Required
+	DBClusterIdentifier string `json:"dbClusterIdentifier"`
+
+	// Engine is the Aurora engine
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=aurora-postgresql;aurora-mysql
+	Engine string `json:"engine"`
+
+	// EngineVersion is the version of the database engine
+	// +optional
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// MasterUsername is the master user; not used by secondary clusters of a global database
+	// +optional
+	MasterUsername string `json:"masterUsername,omitempty"`
+
+	// MasterUserPasswordSecretRef references a Secret key holding the master password
+	// +optional
+	MasterUserPasswordSecretRef *SecretReference `json:"masterUserPasswordSecretRef,omitempty"`
+
+	// MasterUserPassword is the master password (not recommended, use MasterUserPasswordSecretRef)
+	// +optional
+	MasterUserPassword string `json:"masterUserPassword,omitempty"`
+
+	// DatabaseName is the name of the database created with the cluster
+	// +optional
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// Port is the database port (default 5432 for PostgreSQL, 3306 for MySQL)
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Writer is the writer instance
+	// +kubebuilder:validation:Required
+	Writer RDSClusterInstanceSet `json:"writer"`
+
+	// Readers are the Aurora Replicas
+	// +optional
+	Readers *RDSClusterReaderSet `json:"readers,omitempty"`
+
+	// ServerlessV2Scaling is the capacity range of db.serverless instances
+	// +optional
+	ServerlessV2Scaling *RDSServerlessV2Scaling `json:"serverlessV2Scaling,omitempty"`
+
+	// GlobalClusterIdentifier makes the cluster a member of an Aurora global
+	// database. The cluster joins it as a secondary when it exists, and
+	// otherwise creates it as the primary. Removing it detaches the cluster.
+	// +optional
+	GlobalClusterIdentifier string `json:"globalClusterIdentifier,omitempty"`
+
+	// DBSubnetGroupName is the DB subnet group of the cluster
+	// +optional
+	DBSubnetGroupName string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupRef references a DBSubnetGroup in the same namespace; mutually exclusive with DBSubnetGroupName
+	// +optional
+	DBSubnetGroupRef *ResourceReference `json:"dbSubnetGroupRef,omitempty"`
+
+	// VpcSecurityGroupIDs are the VPC security groups of the cluster
+	// +optional
+	VpcSecurityGroupIDs []string `json:"vpcSecurityGroupIDs,omitempty"`
+
+	// SecurityGroupRefs reference SecurityGroups in the same namespace; mutually exclusive with VpcSecurityGroupIDs
+	// +optional
+	SecurityGroupRefs []ResourceReference `json:"securityGroupRefs,omitempty"`
+
+	// DBClusterParameterGroupName is the DB cluster parameter group
+	// +optional
+	DBClusterParameterGroupName string `json:"dbClusterParameterGroupName,omitempty"`
+
+	// StorageEncrypted enables encryption at rest
+	// +optional
+	StorageEncrypted bool `json:"storageEncrypted,omitempty"`
+
+	// KmsKeyID is the KMS key of an encrypted cluster
+	// +optional
+	KmsKeyID string `json:"kmsKeyId,omitempty"`
+
+	// DeletionProtection prevents the cluster from being deleted
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
+	// BackupRetentionPeriod is the number of days to retain backups (1-35)
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=35
+	BackupRetentionPeriod int32 `json:"backupRetentionPeriod,omitempty"`
+
+	// PreferredBackupWindow is the daily backup window (hh24:mi-hh24:mi, UTC)
+	// +optional
+	PreferredBackupWindow string `json:"preferredBackupWindow,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly maintenance window (ddd:hh24:mi-ddd:hh24:mi, UTC)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z]{3}:[0-9]{2}:[0-9]{2}-[A-Za-z]{3}:[0-9]{2}:[0-9]{2}$`
+	PreferredMaintenanceWindow string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// AllowMajorVersionUpgrade must be true to upgrade engineVersion to a new major version
+	// +optional
+	AllowMajorVersionUpgrade bool `json:"allowMajorVersionUpgrade,omitempty"`
+
+	// ApplyImmediately applies modifications now instead of during the next maintenance window
+	// +optional
+	ApplyImmediately bool `json:"applyImmediately,omitempty"`
+
+	// Tags for the cluster and its instances
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines what happens when CR is deleted. Delete skips
+	// the final snapshot, Snapshot takes one unless skipFinalSnapshot is set,
+	// and Retain or Orphan keep the database in AWS
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan;Snapshot
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// SkipFinalSnapshot if true, skips final snapshot on deletion
+	// +optional
+	SkipFinalSnapshot bool `json:"skipFinalSnapshot,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, readerHost, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
+}
+
+// RDSClusterInstanceSet configures the instances of a set
+type RDSClusterInstanceSet struct {
+	// InstanceClass is the instance class (db.r6g.large, ...) or db.serverless
+	// +kubebuilder:validation:Required
+	InstanceClass string `json:"instanceClass"`
+
+	// PubliclyAccessible gives the instances a public address
+	// +optional
+	PubliclyAccessible bool `json:"publiclyAccessible,omitempty"`
+
+	// DBParameterGroupName is the DB parameter group of the instances
+	// +optional
+	DBParameterGroupName string `json:"dbParameterGroupName,omitempty"`
+}
+
+// RDSClusterReaderSet is a set of Aurora Replicas
+type RDSClusterReaderSet struct {
+	// Count is the number of readers
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=15
+	Count int32 `json:"count"`
+
+	// InstanceClass of the readers; defaults to the writer class
+	// +optional
+	InstanceClass string `json:"instanceClass,omitempty"`
+
+	// PubliclyAccessible gives the instances a public address
+	// +optional
+	PubliclyAccessible bool `json:"publiclyAccessible,omitempty"`
+
+	// DBParameterGroupName is the DB parameter group of the instances
+	// +optional
+	DBParameterGroupName string `json:"dbParameterGroupName,omitempty"`
+}
+
+// RDSServerlessV2Scaling is the capacity range, in Aurora capacity units
+// (ACUs), of db.serverless instances
+type RDSServerlessV2Scaling struct {
+	// MinCapacity in ACUs, in steps of 0.5 (e.g. 0.5); 0 lets the instances pause
+	// +kubebuilder:validation:Required
+	MinCapacity resource.Quantity `json:"minCapacity"`
+
+	// MaxCapacity in ACUs, in steps of 0.5, from 1 to 256
+	// +kubebuilder:validation:Required
+	MaxCapacity resource.Quantity `json:"maxCapacity"`
+}
+
+// RDSClusterMember is an instance of the cluster
+type RDSClusterMember struct {
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier"`
+	InstanceClass        string `json:"instanceClass,omitempty"`
+	IsWriter             bool   `json:"isWriter,omitempty"`
+	Status               string `json:"status,omitempty"`
+}
+
+// RDSClusterStatus defines the observed state of RDSCluster
+type RDSClusterStatus struct {
+	// Ready is true when the cluster and all its instances are available
+	Ready bool `json:"ready"`
+
+	// DBClusterArn is the ARN of the cluster
+	// +optional
+	DBClusterArn string `json:"dbClusterArn,omitempty"`
+
+	// Endpoint is the cluster (writer) endpoint
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// ReaderEndpoint load-balances connections across the readers
+	// +optional
+	ReaderEndpoint string `json:"readerEndpoint,omitempty"`
+
+	// Port is the connection port
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Status is the cluster status (available, creating, modifying, etc)
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// EngineVersion is the actual engine version running
+	// +optional
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// PendingModifications are the modifications waiting for the maintenance window
+	// +optional
+	PendingModifications *RDSPendingModifications `json:"pendingModifications,omitempty"`
+
+	// WriterInstance is the current writer instance
+	// +optional
+	WriterInstance string `json:"writerInstance,omitempty"`
+
+	// Members are the instances of the cluster
+	// +optional
+	Members []RDSClusterMember `json:"members,omitempty"`
+
+	// GlobalClusterRole is primary or secondary for a member of a global database
+	// +optional
+	GlobalClusterRole string `json:"globalClusterRole,omitempty"`
+
+	// LastSyncTime is when the cluster was last synced
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rdsc
+// +kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.dbClusterIdentifier`
+// +kubebuilder:printcolumn:name="Engine",type=string,JSONPath=`.spec.engine`
+// +kubebuilder:printcolumn:name="Writer",type=string,JSONPath=`.status.writerInstance`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RDSCluster is the Schema for the rdsclusters API
+type RDSCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RDSClusterSpec   `json:"spec,omitempty"`
+	Status RDSClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RDSClusterList contains a list of RDSCluster
+type RDSClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RDSCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RDSCluster{}, &RDSClusterList{})
+}
@@ -0,0 +1,158 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var rdsclusterlog = logf.Log.WithName("rdscluster-resource")
+
+func (r *RDSCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-rdscluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=rdsclusters,verbs=create;update,versions=v1alpha1,name=vrdscluster.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RDSCluster{}
+
+func (r *RDSCluster) ValidateCreate() (admission.Warnings, error) {
+	rdsclusterlog.Info("validate create", "name", r.Name)
+
+	// Clusters secundários de um global database usam as credenciais do primário
+	if r.Spec.GlobalClusterIdentifier == "" && r.Spec.MasterUsername == "" {
+		return nil, fmt.Errorf("spec.masterUsername is required unless the cluster joins a global database")
+	}
+
+	return r.validateRDSCluster()
+}
+
+func (r *RDSCluster) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	rdsclusterlog.Info("validate update", "name", r.Name)
+
+	oldCluster := old.(*RDSCluster)
+
+	// Campos imutáveis
+	if r.Spec.DBClusterIdentifier != oldCluster.Spec.DBClusterIdentifier {
+		return nil, fmt.Errorf("spec.dbClusterIdentifier is immutable")
+	}
+	if r.Spec.Engine != oldCluster.Spec.Engine {
+		return nil, fmt.Errorf("spec.engine is immutable")
+	}
+	if r.Spec.MasterUsername != oldCluster.Spec.MasterUsername || r.Spec.DatabaseName != oldCluster.Spec.DatabaseName {
+		return nil, fmt.Errorf("spec.masterUsername and spec.databaseName are immutable")
+	}
+	if r.Spec.StorageEncrypted != oldCluster.Spec.StorageEncrypted || r.Spec.KmsKeyID != oldCluster.Spec.KmsKeyID {
+		return nil, fmt.Errorf("spec.storageEncrypted and spec.kmsKeyId are immutable")
+	}
+
+	// Global database: pode ser adicionado ou removido, mas não trocado
+	if r.Spec.GlobalClusterIdentifier != "" && oldCluster.Spec.GlobalClusterIdentifier != "" &&
+		r.Spec.GlobalClusterIdentifier != oldCluster.Spec.GlobalClusterIdentifier {
+		return nil, fmt.Errorf("spec.globalClusterIdentifier cannot be changed; remove it first to detach the cluster")
+	}
+
+	return r.validateRDSCluster()
+}
+
+func (r *RDSCluster) ValidateDelete() (admission.Warnings, error) {
+	rdsclusterlog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *RDSCluster) validateRDSCluster() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar identificador (as instâncias usam o sufixo -N)
+	if len(r.Spec.DBClusterIdentifier) > 60 || !regexp.MustCompile(`^[a-zA-Z](-?[a-zA-Z0-9])*$`).MatchString(r.Spec.DBClusterIdentifier) {
+		return nil, fmt.Errorf("spec.dbClusterIdentifier must start with a letter, contain only letters, digits and single hyphens, and have at most 60 characters")
+	}
+
+	// 3. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("vpcSecurityGroupIDs", len(r.Spec.VpcSecurityGroupIDs) > 0, "securityGroupRefs", r.Spec.SecurityGroupRefs, false); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("dbSubnetGroupName", r.Spec.DBSubnetGroupName != "", "dbSubnetGroupRef", optionalRef(r.Spec.DBSubnetGroupRef), false); err != nil {
+		return nil, err
+	}
+
+	// 4. Validar instâncias
+	if !strings.HasPrefix(r.Spec.Writer.InstanceClass, "db.") {
+		return nil, fmt.Errorf("spec.writer.instanceClass must be an instance class (e.g. db.r6g.large or db.serverless)")
+	}
+	serverless := r.Spec.Writer.InstanceClass == "db.serverless"
+	if readers := r.Spec.Readers; readers != nil {
+		if readers.InstanceClass != "" && !strings.HasPrefix(readers.InstanceClass, "db.") {
+			return nil, fmt.Errorf("spec.readers.instanceClass must be an instance class (e.g. db.r6g.large or db.serverless)")
+		}
+		if readers.Count > 0 && readers.InstanceClass == "db.serverless" {
+			serverless = true
+		}
+	}
+	if r.Spec.Readers == nil || r.Spec.Readers.Count == 0 {
+		warnings = append(warnings, "spec.readers not set, a failover has to recreate the writer instance")
+	}
+
+	// 5. Validar Serverless v2
+	if s := r.Spec.ServerlessV2Scaling; s != nil {
+		minCapacity, maxCapacity := s.MinCapacity.AsApproximateFloat64(), s.MaxCapacity.AsApproximateFloat64()
+		if !halfACU(s.MinCapacity) || !halfACU(s.MaxCapacity) {
+			return nil, fmt.Errorf("spec.serverlessV2Scaling capacities must be multiples of 0.5 ACU")
+		}
+		if maxCapacity < 1 || maxCapacity > 256 {
+			return nil, fmt.Errorf("spec.serverlessV2Scaling.maxCapacity must be between 1 and 256 ACUs")
+		}
+		if minCapacity < 0 || minCapacity > maxCapacity {
+			return nil, fmt.Errorf("spec.serverlessV2Scaling.minCapacity must be between 0 and maxCapacity")
+		}
+	} else if serverless {
+		return nil, fmt.Errorf("spec.serverlessV2Scaling is required for db.serverless instances")
+	}
+
+	// 6. Validar Tags (não podem ter prefixo aws:)
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// 7. Warnings
+	if r.Spec.GlobalClusterIdentifier != "" && (r.Spec.MasterUserPassword != "" || r.Spec.MasterUserPasswordSecretRef != nil) {
+		warnings = append(warnings, "secondary clusters of a global database use the master password of the primary")
+	}
+	if !r.Spec.StorageEncrypted {
+		warnings = append(warnings, "spec.storageEncrypted is false, encryption cannot be enabled after creation")
+	}
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete", "Snapshot")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
+
+// halfACU reports whether the capacity is a multiple of 0.5 ACU
+func halfACU(q resource.Quantity) bool {
+	doubled := q.AsApproximateFloat64() * 2
+	return doubled == math.Trunc(doubled)
+}
@@ -0,0 +1,124 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RDSCluster Webhook", func() {
+	var obj *RDSCluster
+
+	BeforeEach(func() {
+		obj = &RDSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rdscluster",
+				Namespace: "default",
+			},
+			Spec: RDSClusterSpec{
+				ProviderRef:         ProviderReference{Name: "test-provider"},
+				DBClusterIdentifier: "app-aurora",
+				Engine:              "aurora-postgresql",
+				MasterUsername:      "dbadmin",
+				Writer:              RDSClusterInstanceSet{InstanceClass: "db.r6g.large"},
+				Readers:             &RDSClusterReaderSet{Count: 1},
+				StorageEncrypted:    true,
+				DeletionPolicy:      "Retain",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid RDSCluster", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should require masterUsername unless joining a global database", func() {
+			obj.Spec.MasterUsername = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.GlobalClusterIdentifier = "app-global"
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject identifiers too long for the instance names", func() {
+			obj.Spec.DBClusterIdentifier = "a123456789a123456789a123456789a123456789a123456789a1234567890"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require serverless scaling for db.serverless", func() {
+			obj.Spec.Writer.InstanceClass = "db.serverless"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.ServerlessV2Scaling = &RDSServerlessV2Scaling{
+				MinCapacity: resource.MustParse("0.5"),
+				MaxCapacity: resource.MustParse("16"),
+			}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid serverless capacities", func() {
+			obj.Spec.ServerlessV2Scaling = &RDSServerlessV2Scaling{
+				MinCapacity: resource.MustParse("0.75"),
+				MaxCapacity: resource.MustParse("16"),
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.ServerlessV2Scaling.MinCapacity = resource.MustParse("32")
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should warn when there are no readers", func() {
+			obj.Spec.Readers = nil
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow scaling the readers", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Readers.Count = 3
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing the engine", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Engine = "aurora-mysql"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should allow adding and removing the global database, but not changing it", func() {
+			old := obj.DeepCopy()
+			obj.Spec.GlobalClusterIdentifier = "app-global"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+
+			old = obj.DeepCopy()
+			obj.Spec.GlobalClusterIdentifier = "other-global"
+			_, err = obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.GlobalClusterIdentifier = ""
+			_, err = obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
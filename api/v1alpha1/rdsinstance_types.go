@@ -20,11 +20,25 @@ type RDSInstanceSpec struct {
 	// DBInstanceClass is the compute and memory capacity (db.t3.micro, etc)
 	DBInstanceClass string `json:"dbInstanceClass"`
 
-	// AllocatedStorage in GB
+	// AllocatedStorage in GB. Storage can only grow; a smaller value is ignored
 	AllocatedStorage int32 `json:"allocatedStorage"`
 
-	// MasterUsername for the database
-	MasterUsername string `json:"masterUsername"`
+	// StorageType is the storage type (gp2, gp3, io1, io2, standard)
+	// +optional
+	// +kubebuilder:validation:Enum=gp2;gp3;io1;io2;standard
+	StorageType string `json:"storageType,omitempty"`
+
+	// Iops is the provisioned IOPS; required for io1 and io2, optional for gp3
+	// +optional
+	Iops int32 `json:"iops,omitempty"`
+
+	// StorageThroughput is the storage throughput in MiB/s (gp3 only)
+	// +optional
+	StorageThroughput int32 `json:"storageThroughput,omitempty"`
+
+	// MasterUsername for the database; restored instances and replicas keep the one of their source
+	// +optional
+	MasterUsername string `json:"masterUsername,omitempty"`
 
 	// MasterUserPasswordSecretRef references a secret containing the password
 	MasterUserPasswordSecretRef *SecretReference `json:"masterUserPasswordSecretRef,omitempty"`
@@ -44,23 +58,96 @@ type RDSInstanceSpec struct {
 	// PubliclyAccessible specifies if the DB is publicly accessible
 	PubliclyAccessible bool `json:"publiclyAccessible,omitempty"`
 
+	// DBSubnetGroupName is the DB subnet group the instance is placed in
+	// +optional
+	DBSubnetGroupName string `json:"dbSubnetGroupName,omitempty"`
+
+	// DBSubnetGroupRef references a DBSubnetGroup in the same namespace; mutually exclusive with DBSubnetGroupName
+	// +optional
+	DBSubnetGroupRef *ResourceReference `json:"dbSubnetGroupRef,omitempty"`
+
+	// VpcSecurityGroupIDs are the VPC security groups attached to the instance
+	// +optional
+	VpcSecurityGroupIDs []string `json:"vpcSecurityGroupIDs,omitempty"`
+
+	// SecurityGroupRefs references SecurityGroups in the same namespace; mutually exclusive with VpcSecurityGroupIDs
+	// +optional
+	SecurityGroupRefs []ResourceReference `json:"securityGroupRefs,omitempty"`
+
+	// DBParameterGroupName is the DB parameter group applied to the instance
+	// +optional
+	DBParameterGroupName string `json:"dbParameterGroupName,omitempty"`
+
+	// DBParameterGroupRef references a DBParameterGroup in the same namespace; mutually exclusive with DBParameterGroupName
+	// +optional
+	DBParameterGroupRef *ResourceReference `json:"dbParameterGroupRef,omitempty"`
+
+	// OptionGroupName is the option group applied to the instance
+	// +optional
+	OptionGroupName string `json:"optionGroupName,omitempty"`
+
 	// StorageEncrypted specifies if storage is encrypted
 	StorageEncrypted bool `json:"storageEncrypted,omitempty"`
 
+	// DeletionProtection prevents the instance from being deleted, also by the operator
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
 	// BackupRetentionPeriod in days (0-35)
 	BackupRetentionPeriod int32 `json:"backupRetentionPeriod,omitempty"`
 
 	// PreferredBackupWindow in format hh24:mi-hh24:mi
 	PreferredBackupWindow string `json:"preferredBackupWindow,omitempty"`
 
+	// PreferredMaintenanceWindow in format ddd:hh24:mi-ddd:hh24:mi (e.g. sun:05:00-sun:06:00)
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z]{3}:[0-9]{2}:[0-9]{2}-[A-Za-z]{3}:[0-9]{2}:[0-9]{2}$`
+	PreferredMaintenanceWindow string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// AllowMajorVersionUpgrade must be true to change engineVersion to a new major version
+	// +optional
+	AllowMajorVersionUpgrade bool `json:"allowMajorVersionUpgrade,omitempty"`
+
+	// AutoMinorVersionUpgrade lets RDS apply minor engine upgrades during the maintenance window
+	// +optional
+	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
+
+	// ApplyImmediately applies modifications now. When false they are deferred to the
+	// next maintenance window and reported in status.pendingModifications
+	// +optional
+	ApplyImmediately bool `json:"applyImmediately,omitempty"`
+
+	// RestoreFrom creates the instance from a snapshot or a point in time of another
+	// instance instead of an empty database. Only used when the instance is created
+	// +optional
+	RestoreFrom *RDSRestoreSource `json:"restoreFrom,omitempty"`
+
+	// ReplicateFrom creates the instance as a read replica of another instance.
+	// Removing it promotes the replica to a standalone instance
+	// +optional
+	ReplicateFrom *RDSReplicationSource `json:"replicateFrom,omitempty"`
+
 	// Tags for the RDS instance
 	Tags map[string]string `json:"tags,omitempty"`
 
-	// DeletionPolicy determines what happens when CR is deleted
+	// DeletionPolicy determines what happens when CR is deleted. Delete skips
+	// the final snapshot, Snapshot takes one unless skipFinalSnapshot is set,
+	// and Retain or Orphan keep the database in AWS
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan;Snapshot
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
 	// SkipFinalSnapshot if true, skips final snapshot on deletion
 	SkipFinalSnapshot bool `json:"skipFinalSnapshot,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 type SecretReference struct {
@@ -68,6 +155,62 @@ type SecretReference struct {
 	Key  string `json:"key"`
 }
 
+// RDSRestoreSource is a snapshot, or a point in time of a source instance, to
+// restore a new instance from. The master password, when set, replaces the one
+// of the source once the restored instance is available
+type RDSRestoreSource struct {
+	// DBSnapshotIdentifier is the identifier or ARN of the DB snapshot to restore
+	// +optional
+	DBSnapshotIdentifier string `json:"dbSnapshotIdentifier,omitempty"`
+
+	// SnapshotRef references an RDSSnapshot in the same namespace; its newest
+	// available snapshot is restored
+	// +optional
+	SnapshotRef *ResourceReference `json:"snapshotRef,omitempty"`
+
+	// SourceDBInstanceIdentifier is the instance to restore to a point in time
+	// +optional
+	SourceDBInstanceIdentifier string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceRef references an RDSInstance in the same namespace to restore to a point in time
+	// +optional
+	SourceRef *ResourceReference `json:"sourceRef,omitempty"`
+
+	// RestoreTime is the point in time to restore; defaults to the latest restorable time
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// UseLatestRestorableTime restores the latest restorable time of the source
+	// +optional
+	UseLatestRestorableTime bool `json:"useLatestRestorableTime,omitempty"`
+}
+
+// RDSReplicationSource is the source instance of a read replica
+type RDSReplicationSource struct {
+	// SourceDBInstanceIdentifier is the identifier of the source instance, or its
+	// ARN for a cross-region replica
+	// +optional
+	SourceDBInstanceIdentifier string `json:"sourceDBInstanceIdentifier,omitempty"`
+
+	// SourceRef references an RDSInstance in the same namespace
+	// +optional
+	SourceRef *ResourceReference `json:"sourceRef,omitempty"`
+}
+
+// RDSPendingModifications are the modified values RDS will apply during the
+// next maintenance window (or is applying now)
+type RDSPendingModifications struct {
+	DBInstanceClass       string `json:"dbInstanceClass,omitempty"`
+	AllocatedStorage      int32  `json:"allocatedStorage,omitempty"`
+	StorageType           string `json:"storageType,omitempty"`
+	Iops                  int32  `json:"iops,omitempty"`
+	StorageThroughput     int32  `json:"storageThroughput,omitempty"`
+	EngineVersion         string `json:"engineVersion,omitempty"`
+	MultiAZ               *bool  `json:"multiAZ,omitempty"`
+	BackupRetentionPeriod *int32 `json:"backupRetentionPeriod,omitempty"`
+	DBSubnetGroupName     string `json:"dbSubnetGroupName,omitempty"`
+}
+
 // RDSInstanceStatus defines the observed state of RDSInstance
 type RDSInstanceStatus struct {
 	Ready bool `json:"ready"`
@@ -90,11 +233,32 @@ type RDSInstanceStatus struct {
 	// AllocatedStorage in GB
 	AllocatedStorage int32 `json:"allocatedStorage,omitempty"`
 
+	// PendingModifications are the modifications waiting for the maintenance window
+	// +optional
+	PendingModifications *RDSPendingModifications `json:"pendingModifications,omitempty"`
+
+	// ParameterApplyStatus is the status of the parameter group on the instance;
+	// pending-reboot means static parameters only apply after a reboot
+	// +optional
+	ParameterApplyStatus string `json:"parameterApplyStatus,omitempty"`
+
+	// ReadReplicaSourceDBInstanceIdentifier is the source instance while the instance is a read replica
+	// created with replicateFrom; replicas adopted or created outside the operator do not record it
+	// +optional
+	ReadReplicaSourceDBInstanceIdentifier string `json:"readReplicaSourceDBInstanceIdentifier,omitempty"`
+
+	// MasterPasswordPending is true while the restored instance still has the password of its source
+	// +optional
+	MasterPasswordPending bool `json:"masterPasswordPending,omitempty"`
+
 	// LastSyncTime is when the instance was last synced
 	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty"`
 
 	// Conditions represent the latest available observations
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,11 +30,28 @@ var _ webhook.Validator = &RDSInstance{}
 
 func (r *RDSInstance) ValidateCreate() (admission.Warnings, error) {
 	rdsinstancelog.Info("validate create", "name", r.Name)
+
+	// Instâncias restauradas e réplicas usam as credenciais da origem
+	if r.Spec.RestoreFrom == nil && r.Spec.ReplicateFrom == nil && r.Spec.MasterUsername == "" {
+		return nil, fmt.Errorf("spec.masterUsername is required unless the instance is restored or replicated")
+	}
+
 	return r.validateRDSInstance()
 }
 
 func (r *RDSInstance) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
 	rdsinstancelog.Info("validate update", "name", r.Name)
+
+	oldInstance := old.(*RDSInstance)
+
+	// Origem da instância: só pode ser removida (replicateFrom removido promove a réplica)
+	if r.Spec.RestoreFrom != nil && !reflect.DeepEqual(r.Spec.RestoreFrom, oldInstance.Spec.RestoreFrom) {
+		return nil, fmt.Errorf("spec.restoreFrom can only be removed after the instance is created")
+	}
+	if r.Spec.ReplicateFrom != nil && !reflect.DeepEqual(r.Spec.ReplicateFrom, oldInstance.Spec.ReplicateFrom) {
+		return nil, fmt.Errorf("spec.replicateFrom can only be removed, which promotes the read replica")
+	}
+
 	return r.validateRDSInstance()
 }
 
@@ -50,16 +68,80 @@ func (r *RDSInstance) validateRDSInstance() (admission.Warnings, error) {
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 2. Validar Tags (não podem ter prefixo aws:)
+	// 2. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("vpcSecurityGroupIDs", len(r.Spec.VpcSecurityGroupIDs) > 0, "securityGroupRefs", r.Spec.SecurityGroupRefs, false); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("dbSubnetGroupName", r.Spec.DBSubnetGroupName != "", "dbSubnetGroupRef", optionalRef(r.Spec.DBSubnetGroupRef), false); err != nil {
+		return nil, err
+	}
+	if err := validateIDOrRef("dbParameterGroupName", r.Spec.DBParameterGroupName != "", "dbParameterGroupRef", optionalRef(r.Spec.DBParameterGroupRef), false); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar origem (restore ou réplica)
+	if r.Spec.RestoreFrom != nil && r.Spec.ReplicateFrom != nil {
+		return nil, fmt.Errorf("spec.restoreFrom and spec.replicateFrom are mutually exclusive")
+	}
+	if src := r.Spec.RestoreFrom; src != nil {
+		if err := validateIDOrRef("restoreFrom.dbSnapshotIdentifier", src.DBSnapshotIdentifier != "", "restoreFrom.snapshotRef", optionalRef(src.SnapshotRef), false); err != nil {
+			return nil, err
+		}
+		if err := validateIDOrRef("restoreFrom.sourceDBInstanceIdentifier", src.SourceDBInstanceIdentifier != "", "restoreFrom.sourceRef", optionalRef(src.SourceRef), false); err != nil {
+			return nil, err
+		}
+		fromSnapshot := src.DBSnapshotIdentifier != "" || src.SnapshotRef != nil
+		fromInstance := src.SourceDBInstanceIdentifier != "" || src.SourceRef != nil
+		if fromSnapshot == fromInstance {
+			return nil, fmt.Errorf("spec.restoreFrom needs exactly one of a snapshot or a source instance")
+		}
+		if fromSnapshot && (src.RestoreTime != nil || src.UseLatestRestorableTime) {
+			return nil, fmt.Errorf("spec.restoreFrom.restoreTime and useLatestRestorableTime only apply to a source instance")
+		}
+		if src.RestoreTime != nil && src.UseLatestRestorableTime {
+			return nil, fmt.Errorf("spec.restoreFrom.restoreTime and spec.restoreFrom.useLatestRestorableTime are mutually exclusive")
+		}
+	}
+	if src := r.Spec.ReplicateFrom; src != nil {
+		if err := validateIDOrRef("replicateFrom.sourceDBInstanceIdentifier", src.SourceDBInstanceIdentifier != "", "replicateFrom.sourceRef", optionalRef(src.SourceRef), true); err != nil {
+			return nil, err
+		}
+		if r.Spec.MasterUserPassword != "" || r.Spec.MasterUserPasswordSecretRef != nil {
+			warnings = append(warnings, "read replicas use the master password of their source, spec.masterUserPassword is ignored")
+		}
+	}
+
+	// 4. Validar armazenamento
+	switch r.Spec.StorageType {
+	case "io1", "io2":
+		if r.Spec.Iops == 0 {
+			return nil, fmt.Errorf("spec.iops is required for storageType %s", r.Spec.StorageType)
+		}
+	case "gp3":
+	default:
+		if r.Spec.Iops != 0 {
+			return nil, fmt.Errorf("spec.iops requires storageType io1, io2 or gp3")
+		}
+	}
+	if r.Spec.StorageThroughput != 0 && r.Spec.StorageType != "gp3" {
+		return nil, fmt.Errorf("spec.storageThroughput requires storageType gp3")
+	}
+
+	// 5. Validar Tags (não podem ter prefixo aws:)
 	for key := range r.Spec.Tags {
 		if regexp.MustCompile(`^aws:`).MatchString(key) {
 			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 6. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete", "Snapshot")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+	if !r.Spec.ApplyImmediately {
+		warnings = append(warnings, "spec.applyImmediately not set, modifications wait for the next maintenance window")
 	}
 
 	return warnings, nil
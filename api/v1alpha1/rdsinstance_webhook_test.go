@@ -20,7 +20,8 @@ var _ = Describe("Urdsinstance Webhook", func() {
 				Namespace: "default",
 			},
 			Spec: RDSInstanceSpec{
-				ProviderRef: ProviderReference{Name: "test-provider"},
+				ProviderRef:    ProviderReference{Name: "test-provider"},
+				MasterUsername: "dbadmin",
 			},
 		}
 	})
@@ -32,6 +33,16 @@ var _ = Describe("Urdsinstance Webhook", func() {
 			Expect(warnings).NotTo(BeEmpty())
 		})
 
+		It("should accept the Snapshot deletion policy", func() {
+			obj.Spec.DeletionPolicy = "Snapshot"
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.DeletionPolicy = "Stop"
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should reject empty ProviderRef", func() {
 			obj.Spec.ProviderRef.Name = ""
 			_, err := obj.ValidateCreate()
@@ -43,5 +54,96 @@ var _ = Describe("Urdsinstance Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should reject both dbSubnetGroupName and dbSubnetGroupRef", func() {
+			obj.Spec.DBSubnetGroupName = "app"
+			obj.Spec.DBSubnetGroupRef = &ResourceReference{Name: "app"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should require iops for io1 storage", func() {
+			obj.Spec.StorageType = "io1"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.Iops = 3000
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject storageThroughput without gp3", func() {
+			obj.Spec.StorageType = "gp2"
+			obj.Spec.StorageThroughput = 250
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should require masterUsername for an empty database", func() {
+			obj.Spec.MasterUsername = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.RestoreFrom = &RDSRestoreSource{DBSnapshotIdentifier: "prod-db-final"}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject restoreFrom with both a snapshot and a source instance", func() {
+			obj.Spec.RestoreFrom = &RDSRestoreSource{DBSnapshotIdentifier: "prod-db-final", SourceDBInstanceIdentifier: "prod-db"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one"))
+		})
+
+		It("should reject restoreTime for a snapshot restore", func() {
+			now := metav1.Now()
+			obj.Spec.RestoreFrom = &RDSRestoreSource{SnapshotRef: &ResourceReference{Name: "nightly"}, RestoreTime: &now}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.RestoreFrom = &RDSRestoreSource{SourceRef: &ResourceReference{Name: "prod-db"}, RestoreTime: &now}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject restoreFrom with replicateFrom", func() {
+			obj.Spec.RestoreFrom = &RDSRestoreSource{DBSnapshotIdentifier: "prod-db-final"}
+			obj.Spec.ReplicateFrom = &RDSReplicationSource{SourceDBInstanceIdentifier: "prod-db"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should require a source for replicateFrom", func() {
+			obj.Spec.ReplicateFrom = &RDSReplicationSource{}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow removing replicateFrom to promote the replica", func() {
+			old := obj.DeepCopy()
+			old.Spec.ReplicateFrom = &RDSReplicationSource{SourceRef: &ResourceReference{Name: "prod-db"}}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject adding replicateFrom to an existing instance", func() {
+			old := obj.DeepCopy()
+			obj.Spec.ReplicateFrom = &RDSReplicationSource{SourceRef: &ResourceReference{Name: "prod-db"}}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject changing restoreFrom", func() {
+			old := obj.DeepCopy()
+			old.Spec.RestoreFrom = &RDSRestoreSource{DBSnapshotIdentifier: "prod-db-final"}
+			obj.Spec.RestoreFrom = &RDSRestoreSource{DBSnapshotIdentifier: "prod-db-other"}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -0,0 +1,126 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RDSSnapshotSpec defines the desired state of RDSSnapshot
+type RDSSnapshotSpec struct {
+	// ProviderRef references the AWSProvider to use
+	// +kubebuilder:validation:Required
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// DBInstanceIdentifier is the DB instance to snapshot
+	// +optional
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// DBInstanceRef references an RDSInstance in the same namespace; mutually exclusive with DBInstanceIdentifier
+	// +optional
+	DBInstanceRef *ResourceReference `json:"dbInstanceRef,omitempty"`
+
+	// DBSnapshotIdentifier is the identifier of the snapshot. Scheduled snapshots
+	// are named <dbSnapshotIdentifier>-<yyyymmdd-hhmm>
+	// +kubebuilder:validation:Required
+	DBSnapshotIdentifier string `json:"dbSnapshotIdentifier"`
+
+	// Schedule takes a snapshot periodically instead of once
+	// +optional
+	Schedule *RDSSnapshotSchedule `json:"schedule,omitempty"`
+
+	// Tags to apply to the snapshots
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines whether the snapshots are deleted with the resource
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// RDSSnapshotSchedule takes a manual snapshot every Interval and removes the
+// snapshots past the retention. The newest snapshot is always kept
+type RDSSnapshotSchedule struct {
+	// Interval between snapshots (e.g. "24h")
+	// +kubebuilder:validation:Required
+	Interval string `json:"interval"`
+
+	// RetentionCount is the number of snapshots kept; 0 keeps all
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RetentionCount int32 `json:"retentionCount,omitempty"`
+
+	// RetentionPeriod is how long snapshots are kept (e.g. "168h"); empty keeps them forever
+	// +optional
+	RetentionPeriod string `json:"retentionPeriod,omitempty"`
+}
+
+// RDSSnapshotStatus defines the observed state of RDSSnapshot
+type RDSSnapshotStatus struct {
+	// Ready is true when a snapshot is available to restore
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// DBInstanceIdentifier is the resolved DB instance of the snapshots
+	// +optional
+	DBInstanceIdentifier string `json:"dbInstanceIdentifier,omitempty"`
+
+	// Status of the newest snapshot (creating, available, ...)
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// LatestSnapshotIdentifier is the newest available snapshot
+	// +optional
+	LatestSnapshotIdentifier string `json:"latestSnapshotIdentifier,omitempty"`
+
+	// LatestSnapshotArn is the ARN of the newest available snapshot
+	// +optional
+	LatestSnapshotArn string `json:"latestSnapshotArn,omitempty"`
+
+	// LatestSnapshotTime is when the newest available snapshot was taken
+	// +optional
+	LatestSnapshotTime *metav1.Time `json:"latestSnapshotTime,omitempty"`
+
+	// Snapshots lists the snapshots managed by this resource, newest first
+	// +optional
+	Snapshots []string `json:"snapshots,omitempty"`
+
+	// NextSnapshotTime is when the next scheduled snapshot is due
+	// +optional
+	NextSnapshotTime *metav1.Time `json:"nextSnapshotTime,omitempty"`
+
+	// LastSyncTime
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=rdssnap
+// +kubebuilder:printcolumn:name="Snapshot",type=string,JSONPath=`.spec.dbSnapshotIdentifier`
+// +kubebuilder:printcolumn:name="Latest",type=string,JSONPath=`.status.latestSnapshotIdentifier`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.status`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RDSSnapshot is the Schema for the rdssnapshots API
+type RDSSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RDSSnapshotSpec   `json:"spec,omitempty"`
+	Status RDSSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RDSSnapshotList contains a list of RDSSnapshot
+type RDSSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RDSSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RDSSnapshot{}, &RDSSnapshotList{})
+}
@@ -0,0 +1,118 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var rdssnapshotlog = logf.Log.WithName("rdssnapshot-resource")
+
+func (r *RDSSnapshot) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-rdssnapshot,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=rdssnapshots,verbs=create;update,versions=v1alpha1,name=vrdssnapshot.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RDSSnapshot{}
+
+func (r *RDSSnapshot) ValidateCreate() (admission.Warnings, error) {
+	rdssnapshotlog.Info("validate create", "name", r.Name)
+	return r.validateRDSSnapshot()
+}
+
+func (r *RDSSnapshot) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	rdssnapshotlog.Info("validate update", "name", r.Name)
+
+	oldSnapshot := old.(*RDSSnapshot)
+
+	// Campos imutáveis
+	if r.Spec.DBSnapshotIdentifier != oldSnapshot.Spec.DBSnapshotIdentifier {
+		return nil, fmt.Errorf("spec.dbSnapshotIdentifier is immutable")
+	}
+	if r.Spec.DBInstanceIdentifier != oldSnapshot.Spec.DBInstanceIdentifier || refChanged(oldSnapshot.Spec.DBInstanceRef, r.Spec.DBInstanceRef) {
+		return nil, fmt.Errorf("spec.dbInstanceIdentifier and spec.dbInstanceRef are immutable")
+	}
+	if (r.Spec.Schedule == nil) != (oldSnapshot.Spec.Schedule == nil) {
+		return nil, fmt.Errorf("spec.schedule cannot be added or removed; create a new RDSSnapshot instead")
+	}
+
+	return r.validateRDSSnapshot()
+}
+
+func (r *RDSSnapshot) ValidateDelete() (admission.Warnings, error) {
+	rdssnapshotlog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *RDSSnapshot) validateRDSSnapshot() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar instância ou referência
+	if err := validateIDOrRef("dbInstanceIdentifier", r.Spec.DBInstanceIdentifier != "", "dbInstanceRef", optionalRef(r.Spec.DBInstanceRef), true); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar identificador (letra inicial, letras, números e hífens simples)
+	maxLength := 255
+	if r.Spec.Schedule != nil {
+		// Espaço para o sufixo -yyyymmdd-hhmm
+		maxLength -= len("-20060102-1504")
+	}
+	if len(r.Spec.DBSnapshotIdentifier) > maxLength || !regexp.MustCompile(`^[a-zA-Z](-?[a-zA-Z0-9])*$`).MatchString(r.Spec.DBSnapshotIdentifier) {
+		return nil, fmt.Errorf("spec.dbSnapshotIdentifier must start with a letter, contain only letters, digits and single hyphens, and have at most %d characters", maxLength)
+	}
+
+	// 4. Validar agendamento
+	if s := r.Spec.Schedule; s != nil {
+		interval, err := time.ParseDuration(s.Interval)
+		if err != nil || interval < time.Hour {
+			return nil, fmt.Errorf("spec.schedule.interval must be a duration of at least 1h (e.g. 24h)")
+		}
+		if s.RetentionPeriod != "" {
+			period, err := time.ParseDuration(s.RetentionPeriod)
+			if err != nil || period <= 0 {
+				return nil, fmt.Errorf("spec.schedule.retentionPeriod must be a positive duration (e.g. 168h)")
+			}
+			if period < interval {
+				warnings = append(warnings, "spec.schedule.retentionPeriod is shorter than the interval, only the newest snapshot is kept")
+			}
+		}
+		if s.RetentionCount == 0 && s.RetentionPeriod == "" {
+			warnings = append(warnings, "spec.schedule has no retention, snapshots are kept until the RDSSnapshot is deleted")
+		}
+	}
+
+	// 5. Validar Tags
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
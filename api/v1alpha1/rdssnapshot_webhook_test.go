@@ -0,0 +1,95 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RDSSnapshot Webhook", func() {
+	var obj *RDSSnapshot
+
+	BeforeEach(func() {
+		obj = &RDSSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rdssnapshot",
+				Namespace: "default",
+			},
+			Spec: RDSSnapshotSpec{
+				ProviderRef:          ProviderReference{Name: "test-provider"},
+				DBInstanceIdentifier: "prod-db",
+				DBSnapshotIdentifier: "prod-db-before-migration",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid RDSSnapshot", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(BeEmpty())
+		})
+
+		It("should require an instance or a reference", func() {
+			obj.Spec.DBInstanceIdentifier = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.DBInstanceRef = &ResourceReference{Name: "prod-db"}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject invalid snapshot identifiers", func() {
+			obj.Spec.DBSnapshotIdentifier = "1-nightly"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.DBSnapshotIdentifier = "nightly--backup"
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should validate the schedule", func() {
+			obj.Spec.Schedule = &RDSSnapshotSchedule{Interval: "daily"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.Schedule = &RDSSnapshotSchedule{Interval: "10m"}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.Schedule = &RDSSnapshotSchedule{Interval: "24h", RetentionCount: 7}
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject an invalid retention period", func() {
+			obj.Spec.Schedule = &RDSSnapshotSchedule{Interval: "24h", RetentionPeriod: "a week"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should reject changing the snapshot identifier", func() {
+			old := obj.DeepCopy()
+			obj.Spec.DBSnapshotIdentifier = "other"
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("immutable"))
+		})
+
+		It("should allow changing the retention", func() {
+			obj.Spec.Schedule = &RDSSnapshotSchedule{Interval: "24h", RetentionCount: 7}
+			old := obj.DeepCopy()
+			obj.Spec.Schedule.RetentionCount = 14
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
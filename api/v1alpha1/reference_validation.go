@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"fmt"
+)
+
+// validateIDOrRef ensures exactly one of an ID field and its reference field is set.
+// When required is false, setting neither is also accepted.
+func validateIDOrRef(idField string, idSet bool, refField string, refs []ResourceReference, required bool) error {
+	refSet := len(refs) > 0
+
+	if idSet && refSet {
+		return fmt.Errorf("spec.%s and spec.%s are mutually exclusive", idField, refField)
+	}
+	if required && !idSet && !refSet {
+		return fmt.Errorf("one of spec.%s or spec.%s is required", idField, refField)
+	}
+
+	for i, ref := range refs {
+		if ref.Name == "" {
+			return fmt.Errorf("spec.%s[%d].name is required", refField, i)
+		}
+	}
+
+	return nil
+}
+
+// optionalRef converts an optional single reference to the slice form used by validateIDOrRef
+func optionalRef(ref *ResourceReference) []ResourceReference {
+	if ref == nil {
+		return nil
+	}
+	return []ResourceReference{*ref}
+}
+
+// refChanged reports whether an optional single reference was added, removed or renamed
+func refChanged(oldRef, newRef *ResourceReference) bool {
+	if oldRef == nil || newRef == nil {
+		return oldRef != newRef
+	}
+	return oldRef.Name != newRef.Name
+}
@@ -38,9 +38,9 @@ type Route53HostedZoneSpec struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy determines what happens to the hosted zone when the CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 }
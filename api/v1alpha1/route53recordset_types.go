@@ -99,9 +99,9 @@ type Route53RecordSetSpec struct {
 	HealthCheckID string `json:"healthCheckID,omitempty"`
 
 	// DeletionPolicy determines what happens to the record when the CR is deleted
-	// Valid values: Delete, Retain
+	// Valid values: Delete, Retain, Orphan
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 }
@@ -85,9 +85,11 @@ func (r *Route53RecordSet) validateRoute53RecordSet() (admission.Warnings, error
 	}
 
 	// Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	// Warn se TTL muito baixo
 	if r.Spec.TTL != nil && *r.Spec.TTL < 60 {
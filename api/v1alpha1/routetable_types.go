@@ -11,8 +11,12 @@ type RouteTableSpec struct {
 	ProviderRef ProviderReference `json:"providerRef"`
 
 	// VpcID is the ID of the VPC
-	// +kubebuilder:validation:Required
-	VpcID string `json:"vpcID"`
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// VpcRef references a VPC in the same namespace; mutually exclusive with VpcID
+	// +optional
+	VpcRef *ResourceReference `json:"vpcRef,omitempty"`
 
 	// Routes to add to the route table
 	// +optional
@@ -22,15 +26,23 @@ type RouteTableSpec struct {
 	// +optional
 	SubnetAssociations []string `json:"subnetAssociations,omitempty"`
 
+	// SubnetRefs references Subnets in the same namespace to associate; mutually exclusive with SubnetAssociations
+	// +optional
+	SubnetRefs []ResourceReference `json:"subnetRefs,omitempty"`
+
 	// Tags to apply to the route table
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // Route defines a route in the route table
@@ -81,6 +93,9 @@ type RouteTableStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,6 +21,7 @@ var _ = Describe("Uroutetable Webhook", func() {
 			},
 			Spec: RouteTableSpec{
 				ProviderRef: ProviderReference{Name: "test-provider"},
+				VpcID:       "vpc-11111111",
 			},
 		}
 	})
@@ -43,5 +44,26 @@ var _ = Describe("Uroutetable Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should accept a reference instead of an ID", func() {
+			obj.Spec.VpcID = ""
+			obj.Spec.VpcRef = &ResourceReference{Name: "main"}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject both ID and reference", func() {
+			obj.Spec.VpcRef = &ResourceReference{Name: "main"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should reject missing ID and reference", func() {
+			obj.Spec.VpcID = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("one of spec.vpcID or spec.vpcRef"))
+		})
 	})
 })
@@ -41,6 +41,31 @@ type S3BucketSpec struct {
 	// +optional
 	PublicAccessBlock *PublicAccessBlockConfiguration `json:"publicAccessBlock,omitempty"`
 
+	// Policy is the bucket policy JSON document
+	// +optional
+	Policy string `json:"policy,omitempty"`
+
+	// Notifications send bucket events to SQS queues, SNS topics or Lambda functions
+	// +optional
+	Notifications []BucketNotification `json:"notifications,omitempty"`
+
+	// Replication configures cross-region replication; requires versioning
+	// +optional
+	Replication *ReplicationConfiguration `json:"replication,omitempty"`
+
+	// Logging configures server access logging
+	// +optional
+	Logging *LoggingConfiguration `json:"logging,omitempty"`
+
+	// ObjectLock enables S3 Object Lock with an optional default retention;
+	// requires versioning and cannot be removed once set
+	// +optional
+	ObjectLock *ObjectLockConfiguration `json:"objectLock,omitempty"`
+
+	// Website configures static website hosting
+	// +optional
+	Website *WebsiteConfiguration `json:"website,omitempty"`
+
 	// Tags for the bucket
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
@@ -50,6 +75,20 @@ type S3BucketSpec struct {
 	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// ForceDestroy deletes every object version and delete marker before
+	// deleting the bucket. Without it, deleting a non-empty bucket fails.
+	// +optional
+	ForceDestroy bool `json:"forceDestroy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// WriteConnectionSecretToRef makes the controller write the connection details
+	// (host, port, url, arn, ...) to a Secret owned by this resource
+	// +optional
+	WriteConnectionSecretToRef *ConnectionSecretReference `json:"writeConnectionSecretToRef,omitempty"`
 }
 
 // ProviderReference references an AWSProvider resource
@@ -156,6 +195,129 @@ type PublicAccessBlockConfiguration struct {
 	RestrictPublicBuckets bool `json:"restrictPublicBuckets,omitempty"`
 }
 
+// BucketNotification sends bucket events to one destination
+type BucketNotification struct {
+	// ID of the notification
+	ID string `json:"id"`
+
+	// Events that trigger the notification, e.g. s3:ObjectCreated:*
+	// +kubebuilder:validation:MinItems=1
+	Events []string `json:"events"`
+
+	// FilterPrefix limits the notification to keys with this prefix
+	// +optional
+	FilterPrefix string `json:"filterPrefix,omitempty"`
+
+	// FilterSuffix limits the notification to keys with this suffix
+	// +optional
+	FilterSuffix string `json:"filterSuffix,omitempty"`
+
+	// QueueARN of the SQS queue to notify
+	// +optional
+	QueueARN string `json:"queueArn,omitempty"`
+
+	// TopicARN of the SNS topic to notify
+	// +optional
+	TopicARN string `json:"topicArn,omitempty"`
+
+	// LambdaFunctionARN of the Lambda function to invoke
+	// +optional
+	LambdaFunctionARN string `json:"lambdaFunctionArn,omitempty"`
+}
+
+// ReplicationConfiguration defines replication settings
+type ReplicationConfiguration struct {
+	// Role is the ARN of the IAM role S3 assumes to replicate objects
+	Role string `json:"role"`
+
+	// Rules of the replication
+	// +kubebuilder:validation:MinItems=1
+	Rules []ReplicationRule `json:"rules"`
+}
+
+// ReplicationRule defines a replication rule
+type ReplicationRule struct {
+	// ID of the rule
+	ID string `json:"id"`
+
+	// Enabled indicates if the rule is enabled
+	// +optional
+	// +kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Priority decides which rule applies when rules overlap
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Prefix filter
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// DestinationBucket is the name or ARN of the bucket that receives the replicas
+	DestinationBucket string `json:"destinationBucket"`
+
+	// StorageClass of the replicas
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;STANDARD_IA;ONEZONE_IA;INTELLIGENT_TIERING;GLACIER;GLACIER_IR;DEEP_ARCHIVE
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// ReplicateDeleteMarkers replicates delete markers to the destination
+	// +optional
+	ReplicateDeleteMarkers bool `json:"replicateDeleteMarkers,omitempty"`
+}
+
+// LoggingConfiguration defines server access logging settings
+type LoggingConfiguration struct {
+	// TargetBucket receives the access logs
+	TargetBucket string `json:"targetBucket"`
+
+	// TargetPrefix for the log object keys
+	// +optional
+	TargetPrefix string `json:"targetPrefix,omitempty"`
+}
+
+// ObjectLockConfiguration defines the default retention of locked objects
+type ObjectLockConfiguration struct {
+	// Mode of the default retention
+	// +optional
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode,omitempty"`
+
+	// Days of the default retention
+	// +optional
+	Days int32 `json:"days,omitempty"`
+
+	// Years of the default retention
+	// +optional
+	Years int32 `json:"years,omitempty"`
+}
+
+// WebsiteConfiguration defines static website hosting settings
+type WebsiteConfiguration struct {
+	// IndexDocument suffix, e.g. index.html
+	// +optional
+	IndexDocument string `json:"indexDocument,omitempty"`
+
+	// ErrorDocument key
+	// +optional
+	ErrorDocument string `json:"errorDocument,omitempty"`
+
+	// RedirectAllRequestsTo redirects every request to another host
+	// +optional
+	RedirectAllRequestsTo *WebsiteRedirect `json:"redirectAllRequestsTo,omitempty"`
+}
+
+// WebsiteRedirect defines the host requests are redirected to
+type WebsiteRedirect struct {
+	// HostName to redirect to
+	HostName string `json:"hostName"`
+
+	// Protocol of the redirect
+	// +optional
+	// +kubebuilder:validation:Enum=http;https
+	Protocol string `json:"protocol,omitempty"`
+}
+
 // S3BucketStatus defines the observed state of S3Bucket
 type S3BucketStatus struct {
 	// Conditions represent the latest available observations
@@ -178,9 +340,27 @@ type S3BucketStatus struct {
 	// +optional
 	BucketDomainName string `json:"bucketDomainName,omitempty"`
 
+	// WebsiteEndpoint is the static website endpoint, when website hosting is enabled
+	// +optional
+	WebsiteEndpoint string `json:"websiteEndpoint,omitempty"`
+
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// ObjectsDeleted counts the object versions and delete markers removed
+	// while emptying the bucket for deletion with forceDestroy
+	// +optional
+	ObjectsDeleted int64 `json:"objectsDeleted,omitempty"`
+
+	// ManagedConfigurations are the configuration sections (policy,
+	// notifications, replication, logging, website) applied by the operator,
+	// which are removed from the bucket once dropped from the spec
+	// +optional
+	ManagedConfigurations []string `json:"managedConfigurations,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
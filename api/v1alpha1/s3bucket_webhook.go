@@ -5,9 +5,11 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"regexp"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -42,6 +44,11 @@ func (r *S3Bucket) ValidateUpdate(old runtime.Object) (admission.Warnings, error
 		return nil, fmt.Errorf("spec.bucketName is immutable")
 	}
 
+	// Object Lock não pode ser desativado
+	if oldBucket.Spec.ObjectLock != nil && r.Spec.ObjectLock == nil {
+		return nil, fmt.Errorf("spec.objectLock cannot be removed once enabled")
+	}
+
 	return r.validateS3Bucket()
 }
 
@@ -84,10 +91,46 @@ func (r *S3Bucket) validateS3Bucket() (admission.Warnings, error) {
 		}
 	}
 
+	// 6. Validar policy
+	if r.Spec.Policy != "" && !json.Valid([]byte(r.Spec.Policy)) {
+		return nil, fmt.Errorf("spec.policy must be a valid JSON document")
+	}
+
+	// 7. Validar notifications
+	if err := r.validateNotifications(); err != nil {
+		return nil, err
+	}
+
+	// 8. Validar replication
+	if r.Spec.Replication != nil {
+		if err := r.validateReplication(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 9. Validar object lock
+	if r.Spec.ObjectLock != nil {
+		if err := r.validateObjectLock(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 10. Validar website
+	if website := r.Spec.Website; website != nil {
+		if website.RedirectAllRequestsTo != nil && (website.IndexDocument != "" || website.ErrorDocument != "") {
+			return nil, fmt.Errorf("spec.website.redirectAllRequestsTo cannot be combined with indexDocument or errorDocument")
+		}
+		if website.RedirectAllRequestsTo == nil && website.IndexDocument == "" {
+			return nil, fmt.Errorf("spec.website requires indexDocument or redirectAllRequestsTo")
+		}
+	}
+
 	// Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	if r.Spec.Versioning == nil || !r.Spec.Versioning.Enabled {
 		warnings = append(warnings, "versioning not enabled - data loss possible on accidental deletion")
@@ -158,3 +201,83 @@ func (r *S3Bucket) validateLifecycleRule(rule LifecycleRule, index int) error {
 
 	return nil
 }
+
+func (r *S3Bucket) validateNotifications() error {
+	ids := map[string]bool{}
+	for i, n := range r.Spec.Notifications {
+		if n.ID == "" {
+			return fmt.Errorf("spec.notifications[%d].id is required", i)
+		}
+		if ids[n.ID] {
+			return fmt.Errorf("spec.notifications[%d].id %q is duplicated", i, n.ID)
+		}
+		ids[n.ID] = true
+
+		// Exatamente um destino
+		destinations := 0
+		for _, arn := range []string{n.QueueARN, n.TopicARN, n.LambdaFunctionARN} {
+			if arn != "" {
+				destinations++
+			}
+		}
+		if destinations != 1 {
+			return fmt.Errorf("spec.notifications[%d] must set exactly one of queueArn, topicArn or lambdaFunctionArn", i)
+		}
+
+		for j, event := range n.Events {
+			if !strings.HasPrefix(event, "s3:") {
+				return fmt.Errorf("spec.notifications[%d].events[%d] must be an S3 event such as s3:ObjectCreated:*", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *S3Bucket) validateReplication() error {
+	if r.Spec.Versioning == nil || !r.Spec.Versioning.Enabled {
+		return fmt.Errorf("spec.replication requires spec.versioning.enabled")
+	}
+	if r.Spec.Replication.Role == "" {
+		return fmt.Errorf("spec.replication.role is required")
+	}
+
+	// IDs e prioridades únicos
+	ids := map[string]bool{}
+	priorities := map[int32]bool{}
+	for i, rule := range r.Spec.Replication.Rules {
+		if rule.ID == "" {
+			return fmt.Errorf("spec.replication.rules[%d].id is required", i)
+		}
+		if ids[rule.ID] {
+			return fmt.Errorf("spec.replication.rules[%d].id %q is duplicated", i, rule.ID)
+		}
+		ids[rule.ID] = true
+
+		if priorities[rule.Priority] {
+			return fmt.Errorf("spec.replication.rules[%d].priority %d is duplicated", i, rule.Priority)
+		}
+		priorities[rule.Priority] = true
+
+		if rule.DestinationBucket == "" {
+			return fmt.Errorf("spec.replication.rules[%d].destinationBucket is required", i)
+		}
+	}
+	return nil
+}
+
+func (r *S3Bucket) validateObjectLock() error {
+	lock := r.Spec.ObjectLock
+	if r.Spec.Versioning == nil || !r.Spec.Versioning.Enabled {
+		return fmt.Errorf("spec.objectLock requires spec.versioning.enabled")
+	}
+	if lock.Mode == "" {
+		if lock.Days != 0 || lock.Years != 0 {
+			return fmt.Errorf("spec.objectLock.mode is required with days or years")
+		}
+		return nil
+	}
+	if (lock.Days > 0) == (lock.Years > 0) {
+		return fmt.Errorf("spec.objectLock requires either days or years with mode %s", lock.Mode)
+	}
+	return nil
+}
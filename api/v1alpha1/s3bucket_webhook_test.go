@@ -32,6 +32,17 @@ var _ = Describe("S3Bucket Webhook", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should accept Orphan and reject unknown deletion policies", func() {
+			bucket.Spec.DeletionPolicy = "Orphan"
+			_, err := bucket.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			bucket.Spec.DeletionPolicy = "Snapshot"
+			_, err = bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.deletionPolicy"))
+		})
+
 		It("should reject bucket name too short", func() {
 			bucket.Spec.BucketName = "ab"
 			_, err := bucket.ValidateCreate()
@@ -99,6 +110,57 @@ var _ = Describe("S3Bucket Webhook", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("id is required"))
 		})
+
+		It("should reject invalid policy JSON", func() {
+			bucket.Spec.Policy = `{"Version": "2012-10-17",`
+			_, err := bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.policy"))
+		})
+
+		It("should reject notification with more than one destination", func() {
+			bucket.Spec.Notifications = []BucketNotification{{
+				ID:       "uploads",
+				Events:   []string{"s3:ObjectCreated:*"},
+				QueueARN: "arn:aws:sqs:us-east-1:123456789012:uploads",
+				TopicARN: "arn:aws:sns:us-east-1:123456789012:uploads",
+			}}
+			_, err := bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("exactly one"))
+		})
+
+		It("should require versioning for replication", func() {
+			bucket.Spec.Replication = &ReplicationConfiguration{
+				Role:  "arn:aws:iam::123456789012:role/replication",
+				Rules: []ReplicationRule{{ID: "all", DestinationBucket: "replica-bucket"}},
+			}
+			_, err := bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("versioning"))
+
+			bucket.Spec.Versioning = &VersioningConfiguration{Enabled: true}
+			_, err = bucket.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject object lock retention without mode", func() {
+			bucket.Spec.Versioning = &VersioningConfiguration{Enabled: true}
+			bucket.Spec.ObjectLock = &ObjectLockConfiguration{Days: 30}
+			_, err := bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mode is required"))
+		})
+
+		It("should reject website redirect combined with documents", func() {
+			bucket.Spec.Website = &WebsiteConfiguration{
+				IndexDocument:         "index.html",
+				RedirectAllRequestsTo: &WebsiteRedirect{HostName: "example.com"},
+			}
+			_, err := bucket.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("redirectAllRequestsTo"))
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -110,5 +172,16 @@ var _ = Describe("S3Bucket Webhook", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("immutable"))
 		})
+
+		It("should reject removing object lock", func() {
+			bucket.Spec.Versioning = &VersioningConfiguration{Enabled: true}
+			bucket.Spec.ObjectLock = &ObjectLockConfiguration{}
+			oldBucket := bucket.DeepCopy()
+			bucket.Spec.ObjectLock = nil
+
+			_, err := bucket.ValidateUpdate(oldBucket)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("objectLock"))
+		})
 	})
 })
@@ -50,7 +50,7 @@ type SecretsManagerSecretSpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 
@@ -60,6 +60,10 @@ type SecretsManagerSecretSpec struct {
 	// +kubebuilder:validation:Maximum=30
 	// +kubebuilder:default=30
 	RecoveryWindowInDays int32 `json:"recoveryWindowInDays,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // SecretsManagerSecretStatus defines the observed state of SecretsManagerSecret
@@ -107,6 +111,9 @@ type SecretsManagerSecretStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,14 +19,21 @@ type SecurityGroupSpec struct {
 	Description string `json:"description"`
 
 	// VpcID is the ID of the VPC
-	// +kubebuilder:validation:Required
-	VpcID string `json:"vpcID"`
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// VpcRef references a VPC in the same namespace; mutually exclusive with VpcID
+	// +optional
+	VpcRef *ResourceReference `json:"vpcRef,omitempty"`
 
-	// IngressRules are the inbound rules
+	// IngressRules are the inbound rules. They are authoritative: rules of
+	// the group that are not listed here are revoked.
 	// +optional
 	IngressRules []SecurityGroupRule `json:"ingressRules,omitempty"`
 
-	// EgressRules are the outbound rules
+	// EgressRules are the outbound rules. When set they are authoritative,
+	// including over the default allow-all egress rule; when empty the egress
+	// rules of the group are not managed.
 	// +optional
 	EgressRules []SecurityGroupRule `json:"egressRules,omitempty"`
 
@@ -36,14 +43,18 @@ type SecurityGroupSpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // SecurityGroupRule defines a security group rule
 type SecurityGroupRule struct {
-	// IpProtocol is the IP protocol (tcp, udp, icmp, or -1 for all)
+	// IpProtocol is the IP protocol (tcp, udp, icmp, icmpv6, a protocol number, or -1 for all)
 	// +kubebuilder:validation:Required
 	IpProtocol string `json:"ipProtocol"`
 
@@ -63,10 +74,20 @@ type SecurityGroupRule struct {
 	// +optional
 	Ipv6CidrBlocks []string `json:"ipv6CidrBlocks,omitempty"`
 
+	// PrefixListIDs are the IDs of managed prefix lists
+	// +optional
+	PrefixListIDs []string `json:"prefixListIDs,omitempty"`
+
 	// SourceSecurityGroupID is the source security group ID
 	// +optional
 	SourceSecurityGroupID string `json:"sourceSecurityGroupID,omitempty"`
 
+	// SourceSecurityGroupRef references a SecurityGroup in the same namespace
+	// as the source; mutually exclusive with SourceSecurityGroupID. A
+	// reference to this SecurityGroup allows traffic between its members.
+	// +optional
+	SourceSecurityGroupRef *ResourceReference `json:"sourceSecurityGroupRef,omitempty"`
+
 	// Description of the rule
 	// +optional
 	Description string `json:"description,omitempty"`
@@ -90,9 +111,20 @@ type SecurityGroupStatus struct {
 	// +optional
 	VpcID string `json:"vpcID,omitempty"`
 
+	// IngressRules are the inbound rules applied to the group, one per source
+	// +optional
+	IngressRules []SecurityGroupRule `json:"ingressRules,omitempty"`
+
+	// EgressRules are the outbound rules of the group, one per source
+	// +optional
+	EgressRules []SecurityGroupRule `json:"egressRules,omitempty"`
+
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
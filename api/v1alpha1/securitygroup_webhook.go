@@ -6,7 +6,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -50,17 +53,74 @@ func (r *SecurityGroup) validateSecurityGroup() (admission.Warnings, error) {
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 2. Validar Tags (não podem ter prefixo aws:)
+	// 2. Validar IDs ou referências a outros recursos
+	if err := validateIDOrRef("vpcID", r.Spec.VpcID != "", "vpcRef", optionalRef(r.Spec.VpcRef), true); err != nil {
+		return nil, err
+	}
+
+	// 3. Validar regras
+	if err := validateSecurityGroupRules("ingressRules", r.Spec.IngressRules); err != nil {
+		return nil, err
+	}
+	if err := validateSecurityGroupRules("egressRules", r.Spec.EgressRules); err != nil {
+		return nil, err
+	}
+
+	// 4. Validar Tags (não podem ter prefixo aws:)
 	for key := range r.Spec.Tags {
 		if regexp.MustCompile(`^aws:`).MatchString(key) {
 			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
 		}
 	}
 
-	// 3. Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	// 5. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
+
+// validateSecurityGroupRules valida protocolo, portas e origem de cada regra
+func validateSecurityGroupRules(field string, rules []SecurityGroupRule) error {
+	for i, rule := range rules {
+		path := fmt.Sprintf("%s[%d]", field, i)
+
+		protocol := strings.ToLower(rule.IpProtocol)
+		switch protocol {
+		case "tcp", "udp", "icmp", "icmpv6", "-1", "all":
+		default:
+			if n, err := strconv.Atoi(protocol); err != nil || n < 0 || n > 255 {
+				return fmt.Errorf("spec.%s.ipProtocol must be tcp, udp, icmp, icmpv6, -1 or a protocol number, got %q", path, rule.IpProtocol)
+			}
+		}
+
+		if protocol == "tcp" || protocol == "udp" {
+			if rule.FromPort < -1 || rule.FromPort > 65535 || rule.ToPort < -1 || rule.ToPort > 65535 {
+				return fmt.Errorf("spec.%s ports must be between 0 and 65535", path)
+			}
+			if rule.FromPort > rule.ToPort {
+				return fmt.Errorf("spec.%s.fromPort must not be greater than toPort", path)
+			}
+		}
+
+		for _, cidr := range append(append([]string{}, rule.CidrBlocks...), rule.Ipv6CidrBlocks...) {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return fmt.Errorf("spec.%s has an invalid CIDR block: %s", path, cidr)
+			}
+		}
+
+		if err := validateIDOrRef(path+".sourceSecurityGroupID", rule.SourceSecurityGroupID != "",
+			path+".sourceSecurityGroupRef", optionalRef(rule.SourceSecurityGroupRef), false); err != nil {
+			return err
+		}
+
+		if len(rule.CidrBlocks) == 0 && len(rule.Ipv6CidrBlocks) == 0 && len(rule.PrefixListIDs) == 0 &&
+			rule.SourceSecurityGroupID == "" && rule.SourceSecurityGroupRef == nil {
+			return fmt.Errorf("spec.%s needs a source: cidrBlocks, ipv6CidrBlocks, prefixListIDs, sourceSecurityGroupID or sourceSecurityGroupRef", path)
+		}
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ var _ = Describe("Usecuritygroup Webhook", func() {
 			},
 			Spec: SecurityGroupSpec{
 				ProviderRef: ProviderReference{Name: "test-provider"},
+				VpcID:       "vpc-11111111",
 			},
 		}
 	})
@@ -43,5 +44,68 @@ var _ = Describe("Usecuritygroup Webhook", func() {
 			_, err := obj.ValidateCreate()
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should accept a reference instead of an ID", func() {
+			obj.Spec.VpcID = ""
+			obj.Spec.VpcRef = &ResourceReference{Name: "main"}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject both ID and reference", func() {
+			obj.Spec.VpcRef = &ResourceReference{Name: "main"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should reject missing ID and reference", func() {
+			obj.Spec.VpcID = ""
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("one of spec.vpcID or spec.vpcRef"))
+		})
+
+		It("should accept rules with CIDR, prefix list and group reference sources", func() {
+			obj.Spec.IngressRules = []SecurityGroupRule{
+				{IpProtocol: "tcp", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/16"}, Description: "HTTPS"},
+				{IpProtocol: "tcp", FromPort: 5432, ToPort: 5432, SourceSecurityGroupRef: &ResourceReference{Name: "app"}},
+				{IpProtocol: "-1", PrefixListIDs: []string{"pl-12345678"}},
+			}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a rule without a source", func() {
+			obj.Spec.IngressRules = []SecurityGroupRule{{IpProtocol: "tcp", FromPort: 22, ToPort: 22}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("needs a source"))
+		})
+
+		It("should reject a rule with both a source group ID and reference", func() {
+			obj.Spec.IngressRules = []SecurityGroupRule{{
+				IpProtocol: "tcp", FromPort: 22, ToPort: 22,
+				SourceSecurityGroupID: "sg-12345678", SourceSecurityGroupRef: &ResourceReference{Name: "app"},
+			}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		})
+
+		It("should reject invalid protocols, ports and CIDR blocks", func() {
+			obj.Spec.IngressRules = []SecurityGroupRule{{IpProtocol: "http", CidrBlocks: []string{"10.0.0.0/16"}}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.IngressRules = []SecurityGroupRule{{IpProtocol: "tcp", FromPort: 443, ToPort: 80, CidrBlocks: []string{"10.0.0.0/16"}}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.EgressRules = nil
+			obj.Spec.IngressRules = []SecurityGroupRule{{IpProtocol: "tcp", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0"}}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -143,7 +143,7 @@ type SetupEKSSpec struct {
 
 	// DeletionPolicy define o comportamento ao deletar o CR
 	// +kubebuilder:default=Delete
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +optional
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
 }
@@ -11,8 +11,12 @@ type SubnetSpec struct {
 	ProviderRef ProviderReference `json:"providerRef"`
 
 	// VpcID is the ID of the VPC
-	// +kubebuilder:validation:Required
-	VpcID string `json:"vpcID"`
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// VpcRef references a VPC in the same namespace; mutually exclusive with VpcID
+	// +optional
+	VpcRef *ResourceReference `json:"vpcRef,omitempty"`
 
 	// CidrBlock is the IPv4 CIDR block for the subnet
 	// +kubebuilder:validation:Required
@@ -33,9 +37,13 @@ type SubnetSpec struct {
 
 	// DeletionPolicy
 	// +optional
-	// +kubebuilder:validation:Enum=Delete;Retain
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
 	// +kubebuilder:default=Delete
 	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+
+	// DriftPolicy overrides the provider drift detection settings for this resource
+	// +optional
+	DriftPolicy *DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // SubnetStatus defines the observed state of Subnet
@@ -71,6 +79,9 @@ type SubnetStatus struct {
 	// LastSyncTime
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// DriftStatus holds the result of the last drift check
+	DriftStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -45,6 +45,9 @@ func (r *Subnet) ValidateUpdate(old runtime.Object) (admission.Warnings, error)
 	if r.Spec.VpcID != oldSubnet.Spec.VpcID {
 		return nil, fmt.Errorf("spec.vpcID is immutable")
 	}
+	if refChanged(oldSubnet.Spec.VpcRef, r.Spec.VpcRef) {
+		return nil, fmt.Errorf("spec.vpcRef is immutable")
+	}
 	if r.Spec.AvailabilityZone != oldSubnet.Spec.AvailabilityZone {
 		return nil, fmt.Errorf("spec.availabilityZone is immutable")
 	}
@@ -70,7 +73,10 @@ func (r *Subnet) validateSubnet() (admission.Warnings, error) {
 		return nil, fmt.Errorf("spec.providerRef.name is required")
 	}
 
-	// 3. Validar VPC ID format
+	// 3. Validar VPC ID ou referência
+	if err := validateIDOrRef("vpcID", r.Spec.VpcID != "", "vpcRef", optionalRef(r.Spec.VpcRef), true); err != nil {
+		return nil, err
+	}
 	if r.Spec.VpcID != "" {
 		if !regexp.MustCompile(`^vpc-[0-9a-f]{8,17}$`).MatchString(r.Spec.VpcID) {
 			return nil, fmt.Errorf("spec.vpcID must be in format 'vpc-xxxxxxxxx'")
@@ -85,9 +91,11 @@ func (r *Subnet) validateSubnet() (admission.Warnings, error) {
 	}
 
 	// Warnings
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
@@ -0,0 +1,208 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TargetGroupSpec defines the desired state of TargetGroup
+type TargetGroupSpec struct {
+	// ProviderRef references the AWSProvider for credentials
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// TargetGroupName is the name of the target group
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	TargetGroupName string `json:"targetGroupName"`
+
+	// Protocol is HTTP or HTTPS for ALB listeners and TCP, TLS, UDP or TCP_UDP for NLB listeners
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP;TLS;UDP;TCP_UDP
+	Protocol string `json:"protocol"`
+
+	// ProtocolVersion is HTTP1, HTTP2 or GRPC, for HTTP and HTTPS target groups
+	// +kubebuilder:validation:Enum=HTTP1;HTTP2;GRPC
+	// +optional
+	ProtocolVersion string `json:"protocolVersion,omitempty"`
+
+	// Port is the port targets receive traffic on, unless a target overrides it
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// TargetType is instance (targets are EC2 instance IDs) or ip
+	// +kubebuilder:validation:Enum=instance;ip
+	// +kubebuilder:default=instance
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+
+	// VpcID is the VPC of the targets
+	// +optional
+	VpcID string `json:"vpcID,omitempty"`
+
+	// VpcRef references a VPC in the same namespace; mutually exclusive with VpcID
+	// +optional
+	VpcRef *ResourceReference `json:"vpcRef,omitempty"`
+
+	// HealthCheck overrides the AWS health check defaults
+	// +optional
+	HealthCheck *TargetGroupHealthCheck `json:"healthCheck,omitempty"`
+
+	// DeregistrationDelaySeconds is how long deregistered targets keep serving in-flight requests
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	DeregistrationDelaySeconds *int32 `json:"deregistrationDelaySeconds,omitempty"`
+
+	// Targets are registered in the target group. When set, targets registered
+	// by other means are deregistered; when empty, targets are not managed.
+	// +optional
+	Targets []TargetGroupTarget `json:"targets,omitempty"`
+
+	// Tags are custom tags for the target group
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// DeletionPolicy determines what happens when the CR is deleted
+	// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+	// +kubebuilder:default=Delete
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// TargetGroupHealthCheck configures the health check of a target group
+type TargetGroupHealthCheck struct {
+	// Protocol used to check targets
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+
+	// Port used to check targets, or traffic-port for the port targets receive traffic on
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// Path of HTTP and HTTPS health checks
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Matcher lists the success codes (e.g. 200-299), gRPC codes for GRPC target groups
+	// +optional
+	Matcher string `json:"matcher,omitempty"`
+
+	// IntervalSeconds between health checks
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// TimeoutSeconds after which a health check fails
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// HealthyThresholdCount is the number of successful checks before a target is healthy
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThresholdCount int32 `json:"healthyThresholdCount,omitempty"`
+
+	// UnhealthyThresholdCount is the number of failed checks before a target is unhealthy
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThresholdCount int32 `json:"unhealthyThresholdCount,omitempty"`
+}
+
+// TargetGroupTarget is a target registered in a target group. Set one of
+// InstanceID or InstanceRef for instance target groups and IP for ip target groups.
+type TargetGroupTarget struct {
+	// InstanceID is the ID of an EC2 instance
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// InstanceRef references an EC2Instance in the same namespace; mutually exclusive with InstanceID
+	// +optional
+	InstanceRef *ResourceReference `json:"instanceRef,omitempty"`
+
+	// IP is the IP address of the target
+	// +optional
+	IP string `json:"ip,omitempty"`
+
+	// Port overrides the target group port for this target
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// AvailabilityZone of an IP target outside the VPC, or all
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+}
+
+// TargetHealthStatus is the health of a registered target
+type TargetHealthStatus struct {
+	// ID is the instance ID or IP address of the target
+	ID string `json:"id"`
+
+	// Port the target receives traffic on
+	Port int32 `json:"port"`
+
+	// State is initial, healthy, unhealthy, unused, draining or unavailable
+	State string `json:"state"`
+
+	// Reason explains a state other than healthy
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// TargetGroupStatus defines the observed state of TargetGroup
+type TargetGroupStatus struct {
+	// Ready indicates if the target group exists
+	Ready bool `json:"ready,omitempty"`
+
+	// TargetGroupARN is the ARN of the target group
+	TargetGroupARN string `json:"targetGroupARN,omitempty"`
+
+	// LoadBalancerARNs are the load balancers forwarding to the target group
+	// +optional
+	LoadBalancerARNs []string `json:"loadBalancerARNs,omitempty"`
+
+	// Targets are the registered targets with their health
+	// +optional
+	Targets []TargetHealthStatus `json:"targets,omitempty"`
+
+	// HealthyTargets is the number of healthy targets
+	HealthyTargets int32 `json:"healthyTargets,omitempty"`
+
+	// LastSyncTime is the last time the resource was synced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=tg
+// +kubebuilder:printcolumn:name="Protocol",type=string,JSONPath=`.spec.protocol`
+// +kubebuilder:printcolumn:name="Port",type=integer,JSONPath=`.spec.port`
+// +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.healthyTargets`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TargetGroup is the Schema for the targetgroups API
+type TargetGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupSpec   `json:"spec,omitempty"`
+	Status TargetGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupList contains a list of TargetGroup
+type TargetGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetGroup{}, &TargetGroupList{})
+}
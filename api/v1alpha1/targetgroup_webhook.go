@@ -0,0 +1,141 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var targetgrouplog = logf.Log.WithName("targetgroup-resource")
+
+func (r *TargetGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-targetgroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=targetgroups,verbs=create;update,versions=v1alpha1,name=vtargetgroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &TargetGroup{}
+
+func (r *TargetGroup) ValidateCreate() (admission.Warnings, error) {
+	targetgrouplog.Info("validate create", "name", r.Name)
+	return r.validateTargetGroup()
+}
+
+func (r *TargetGroup) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	targetgrouplog.Info("validate update", "name", r.Name)
+
+	oldTG := old.(*TargetGroup)
+
+	// Campos imutáveis (a AWS não permite alterá-los no target group)
+	if r.Spec.TargetGroupName != oldTG.Spec.TargetGroupName {
+		return nil, fmt.Errorf("spec.targetGroupName is immutable")
+	}
+	if r.Spec.Protocol != oldTG.Spec.Protocol || r.Spec.ProtocolVersion != oldTG.Spec.ProtocolVersion {
+		return nil, fmt.Errorf("spec.protocol and spec.protocolVersion are immutable")
+	}
+	if r.Spec.Port != oldTG.Spec.Port {
+		return nil, fmt.Errorf("spec.port is immutable")
+	}
+	if r.Spec.TargetType != oldTG.Spec.TargetType {
+		return nil, fmt.Errorf("spec.targetType is immutable")
+	}
+	if r.Spec.VpcID != oldTG.Spec.VpcID || refChanged(oldTG.Spec.VpcRef, r.Spec.VpcRef) {
+		return nil, fmt.Errorf("spec.vpcID and spec.vpcRef are immutable")
+	}
+
+	return r.validateTargetGroup()
+}
+
+func (r *TargetGroup) ValidateDelete() (admission.Warnings, error) {
+	targetgrouplog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *TargetGroup) validateTargetGroup() (admission.Warnings, error) {
+	var warnings admission.Warnings
+
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar nome (letras, números e hífens, sem hífen nas pontas)
+	if !regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$`).MatchString(r.Spec.TargetGroupName) {
+		return nil, fmt.Errorf("spec.targetGroupName must have 1-32 letters, digits or hyphens and cannot start or end with a hyphen")
+	}
+
+	// 3. Validar VPC ou referência
+	if err := validateIDOrRef("vpcID", r.Spec.VpcID != "", "vpcRef", optionalRef(r.Spec.VpcRef), true); err != nil {
+		return nil, err
+	}
+
+	// 4. Validar protocolo e health check
+	if r.Spec.ProtocolVersion != "" && r.Spec.Protocol != "HTTP" && r.Spec.Protocol != "HTTPS" {
+		return nil, fmt.Errorf("spec.protocolVersion is only valid for HTTP and HTTPS target groups")
+	}
+	if hc := r.Spec.HealthCheck; hc != nil {
+		if hc.TimeoutSeconds != 0 && hc.IntervalSeconds != 0 && hc.TimeoutSeconds >= hc.IntervalSeconds {
+			return nil, fmt.Errorf("spec.healthCheck.timeoutSeconds must be less than intervalSeconds")
+		}
+		if hc.Path != "" && !strings.HasPrefix(hc.Path, "/") {
+			return nil, fmt.Errorf("spec.healthCheck.path must start with /")
+		}
+	}
+
+	// 5. Validar targets conforme o targetType
+	targetType := r.Spec.TargetType
+	if targetType == "" {
+		targetType = "instance"
+	}
+	for i, target := range r.Spec.Targets {
+		path := fmt.Sprintf("targets[%d]", i)
+		if targetType == "ip" {
+			if target.InstanceID != "" || target.InstanceRef != nil {
+				return nil, fmt.Errorf("spec.%s must set ip, not an instance, when spec.targetType is ip", path)
+			}
+			if net.ParseIP(target.IP) == nil {
+				return nil, fmt.Errorf("spec.%s.ip must be an IP address, got %q", path, target.IP)
+			}
+			continue
+		}
+
+		if target.IP != "" {
+			return nil, fmt.Errorf("spec.%s.ip requires spec.targetType ip", path)
+		}
+		if err := validateIDOrRef(path+".instanceID", target.InstanceID != "", path+".instanceRef", optionalRef(target.InstanceRef), true); err != nil {
+			return nil, err
+		}
+		if target.InstanceID != "" && !strings.HasPrefix(target.InstanceID, "i-") {
+			return nil, fmt.Errorf("spec.%s.instanceID must be an instance ID (i-...), got %q", path, target.InstanceID)
+		}
+	}
+
+	// 6. Validar Tags (não podem ter prefixo aws:)
+	for key := range r.Spec.Tags {
+		if regexp.MustCompile(`^aws:`).MatchString(key) {
+			return nil, fmt.Errorf("tag keys cannot start with 'aws:': %s", key)
+		}
+	}
+
+	// 7. Warnings
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, policyWarnings...)
+
+	return warnings, nil
+}
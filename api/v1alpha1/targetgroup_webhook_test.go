@@ -0,0 +1,105 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("TargetGroup Webhook", func() {
+	var obj *TargetGroup
+
+	BeforeEach(func() {
+		obj = &TargetGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-targetgroup",
+				Namespace: "default",
+			},
+			Spec: TargetGroupSpec{
+				ProviderRef:     ProviderReference{Name: "test-provider"},
+				TargetGroupName: "api",
+				Protocol:        "HTTP",
+				Port:            8080,
+				TargetType:      "instance",
+				VpcID:           "vpc-12345678",
+				DeletionPolicy:  "Delete",
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid TargetGroup", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should require exactly one of vpcID and vpcRef", func() {
+			obj.Spec.VpcRef = &ResourceReference{Name: "main"}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.VpcID = ""
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject names ending with a hyphen", func() {
+			obj.Spec.TargetGroupName = "api-"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject protocolVersion on TCP target groups", func() {
+			obj.Spec.Protocol = "TCP"
+			obj.Spec.ProtocolVersion = "HTTP2"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a health check timeout above the interval", func() {
+			obj.Spec.HealthCheck = &TargetGroupHealthCheck{IntervalSeconds: 10, TimeoutSeconds: 10}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should validate targets against the target type", func() {
+			obj.Spec.Targets = []TargetGroupTarget{{InstanceID: "i-0123456789abcdef0"}, {InstanceRef: &ResourceReference{Name: "web"}}}
+			_, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Targets = []TargetGroupTarget{{IP: "10.0.1.10"}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.TargetType = "ip"
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Targets = []TargetGroupTarget{{IP: "not-an-ip"}}
+			_, err = obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing the health check and targets", func() {
+			old := obj.DeepCopy()
+			obj.Spec.HealthCheck = &TargetGroupHealthCheck{Path: "/healthz"}
+			obj.Spec.Targets = []TargetGroupTarget{{InstanceID: "i-0123456789abcdef0"}}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing the port", func() {
+			old := obj.DeepCopy()
+			obj.Spec.Port = 9090
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,115 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TargetGroupBindingSpec defines the desired state of TargetGroupBinding
+type TargetGroupBindingSpec struct {
+	// ProviderRef references the AWSProvider for credentials
+	ProviderRef ProviderReference `json:"providerRef"`
+
+	// TargetGroupARN is the ARN of the target group
+	// +optional
+	TargetGroupARN string `json:"targetGroupARN,omitempty"`
+
+	// TargetGroupRef references a TargetGroup in the same namespace; mutually exclusive with TargetGroupARN
+	// +optional
+	TargetGroupRef *ResourceReference `json:"targetGroupRef,omitempty"`
+
+	// TargetType must match the target group: ip registers pod IPs, instance
+	// registers the nodes with the Service node port. Defaults to the target
+	// type of the referenced TargetGroup; required with TargetGroupARN.
+	// +kubebuilder:validation:Enum=instance;ip
+	// +optional
+	TargetType string `json:"targetType,omitempty"`
+
+	// ServiceRef is the Service whose endpoints are registered
+	ServiceRef TargetGroupBindingServiceRef `json:"serviceRef"`
+
+	// NodeSelector restricts the nodes registered by instance bindings
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+}
+
+// TargetGroupBindingServiceRef references a port of a Service in the same namespace
+type TargetGroupBindingServiceRef struct {
+	// Name of the Service
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port is the name or number of the Service port
+	Port intstr.IntOrString `json:"port"`
+}
+
+// TargetGroupBindingTarget is a target registered by a TargetGroupBinding
+type TargetGroupBindingTarget struct {
+	// ID is the instance ID or IP address of the target
+	ID string `json:"id"`
+
+	// Port the target receives traffic on
+	Port int32 `json:"port"`
+}
+
+// TargetGroupBindingStatus defines the observed state of TargetGroupBinding
+type TargetGroupBindingStatus struct {
+	// Ready indicates if the endpoints of the Service are registered
+	Ready bool `json:"ready,omitempty"`
+
+	// TargetGroupARN is the resolved ARN of the target group
+	TargetGroupARN string `json:"targetGroupARN,omitempty"`
+
+	// TargetType is the resolved target type
+	TargetType string `json:"targetType,omitempty"`
+
+	// Targets are the registered targets with their health
+	// +optional
+	Targets []TargetHealthStatus `json:"targets,omitempty"`
+
+	// RegisteredTargets is the number of endpoints that should receive traffic
+	RegisteredTargets int32 `json:"registeredTargets,omitempty"`
+
+	// ManagedTargets are the targets registered by the binding. Only these
+	// are deregistered, so other targets of the group are left alone.
+	// +optional
+	ManagedTargets []TargetGroupBindingTarget `json:"managedTargets,omitempty"`
+
+	// HealthyTargets is the number of healthy targets
+	HealthyTargets int32 `json:"healthyTargets,omitempty"`
+
+	// LastSyncTime is the last time the resource was synced
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=tgb
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.spec.serviceRef.name`
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.status.targetType`
+// +kubebuilder:printcolumn:name="Registered",type=integer,JSONPath=`.status.registeredTargets`
+// +kubebuilder:printcolumn:name="Healthy",type=integer,JSONPath=`.status.healthyTargets`
+// +kubebuilder:printcolumn:name="Ready",type=boolean,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TargetGroupBinding is the Schema for the targetgroupbindings API
+type TargetGroupBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupBindingSpec   `json:"spec,omitempty"`
+	Status TargetGroupBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupBindingList contains a list of TargetGroupBinding
+type TargetGroupBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroupBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetGroupBinding{}, &TargetGroupBindingList{})
+}
@@ -0,0 +1,91 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var targetgroupbindinglog = logf.Log.WithName("targetgroupbinding-resource")
+
+func (r *TargetGroupBinding) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-aws-infra-operator-io-v1alpha1-targetgroupbinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=aws-infra-operator.runner.codes,resources=targetgroupbindings,verbs=create;update,versions=v1alpha1,name=vtargetgroupbinding.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &TargetGroupBinding{}
+
+func (r *TargetGroupBinding) ValidateCreate() (admission.Warnings, error) {
+	targetgroupbindinglog.Info("validate create", "name", r.Name)
+	return r.validateTargetGroupBinding()
+}
+
+func (r *TargetGroupBinding) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	targetgroupbindinglog.Info("validate update", "name", r.Name)
+
+	oldBinding := old.(*TargetGroupBinding)
+
+	// O target group e o tipo de target são imutáveis
+	if r.Spec.TargetGroupARN != oldBinding.Spec.TargetGroupARN || refChanged(oldBinding.Spec.TargetGroupRef, r.Spec.TargetGroupRef) {
+		return nil, fmt.Errorf("spec.targetGroupARN and spec.targetGroupRef are immutable")
+	}
+	if r.Spec.TargetType != oldBinding.Spec.TargetType {
+		return nil, fmt.Errorf("spec.targetType is immutable")
+	}
+
+	return r.validateTargetGroupBinding()
+}
+
+func (r *TargetGroupBinding) ValidateDelete() (admission.Warnings, error) {
+	targetgroupbindinglog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *TargetGroupBinding) validateTargetGroupBinding() (admission.Warnings, error) {
+	// 1. Validar ProviderRef
+	if r.Spec.ProviderRef.Name == "" {
+		return nil, fmt.Errorf("spec.providerRef.name is required")
+	}
+
+	// 2. Validar target group ou referência
+	if err := validateIDOrRef("targetGroupARN", r.Spec.TargetGroupARN != "", "targetGroupRef", optionalRef(r.Spec.TargetGroupRef), true); err != nil {
+		return nil, err
+	}
+	if r.Spec.TargetGroupARN != "" && r.Spec.TargetType == "" {
+		return nil, fmt.Errorf("spec.targetType is required with spec.targetGroupARN")
+	}
+
+	// 3. Validar Service
+	if r.Spec.ServiceRef.Name == "" {
+		return nil, fmt.Errorf("spec.serviceRef.name is required")
+	}
+	port := r.Spec.ServiceRef.Port
+	if (port.Type == intstr.Int && (port.IntVal < 1 || port.IntVal > 65535)) || (port.Type == intstr.String && port.StrVal == "") {
+		return nil, fmt.Errorf("spec.serviceRef.port must be a port number or name")
+	}
+
+	// 4. Validar nodeSelector (somente bindings instance)
+	if r.Spec.NodeSelector != nil {
+		if r.Spec.TargetType == "ip" {
+			return nil, fmt.Errorf("spec.nodeSelector is only valid for instance bindings")
+		}
+		if _, err := metav1.LabelSelectorAsSelector(r.Spec.NodeSelector); err != nil {
+			return nil, fmt.Errorf("spec.nodeSelector is invalid: %w", err)
+		}
+	}
+
+	return nil, nil
+}
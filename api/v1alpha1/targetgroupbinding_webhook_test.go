@@ -0,0 +1,89 @@
+// Package v1alpha1 contém as definições de API para aws-infra-operator.runner.codes/v1alpha1.
+//
+// Este package define todos os Custom Resource Definitions (CRDs) para gerenciamento
+// de recursos AWS através do Kubernetes.
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("TargetGroupBinding Webhook", func() {
+	var obj *TargetGroupBinding
+
+	BeforeEach(func() {
+		obj = &TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-targetgroupbinding",
+				Namespace: "default",
+			},
+			Spec: TargetGroupBindingSpec{
+				ProviderRef:    ProviderReference{Name: "test-provider"},
+				TargetGroupRef: &ResourceReference{Name: "api"},
+				ServiceRef:     TargetGroupBindingServiceRef{Name: "api", Port: intstr.FromString("http")},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("should accept valid TargetGroupBinding", func() {
+			warnings, err := obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("should require the target type with a target group ARN", func() {
+			obj.Spec.TargetGroupRef = nil
+			obj.Spec.TargetGroupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/api/abc"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.TargetType = "ip"
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should require exactly one of targetGroupARN and targetGroupRef", func() {
+			obj.Spec.TargetGroupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/api/abc"
+			obj.Spec.TargetType = "ip"
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an invalid service port", func() {
+			obj.Spec.ServiceRef.Port = intstr.FromInt(0)
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a node selector on ip bindings", func() {
+			obj.Spec.TargetType = "ip"
+			obj.Spec.NodeSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "web"}}
+			_, err := obj.ValidateCreate()
+			Expect(err).To(HaveOccurred())
+
+			obj.Spec.TargetType = "instance"
+			_, err = obj.ValidateCreate()
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("should allow changing the service", func() {
+			old := obj.DeepCopy()
+			obj.Spec.ServiceRef = TargetGroupBindingServiceRef{Name: "api-v2", Port: intstr.FromInt(8080)}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject changing the target group", func() {
+			old := obj.DeepCopy()
+			obj.Spec.TargetGroupRef = &ResourceReference{Name: "other"}
+			_, err := obj.ValidateUpdate(old)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -79,9 +79,11 @@ func (r *VPC) validateVPC() (admission.Warnings, error) {
 	}
 
 	// 4. Warnings (não bloqueiam)
-	if r.Spec.DeletionPolicy == "" {
-		warnings = append(warnings, "spec.deletionPolicy not set, defaulting to 'Delete'")
+	policyWarnings, err := validateDeletionPolicy(r.Spec.DeletionPolicy, "Delete")
+	if err != nil {
+		return nil, err
 	}
+	warnings = append(warnings, policyWarnings...)
 
 	return warnings, nil
 }
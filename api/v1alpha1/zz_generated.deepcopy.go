@@ -93,11 +93,21 @@ func (in *ALBSpec) DeepCopyInto(out *ALBSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.SecurityGroups != nil {
 		in, out := &in.SecurityGroups, &out.SecurityGroups
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -105,6 +115,11 @@ func (in *ALBSpec) DeepCopyInto(out *ALBSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALBSpec.
@@ -124,6 +139,7 @@ func (in *ALBStatus) DeepCopyInto(out *ALBStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ALBStatus.
@@ -211,6 +227,16 @@ func (in *APIGatewaySpec) DeepCopyInto(out *APIGatewaySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIGatewaySpec.
@@ -230,6 +256,7 @@ func (in *APIGatewayStatus) DeepCopyInto(out *APIGatewayStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIGatewayStatus.
@@ -326,6 +353,11 @@ func (in *AWSProviderSpec) DeepCopyInto(out *AWSProviderSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftDetection != nil {
+		in, out := &in.DriftDetection, &out.DriftDetection
+		*out = new(DriftDetectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProviderSpec.
@@ -409,6 +441,291 @@ func (in *AttributeDefinition) DeepCopy() *AttributeDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroup) DeepCopyInto(out *AutoScalingGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroup.
+func (in *AutoScalingGroup) DeepCopy() *AutoScalingGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoScalingGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupList) DeepCopyInto(out *AutoScalingGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AutoScalingGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupList.
+func (in *AutoScalingGroupList) DeepCopy() *AutoScalingGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AutoScalingGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupSpec) DeepCopyInto(out *AutoScalingGroupSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.DesiredCapacity != nil {
+		in, out := &in.DesiredCapacity, &out.DesiredCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	in.LaunchTemplate.DeepCopyInto(&out.LaunchTemplate)
+	if in.MixedInstancesPolicy != nil {
+		in, out := &in.MixedInstancesPolicy, &out.MixedInstancesPolicy
+		*out = new(AutoScalingMixedInstancesPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheckGracePeriodSeconds != nil {
+		in, out := &in.HealthCheckGracePeriodSeconds, &out.HealthCheckGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetGroupARNs != nil {
+		in, out := &in.TargetGroupARNs, &out.TargetGroupARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroupRefs != nil {
+		in, out := &in.TargetGroupRefs, &out.TargetGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.LifecycleHooks != nil {
+		in, out := &in.LifecycleHooks, &out.LifecycleHooks
+		*out = make([]AutoScalingLifecycleHook, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstanceRefresh != nil {
+		in, out := &in.InstanceRefresh, &out.InstanceRefresh
+		*out = new(AutoScalingInstanceRefresh)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupSpec.
+func (in *AutoScalingGroupSpec) DeepCopy() *AutoScalingGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingGroupStatus) DeepCopyInto(out *AutoScalingGroupStatus) {
+	*out = *in
+	if in.InstanceRefresh != nil {
+		in, out := &in.InstanceRefresh, &out.InstanceRefresh
+		*out = new(AutoScalingInstanceRefreshStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingGroupStatus.
+func (in *AutoScalingGroupStatus) DeepCopy() *AutoScalingGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingInstanceRefresh) DeepCopyInto(out *AutoScalingInstanceRefresh) {
+	*out = *in
+	if in.MinHealthyPercentage != nil {
+		in, out := &in.MinHealthyPercentage, &out.MinHealthyPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InstanceWarmupSeconds != nil {
+		in, out := &in.InstanceWarmupSeconds, &out.InstanceWarmupSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SkipMatching != nil {
+		in, out := &in.SkipMatching, &out.SkipMatching
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingInstanceRefresh.
+func (in *AutoScalingInstanceRefresh) DeepCopy() *AutoScalingInstanceRefresh {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingInstanceRefresh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingInstanceRefreshStatus) DeepCopyInto(out *AutoScalingInstanceRefreshStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingInstanceRefreshStatus.
+func (in *AutoScalingInstanceRefreshStatus) DeepCopy() *AutoScalingInstanceRefreshStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingInstanceRefreshStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingInstanceType) DeepCopyInto(out *AutoScalingInstanceType) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingInstanceType.
+func (in *AutoScalingInstanceType) DeepCopy() *AutoScalingInstanceType {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingInstanceType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingLaunchTemplate) DeepCopyInto(out *AutoScalingLaunchTemplate) {
+	*out = *in
+	if in.LaunchTemplateRef != nil {
+		in, out := &in.LaunchTemplateRef, &out.LaunchTemplateRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingLaunchTemplate.
+func (in *AutoScalingLaunchTemplate) DeepCopy() *AutoScalingLaunchTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingLaunchTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingLifecycleHook) DeepCopyInto(out *AutoScalingLifecycleHook) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingLifecycleHook.
+func (in *AutoScalingLifecycleHook) DeepCopy() *AutoScalingLifecycleHook {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingLifecycleHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingMixedInstancesPolicy) DeepCopyInto(out *AutoScalingMixedInstancesPolicy) {
+	*out = *in
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]AutoScalingInstanceType, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnDemandBaseCapacity != nil {
+		in, out := &in.OnDemandBaseCapacity, &out.OnDemandBaseCapacity
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnDemandPercentageAboveBaseCapacity != nil {
+		in, out := &in.OnDemandPercentageAboveBaseCapacity, &out.OnDemandPercentageAboveBaseCapacity
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingMixedInstancesPolicy.
+func (in *AutoScalingMixedInstancesPolicy) DeepCopy() *AutoScalingMixedInstancesPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingMixedInstancesPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BastionInstanceConfig) DeepCopyInto(out *BastionInstanceConfig) {
 	*out = *in
@@ -476,6 +793,26 @@ func (in *BlockDeviceMapping) DeepCopy() *BlockDeviceMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketNotification) DeepCopyInto(out *BucketNotification) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketNotification.
+func (in *BucketNotification) DeepCopy() *BucketNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CORSRule) DeepCopyInto(out *CORSRule) {
 	*out = *in
@@ -616,6 +953,11 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
@@ -640,6 +982,7 @@ func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateStatus.
@@ -819,6 +1162,11 @@ func (in *CloudFrontSpec) DeepCopyInto(out *CloudFrontSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontSpec.
@@ -838,6 +1186,7 @@ func (in *CloudFrontStatus) DeepCopyInto(out *CloudFrontStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFrontStatus.
@@ -1111,6 +1460,21 @@ func (in *ComputeStackStatus) DeepCopy() *ComputeStackStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionSecretReference) DeepCopyInto(out *ConnectionSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionSecretReference.
+func (in *ConnectionSecretReference) DeepCopy() *ConnectionSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CorsConfiguration) DeepCopyInto(out *CorsConfiguration) {
 	*out = *in
@@ -1177,32 +1541,327 @@ func (in *CustomOriginConfig) DeepCopy() *CustomOriginConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeadLetterQueueConfig) DeepCopyInto(out *DeadLetterQueueConfig) {
+func (in *DBParameterGroup) DeepCopyInto(out *DBParameterGroup) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterQueueConfig.
-func (in *DeadLetterQueueConfig) DeepCopy() *DeadLetterQueueConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroup.
+func (in *DBParameterGroup) DeepCopy() *DBParameterGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(DeadLetterQueueConfig)
+	out := new(DBParameterGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DriftDetail) DeepCopyInto(out *DriftDetail) {
-	*out = *in
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetail.
-func (in *DriftDetail) DeepCopy() *DriftDetail {
-	if in == nil {
-		return nil
-	}
-	out := new(DriftDetail)
-	in.DeepCopyInto(out)
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupList) DeepCopyInto(out *DBParameterGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBParameterGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupList.
+func (in *DBParameterGroupList) DeepCopy() *DBParameterGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBParameterGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupSpec) DeepCopyInto(out *DBParameterGroupSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupSpec.
+func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
+func (in *DBParameterGroupStatus) DeepCopy() *DBParameterGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBParameterGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroup) DeepCopyInto(out *DBSubnetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroup.
+func (in *DBSubnetGroup) DeepCopy() *DBSubnetGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupList) DeepCopyInto(out *DBSubnetGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBSubnetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupList.
+func (in *DBSubnetGroupList) DeepCopy() *DBSubnetGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBSubnetGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupSpec) DeepCopyInto(out *DBSubnetGroupSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupSpec.
+func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupStatus.
+func (in *DBSubnetGroupStatus) DeepCopy() *DBSubnetGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBSubnetGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterQueueConfig) DeepCopyInto(out *DeadLetterQueueConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterQueueConfig.
+func (in *DeadLetterQueueConfig) DeepCopy() *DeadLetterQueueConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterQueueConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetail) DeepCopyInto(out *DriftDetail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetail.
+func (in *DriftDetail) DeepCopy() *DriftDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetectionConfig) DeepCopyInto(out *DriftDetectionConfig) {
+	*out = *in
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetectionConfig.
+func (in *DriftDetectionConfig) DeepCopy() *DriftDetectionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetectionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftPolicy) DeepCopyInto(out *DriftPolicy) {
+	*out = *in
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftPolicy.
+func (in *DriftPolicy) DeepCopy() *DriftPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftStatus) DeepCopyInto(out *DriftStatus) {
+	*out = *in
+	if in.DriftDetails != nil {
+		in, out := &in.DriftDetails, &out.DriftDetails
+		*out = make([]DriftDetail, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastDriftCheck != nil {
+		in, out := &in.LastDriftCheck, &out.LastDriftCheck
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftStatus.
+func (in *DriftStatus) DeepCopy() *DriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftStatus)
+	in.DeepCopyInto(out)
 	return out
 }
 
@@ -1269,6 +1928,11 @@ func (in *DynamoDBTableList) DeepCopyObject() runtime.Object {
 func (in *DynamoDBTableSpec) DeepCopyInto(out *DynamoDBTableSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.ProvisionedThroughput != nil {
+		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
+		*out = new(ProvisionedThroughput)
+		**out = **in
+	}
 	out.HashKey = in.HashKey
 	if in.RangeKey != nil {
 		in, out := &in.RangeKey, &out.RangeKey
@@ -1287,6 +1951,11 @@ func (in *DynamoDBTableSpec) DeepCopyInto(out *DynamoDBTableSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TimeToLive != nil {
+		in, out := &in.TimeToLive, &out.TimeToLive
+		*out = new(TimeToLiveSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -1294,6 +1963,16 @@ func (in *DynamoDBTableSpec) DeepCopyInto(out *DynamoDBTableSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoDBTableSpec.
@@ -1320,6 +1999,7 @@ func (in *DynamoDBTableStatus) DeepCopyInto(out *DynamoDBTableStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamoDBTableStatus.
@@ -1410,11 +2090,21 @@ func (in *EC2InstanceList) DeepCopyObject() runtime.Object {
 func (in *EC2InstanceSpec) DeepCopyInto(out *EC2InstanceSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.SecurityGroupIDs != nil {
 		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.BlockDeviceMappings != nil {
 		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
 		*out = make([]BlockDeviceMapping, len(*in))
@@ -1429,6 +2119,11 @@ func (in *EC2InstanceSpec) DeepCopyInto(out *EC2InstanceSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2InstanceSpec.
@@ -1463,6 +2158,7 @@ func (in *EC2InstanceStatus) DeepCopyInto(out *EC2InstanceStatus) {
 		in, out := &in.ConsoleOutputTimestamp, &out.ConsoleOutputTimestamp
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EC2InstanceStatus.
@@ -1668,6 +2364,11 @@ func (in *ECRRepositorySpec) DeepCopyInto(out *ECRRepositorySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRRepositorySpec.
@@ -1692,6 +2393,7 @@ func (in *ECRRepositoryStatus) DeepCopyInto(out *ECRRepositoryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECRRepositoryStatus.
@@ -1799,6 +2501,11 @@ func (in *ECSClusterSpec) DeepCopyInto(out *ECSClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECSClusterSpec.
@@ -1818,6 +2525,7 @@ func (in *ECSClusterStatus) DeepCopyInto(out *ECSClusterStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ECSClusterStatus.
@@ -1951,6 +2659,11 @@ func (in *EKSClusterSpec) DeepCopyInto(out *EKSClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterSpec.
@@ -1970,6 +2683,7 @@ func (in *EKSClusterStatus) DeepCopyInto(out *EKSClusterStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterStatus.
@@ -2162,6 +2876,16 @@ func (in *ElastiCacheClusterSpec) DeepCopyInto(out *ElastiCacheClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElastiCacheClusterSpec.
@@ -2217,6 +2941,7 @@ func (in *ElastiCacheClusterStatus) DeepCopyInto(out *ElastiCacheClusterStatus)
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElastiCacheClusterStatus.
@@ -2299,6 +3024,11 @@ func (in *ElasticIPSpec) DeepCopyInto(out *ElasticIPSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPSpec.
@@ -2318,6 +3048,7 @@ func (in *ElasticIPStatus) DeepCopyInto(out *ElasticIPStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPStatus.
@@ -2423,6 +3154,11 @@ func (in *GlobalSecondaryIndex) DeepCopyInto(out *GlobalSecondaryIndex) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProvisionedThroughput != nil {
+		in, out := &in.ProvisionedThroughput, &out.ProvisionedThroughput
+		*out = new(ProvisionedThroughput)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalSecondaryIndex.
@@ -2515,6 +3251,11 @@ func (in *IAMRoleSpec) DeepCopyInto(out *IAMRoleSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleSpec.
@@ -2538,6 +3279,7 @@ func (in *IAMRoleStatus) DeepCopyInto(out *IAMRoleStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleStatus.
@@ -2658,6 +3400,11 @@ func (in *InternetGatewayList) DeepCopyObject() runtime.Object {
 func (in *InternetGatewaySpec) DeepCopyInto(out *InternetGatewaySpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.VpcRef != nil {
+		in, out := &in.VpcRef, &out.VpcRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -2665,6 +3412,11 @@ func (in *InternetGatewaySpec) DeepCopyInto(out *InternetGatewaySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetGatewaySpec.
@@ -2684,6 +3436,7 @@ func (in *InternetGatewayStatus) DeepCopyInto(out *InternetGatewayStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetGatewayStatus.
@@ -2766,6 +3519,11 @@ func (in *KMSKeySpec) DeepCopyInto(out *KMSKeySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeySpec.
@@ -2800,6 +3558,7 @@ func (in *KMSKeyStatus) DeepCopyInto(out *KMSKeyStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSKeyStatus.
@@ -2827,9 +3586,148 @@ func (in *KeyPairSecretRef) DeepCopy() *KeyPairSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaAlias) DeepCopyInto(out *LambdaAlias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaAlias.
+func (in *LambdaAlias) DeepCopy() *LambdaAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LambdaAlias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaAliasList) DeepCopyInto(out *LambdaAliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LambdaAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaAliasList.
+func (in *LambdaAliasList) DeepCopy() *LambdaAliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaAliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LambdaAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaAliasRoutingConfig) DeepCopyInto(out *LambdaAliasRoutingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaAliasRoutingConfig.
+func (in *LambdaAliasRoutingConfig) DeepCopy() *LambdaAliasRoutingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaAliasRoutingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaAliasSpec) DeepCopyInto(out *LambdaAliasSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.FunctionRef != nil {
+		in, out := &in.FunctionRef, &out.FunctionRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.RoutingConfig != nil {
+		in, out := &in.RoutingConfig, &out.RoutingConfig
+		*out = new(LambdaAliasRoutingConfig)
+		**out = **in
+	}
+	if in.TrafficShift != nil {
+		in, out := &in.TrafficShift, &out.TrafficShift
+		*out = new(LambdaTrafficShift)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaAliasSpec.
+func (in *LambdaAliasSpec) DeepCopy() *LambdaAliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaAliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaAliasStatus) DeepCopyInto(out *LambdaAliasStatus) {
+	*out = *in
+	if in.TrafficShift != nil {
+		in, out := &in.TrafficShift, &out.TrafficShift
+		*out = new(LambdaTrafficShiftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaAliasStatus.
+func (in *LambdaAliasStatus) DeepCopy() *LambdaAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaCode) DeepCopyInto(out *LambdaCode) {
 	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(LambdaCodeOCI)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaCode.
@@ -2842,6 +3740,26 @@ func (in *LambdaCode) DeepCopy() *LambdaCode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaCodeOCI) DeepCopyInto(out *LambdaCodeOCI) {
+	*out = *in
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaCodeOCI.
+func (in *LambdaCodeOCI) DeepCopy() *LambdaCodeOCI {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaCodeOCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaEnvironment) DeepCopyInto(out *LambdaEnvironment) {
 	*out = *in
@@ -2864,6 +3782,91 @@ func (in *LambdaEnvironment) DeepCopy() *LambdaEnvironment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaEventSource) DeepCopyInto(out *LambdaEventSource) {
+	*out = *in
+	if in.SQSQueueRef != nil {
+		in, out := &in.SQSQueueRef, &out.SQSQueueRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.DynamoDBTableRef != nil {
+		in, out := &in.DynamoDBTableRef, &out.DynamoDBTableRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FilterPatterns != nil {
+		in, out := &in.FilterPatterns, &out.FilterPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaximumRetryAttempts != nil {
+		in, out := &in.MaximumRetryAttempts, &out.MaximumRetryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(LambdaEventSourceDestination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaEventSource.
+func (in *LambdaEventSource) DeepCopy() *LambdaEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaEventSourceDestination) DeepCopyInto(out *LambdaEventSourceDestination) {
+	*out = *in
+	if in.SQSQueueRef != nil {
+		in, out := &in.SQSQueueRef, &out.SQSQueueRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.SNSTopicRef != nil {
+		in, out := &in.SNSTopicRef, &out.SNSTopicRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaEventSourceDestination.
+func (in *LambdaEventSourceDestination) DeepCopy() *LambdaEventSourceDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaEventSourceDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaEventSourceStatus) DeepCopyInto(out *LambdaEventSourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaEventSourceStatus.
+func (in *LambdaEventSourceStatus) DeepCopy() *LambdaEventSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaEventSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaFunction) DeepCopyInto(out *LambdaFunction) {
 	*out = *in
@@ -2927,7 +3930,7 @@ func (in *LambdaFunctionList) DeepCopyObject() runtime.Object {
 func (in *LambdaFunctionSpec) DeepCopyInto(out *LambdaFunctionSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
-	out.Code = in.Code
+	in.Code.DeepCopyInto(&out.Code)
 	if in.Environment != nil {
 		in, out := &in.Environment, &out.Environment
 		*out = new(LambdaEnvironment)
@@ -2950,6 +3953,25 @@ func (in *LambdaFunctionSpec) DeepCopyInto(out *LambdaFunctionSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EventSources != nil {
+		in, out := &in.EventSources, &out.EventSources
+		*out = make([]LambdaEventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]LambdaPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionSpec.
@@ -2965,6 +3987,21 @@ func (in *LambdaFunctionSpec) DeepCopy() *LambdaFunctionSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaFunctionStatus) DeepCopyInto(out *LambdaFunctionStatus) {
 	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]LambdaFunctionVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.EventSources != nil {
+		in, out := &in.EventSources, &out.EventSources
+		*out = make([]LambdaEventSourceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PermissionStatementIDs != nil {
+		in, out := &in.PermissionStatementIDs, &out.PermissionStatementIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -2973,6 +4010,7 @@ func (in *LambdaFunctionStatus) DeepCopyInto(out *LambdaFunctionStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionStatus.
@@ -2986,102 +4024,108 @@ func (in *LambdaFunctionStatus) DeepCopy() *LambdaFunctionStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LambdaVpcConfig) DeepCopyInto(out *LambdaVpcConfig) {
+func (in *LambdaFunctionVersion) DeepCopyInto(out *LambdaFunctionVersion) {
 	*out = *in
-	if in.SecurityGroupIds != nil {
-		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SubnetIds != nil {
-		in, out := &in.SubnetIds, &out.SubnetIds
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaVpcConfig.
-func (in *LambdaVpcConfig) DeepCopy() *LambdaVpcConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionVersion.
+func (in *LambdaFunctionVersion) DeepCopy() *LambdaFunctionVersion {
 	if in == nil {
 		return nil
 	}
-	out := new(LambdaVpcConfig)
+	out := new(LambdaFunctionVersion)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleExpiration) DeepCopyInto(out *LifecycleExpiration) {
+func (in *LambdaPermission) DeepCopyInto(out *LambdaPermission) {
 	*out = *in
+	if in.SNSTopicRef != nil {
+		in, out := &in.SNSTopicRef, &out.SNSTopicRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleExpiration.
-func (in *LifecycleExpiration) DeepCopy() *LifecycleExpiration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaPermission.
+func (in *LambdaPermission) DeepCopy() *LambdaPermission {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleExpiration)
+	out := new(LambdaPermission)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
+func (in *LambdaTrafficShift) DeepCopyInto(out *LambdaTrafficShift) {
 	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlarmNames != nil {
+		in, out := &in.AlarmNames, &out.AlarmNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicy.
-func (in *LifecyclePolicy) DeepCopy() *LifecyclePolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaTrafficShift.
+func (in *LambdaTrafficShift) DeepCopy() *LambdaTrafficShift {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecyclePolicy)
+	out := new(LambdaTrafficShift)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+func (in *LambdaTrafficShiftStatus) DeepCopyInto(out *LambdaTrafficShiftStatus) {
 	*out = *in
-	if in.Expiration != nil {
-		in, out := &in.Expiration, &out.Expiration
-		*out = new(LifecycleExpiration)
-		**out = **in
-	}
-	if in.Transitions != nil {
-		in, out := &in.Transitions, &out.Transitions
-		*out = make([]LifecycleTransition, len(*in))
-		copy(*out, *in)
-	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
-func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaTrafficShiftStatus.
+func (in *LambdaTrafficShiftStatus) DeepCopy() *LambdaTrafficShiftStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleRule)
+	out := new(LambdaTrafficShiftStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LifecycleTransition) DeepCopyInto(out *LifecycleTransition) {
+func (in *LambdaVpcConfig) DeepCopyInto(out *LambdaVpcConfig) {
 	*out = *in
+	if in.SecurityGroupIds != nil {
+		in, out := &in.SecurityGroupIds, &out.SecurityGroupIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIds != nil {
+		in, out := &in.SubnetIds, &out.SubnetIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleTransition.
-func (in *LifecycleTransition) DeepCopy() *LifecycleTransition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaVpcConfig.
+func (in *LambdaVpcConfig) DeepCopy() *LambdaVpcConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LifecycleTransition)
+	out := new(LambdaVpcConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGateway) DeepCopyInto(out *NATGateway) {
+func (in *LaunchTemplate) DeepCopyInto(out *LaunchTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3089,18 +4133,18 @@ func (in *NATGateway) DeepCopyInto(out *NATGateway) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGateway.
-func (in *NATGateway) DeepCopy() *NATGateway {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplate.
+func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGateway)
+	out := new(LaunchTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NATGateway) DeepCopyObject() runtime.Object {
+func (in *LaunchTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3108,46 +4152,31 @@ func (in *NATGateway) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewayConfig) DeepCopyInto(out *NATGatewayConfig) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayConfig.
-func (in *NATGatewayConfig) DeepCopy() *NATGatewayConfig {
-	if in == nil {
-		return nil
-	}
-	out := new(NATGatewayConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewayList) DeepCopyInto(out *NATGatewayList) {
+func (in *LaunchTemplateList) DeepCopyInto(out *LaunchTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NATGateway, len(*in))
+		*out = make([]LaunchTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayList.
-func (in *NATGatewayList) DeepCopy() *NATGatewayList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateList.
+func (in *LaunchTemplateList) DeepCopy() *LaunchTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewayList)
+	out := new(LaunchTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NATGatewayList) DeepCopyObject() runtime.Object {
+func (in *LaunchTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3155,9 +4184,38 @@ func (in *NATGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
+func (in *LaunchTemplateSpec) DeepCopyInto(out *LaunchTemplateSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockDeviceMappings != nil {
+		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
+		*out = make([]BlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceTags != nil {
+		in, out := &in.InstanceTags, &out.InstanceTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.UpdateDefaultVersion != nil {
+		in, out := &in.UpdateDefaultVersion, &out.UpdateDefaultVersion
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -3167,18 +4225,18 @@ func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewaySpec.
-func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpec.
+func (in *LaunchTemplateSpec) DeepCopy() *LaunchTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewaySpec)
+	out := new(LaunchTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
+func (in *LaunchTemplateStatus) DeepCopyInto(out *LaunchTemplateStatus) {
 	*out = *in
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
@@ -3186,84 +4244,107 @@ func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
-func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateStatus.
+func (in *LaunchTemplateStatus) DeepCopy() *LaunchTemplateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewayStatus)
+	out := new(LaunchTemplateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NATGatewayStatusInfo) DeepCopyInto(out *NATGatewayStatusInfo) {
+func (in *LifecycleExpiration) DeepCopyInto(out *LifecycleExpiration) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatusInfo.
-func (in *NATGatewayStatusInfo) DeepCopy() *NATGatewayStatusInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleExpiration.
+func (in *LifecycleExpiration) DeepCopy() *LifecycleExpiration {
 	if in == nil {
 		return nil
 	}
-	out := new(NATGatewayStatusInfo)
+	out := new(LifecycleExpiration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NLB) DeepCopyInto(out *NLB) {
+func (in *LifecyclePolicy) DeepCopyInto(out *LifecyclePolicy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLB.
-func (in *NLB) DeepCopy() *NLB {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecyclePolicy.
+func (in *LifecyclePolicy) DeepCopy() *LifecyclePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(NLB)
+	out := new(LifecyclePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NLB) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LifecycleRule) DeepCopyInto(out *LifecycleRule) {
+	*out = *in
+	if in.Expiration != nil {
+		in, out := &in.Expiration, &out.Expiration
+		*out = new(LifecycleExpiration)
+		**out = **in
 	}
-	return nil
+	if in.Transitions != nil {
+		in, out := &in.Transitions, &out.Transitions
+		*out = make([]LifecycleTransition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleRule.
+func (in *LifecycleRule) DeepCopy() *LifecycleRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LifecycleRule)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NLBList) DeepCopyInto(out *NLBList) {
+func (in *LifecycleTransition) DeepCopyInto(out *LifecycleTransition) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]NLB, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LifecycleTransition.
+func (in *LifecycleTransition) DeepCopy() *LifecycleTransition {
+	if in == nil {
+		return nil
 	}
+	out := new(LifecycleTransition)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBList.
-func (in *NLBList) DeepCopy() *NLBList {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Listener) DeepCopyInto(out *Listener) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Listener.
+func (in *Listener) DeepCopy() *Listener {
 	if in == nil {
 		return nil
 	}
-	out := new(NLBList)
+	out := new(Listener)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NLBList) DeepCopyObject() runtime.Object {
+func (in *Listener) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3271,232 +4352,290 @@ func (in *NLBList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NLBSpec) DeepCopyInto(out *NLBSpec) {
+func (in *ListenerAction) DeepCopyInto(out *ListenerAction) {
 	*out = *in
-	out.ProviderRef = in.ProviderRef
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.TargetGroups != nil {
+		in, out := &in.TargetGroups, &out.TargetGroups
+		*out = make([]ListenerTargetGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(ListenerRedirect)
+		**out = **in
+	}
+	if in.FixedResponse != nil {
+		in, out := &in.FixedResponse, &out.FixedResponse
+		*out = new(ListenerFixedResponse)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBSpec.
-func (in *NLBSpec) DeepCopy() *NLBSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerAction.
+func (in *ListenerAction) DeepCopy() *ListenerAction {
 	if in == nil {
 		return nil
 	}
-	out := new(NLBSpec)
+	out := new(ListenerAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NLBStatus) DeepCopyInto(out *NLBStatus) {
+func (in *ListenerFixedResponse) DeepCopyInto(out *ListenerFixedResponse) {
 	*out = *in
-	if in.LastSyncTime != nil {
-		in, out := &in.LastSyncTime, &out.LastSyncTime
-		*out = (*in).DeepCopy()
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBStatus.
-func (in *NLBStatus) DeepCopy() *NLBStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerFixedResponse.
+func (in *ListenerFixedResponse) DeepCopy() *ListenerFixedResponse {
 	if in == nil {
 		return nil
 	}
-	out := new(NLBStatus)
+	out := new(ListenerFixedResponse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodePoolConfig) DeepCopyInto(out *NodePoolConfig) {
+func (in *ListenerList) DeepCopyInto(out *ListenerList) {
 	*out = *in
-	if in.InstanceTypes != nil {
-		in, out := &in.InstanceTypes, &out.InstanceTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ScalingConfig != nil {
-		in, out := &in.ScalingConfig, &out.ScalingConfig
-		*out = new(NodePoolScalingConfig)
-		**out = **in
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]NodeTaintConfig, len(*in))
-		copy(*out, *in)
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Listener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.UpdateConfig != nil {
-		in, out := &in.UpdateConfig, &out.UpdateConfig
-		*out = new(NodePoolUpdateConfig)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolConfig.
-func (in *NodePoolConfig) DeepCopy() *NodePoolConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerList.
+func (in *ListenerList) DeepCopy() *ListenerList {
 	if in == nil {
 		return nil
 	}
-	out := new(NodePoolConfig)
+	out := new(ListenerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ListenerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodePoolDefaults) DeepCopyInto(out *NodePoolDefaults) {
+func (in *ListenerRedirect) DeepCopyInto(out *ListenerRedirect) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolDefaults.
-func (in *NodePoolDefaults) DeepCopy() *NodePoolDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRedirect.
+func (in *ListenerRedirect) DeepCopy() *ListenerRedirect {
 	if in == nil {
 		return nil
 	}
-	out := new(NodePoolDefaults)
+	out := new(ListenerRedirect)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodePoolScalingConfig) DeepCopyInto(out *NodePoolScalingConfig) {
+func (in *ListenerRule) DeepCopyInto(out *ListenerRule) {
 	*out = *in
+	in.Conditions.DeepCopyInto(&out.Conditions)
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]ListenerAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolScalingConfig.
-func (in *NodePoolScalingConfig) DeepCopy() *NodePoolScalingConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRule.
+func (in *ListenerRule) DeepCopy() *ListenerRule {
 	if in == nil {
 		return nil
 	}
-	out := new(NodePoolScalingConfig)
+	out := new(ListenerRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodePoolStatusInfo) DeepCopyInto(out *NodePoolStatusInfo) {
+func (in *ListenerRuleConditions) DeepCopyInto(out *ListenerRuleConditions) {
 	*out = *in
-	if in.InstanceTypes != nil {
-		in, out := &in.InstanceTypes, &out.InstanceTypes
+	if in.HostHeaders != nil {
+		in, out := &in.HostHeaders, &out.HostHeaders
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
+	if in.PathPatterns != nil {
+		in, out := &in.PathPatterns, &out.PathPatterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTPRequestMethods != nil {
+		in, out := &in.HTTPRequestMethods, &out.HTTPRequestMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatusInfo.
-func (in *NodePoolStatusInfo) DeepCopy() *NodePoolStatusInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleConditions.
+func (in *ListenerRuleConditions) DeepCopy() *ListenerRuleConditions {
 	if in == nil {
 		return nil
 	}
-	out := new(NodePoolStatusInfo)
+	out := new(ListenerRuleConditions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodePoolUpdateConfig) DeepCopyInto(out *NodePoolUpdateConfig) {
+func (in *ListenerRuleStatus) DeepCopyInto(out *ListenerRuleStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpdateConfig.
-func (in *NodePoolUpdateConfig) DeepCopy() *NodePoolUpdateConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerRuleStatus.
+func (in *ListenerRuleStatus) DeepCopy() *ListenerRuleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NodePoolUpdateConfig)
+	out := new(ListenerRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeTaintConfig) DeepCopyInto(out *NodeTaintConfig) {
+func (in *ListenerSpec) DeepCopyInto(out *ListenerSpec) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaintConfig.
-func (in *NodeTaintConfig) DeepCopy() *NodeTaintConfig {
-	if in == nil {
-		return nil
+	out.ProviderRef = in.ProviderRef
+	if in.ALBRef != nil {
+		in, out := &in.ALBRef, &out.ALBRef
+		*out = new(ResourceReference)
+		**out = **in
 	}
-	out := new(NodeTaintConfig)
+	if in.NLBRef != nil {
+		in, out := &in.NLBRef, &out.NLBRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.CertificateARNs != nil {
+		in, out := &in.CertificateARNs, &out.CertificateARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CertificateRefs != nil {
+		in, out := &in.CertificateRefs, &out.CertificateRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultActions != nil {
+		in, out := &in.DefaultActions, &out.DefaultActions
+		*out = make([]ListenerAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ListenerRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerSpec.
+func (in *ListenerSpec) DeepCopy() *ListenerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProviderReference) DeepCopyInto(out *ProviderReference) {
+func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ListenerRuleStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerStatus.
+func (in *ListenerStatus) DeepCopy() *ListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerTargetGroup) DeepCopyInto(out *ListenerTargetGroup) {
 	*out = *in
+	if in.TargetGroupRef != nil {
+		in, out := &in.TargetGroupRef, &out.TargetGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderReference.
-func (in *ProviderReference) DeepCopy() *ProviderReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerTargetGroup.
+func (in *ListenerTargetGroup) DeepCopy() *ListenerTargetGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ProviderReference)
+	out := new(ListenerTargetGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
-func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicAccessBlockConfiguration)
+	out := new(LoggingConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RDSInstance) DeepCopyInto(out *RDSInstance) {
+func (in *NATGateway) DeepCopyInto(out *NATGateway) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3504,18 +4643,18 @@ func (in *RDSInstance) DeepCopyInto(out *RDSInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstance.
-func (in *RDSInstance) DeepCopy() *RDSInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGateway.
+func (in *NATGateway) DeepCopy() *NATGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(RDSInstance)
+	out := new(NATGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RDSInstance) DeepCopyObject() runtime.Object {
+func (in *NATGateway) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3523,31 +4662,46 @@ func (in *RDSInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RDSInstanceList) DeepCopyInto(out *RDSInstanceList) {
+func (in *NATGatewayConfig) DeepCopyInto(out *NATGatewayConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayConfig.
+func (in *NATGatewayConfig) DeepCopy() *NATGatewayConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayList) DeepCopyInto(out *NATGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RDSInstance, len(*in))
+		*out = make([]NATGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceList.
-func (in *RDSInstanceList) DeepCopy() *RDSInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayList.
+func (in *NATGatewayList) DeepCopy() *NATGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(RDSInstanceList)
+	out := new(NATGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RDSInstanceList) DeepCopyObject() runtime.Object {
+func (in *NATGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3555,12 +4709,17 @@ func (in *RDSInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RDSInstanceSpec) DeepCopyInto(out *RDSInstanceSpec) {
+func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
-	if in.MasterUserPasswordSecretRef != nil {
-		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
-		*out = new(SecretReference)
+	if in.SubnetRef != nil {
+		in, out := &in.SubnetRef, &out.SubnetRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.AllocationRef != nil {
+		in, out := &in.AllocationRef, &out.AllocationRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
 	if in.Tags != nil {
@@ -3570,58 +4729,60 @@ func (in *RDSInstanceSpec) DeepCopyInto(out *RDSInstanceSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceSpec.
-func (in *RDSInstanceSpec) DeepCopy() *RDSInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewaySpec.
+func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RDSInstanceSpec)
+	out := new(NATGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RDSInstanceStatus) DeepCopyInto(out *RDSInstanceStatus) {
+func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
 	*out = *in
-	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceStatus.
-func (in *RDSInstanceStatus) DeepCopy() *RDSInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
+func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RDSInstanceStatus)
+	out := new(NATGatewayStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *NATGatewayStatusInfo) DeepCopyInto(out *NATGatewayStatusInfo) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatusInfo.
+func (in *NATGatewayStatusInfo) DeepCopy() *NATGatewayStatusInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(NATGatewayStatusInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53HostedZone) DeepCopyInto(out *Route53HostedZone) {
+func (in *NLB) DeepCopyInto(out *NLB) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -3629,18 +4790,18 @@ func (in *Route53HostedZone) DeepCopyInto(out *Route53HostedZone) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZone.
-func (in *Route53HostedZone) DeepCopy() *Route53HostedZone {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLB.
+func (in *NLB) DeepCopy() *NLB {
 	if in == nil {
 		return nil
 	}
-	out := new(Route53HostedZone)
+	out := new(NLB)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route53HostedZone) DeepCopyObject() runtime.Object {
+func (in *NLB) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3648,31 +4809,31 @@ func (in *Route53HostedZone) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53HostedZoneList) DeepCopyInto(out *Route53HostedZoneList) {
+func (in *NLBList) DeepCopyInto(out *NLBList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Route53HostedZone, len(*in))
+		*out = make([]NLB, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneList.
-func (in *Route53HostedZoneList) DeepCopy() *Route53HostedZoneList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBList.
+func (in *NLBList) DeepCopy() *NLBList {
 	if in == nil {
 		return nil
 	}
-	out := new(Route53HostedZoneList)
+	out := new(NLBList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route53HostedZoneList) DeepCopyObject() runtime.Object {
+func (in *NLBList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3680,9 +4841,19 @@ func (in *Route53HostedZoneList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53HostedZoneSpec) DeepCopyInto(out *Route53HostedZoneSpec) {
+func (in *NLBSpec) DeepCopyInto(out *NLBSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -3690,95 +4861,1071 @@ func (in *Route53HostedZoneSpec) DeepCopyInto(out *Route53HostedZoneSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneSpec.
-func (in *Route53HostedZoneSpec) DeepCopy() *Route53HostedZoneSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBSpec.
+func (in *NLBSpec) DeepCopy() *NLBSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Route53HostedZoneSpec)
+	out := new(NLBSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53HostedZoneStatus) DeepCopyInto(out *Route53HostedZoneStatus) {
+func (in *NLBStatus) DeepCopyInto(out *NLBStatus) {
 	*out = *in
-	if in.NameServers != nil {
-		in, out := &in.NameServers, &out.NameServers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneStatus.
-func (in *Route53HostedZoneStatus) DeepCopy() *Route53HostedZoneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NLBStatus.
+func (in *NLBStatus) DeepCopy() *NLBStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Route53HostedZoneStatus)
+	out := new(NLBStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53RecordSet) DeepCopyInto(out *Route53RecordSet) {
+func (in *NodePoolConfig) DeepCopyInto(out *NodePoolConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53RecordSet.
-func (in *Route53RecordSet) DeepCopy() *Route53RecordSet {
-	if in == nil {
-		return nil
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(Route53RecordSet)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route53RecordSet) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.ScalingConfig != nil {
+		in, out := &in.ScalingConfig, &out.ScalingConfig
+		*out = new(NodePoolScalingConfig)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route53RecordSetList) DeepCopyInto(out *Route53RecordSetList) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Route53RecordSet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeTaintConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
+	if in.UpdateConfig != nil {
+		in, out := &in.UpdateConfig, &out.UpdateConfig
+		*out = new(NodePoolUpdateConfig)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53RecordSetList.
-func (in *Route53RecordSetList) DeepCopy() *Route53RecordSetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolConfig.
+func (in *NodePoolConfig) DeepCopy() *NodePoolConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Route53RecordSetList)
+	out := new(NodePoolConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Route53RecordSetList) DeepCopyObject() runtime.Object {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolDefaults) DeepCopyInto(out *NodePoolDefaults) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolDefaults.
+func (in *NodePoolDefaults) DeepCopy() *NodePoolDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolScalingConfig) DeepCopyInto(out *NodePoolScalingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolScalingConfig.
+func (in *NodePoolScalingConfig) DeepCopy() *NodePoolScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolStatusInfo) DeepCopyInto(out *NodePoolStatusInfo) {
+	*out = *in
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStatusInfo.
+func (in *NodePoolStatusInfo) DeepCopy() *NodePoolStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolUpdateConfig) DeepCopyInto(out *NodePoolUpdateConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpdateConfig.
+func (in *NodePoolUpdateConfig) DeepCopy() *NodePoolUpdateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolUpdateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTaintConfig) DeepCopyInto(out *NodeTaintConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTaintConfig.
+func (in *NodeTaintConfig) DeepCopy() *NodeTaintConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTaintConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectLockConfiguration) DeepCopyInto(out *ObjectLockConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectLockConfiguration.
+func (in *ObjectLockConfiguration) DeepCopy() *ObjectLockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectLockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderReference) DeepCopyInto(out *ProviderReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderReference.
+func (in *ProviderReference) DeepCopy() *ProviderReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedThroughput) DeepCopyInto(out *ProvisionedThroughput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedThroughput.
+func (in *ProvisionedThroughput) DeepCopy() *ProvisionedThroughput {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisionedThroughput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicAccessBlockConfiguration) DeepCopyInto(out *PublicAccessBlockConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicAccessBlockConfiguration.
+func (in *PublicAccessBlockConfiguration) DeepCopy() *PublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSCluster) DeepCopyInto(out *RDSCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSCluster.
+func (in *RDSCluster) DeepCopy() *RDSCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterInstanceSet) DeepCopyInto(out *RDSClusterInstanceSet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterInstanceSet.
+func (in *RDSClusterInstanceSet) DeepCopy() *RDSClusterInstanceSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterInstanceSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterList) DeepCopyInto(out *RDSClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDSCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterList.
+func (in *RDSClusterList) DeepCopy() *RDSClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterMember) DeepCopyInto(out *RDSClusterMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterMember.
+func (in *RDSClusterMember) DeepCopy() *RDSClusterMember {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterReaderSet) DeepCopyInto(out *RDSClusterReaderSet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterReaderSet.
+func (in *RDSClusterReaderSet) DeepCopy() *RDSClusterReaderSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterReaderSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterSpec) DeepCopyInto(out *RDSClusterSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.MasterUserPasswordSecretRef != nil {
+		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	out.Writer = in.Writer
+	if in.Readers != nil {
+		in, out := &in.Readers, &out.Readers
+		*out = new(RDSClusterReaderSet)
+		**out = **in
+	}
+	if in.ServerlessV2Scaling != nil {
+		in, out := &in.ServerlessV2Scaling, &out.ServerlessV2Scaling
+		*out = new(RDSServerlessV2Scaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBSubnetGroupRef != nil {
+		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.VpcSecurityGroupIDs != nil {
+		in, out := &in.VpcSecurityGroupIDs, &out.VpcSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterSpec.
+func (in *RDSClusterSpec) DeepCopy() *RDSClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSClusterStatus) DeepCopyInto(out *RDSClusterStatus) {
+	*out = *in
+	if in.PendingModifications != nil {
+		in, out := &in.PendingModifications, &out.PendingModifications
+		*out = new(RDSPendingModifications)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]RDSClusterMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSClusterStatus.
+func (in *RDSClusterStatus) DeepCopy() *RDSClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSInstance) DeepCopyInto(out *RDSInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstance.
+func (in *RDSInstance) DeepCopy() *RDSInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSInstanceList) DeepCopyInto(out *RDSInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDSInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceList.
+func (in *RDSInstanceList) DeepCopy() *RDSInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSInstanceSpec) DeepCopyInto(out *RDSInstanceSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.MasterUserPasswordSecretRef != nil {
+		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.DBSubnetGroupRef != nil {
+		in, out := &in.DBSubnetGroupRef, &out.DBSubnetGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.VpcSecurityGroupIDs != nil {
+		in, out := &in.VpcSecurityGroupIDs, &out.VpcSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DBParameterGroupRef != nil {
+		in, out := &in.DBParameterGroupRef, &out.DBParameterGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.AutoMinorVersionUpgrade != nil {
+		in, out := &in.AutoMinorVersionUpgrade, &out.AutoMinorVersionUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(RDSRestoreSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicateFrom != nil {
+		in, out := &in.ReplicateFrom, &out.ReplicateFrom
+		*out = new(RDSReplicationSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceSpec.
+func (in *RDSInstanceSpec) DeepCopy() *RDSInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSInstanceStatus) DeepCopyInto(out *RDSInstanceStatus) {
+	*out = *in
+	if in.PendingModifications != nil {
+		in, out := &in.PendingModifications, &out.PendingModifications
+		*out = new(RDSPendingModifications)
+		(*in).DeepCopyInto(*out)
+	}
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceStatus.
+func (in *RDSInstanceStatus) DeepCopy() *RDSInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSPendingModifications) DeepCopyInto(out *RDSPendingModifications) {
+	*out = *in
+	if in.MultiAZ != nil {
+		in, out := &in.MultiAZ, &out.MultiAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackupRetentionPeriod != nil {
+		in, out := &in.BackupRetentionPeriod, &out.BackupRetentionPeriod
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSPendingModifications.
+func (in *RDSPendingModifications) DeepCopy() *RDSPendingModifications {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSPendingModifications)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSReplicationSource) DeepCopyInto(out *RDSReplicationSource) {
+	*out = *in
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSReplicationSource.
+func (in *RDSReplicationSource) DeepCopy() *RDSReplicationSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSReplicationSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSRestoreSource) DeepCopyInto(out *RDSRestoreSource) {
+	*out = *in
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSRestoreSource.
+func (in *RDSRestoreSource) DeepCopy() *RDSRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSServerlessV2Scaling) DeepCopyInto(out *RDSServerlessV2Scaling) {
+	*out = *in
+	out.MinCapacity = in.MinCapacity.DeepCopy()
+	out.MaxCapacity = in.MaxCapacity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSServerlessV2Scaling.
+func (in *RDSServerlessV2Scaling) DeepCopy() *RDSServerlessV2Scaling {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSServerlessV2Scaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSSnapshot) DeepCopyInto(out *RDSSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSSnapshot.
+func (in *RDSSnapshot) DeepCopy() *RDSSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSSnapshotList) DeepCopyInto(out *RDSSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RDSSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSSnapshotList.
+func (in *RDSSnapshotList) DeepCopy() *RDSSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RDSSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSSnapshotSchedule) DeepCopyInto(out *RDSSnapshotSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSSnapshotSchedule.
+func (in *RDSSnapshotSchedule) DeepCopy() *RDSSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSSnapshotSpec) DeepCopyInto(out *RDSSnapshotSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.DBInstanceRef != nil {
+		in, out := &in.DBInstanceRef, &out.DBInstanceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(RDSSnapshotSchedule)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSSnapshotSpec.
+func (in *RDSSnapshotSpec) DeepCopy() *RDSSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RDSSnapshotStatus) DeepCopyInto(out *RDSSnapshotStatus) {
+	*out = *in
+	if in.LatestSnapshotTime != nil {
+		in, out := &in.LatestSnapshotTime, &out.LatestSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextSnapshotTime != nil {
+		in, out := &in.NextSnapshotTime, &out.NextSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSSnapshotStatus.
+func (in *RDSSnapshotStatus) DeepCopy() *RDSSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RDSSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationConfiguration) DeepCopyInto(out *ReplicationConfiguration) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ReplicationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationConfiguration.
+func (in *ReplicationConfiguration) DeepCopy() *ReplicationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRule) DeepCopyInto(out *ReplicationRule) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRule.
+func (in *ReplicationRule) DeepCopy() *ReplicationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53HostedZone) DeepCopyInto(out *Route53HostedZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZone.
+func (in *Route53HostedZone) DeepCopy() *Route53HostedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53HostedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route53HostedZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53HostedZoneList) DeepCopyInto(out *Route53HostedZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route53HostedZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneList.
+func (in *Route53HostedZoneList) DeepCopy() *Route53HostedZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53HostedZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route53HostedZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53HostedZoneSpec) DeepCopyInto(out *Route53HostedZoneSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneSpec.
+func (in *Route53HostedZoneSpec) DeepCopy() *Route53HostedZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53HostedZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53HostedZoneStatus) DeepCopyInto(out *Route53HostedZoneStatus) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53HostedZoneStatus.
+func (in *Route53HostedZoneStatus) DeepCopy() *Route53HostedZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53HostedZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53RecordSet) DeepCopyInto(out *Route53RecordSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53RecordSet.
+func (in *Route53RecordSet) DeepCopy() *Route53RecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53RecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route53RecordSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route53RecordSetList) DeepCopyInto(out *Route53RecordSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Route53RecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route53RecordSetList.
+func (in *Route53RecordSetList) DeepCopy() *Route53RecordSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(Route53RecordSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Route53RecordSetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -3908,6 +6055,11 @@ func (in *RouteTableList) DeepCopyObject() runtime.Object {
 func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.VpcRef != nil {
+		in, out := &in.VpcRef, &out.VpcRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.Routes != nil {
 		in, out := &in.Routes, &out.Routes
 		*out = make([]Route, len(*in))
@@ -3918,6 +6070,11 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SubnetRefs != nil {
+		in, out := &in.SubnetRefs, &out.SubnetRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -3925,6 +6082,11 @@ func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
@@ -3949,6 +6111,7 @@ func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
@@ -4073,6 +6236,33 @@ func (in *S3BucketSpec) DeepCopyInto(out *S3BucketSpec) {
 		*out = new(PublicAccessBlockConfiguration)
 		**out = **in
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]BucketNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfiguration)
+		**out = **in
+	}
+	if in.ObjectLock != nil {
+		in, out := &in.ObjectLock, &out.ObjectLock
+		*out = new(ObjectLockConfiguration)
+		**out = **in
+	}
+	if in.Website != nil {
+		in, out := &in.Website, &out.Website
+		*out = new(WebsiteConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -4080,6 +6270,16 @@ func (in *S3BucketSpec) DeepCopyInto(out *S3BucketSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketSpec.
@@ -4106,6 +6306,12 @@ func (in *S3BucketStatus) DeepCopyInto(out *S3BucketStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ManagedConfigurations != nil {
+		in, out := &in.ManagedConfigurations, &out.ManagedConfigurations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3BucketStatus.
@@ -4223,6 +6429,16 @@ func (in *SNSTopicSpec) DeepCopyInto(out *SNSTopicSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicSpec.
@@ -4254,6 +6470,7 @@ func (in *SNSTopicStatus) DeepCopyInto(out *SNSTopicStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SNSTopicStatus.
@@ -4341,6 +6558,16 @@ func (in *SQSQueueSpec) DeepCopyInto(out *SQSQueueSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WriteConnectionSecretToRef != nil {
+		in, out := &in.WriteConnectionSecretToRef, &out.WriteConnectionSecretToRef
+		*out = new(ConnectionSecretReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQSQueueSpec.
@@ -4367,6 +6594,7 @@ func (in *SQSQueueStatus) DeepCopyInto(out *SQSQueueStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQSQueueStatus.
@@ -4504,6 +6732,11 @@ func (in *SecretsManagerSecretSpec) DeepCopyInto(out *SecretsManagerSecretSpec)
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerSecretSpec.
@@ -4550,6 +6783,7 @@ func (in *SecretsManagerSecretStatus) DeepCopyInto(out *SecretsManagerSecretStat
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManagerSecretStatus.
@@ -4659,6 +6893,16 @@ func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceSecurityGroupRef != nil {
+		in, out := &in.SourceSecurityGroupRef, &out.SourceSecurityGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
@@ -4690,6 +6934,11 @@ func (in *SecurityGroupRuleConfig) DeepCopy() *SecurityGroupRuleConfig {
 func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.VpcRef != nil {
+		in, out := &in.VpcRef, &out.VpcRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.IngressRules != nil {
 		in, out := &in.IngressRules, &out.IngressRules
 		*out = make([]SecurityGroupRule, len(*in))
@@ -4711,6 +6960,11 @@ func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
@@ -4726,10 +6980,25 @@ func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 	*out = *in
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
@@ -4918,94 +7187,401 @@ func (in *SetupEKSSpec) DeepCopyInto(out *SetupEKSSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupEKSSpec.
-func (in *SetupEKSSpec) DeepCopy() *SetupEKSSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupEKSSpec.
+func (in *SetupEKSSpec) DeepCopy() *SetupEKSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupEKSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetupEKSStatus) DeepCopyInto(out *SetupEKSStatus) {
+	*out = *in
+	if in.VPC != nil {
+		in, out := &in.VPC, &out.VPC
+		*out = new(VPCStatusInfo)
+		**out = **in
+	}
+	if in.InternetGateway != nil {
+		in, out := &in.InternetGateway, &out.InternetGateway
+		*out = new(IGWStatusInfo)
+		**out = **in
+	}
+	if in.PublicSubnets != nil {
+		in, out := &in.PublicSubnets, &out.PublicSubnets
+		*out = make([]SubnetStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateSubnets != nil {
+		in, out := &in.PrivateSubnets, &out.PrivateSubnets
+		*out = make([]SubnetStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.NATGateways != nil {
+		in, out := &in.NATGateways, &out.NATGateways
+		*out = make([]NATGatewayStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTables != nil {
+		in, out := &in.RouteTables, &out.RouteTables
+		*out = make([]RouteTableStatusInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(EKSClusterStatusInfo)
+		**out = **in
+	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]NodePoolStatusInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]AddonStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterRole != nil {
+		in, out := &in.ClusterRole, &out.ClusterRole
+		*out = new(IAMRoleStatusInfo)
+		**out = **in
+	}
+	if in.NodeRole != nil {
+		in, out := &in.NodeRole, &out.NodeRole
+		*out = new(IAMRoleStatusInfo)
+		**out = **in
+	}
+	if in.ClusterSecurityGroup != nil {
+		in, out := &in.ClusterSecurityGroup, &out.ClusterSecurityGroup
+		*out = new(SecurityGroupStatusInfo)
+		**out = **in
+	}
+	if in.NodeSecurityGroup != nil {
+		in, out := &in.NodeSecurityGroup, &out.NodeSecurityGroup
+		*out = new(SecurityGroupStatusInfo)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupEKSStatus.
+func (in *SetupEKSStatus) DeepCopy() *SetupEKSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SetupEKSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subnet) DeepCopyInto(out *Subnet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
+func (in *Subnet) DeepCopy() *Subnet {
+	if in == nil {
+		return nil
+	}
+	out := new(Subnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Subnet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetConfig) DeepCopyInto(out *SubnetConfig) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetConfig.
+func (in *SubnetConfig) DeepCopy() *SubnetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetList) DeepCopyInto(out *SubnetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetList.
+func (in *SubnetList) DeepCopy() *SubnetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubnetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.VpcRef != nil {
+		in, out := &in.VpcRef, &out.VpcRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
+func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
+func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatusInfo) DeepCopyInto(out *SubnetStatusInfo) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatusInfo.
+func (in *SubnetStatusInfo) DeepCopy() *SubnetStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroup) DeepCopyInto(out *TargetGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroup.
+func (in *TargetGroup) DeepCopy() *TargetGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(SetupEKSSpec)
+	out := new(TargetGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SetupEKSStatus) DeepCopyInto(out *SetupEKSStatus) {
+func (in *TargetGroupBinding) DeepCopyInto(out *TargetGroupBinding) {
 	*out = *in
-	if in.VPC != nil {
-		in, out := &in.VPC, &out.VPC
-		*out = new(VPCStatusInfo)
-		**out = **in
-	}
-	if in.InternetGateway != nil {
-		in, out := &in.InternetGateway, &out.InternetGateway
-		*out = new(IGWStatusInfo)
-		**out = **in
-	}
-	if in.PublicSubnets != nil {
-		in, out := &in.PublicSubnets, &out.PublicSubnets
-		*out = make([]SubnetStatusInfo, len(*in))
-		copy(*out, *in)
-	}
-	if in.PrivateSubnets != nil {
-		in, out := &in.PrivateSubnets, &out.PrivateSubnets
-		*out = make([]SubnetStatusInfo, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBinding.
+func (in *TargetGroupBinding) DeepCopy() *TargetGroupBinding {
+	if in == nil {
+		return nil
 	}
-	if in.NATGateways != nil {
-		in, out := &in.NATGateways, &out.NATGateways
-		*out = make([]NATGatewayStatusInfo, len(*in))
-		copy(*out, *in)
+	out := new(TargetGroupBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.RouteTables != nil {
-		in, out := &in.RouteTables, &out.RouteTables
-		*out = make([]RouteTableStatusInfo, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingList) DeepCopyInto(out *TargetGroupBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetGroupBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Cluster != nil {
-		in, out := &in.Cluster, &out.Cluster
-		*out = new(EKSClusterStatusInfo)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingList.
+func (in *TargetGroupBindingList) DeepCopy() *TargetGroupBindingList {
+	if in == nil {
+		return nil
 	}
-	if in.NodePools != nil {
-		in, out := &in.NodePools, &out.NodePools
-		*out = make([]NodePoolStatusInfo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(TargetGroupBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Addons != nil {
-		in, out := &in.Addons, &out.Addons
-		*out = make([]AddonStatusInfo, len(*in))
-		copy(*out, *in)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingServiceRef) DeepCopyInto(out *TargetGroupBindingServiceRef) {
+	*out = *in
+	out.Port = in.Port
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingServiceRef.
+func (in *TargetGroupBindingServiceRef) DeepCopy() *TargetGroupBindingServiceRef {
+	if in == nil {
+		return nil
 	}
-	if in.ClusterRole != nil {
-		in, out := &in.ClusterRole, &out.ClusterRole
-		*out = new(IAMRoleStatusInfo)
+	out := new(TargetGroupBindingServiceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.TargetGroupRef != nil {
+		in, out := &in.TargetGroupRef, &out.TargetGroupRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
-	if in.NodeRole != nil {
-		in, out := &in.NodeRole, &out.NodeRole
-		*out = new(IAMRoleStatusInfo)
-		**out = **in
+	out.ServiceRef = in.ServiceRef
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ClusterSecurityGroup != nil {
-		in, out := &in.ClusterSecurityGroup, &out.ClusterSecurityGroup
-		*out = new(SecurityGroupStatusInfo)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
+func (in *TargetGroupBindingSpec) DeepCopy() *TargetGroupBindingSpec {
+	if in == nil {
+		return nil
 	}
-	if in.NodeSecurityGroup != nil {
-		in, out := &in.NodeSecurityGroup, &out.NodeSecurityGroup
-		*out = new(SecurityGroupStatusInfo)
-		**out = **in
+	out := new(TargetGroupBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupBindingStatus) DeepCopyInto(out *TargetGroupBindingStatus) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetHealthStatus, len(*in))
+		copy(*out, *in)
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ManagedTargets != nil {
+		in, out := &in.ManagedTargets, &out.ManagedTargets
+		*out = make([]TargetGroupBindingTarget, len(*in))
+		copy(*out, *in)
 	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
@@ -5013,91 +7589,72 @@ func (in *SetupEKSStatus) DeepCopyInto(out *SetupEKSStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetupEKSStatus.
-func (in *SetupEKSStatus) DeepCopy() *SetupEKSStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingStatus.
+func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SetupEKSStatus)
+	out := new(TargetGroupBindingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Subnet) DeepCopyInto(out *Subnet) {
+func (in *TargetGroupBindingTarget) DeepCopyInto(out *TargetGroupBindingTarget) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
-func (in *Subnet) DeepCopy() *Subnet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingTarget.
+func (in *TargetGroupBindingTarget) DeepCopy() *TargetGroupBindingTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(Subnet)
+	out := new(TargetGroupBindingTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Subnet) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetConfig) DeepCopyInto(out *SubnetConfig) {
+func (in *TargetGroupHealthCheck) DeepCopyInto(out *TargetGroupHealthCheck) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetConfig.
-func (in *SubnetConfig) DeepCopy() *SubnetConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupHealthCheck.
+func (in *TargetGroupHealthCheck) DeepCopy() *TargetGroupHealthCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetConfig)
+	out := new(TargetGroupHealthCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetList) DeepCopyInto(out *SubnetList) {
+func (in *TargetGroupList) DeepCopyInto(out *TargetGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Subnet, len(*in))
+		*out = make([]TargetGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetList.
-func (in *SubnetList) DeepCopy() *SubnetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupList.
+func (in *TargetGroupList) DeepCopy() *TargetGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetList)
+	out := new(TargetGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SubnetList) DeepCopyObject() runtime.Object {
+func (in *TargetGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -5105,9 +7662,31 @@ func (in *SubnetList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+func (in *TargetGroupSpec) DeepCopyInto(out *TargetGroupSpec) {
 	*out = *in
 	out.ProviderRef = in.ProviderRef
+	if in.VpcRef != nil {
+		in, out := &in.VpcRef, &out.VpcRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(TargetGroupHealthCheck)
+		**out = **in
+	}
+	if in.DeregistrationDelaySeconds != nil {
+		in, out := &in.DeregistrationDelaySeconds, &out.DeregistrationDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetGroupTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -5117,46 +7696,96 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
-func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupSpec.
+func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetSpec)
+	out := new(TargetGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+func (in *TargetGroupStatus) DeepCopyInto(out *TargetGroupStatus) {
 	*out = *in
+	if in.LoadBalancerARNs != nil {
+		in, out := &in.LoadBalancerARNs, &out.LoadBalancerARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetHealthStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
-func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupStatus.
+func (in *TargetGroupStatus) DeepCopy() *TargetGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetStatus)
+	out := new(TargetGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetStatusInfo) DeepCopyInto(out *SubnetStatusInfo) {
+func (in *TargetGroupTarget) DeepCopyInto(out *TargetGroupTarget) {
 	*out = *in
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatusInfo.
-func (in *SubnetStatusInfo) DeepCopy() *SubnetStatusInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupTarget.
+func (in *TargetGroupTarget) DeepCopy() *TargetGroupTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetStatusInfo)
+	out := new(TargetGroupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetHealthStatus) DeepCopyInto(out *TargetHealthStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealthStatus.
+func (in *TargetHealthStatus) DeepCopy() *TargetHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeToLiveSpec) DeepCopyInto(out *TimeToLiveSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeToLiveSpec.
+func (in *TimeToLiveSpec) DeepCopy() *TimeToLiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeToLiveSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5231,6 +7860,11 @@ func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(DriftPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
@@ -5250,6 +7884,7 @@ func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	in.DriftStatus.DeepCopyInto(&out.DriftStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCStatus.
@@ -5306,3 +7941,38 @@ func (in *ViewerCertificate) DeepCopy() *ViewerCertificate {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebsiteConfiguration) DeepCopyInto(out *WebsiteConfiguration) {
+	*out = *in
+	if in.RedirectAllRequestsTo != nil {
+		in, out := &in.RedirectAllRequestsTo, &out.RedirectAllRequestsTo
+		*out = new(WebsiteRedirect)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebsiteConfiguration.
+func (in *WebsiteConfiguration) DeepCopy() *WebsiteConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebsiteConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebsiteRedirect) DeepCopyInto(out *WebsiteRedirect) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebsiteRedirect.
+func (in *WebsiteRedirect) DeepCopy() *WebsiteRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(WebsiteRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
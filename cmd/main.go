@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -152,6 +153,16 @@ func main() {
 			os.Exit(1)
 		}
 
+		// Setup LambdaAlias Controller
+		if err = (&controllers.LambdaAliasReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			AWSClientFactory: awsClientFactory,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "LambdaAlias")
+			os.Exit(1)
+		}
+
 		// Setup RDSInstance Controller
 		if err = (&controllers.RDSInstanceReconciler{
 			Client:           mgr.GetClient(),
@@ -162,6 +173,46 @@ func main() {
 			os.Exit(1)
 		}
 
+		// Setup DBSubnetGroup Controller
+		if err = (&controllers.DBSubnetGroupReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			AWSClientFactory: awsClientFactory,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DBSubnetGroup")
+			os.Exit(1)
+		}
+
+		// Setup DBParameterGroup Controller
+		if err = (&controllers.DBParameterGroupReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			AWSClientFactory: awsClientFactory,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DBParameterGroup")
+			os.Exit(1)
+		}
+
+		// Setup RDSCluster Controller
+		if err = (&controllers.RDSClusterReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			AWSClientFactory: awsClientFactory,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RDSCluster")
+			os.Exit(1)
+		}
+
+		// Setup RDSSnapshot Controller
+		if err = (&controllers.RDSSnapshotReconciler{
+			Client:           mgr.GetClient(),
+			Scheme:           mgr.GetScheme(),
+			AWSClientFactory: awsClientFactory,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "RDSSnapshot")
+			os.Exit(1)
+		}
+
 		// Setup ECRRepository Controller
 		if err = (&controllers.ECRRepositoryReconciler{
 			Client:           mgr.GetClient(),
@@ -362,6 +413,56 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup TargetGroup Controller
+	if err = (&controllers.TargetGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGroup")
+		os.Exit(1)
+	}
+
+	// Setup Listener Controller
+	if err = (&controllers.ListenerReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Listener")
+		os.Exit(1)
+	}
+
+	// Setup TargetGroupBinding Controller
+	if err = (&controllers.TargetGroupBindingReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
+		os.Exit(1)
+	}
+
+	// Setup LaunchTemplate Controller
+	if err = (&controllers.LaunchTemplateReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LaunchTemplate")
+		os.Exit(1)
+	}
+
+	// Setup AutoScalingGroup Controller
+	if err = (&controllers.AutoScalingGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AutoScalingGroup")
+		os.Exit(1)
+	}
+
 	// Setup Certificate Controller
 	if err = (&controllers.CertificateReconciler{
 		Client:           mgr.GetClient(),
@@ -465,10 +566,12 @@ func runAPIServer() {
 	var port int
 	var host string
 	var stateDir string
+	var stateBackend string
 	var region string
 	var endpoint string
 	var apiKeys string
 	var corsOrigins string
+	var lockTimeout time.Duration
 
 	for i := 2; i < len(os.Args); i++ {
 		arg := os.Args[i]
@@ -488,6 +591,21 @@ func runAPIServer() {
 				stateDir = os.Args[i+1]
 				i++
 			}
+		case arg == "--state-backend":
+			if i+1 < len(os.Args) {
+				stateBackend = os.Args[i+1]
+				i++
+			}
+		case arg == "--lock-timeout":
+			if i+1 < len(os.Args) {
+				d, err := time.ParseDuration(os.Args[i+1])
+				if err != nil || d < 0 {
+					fmt.Fprintf(os.Stderr, "Erro: valor inválido para --lock-timeout: %s\n", os.Args[i+1])
+					os.Exit(1)
+				}
+				lockTimeout = d
+				i++
+			}
 		case arg == "--region":
 			if i+1 < len(os.Args) {
 				region = os.Args[i+1]
@@ -522,6 +640,13 @@ func runAPIServer() {
 		host = "0.0.0.0"
 	}
 
+	// State backend
+	backendConfig, err := core.ParseStateBackend(stateBackend)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+		os.Exit(1)
+	}
+
 	// AWS config
 	awsConfig := core.AWSConfig{
 		Region:   region,
@@ -550,6 +675,8 @@ func runAPIServer() {
 		Host:           host,
 		Version:        "1.0.1",
 		StateDir:       stateDir,
+		StateBackend:   backendConfig,
+		LockTimeout:    lockTimeout,
 		AllowedOrigins: origins,
 		Auth:           authConfig,
 		AWS:            awsConfig,
@@ -568,7 +695,7 @@ func runAPIServer() {
 
 // printServeUsage imprime a ajuda do comando serve
 func printServeUsage() {
-	fmt.Println(`
+	fmt.Print(`
 Infra Operator AWS - Modo API Server
 
 Uso:
@@ -578,6 +705,9 @@ Flags:
   -p, --port int             Porta do servidor (padrão: 8080)
   --host string              Host do servidor (padrão: 0.0.0.0)
   --state-dir string         Diretório de estado (padrão: ~/.infra-operator/state)
+  --state-backend string     Backend de estado: local, s3://bucket/prefixo?lockTable=tabela,
+                             configmap://namespace/nome ou secret://namespace/nome (padrão: local)
+  --lock-timeout duration    Tempo de espera pelo lock do estado em apply/delete (padrão: 0)
   --region string            Região AWS (padrão: us-east-1 ou env AWS_REGION)
   --endpoint string          URL do endpoint AWS (para LocalStack)
   --api-keys string          API keys separadas por vírgula (habilita autenticação)
@@ -596,6 +726,9 @@ Exemplos:
   # Inicia em porta customizada
   infra-operator serve --port 3000
 
+  # Mantém o estado em um ConfigMap (sobrevive a reinícios do pod)
+  infra-operator serve --state-backend configmap://infra-operator/api-state
+
 Endpoints:
   GET  /health              - Health check
   POST /api/v1/plan         - Gera plano de execução
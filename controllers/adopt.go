@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+)
+
+// adoptedID returns the AWS ID the controller binds to. Once the ID is recorded in
+// the status it is currentID; before that it is the value of the adopt annotation,
+// checked with exists so a wrong ID is reported instead of creating a new resource.
+func adoptedID(ctx context.Context, recorder record.EventRecorder, obj client.Object, currentID string, exists func(ctx context.Context, id string) (bool, error)) (string, error) {
+	id := obj.GetAnnotations()[infrav1alpha1.AdoptAnnotation]
+	if currentID != "" || id == "" {
+		return currentID, nil
+	}
+
+	found, err := exists(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to check adopted resource %s: %w", id, err)
+	}
+	if !found {
+		err := fmt.Errorf("resource %s from annotation %s does not exist", id, infrav1alpha1.AdoptAnnotation)
+		recorder.Event(obj, "Warning", "AdoptFailed", err.Error())
+		return "", err
+	}
+
+	log.FromContext(ctx).Info("Adopting existing AWS resource", "id", id)
+	recorder.Event(obj, "Normal", "Adopted", fmt.Sprintf("Bound to existing AWS resource %s", id))
+	return id, nil
+}
+
+// adoptedName is adoptedID for resources identified by a name in the spec, such as
+// buckets and roles. bound reports whether the name is already recorded in the status.
+// The annotation must name the same resource as the spec.
+func adoptedName(ctx context.Context, recorder record.EventRecorder, obj client.Object, name string, bound bool, exists func(ctx context.Context, id string) (bool, error)) error {
+	if id := obj.GetAnnotations()[infrav1alpha1.AdoptAnnotation]; !bound && id != "" && id != name {
+		err := fmt.Errorf("annotation %s names %s, but the spec names %s", infrav1alpha1.AdoptAnnotation, id, name)
+		recorder.Event(obj, "Warning", "AdoptFailed", err.Error())
+		return err
+	}
+
+	currentID := ""
+	if bound {
+		currentID = name
+	}
+	_, err := adoptedID(ctx, recorder, obj, currentID, exists)
+	return err
+}
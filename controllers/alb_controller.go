@@ -5,14 +5,18 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const albFinalizerName = "alb.infra.operator.aws.io/finalizer"
@@ -22,6 +26,7 @@ type ALBReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=infra.operator.aws.io,resources=albs,verbs=get;list;watch;create;update;patch;delete
@@ -76,12 +81,60 @@ func (r *ALBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	// Convert CR to domain model
 	lb := mapper.CRToDomainALB(albCR)
 
-	// Sync load balancer
-	if err := albUseCase.SyncLoadBalancer(ctx, lb); err != nil {
-		logger.Error(err, "failed to sync load balancer")
-		albCR.Status.Ready = false
-		r.Status().Update(ctx, albCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	// Resolve references to other resources in the namespace
+	if len(albCR.Spec.SubnetRefs) > 0 {
+		subnetIDs, err := resolveSubnetRefs(ctx, r.Client, albCR.Namespace, albCR.Spec.SubnetRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, albCR, err)
+		}
+		lb.Subnets = subnetIDs
+	}
+	if len(albCR.Spec.SecurityGroupRefs) > 0 {
+		groupIDs, err := resolveSecurityGroupRefs(ctx, r.Client, albCR.Namespace, albCR.Spec.SecurityGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, albCR, err)
+		}
+		lb.SecurityGroups = groupIDs
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, albCR, driftCheck{
+		kind:        "ALB",
+		resourceID:  albCR.Status.LoadBalancerARN,
+		providerRef: albCR.Spec.ProviderRef,
+		policy:      albCR.Spec.DriftPolicy,
+		status:      &albCR.Status.DriftStatus,
+		desired:     mapper.ALBDriftState(lb),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetALBRepository(ctx, albCR.Spec.ProviderRef, albCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, lb.LoadBalancerName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.ALBDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return albUseCase.SyncLoadBalancer(ctx, lb)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, albCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync load balancer
+		if err := albUseCase.SyncLoadBalancer(ctx, lb); err != nil {
+			logger.Error(err, "failed to sync load balancer")
+			albCR.Status.Ready = false
+			r.Status().Update(ctx, albCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -90,12 +143,23 @@ func (r *ALBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *ALBReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("alb-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.ALB{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.ALB)
+		return append(refKeys("Subnet", cr.Spec.SubnetRefs...), refKeys("SecurityGroup", cr.Spec.SecurityGroupRefs...)...)
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ALB{}).
-		Complete(r)
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.ALBList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.ALBList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("ALB", mgr.GetClient(), &infrav1alpha1.ALB{}, r))
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +14,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const apigatewayFinalizer = "aws-infra-operator.runner.codes/apigateway-finalizer"
@@ -21,6 +25,7 @@ type APIGatewayReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *APIGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -65,9 +70,42 @@ func (r *APIGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Sync API
 	domainAPI := mapper.CRToDomainAPIGateway(&apigateway)
-	if err := useCase.SyncAPI(ctx, domainAPI); err != nil {
-		logger.Error(err, "Failed to sync API")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, &apigateway, driftCheck{
+		kind:        "APIGateway",
+		resourceID:  apigateway.Status.APIID,
+		providerRef: apigateway.Spec.ProviderRef,
+		policy:      apigateway.Spec.DriftPolicy,
+		status:      &apigateway.Status.DriftStatus,
+		desired:     mapper.APIGatewayDriftState(domainAPI),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetAPIGatewayRepository(ctx, apigateway.Spec.ProviderRef, apigateway.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, apigateway.Status.APIID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.APIGatewayDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncAPI(ctx, domainAPI)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, &apigateway); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncAPI(ctx, domainAPI); err != nil {
+			logger.Error(err, "Failed to sync API")
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
 	}
 
 	// Update status
@@ -77,11 +115,21 @@ func (r *APIGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, &apigateway, apigateway.Spec.WriteConnectionSecretToRef, endpointConnectionDetails(apigateway.Status.APIEndpoint, "")); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *APIGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("apigateway-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.APIGateway{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("APIGateway", mgr.GetClient(), &infrav1alpha1.APIGateway{}, r))
 }
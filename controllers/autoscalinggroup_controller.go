@@ -0,0 +1,170 @@
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const autoScalingGroupFinalizer = "aws-infra-operator.runner.codes/autoscalinggroup-finalizer"
+
+// AutoScalingGroupReconciler reconciles a AutoScalingGroup object
+type AutoScalingGroupReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=autoscalinggroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=autoscalinggroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=autoscalinggroups/finalizers,verbs=update
+
+func (r *AutoScalingGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.AutoScalingGroup{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetAutoScalingGroupUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to get AutoScalingGroup use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	group := mapper.CRToDomainAutoScalingGroup(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, autoScalingGroupFinalizer) {
+			if err := useCase.DeleteGroup(ctx, group); err != nil {
+				logger.Error(err, "failed to delete auto scaling group")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			// Instances are terminated first, so wait until the group is gone
+			if group.Deleting() {
+				logger.Info("Waiting for auto scaling group deletion", "name", group.Name)
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+			}
+			controllerutil.RemoveFinalizer(cr, autoScalingGroupFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, autoScalingGroupFinalizer) {
+		controllerutil.AddFinalizer(cr, autoScalingGroupFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve references to other resources in the namespace
+	if len(cr.Spec.SubnetRefs) > 0 {
+		subnetIDs, err := resolveSubnetRefs(ctx, r.Client, cr.Namespace, cr.Spec.SubnetRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		group.SubnetIDs = subnetIDs
+	}
+	if len(cr.Spec.TargetGroupRefs) > 0 {
+		targetGroupARNs, err := resolveTargetGroupRefs(ctx, r.Client, cr.Namespace, cr.Spec.TargetGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		group.TargetGroupARNs = targetGroupARNs
+	}
+	if ref := cr.Spec.LaunchTemplate.LaunchTemplateRef; ref != nil {
+		lt, err := resolveLaunchTemplateRef(ctx, r.Client, cr.Namespace, *ref)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		group.LaunchTemplate.ID = lt.Status.LaunchTemplateID
+		// Pin the latest version, so every change of the LaunchTemplate is rolled out
+		if group.LaunchTemplate.Version == "" {
+			group.LaunchTemplate.Version = strconv.FormatInt(lt.Status.LatestVersion, 10)
+		}
+	}
+
+	if err := useCase.SyncGroup(ctx, group); err != nil {
+		logger.Error(err, "failed to sync auto scaling group")
+		cr.Status.Ready = false
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if group.RefreshStarted {
+		r.Recorder.Eventf(cr, "Normal", "InstanceRefreshStarted", "Replacing instances with launch template %s version %s", group.LaunchTemplate.ID, group.LaunchTemplate.Version)
+	}
+
+	mapper.DomainToStatusAutoScalingGroup(group, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Follow instances more closely while they launch or are replaced
+	if !cr.Status.Ready || group.RefreshActive() {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// launchTemplateVersionChanged lets through LaunchTemplate events where
+// status.ready flips or a new version is created
+var launchTemplateVersionChanged = predicate.Or(referenceBecameReady, predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldLT, okOld := e.ObjectOld.(*infrav1alpha1.LaunchTemplate)
+		newLT, okNew := e.ObjectNew.(*infrav1alpha1.LaunchTemplate)
+		return okOld && okNew && oldLT.Status.LatestVersion != newLT.Status.LatestVersion
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+})
+
+// SetupWithManager sets up the controller with the Manager
+func (r *AutoScalingGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("autoscalinggroup-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.AutoScalingGroup{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.AutoScalingGroup)
+		keys := refKeys("Subnet", cr.Spec.SubnetRefs...)
+		keys = append(keys, refKeys("TargetGroup", cr.Spec.TargetGroupRefs...)...)
+		return append(keys, refKeys("LaunchTemplate", optionalRefs(cr.Spec.LaunchTemplate.LaunchTemplateRef)...)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.AutoScalingGroup{}).
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.AutoScalingGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.TargetGroup{}, enqueueReferencing(mgr.GetClient(), "TargetGroup", &infrav1alpha1.AutoScalingGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.LaunchTemplate{}, enqueueReferencing(mgr.GetClient(), "LaunchTemplate", &infrav1alpha1.AutoScalingGroupList{}), builder.WithPredicates(launchTemplateVersionChanged)).
+		Complete(inframetrics.InstrumentReconciler("AutoScalingGroup", mgr.GetClient(), &infrav1alpha1.AutoScalingGroup{}, r))
+}
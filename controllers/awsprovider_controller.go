@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 // AWSProviderReconciler reconciles an AWSProvider object
@@ -39,6 +41,7 @@ func (r *AWSProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	provider := &infrav1alpha1.AWSProvider{}
 	if err := r.Get(ctx, req.NamespacedName, provider); err != nil {
 		if errors.IsNotFound(err) {
+			inframetrics.ProviderReady.DeletePartialMatch(prometheus.Labels{"provider_name": req.Name})
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
@@ -81,6 +84,8 @@ func (r *AWSProviderReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	inframetrics.NewProviderMetricsRecorder(provider.Name, provider.Spec.Region).SetReady()
+
 	logger.Info("Successfully reconciled AWSProvider",
 		"account", provider.Status.AccountID,
 		"identity", provider.Status.CallerIdentity)
@@ -157,6 +162,9 @@ func (r *AWSProviderReconciler) buildAWSConfig(ctx context.Context, provider *in
 		cfg.BaseEndpoint = aws.String(provider.Spec.Endpoint)
 	}
 
+	// Record per-service/per-operation AWS API metrics for every client built from this config
+	cfg.APIOptions = append(cfg.APIOptions, inframetrics.AddAWSAPIMetrics)
+
 	return cfg, nil
 }
 
@@ -206,6 +214,13 @@ func (r *AWSProviderReconciler) updateStatus(ctx context.Context, provider *infr
 		return ctrl.Result{}, err
 	}
 
+	providerMetrics := inframetrics.NewProviderMetricsRecorder(provider.Name, provider.Spec.Region)
+	if ready {
+		providerMetrics.SetReady()
+	} else {
+		providerMetrics.SetNotReady()
+	}
+
 	// Retry after 1 minute on failure
 	if !ready {
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
@@ -218,5 +233,5 @@ func (r *AWSProviderReconciler) updateStatus(ctx context.Context, provider *infr
 func (r *AWSProviderReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.AWSProvider{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("AWSProvider", mgr.GetClient(), &infrav1alpha1.AWSProvider{}, r))
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const certificateFinalizerName = "certificate.infra.operator.aws.io/finalizer"
@@ -21,6 +24,7 @@ type CertificateReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=certificates,verbs=get;list;watch;create;update;patch;delete
@@ -64,11 +68,44 @@ func (r *CertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	cert := mapper.CRToDomainACM(certCR)
-	if err := certUseCase.SyncCertificate(ctx, cert); err != nil {
-		logger.Error(err, "failed to sync certificate")
-		certCR.Status.Ready = false
-		r.Status().Update(ctx, certCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, certCR, driftCheck{
+		kind:        "Certificate",
+		resourceID:  certCR.Status.CertificateARN,
+		providerRef: certCR.Spec.ProviderRef,
+		policy:      certCR.Spec.DriftPolicy,
+		status:      &certCR.Status.DriftStatus,
+		desired:     mapper.CertificateDriftState(cert),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetACMRepository(ctx, certCR.Spec.ProviderRef, certCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Describe(ctx, certCR.Status.CertificateARN)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.CertificateDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return certUseCase.SyncCertificate(ctx, cert)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, certCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := certUseCase.SyncCertificate(ctx, cert); err != nil {
+			logger.Error(err, "failed to sync certificate")
+			certCR.Status.Ready = false
+			r.Status().Update(ctx, certCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusACM(cert, certCR)
@@ -76,11 +113,14 @@ func (r *CertificateReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *CertificateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("certificate-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.Certificate{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("Certificate", mgr.GetClient(), &infrav1alpha1.Certificate{}, r))
 }
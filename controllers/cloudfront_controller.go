@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const cloudfrontFinalizer = "aws-infra-operator.runner.codes/cloudfront-finalizer"
@@ -21,6 +24,7 @@ type CloudFrontReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *CloudFrontReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -61,9 +65,42 @@ func (r *CloudFrontReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	domainDist := mapper.CRToDomainCloudFront(&cloudfront)
-	if err := useCase.SyncDistribution(ctx, domainDist); err != nil {
-		logger.Error(err, "Failed to sync distribution")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, &cloudfront, driftCheck{
+		kind:        "CloudFront",
+		resourceID:  cloudfront.Status.DistributionID,
+		providerRef: cloudfront.Spec.ProviderRef,
+		policy:      cloudfront.Spec.DriftPolicy,
+		status:      &cloudfront.Status.DriftStatus,
+		desired:     mapper.CloudFrontDriftState(domainDist),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetCloudFrontRepository(ctx, cloudfront.Spec.ProviderRef, cloudfront.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, cloudfront.Status.DistributionID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.CloudFrontDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncDistribution(ctx, domainDist)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, &cloudfront); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncDistribution(ctx, domainDist); err != nil {
+			logger.Error(err, "Failed to sync distribution")
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		}
 	}
 
 	mapper.DomainToStatusCloudFront(domainDist, &cloudfront)
@@ -72,11 +109,14 @@ func (r *CloudFrontReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *CloudFrontReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("cloudfront-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.CloudFront{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("CloudFront", mgr.GetClient(), &infrav1alpha1.CloudFront{}, r))
 }
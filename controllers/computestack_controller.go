@@ -21,6 +21,7 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const computeStackFinalizerName = "computestack.aws-infra-operator.runner.codes/finalizer"
@@ -1368,8 +1369,8 @@ func isNotFoundError(err error) bool {
 func (r *ComputeStackReconciler) deleteStack(ctx context.Context, ec2Client *ec2.Client, stack *infrav1alpha1.ComputeStack) error {
 	logger := log.FromContext(ctx)
 
-	if stack.Spec.DeletionPolicy == "Retain" {
-		logger.Info("DeletionPolicy is Retain, keeping resources in AWS")
+	if infrav1alpha1.DeletionPolicyKeepsResource(stack.Spec.DeletionPolicy) {
+		logger.Info("DeletionPolicy keeps resources in AWS", "policy", stack.Spec.DeletionPolicy)
 		return nil
 	}
 
@@ -1755,8 +1756,8 @@ func (r *ComputeStackReconciler) deleteStack(ctx context.Context, ec2Client *ec2
 func (r *ComputeStackReconciler) deleteStackAsync(ctx context.Context, ec2Client *ec2.Client, stack *infrav1alpha1.ComputeStack) (bool, error) {
 	logger := log.FromContext(ctx)
 
-	if stack.Spec.DeletionPolicy == "Retain" {
-		logger.Info("DeletionPolicy is Retain, keeping resources in AWS")
+	if infrav1alpha1.DeletionPolicyKeepsResource(stack.Spec.DeletionPolicy) {
+		logger.Info("DeletionPolicy keeps resources in AWS", "policy", stack.Spec.DeletionPolicy)
 		return true, nil
 	}
 
@@ -2657,5 +2658,5 @@ func (r *ComputeStackReconciler) checkBastionReady(ctx context.Context, ec2Clien
 func (r *ComputeStackReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ComputeStack{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("ComputeStack", mgr.GetClient(), &infrav1alpha1.ComputeStack{}, r))
 }
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+)
+
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// connectionSecretLabel marks Secrets written from spec.writeConnectionSecretToRef.
+const connectionSecretLabel = "aws-infra-operator.runner.codes/connection-secret"
+
+// writeConnectionSecret creates or updates the Secret named by ref with the
+// non-empty connection details. The Secret is controlled by owner, so it is
+// garbage-collected with it; a Secret controlled by another object is not touched.
+func writeConnectionSecret(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, ref *infrav1alpha1.ConnectionSecretReference, details map[string]string) error {
+	if ref == nil {
+		return nil
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ref.Name,
+			Namespace: owner.GetNamespace(),
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, c, secret, func() error {
+		if err := controllerutil.SetControllerReference(owner, secret, scheme); err != nil {
+			return err
+		}
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string)
+		}
+		secret.Labels["app.kubernetes.io/managed-by"] = "infra-operator"
+		secret.Labels[connectionSecretLabel] = owner.GetName()
+		secret.Type = corev1.SecretTypeOpaque
+
+		// Keys the resource no longer provides are removed so the Secret mirrors it exactly
+		secret.Data = make(map[string][]byte, len(details))
+		for key, value := range details {
+			if value != "" {
+				secret.Data[key] = []byte(value)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write connection secret %s: %w", ref.Name, err)
+	}
+	return nil
+}
+
+// rdsConnectionDetails returns the connection details of a DB instance. The url
+// is only written for engines with a well-known URL scheme.
+func rdsConnectionDetails(cr *infrav1alpha1.RDSInstance, password string) map[string]string {
+	details := map[string]string{
+		infrav1alpha1.ConnectionSecretKeyHost:     cr.Status.Endpoint,
+		infrav1alpha1.ConnectionSecretKeyARN:      cr.Status.DBInstanceArn,
+		infrav1alpha1.ConnectionSecretKeyUsername: cr.Spec.MasterUsername,
+		infrav1alpha1.ConnectionSecretKeyPassword: password,
+		"dbname": cr.Spec.DBName,
+	}
+	if cr.Status.Port != 0 {
+		details[infrav1alpha1.ConnectionSecretKeyPort] = strconv.Itoa(int(cr.Status.Port))
+	}
+
+	details[infrav1alpha1.ConnectionSecretKeyURL] = databaseURL(cr.Spec.Engine, cr.Spec.MasterUsername, password, cr.Status.Endpoint, cr.Status.Port, cr.Spec.DBName)
+	return details
+}
+
+// rdsClusterConnectionDetails returns the connection details of an Aurora
+// cluster: host is the writer endpoint and readerHost the reader endpoint.
+func rdsClusterConnectionDetails(cr *infrav1alpha1.RDSCluster, password string) map[string]string {
+	details := map[string]string{
+		infrav1alpha1.ConnectionSecretKeyHost:     cr.Status.Endpoint,
+		infrav1alpha1.ConnectionSecretKeyARN:      cr.Status.DBClusterArn,
+		infrav1alpha1.ConnectionSecretKeyUsername: cr.Spec.MasterUsername,
+		infrav1alpha1.ConnectionSecretKeyPassword: password,
+		"readerHost": cr.Status.ReaderEndpoint,
+		"dbname":     cr.Spec.DatabaseName,
+	}
+	if cr.Status.Port != 0 {
+		details[infrav1alpha1.ConnectionSecretKeyPort] = strconv.Itoa(int(cr.Status.Port))
+	}
+	details[infrav1alpha1.ConnectionSecretKeyURL] = databaseURL(cr.Spec.Engine, cr.Spec.MasterUsername, password, cr.Status.Endpoint, cr.Status.Port, cr.Spec.DatabaseName)
+	return details
+}
+
+// databaseURL returns a postgres:// or mysql:// URL with credentials, or ""
+// for other engines and while the endpoint is unknown
+func databaseURL(engine, username, password, host string, port int32, dbname string) string {
+	var scheme string
+	switch {
+	case strings.Contains(engine, "postgres"):
+		scheme = "postgres"
+	case strings.Contains(engine, "mysql"), engine == "mariadb":
+		scheme = "mysql"
+	}
+	if scheme == "" || host == "" || port == 0 {
+		return ""
+	}
+	u := url.URL{
+		Scheme: scheme,
+		User:   url.UserPassword(username, password),
+		Host:   net.JoinHostPort(host, strconv.Itoa(int(port))),
+		Path:   "/" + dbname,
+	}
+	return u.String()
+}
+
+// elastiCacheConnectionDetails returns the connection details of a cache cluster,
+// preferring the configuration endpoint (cluster mode), then the primary endpoint
+// and finally the first node.
+func elastiCacheConnectionDetails(cr *infrav1alpha1.ElastiCacheCluster, authToken string) map[string]string {
+	details := map[string]string{
+		infrav1alpha1.ConnectionSecretKeyARN:      cr.Status.CacheClusterARN,
+		infrav1alpha1.ConnectionSecretKeyPassword: authToken,
+	}
+
+	endpoint := cr.Status.ConfigurationEndpoint
+	if endpoint == nil {
+		endpoint = cr.Status.PrimaryEndpoint
+	}
+	if endpoint == nil && len(cr.Status.NodeEndpoints) > 0 {
+		endpoint = &cr.Status.NodeEndpoints[0]
+	}
+	if endpoint == nil || endpoint.Address == "" {
+		return details
+	}
+
+	port := strconv.Itoa(int(endpoint.Port))
+	details[infrav1alpha1.ConnectionSecretKeyHost] = endpoint.Address
+	details[infrav1alpha1.ConnectionSecretKeyPort] = port
+
+	scheme := cr.Spec.Engine
+	if scheme == "redis" && cr.Spec.TransitEncryptionEnabled {
+		scheme = "rediss"
+	}
+	u := url.URL{Scheme: scheme, Host: net.JoinHostPort(endpoint.Address, port)}
+	if authToken != "" {
+		u.User = url.UserPassword("", authToken)
+	}
+	details[infrav1alpha1.ConnectionSecretKeyURL] = u.String()
+	return details
+}
+
+// endpointConnectionDetails returns the connection details of resources reached
+// through an HTTPS endpoint (SQS queues, S3 buckets, API Gateway APIs).
+func endpointConnectionDetails(endpoint, arn string) map[string]string {
+	details := map[string]string{
+		infrav1alpha1.ConnectionSecretKeyURL: endpoint,
+		infrav1alpha1.ConnectionSecretKeyARN: arn,
+	}
+	if u, err := url.Parse(endpoint); err == nil {
+		details[infrav1alpha1.ConnectionSecretKeyHost] = u.Hostname()
+	}
+	return details
+}
+
+// s3BucketConnectionDetails returns the connection details of a bucket. The url
+// and host are only written once the bucket domain name is known.
+func s3BucketConnectionDetails(cr *infrav1alpha1.S3Bucket) map[string]string {
+	if cr.Status.BucketDomainName == "" {
+		return endpointConnectionDetails("", cr.Status.ARN)
+	}
+	return endpointConnectionDetails("https://"+cr.Status.BucketDomainName, cr.Status.ARN)
+}
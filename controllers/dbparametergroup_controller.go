@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const dbParameterGroupFinalizerName = "dbparametergroup.aws-infra-operator.runner.codes/finalizer"
+
+// DBParameterGroupReconciler reconciles a DBParameterGroup object
+type DBParameterGroupReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbparametergroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbparametergroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbparametergroups/finalizers,verbs=update
+
+func (r *DBParameterGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	cr := &infrav1alpha1.DBParameterGroup{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetDBParameterGroupUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, dbParameterGroupFinalizerName) {
+			group := mapper.CRToDomainDBParameterGroup(cr)
+			if err := useCase.DeleteParameterGroup(ctx, group); err != nil {
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(cr, dbParameterGroupFinalizerName)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(cr, dbParameterGroupFinalizerName) {
+		controllerutil.AddFinalizer(cr, dbParameterGroupFinalizerName)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	group := mapper.CRToDomainDBParameterGroup(cr)
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, cr, driftCheck{
+		kind:        "DBParameterGroup",
+		resourceID:  cr.Status.ARN,
+		providerRef: cr.Spec.ProviderRef,
+		policy:      cr.Spec.DriftPolicy,
+		status:      &cr.Status.DriftStatus,
+		desired:     mapper.DBParameterGroupDriftState(group),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetDBParameterGroupRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, group.Name)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.DBParameterGroupDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncParameterGroup(ctx, group)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncParameterGroup(ctx, group); err != nil {
+			logger.Error(err, "Failed to sync DB parameter group")
+			cr.Status.Ready = false
+			r.Status().Update(ctx, cr)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
+	}
+
+	mapper.DomainToStatusDBParameterGroup(group, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DBParameterGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("dbparametergroup-controller")
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.DBParameterGroup{}).
+		Complete(inframetrics.InstrumentReconciler("DBParameterGroup", mgr.GetClient(), &infrav1alpha1.DBParameterGroup{}, r))
+}
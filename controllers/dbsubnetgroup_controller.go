@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const dbSubnetGroupFinalizerName = "dbsubnetgroup.aws-infra-operator.runner.codes/finalizer"
+
+// DBSubnetGroupReconciler reconciles a DBSubnetGroup object
+type DBSubnetGroupReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbsubnetgroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbsubnetgroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dbsubnetgroups/finalizers,verbs=update
+
+func (r *DBSubnetGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	cr := &infrav1alpha1.DBSubnetGroup{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetDBSubnetGroupUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, dbSubnetGroupFinalizerName) {
+			group := mapper.CRToDomainDBSubnetGroup(cr)
+			if err := useCase.DeleteSubnetGroup(ctx, group); err != nil {
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(cr, dbSubnetGroupFinalizerName)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(cr, dbSubnetGroupFinalizerName) {
+		controllerutil.AddFinalizer(cr, dbSubnetGroupFinalizerName)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	group := mapper.CRToDomainDBSubnetGroup(cr)
+
+	// Resolve references to other resources in the namespace
+	if len(cr.Spec.SubnetRefs) > 0 {
+		subnetIDs, err := resolveSubnetRefs(ctx, r.Client, cr.Namespace, cr.Spec.SubnetRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		group.SubnetIDs = subnetIDs
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, cr, driftCheck{
+		kind:        "DBSubnetGroup",
+		resourceID:  cr.Status.ARN,
+		providerRef: cr.Spec.ProviderRef,
+		policy:      cr.Spec.DriftPolicy,
+		status:      &cr.Status.DriftStatus,
+		desired:     mapper.DBSubnetGroupDriftState(group),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetDBSubnetGroupRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, group.Name)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.DBSubnetGroupDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncSubnetGroup(ctx, group)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncSubnetGroup(ctx, group); err != nil {
+			logger.Error(err, "Failed to sync DB subnet group")
+			cr.Status.Ready = false
+			r.Status().Update(ctx, cr)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
+	}
+
+	mapper.DomainToStatusDBSubnetGroup(group, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DBSubnetGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("dbsubnetgroup-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.DBSubnetGroup{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.DBSubnetGroup)
+		return refKeys("Subnet", cr.Spec.SubnetRefs...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.DBSubnetGroup{}).
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.DBSubnetGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("DBSubnetGroup", mgr.GetClient(), &infrav1alpha1.DBSubnetGroup{}, r))
+}
@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/drift"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// driftCheck describes how to compare a managed resource with its actual AWS state.
+type driftCheck struct {
+	// kind is the resource type reported in events and metrics (e.g. "VPC")
+	kind string
+
+	// resourceID identifies the AWS resource; empty means it was not created yet
+	resourceID string
+
+	providerRef infrav1alpha1.ProviderReference
+	policy      *infrav1alpha1.DriftPolicy
+	status      *infrav1alpha1.DriftStatus
+
+	// desired is the projection of the domain object built from the CR
+	desired drift.State
+
+	// actual fetches the projection of the resource as it exists in AWS
+	actual func(ctx context.Context) (drift.State, error)
+
+	// sync re-applies the desired state through the use case; used as heal function
+	sync func(ctx context.Context) error
+}
+
+// driftOutcome tells the controller how to continue after a drift check.
+type driftOutcome struct {
+	// synced is true when sync already ran as the auto-heal action
+	synced bool
+
+	// pending is true when drift was detected in alert-only mode, so the
+	// controller must not overwrite the AWS resource with sync
+	pending bool
+
+	// interval is the drift check interval, zero when drift detection is disabled
+	interval time.Duration
+}
+
+// requeueAfter returns the shorter of the controller requeue and the drift interval.
+func (o driftOutcome) requeueAfter(defaultRequeue time.Duration) time.Duration {
+	if o.interval > 0 && o.interval < defaultRequeue {
+		return o.interval
+	}
+	return defaultRequeue
+}
+
+// checkDrift runs drift detection for an existing resource before it is synced,
+// records the result in the CR status and applies the configured drift action.
+// Errors are logged and never block the regular reconciliation.
+func checkDrift(ctx context.Context, c client.Client, recorder record.EventRecorder, obj client.Object, check driftCheck) driftOutcome {
+	logger := log.FromContext(ctx)
+	outcome := driftOutcome{}
+
+	if check.resourceID == "" {
+		return outcome
+	}
+
+	providerNamespace := check.providerRef.Namespace
+	if providerNamespace == "" {
+		providerNamespace = obj.GetNamespace()
+	}
+
+	provider := &infrav1alpha1.AWSProvider{}
+	if err := c.Get(ctx, types.NamespacedName{Name: check.providerRef.Name, Namespace: providerNamespace}, provider); err != nil {
+		logger.Error(err, "Failed to get AWSProvider for drift detection")
+		return outcome
+	}
+
+	cfg := drift.ApplyPolicy(drift.ConfigFromProvider(provider), check.policy)
+	if !cfg.Enabled {
+		check.status.DriftDetected = false
+		check.status.DriftDetails = nil
+		return outcome
+	}
+	outcome.interval = cfg.CheckInterval
+
+	// A spec edit is not drift: apply it before checking, so alert-only mode
+	// only holds back changes made outside the operator. Resources from
+	// before the generation was recorded are assumed to match their spec.
+	switch generation := obj.GetGeneration(); {
+	case check.status.ObservedGeneration == 0:
+		check.status.ObservedGeneration = generation
+	case check.status.ObservedGeneration != generation:
+		if err := check.sync(ctx); err != nil {
+			logger.Error(err, "Failed to apply spec changes", "resourceType", check.kind, "resourceID", check.resourceID)
+			return outcome
+		}
+		outcome.synced = true
+		check.status.ObservedGeneration = generation
+		check.status.DriftDetected = false
+		check.status.DriftDetails = nil
+	}
+
+	if !drift.ShouldCheckDrift(check.status.LastDriftCheck, cfg.CheckInterval) {
+		outcome.pending = check.status.DriftDetected && cfg.DefaultAction == drift.ActionAlertOnly
+		return outcome
+	}
+
+	actual, err := check.actual(ctx)
+	if err != nil {
+		logger.Error(err, "Failed to get actual state for drift detection", "resourceType", check.kind, "resourceID", check.resourceID)
+		return outcome
+	}
+
+	heal := func(ctx context.Context, _ []drift.DriftItem, _ runtime.Object) error {
+		if err := check.sync(ctx); err != nil {
+			return err
+		}
+		outcome.synced = true
+		return nil
+	}
+
+	helper := drift.NewControllerHelper(cfg, recorder, heal)
+	result, err := helper.CheckAndReconcileDrift(ctx, check.desired, actual.Scope(check.desired), check.kind, check.resourceID, obj)
+	if err != nil {
+		logger.Error(err, "Drift reconciliation failed", "resourceType", check.kind, "resourceID", check.resourceID)
+	}
+
+	// Re-check after healing so the status reflects what sync could not correct
+	if outcome.synced {
+		if actual, err := check.actual(ctx); err == nil {
+			if healed, err := drift.NewDetector(cfg).DetectDrift(ctx, check.desired, actual.Scope(check.desired), check.kind, check.resourceID); err == nil {
+				result = healed
+			}
+		}
+	}
+
+	now := metav1.Now()
+	check.status.LastDriftCheck = &now
+	if result == nil {
+		return outcome
+	}
+
+	check.status.DriftDetected = result.HasDrift
+	check.status.DriftDetails = drift.GetDriftDetailsForStatus(result.Drifts)
+	outcome.pending = result.HasDrift && cfg.DefaultAction == drift.ActionAlertOnly
+
+	return outcome
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/drift"
+)
+
+// newAlertOnlyDriftCheck returns a VPC whose drift was checked ten minutes
+// ago, and a check of it against an alert-only provider
+func newAlertOnlyDriftCheck(t *testing.T, generation, observedGeneration int64, actualCidr string, synced *bool) (*infrav1alpha1.VPC, driftCheck, *record.FakeRecorder, *fake.ClientBuilder) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := infrav1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	provider := &infrav1alpha1.AWSProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"},
+		Spec: infrav1alpha1.AWSProviderSpec{
+			DriftDetection: &infrav1alpha1.DriftDetectionConfig{Enabled: true, CheckInterval: "5m"},
+		},
+	}
+	lastCheck := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	vpc := &infrav1alpha1.VPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default", Generation: generation},
+		Spec:       infrav1alpha1.VPCSpec{ProviderRef: infrav1alpha1.ProviderReference{Name: "aws"}, CidrBlock: "10.1.0.0/16"},
+	}
+	vpc.Status.DriftStatus = infrav1alpha1.DriftStatus{LastDriftCheck: &lastCheck, ObservedGeneration: observedGeneration}
+
+	check := driftCheck{
+		kind:        "VPC",
+		resourceID:  "vpc-123",
+		providerRef: vpc.Spec.ProviderRef,
+		status:      &vpc.Status.DriftStatus,
+		desired:     drift.State{}.Set("cidrBlock", vpc.Spec.CidrBlock),
+		actual: func(ctx context.Context) (drift.State, error) {
+			return drift.State{}.Set("cidrBlock", actualCidr), nil
+		},
+		sync: func(ctx context.Context) error {
+			*synced = true
+			actualCidr = vpc.Spec.CidrBlock
+			return nil
+		},
+	}
+	return vpc, check, record.NewFakeRecorder(10), fake.NewClientBuilder().WithScheme(scheme).WithObjects(provider)
+}
+
+// TestCheckDrift_AlertOnlyAppliesSpecEdits verifies a spec edit reconciled
+// after the check interval is applied instead of being reported as drift.
+func TestCheckDrift_AlertOnlyAppliesSpecEdits(t *testing.T) {
+	synced := false
+	vpc, check, recorder, builder := newAlertOnlyDriftCheck(t, 2, 1, "10.0.0.0/16", &synced)
+
+	outcome := checkDrift(context.Background(), builder.Build(), recorder, vpc, check)
+	if !synced || !outcome.synced {
+		t.Error("Expected the edited spec to be synced")
+	}
+	if outcome.pending || vpc.Status.DriftDetected {
+		t.Errorf("Expected no drift after applying the spec, got %+v", vpc.Status.DriftDetails)
+	}
+	if vpc.Status.ObservedGeneration != 2 {
+		t.Errorf("Expected observed generation 2, got %d", vpc.Status.ObservedGeneration)
+	}
+}
+
+// TestCheckDrift_AlertOnlyHoldsOutOfBandChanges verifies changes made outside
+// the operator are reported and not overwritten.
+func TestCheckDrift_AlertOnlyHoldsOutOfBandChanges(t *testing.T) {
+	synced := false
+	vpc, check, recorder, builder := newAlertOnlyDriftCheck(t, 2, 2, "10.0.0.0/16", &synced)
+
+	outcome := checkDrift(context.Background(), builder.Build(), recorder, vpc, check)
+	if synced || outcome.synced {
+		t.Error("Expected no sync in alert-only mode")
+	}
+	if !outcome.pending || !vpc.Status.DriftDetected {
+		t.Error("Expected the out-of-band change to be reported as drift")
+	}
+}
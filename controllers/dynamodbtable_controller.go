@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -17,7 +19,9 @@ import (
 	ddbadapter "infra-operator/internal/adapters/aws/dynamodb"
 	ddbusecase "infra-operator/internal/usecases/dynamodb"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const dynamodbTableFinalizer = "aws-infra-operator.runner.codes/dynamodbtable-finalizer"
@@ -27,6 +31,7 @@ type DynamoDBTableReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=dynamodbtables,verbs=get;list;watch;create;update;patch;delete
@@ -91,10 +96,38 @@ func (r *DynamoDBTableReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// 8. Execute business logic through use case (idempotent)
-	if err := ddbUseCase.SyncTable(ctx, domainTable); err != nil {
-		logger.Error(err, "Failed to sync DynamoDB table")
-		return r.updateStatus(ctx, tableCR, false, fmt.Sprintf("Sync failed: %v", err))
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, tableCR, driftCheck{
+		kind:        "DynamoDBTable",
+		resourceID:  tableCR.Status.TableARN,
+		providerRef: tableCR.Spec.ProviderRef,
+		policy:      tableCR.Spec.DriftPolicy,
+		status:      &tableCR.Status.DriftStatus,
+		desired:     mapper.DynamoDBTableDriftState(domainTable),
+		actual: func(ctx context.Context) (drift.State, error) {
+			current, err := ddbRepo.Get(ctx, domainTable.Name)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.DynamoDBTableDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return ddbUseCase.SyncTable(ctx, domainTable)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, tableCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// 8. Execute business logic through use case (idempotent)
+		if err := ddbUseCase.SyncTable(ctx, domainTable); err != nil {
+			logger.Error(err, "Failed to sync DynamoDB table")
+			return r.updateStatus(ctx, tableCR, false, fmt.Sprintf("Sync failed: %v", err))
+		}
 	}
 
 	// 9. Update CR status from domain model
@@ -114,12 +147,23 @@ func (r *DynamoDBTableReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, tableCR, tableCR.Spec.WriteConnectionSecretToRef, map[string]string{infrav1alpha1.ConnectionSecretKeyARN: tableCR.Status.TableARN, "streamArn": tableCR.Status.StreamARN}); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("Successfully reconciled DynamoDBTable",
 		"table", domainTable.Name,
 		"status", domainTable.Status)
 
+	// Apply the remaining changes once the table and its indexes are ACTIVE
+	if domainTable.UpdatePending {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	// Requeue after 5 minutes for drift detection
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // updateStatus updates the CR status
@@ -156,7 +200,11 @@ func (r *DynamoDBTableReconciler) updateStatus(ctx context.Context, tableCR *inf
 
 // SetupWithManager sets up the controller with the Manager
 func (r *DynamoDBTableReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("dynamodbtable-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.DynamoDBTable{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("DynamoDBTable", mgr.GetClient(), &infrav1alpha1.DynamoDBTable{}, r))
 }
@@ -4,16 +4,21 @@ import (
 	"context"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 // Número máximo de linhas do console output a armazenar no status
@@ -26,6 +31,7 @@ type EC2InstanceReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *awsclients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=ec2instances,verbs=get;list;watch;create;update;patch;delete
@@ -80,19 +86,88 @@ func (r *EC2InstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Convert to domain
 	instance := mapper.CRToDomainEC2Instance(ec2Instance)
 
-	// Sync instance
-	if err := ec2UseCase.SyncInstance(ctx, instance); err != nil {
-		logger.Error(err, "Failed to sync EC2 instance")
-		ec2Instance.Status.Ready = false
-		if updateErr := r.Status().Update(ctx, ec2Instance); updateErr != nil {
-			logger.Error(updateErr, "Failed to update EC2Instance status")
+	// Bind to an existing AWS resource when the CR is adopted
+	if instance.InstanceID, err = adoptedID(ctx, r.Recorder, ec2Instance, instance.InstanceID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetEC2Repository(ctx, ec2Instance.Spec.ProviderRef, ec2Instance.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resolve references to other resources in the namespace
+	if ec2Instance.Spec.SubnetRef != nil {
+		subnetID, err := resolveSubnetRef(ctx, r.Client, ec2Instance.Namespace, *ec2Instance.Spec.SubnetRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, ec2Instance, err)
+		}
+		instance.SubnetID = subnetID
+	}
+	if len(ec2Instance.Spec.SecurityGroupRefs) > 0 {
+		groupIDs, err := resolveSecurityGroupRefs(ctx, r.Client, ec2Instance.Namespace, ec2Instance.Spec.SecurityGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, ec2Instance, err)
+		}
+		instance.SecurityGroupIDs = groupIDs
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, ec2Instance, driftCheck{
+		kind:        "EC2Instance",
+		resourceID:  instance.InstanceID,
+		providerRef: ec2Instance.Spec.ProviderRef,
+		policy:      ec2Instance.Spec.DriftPolicy,
+		status:      &ec2Instance.Status.DriftStatus,
+		desired:     mapper.EC2InstanceDriftState(instance),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetEC2Repository(ctx, ec2Instance.Spec.ProviderRef, ec2Instance.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, instance.InstanceID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.EC2InstanceDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return ec2UseCase.SyncInstance(ctx, instance)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, ec2Instance); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync instance
+		if err := ec2UseCase.SyncInstance(ctx, instance); err != nil {
+			logger.Error(err, "Failed to sync EC2 instance")
+			ec2Instance.Status.Ready = false
+			if updateErr := r.Status().Update(ctx, ec2Instance); updateErr != nil {
+				logger.Error(updateErr, "Failed to update EC2Instance status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update status
+	replacementRequired := meta.IsStatusConditionTrue(ec2Instance.Status.Conditions, "ReplacementRequired")
+	restartRequired := meta.IsStatusConditionTrue(ec2Instance.Status.Conditions, "RestartRequired")
 	mapper.DomainToStatusEC2Instance(instance, ec2Instance)
 
+	// Only report changes that can't be applied when they first show up
+	if len(instance.ReplacementFields) > 0 && !replacementRequired {
+		r.Recorder.Eventf(ec2Instance, "Warning", "ReplacementRequired", "Fields %v can only be applied by replacing the instance", instance.ReplacementFields)
+	}
+	if instance.RestartRequired && !restartRequired {
+		r.Recorder.Eventf(ec2Instance, "Warning", "RestartRequired", "Instance type %s is applied once the instance is stopped; set spec.allowRestart to restart it", instance.InstanceType)
+	}
+
 	// Busca console output se habilitado e instância está running
 	if ec2Instance.Spec.EnableConsoleOutput && ec2Instance.Status.InstanceID != "" {
 		if err := r.fetchConsoleOutput(ctx, ec2Instance); err != nil {
@@ -112,8 +187,13 @@ func (r *EC2InstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		"state", ec2Instance.Status.InstanceState,
 		"consoleOutputEnabled", ec2Instance.Spec.EnableConsoleOutput)
 
+	// Follow the instance more closely while it starts, stops or is modified
+	if !instance.InDesiredState() || instance.IsTransitioning() {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	// Requeue after 5 minutes
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // fetchConsoleOutput busca os logs do console da EC2 e armazena no status
@@ -142,7 +222,18 @@ func (r *EC2InstanceReconciler) fetchConsoleOutput(ctx context.Context, ec2Insta
 }
 
 func (r *EC2InstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("ec2instance-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.EC2Instance{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.EC2Instance)
+		return append(refKeys("Subnet", optionalRefs(cr.Spec.SubnetRef)...), refKeys("SecurityGroup", cr.Spec.SecurityGroupRefs...)...)
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.EC2Instance{}).
-		Complete(r)
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.EC2InstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.EC2InstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("EC2Instance", mgr.GetClient(), &infrav1alpha1.EC2Instance{}, r))
 }
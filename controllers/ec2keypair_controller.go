@@ -19,6 +19,8 @@ import (
 	"infra-operator/internal/domain/keypair"
 	keypairusecase "infra-operator/internal/usecases/keypair"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const ec2KeyPairFinalizer = "ec2keypair.aws-infra-operator.runner.codes/finalizer"
@@ -60,7 +62,7 @@ func (r *EC2KeyPairReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	uc := keypairusecase.NewKeyPairUseCase(repo)
 
 	// Mapeia o CR para o domínio
-	kp := r.crToDomain(cr)
+	kp := mapper.CRToDomainEC2KeyPair(cr)
 
 	// Trata a deleção
 	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -102,7 +104,7 @@ func (r *EC2KeyPairReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Atualiza o status
-	r.domainToStatus(kp, cr)
+	mapper.DomainToStatusEC2KeyPair(kp, cr)
 
 	if err := r.Status().Update(ctx, cr); err != nil {
 		logger.Error(err, "Falha ao atualizar status")
@@ -113,49 +115,6 @@ func (r *EC2KeyPairReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
 }
 
-// crToDomain converte o CR para o modelo de domínio
-func (r *EC2KeyPairReconciler) crToDomain(cr *infrav1alpha1.EC2KeyPair) *keypair.KeyPair {
-	keyName := cr.Spec.KeyName
-	if keyName == "" {
-		keyName = cr.Name
-	}
-
-	// Garante que as tags incluem Name
-	tags := cr.Spec.Tags
-	if tags == nil {
-		tags = make(map[string]string)
-	}
-	if _, exists := tags["Name"]; !exists {
-		tags["Name"] = cr.Name
-	}
-
-	kp := &keypair.KeyPair{
-		KeyName:           keyName,
-		PublicKeyMaterial: cr.Spec.PublicKeyMaterial,
-		Tags:              tags,
-		DeletionPolicy:    cr.Spec.DeletionPolicy,
-	}
-
-	if cr.Status.KeyPairID != "" {
-		kp.KeyPairID = cr.Status.KeyPairID
-		kp.KeyFingerprint = cr.Status.KeyFingerprint
-		kp.KeyType = cr.Status.KeyType
-	}
-
-	return kp
-}
-
-// domainToStatus atualiza o status do CR com dados do domínio
-func (r *EC2KeyPairReconciler) domainToStatus(kp *keypair.KeyPair, cr *infrav1alpha1.EC2KeyPair) {
-	now := metav1.Now()
-	cr.Status.Ready = kp.IsReady()
-	cr.Status.KeyPairID = kp.KeyPairID
-	cr.Status.KeyFingerprint = kp.KeyFingerprint
-	cr.Status.KeyName = kp.KeyName
-	cr.Status.KeyType = kp.KeyType
-	cr.Status.LastSyncTime = &now
-}
-
 // createPrivateKeySecret cria um secret Kubernetes com a chave privada
 func (r *EC2KeyPairReconciler) createPrivateKeySecret(ctx context.Context, cr *infrav1alpha1.EC2KeyPair, kp *keypair.KeyPair) error {
 	if cr.Spec.SecretRef == nil {
@@ -208,5 +167,5 @@ func (r *EC2KeyPairReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.EC2KeyPair{}).
 		Owns(&corev1.Secret{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("EC2KeyPair", mgr.GetClient(), &infrav1alpha1.EC2KeyPair{}, r))
 }
@@ -6,6 +6,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -14,7 +15,9 @@ import (
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/internal/ports"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const ecrFinalizerName = "aws-infra-operator.runner.codes/ecr-finalizer"
@@ -24,6 +27,7 @@ type ECRRepositoryReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=ecrrepositories,verbs=get;list;watch;create;update;patch;delete
@@ -66,17 +70,49 @@ func (r *ECRRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	// Convert CR to domain model
 	repository := mapper.CRToDomainECRRepository(ecrRepo)
 
-	// Sync the repository
-	if err := ecrUseCase.SyncRepository(ctx, repository); err != nil {
-		logger.Error(err, "Failed to sync ECR repository")
-
-		// Update status with error
-		ecrRepo.Status.Ready = false
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, ecrRepo, driftCheck{
+		kind:        "ECRRepository",
+		resourceID:  ecrRepo.Status.RepositoryArn,
+		providerRef: ecrRepo.Spec.ProviderRef,
+		policy:      ecrRepo.Spec.DriftPolicy,
+		status:      &ecrRepo.Status.DriftStatus,
+		desired:     mapper.ECRRepositoryDriftState(repository),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetECRRepository(ctx, ecrRepo.Spec.ProviderRef, ecrRepo.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, repository.RepositoryName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.ECRRepositoryDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return ecrUseCase.SyncRepository(ctx, repository)
+		},
+	})
+	if outcome.pending {
 		if err := r.Status().Update(ctx, ecrRepo); err != nil {
-			logger.Error(err, "Failed to update status")
+			return ctrl.Result{}, err
 		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync the repository
+		if err := ecrUseCase.SyncRepository(ctx, repository); err != nil {
+			logger.Error(err, "Failed to sync ECR repository")
 
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+			// Update status with error
+			ecrRepo.Status.Ready = false
+			if err := r.Status().Update(ctx, ecrRepo); err != nil {
+				logger.Error(err, "Failed to update status")
+			}
+
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update CR status from domain model
@@ -94,7 +130,7 @@ func (r *ECRRepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		"imageCount", repository.ImageCount)
 
 	// Requeue to check status periodically
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *ECRRepositoryReconciler) handleDeletion(ctx context.Context, ecrRepo *infrav1alpha1.ECRRepository, ecrUseCase ports.ECRUseCase) (ctrl.Result, error) {
@@ -122,7 +158,10 @@ func (r *ECRRepositoryReconciler) handleDeletion(ctx context.Context, ecrRepo *i
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ECRRepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("ecrrepository-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ECRRepository{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("ECRRepository", mgr.GetClient(), &infrav1alpha1.ECRRepository{}, r))
 }
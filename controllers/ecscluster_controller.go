@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const ecsClusterFinalizerName = "ecscluster.infra.operator.aws.io/finalizer"
@@ -22,6 +25,7 @@ type ECSClusterReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=ecsclusters,verbs=get;list;watch;create;update;patch;delete
@@ -76,12 +80,44 @@ func (r *ECSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	// Convert CR to domain model
 	cluster := mapper.CRToDomainECSCluster(ecsClusterCR)
 
-	// Sync cluster
-	if err := ecsUseCase.SyncCluster(ctx, cluster); err != nil {
-		logger.Error(err, "failed to sync ECS cluster")
-		ecsClusterCR.Status.Ready = false
-		r.Status().Update(ctx, ecsClusterCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, ecsClusterCR, driftCheck{
+		kind:        "ECSCluster",
+		resourceID:  ecsClusterCR.Status.ClusterARN,
+		providerRef: ecsClusterCR.Spec.ProviderRef,
+		policy:      ecsClusterCR.Spec.DriftPolicy,
+		status:      &ecsClusterCR.Status.DriftStatus,
+		desired:     mapper.ECSClusterDriftState(cluster),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetECSRepository(ctx, ecsClusterCR.Spec.ProviderRef, ecsClusterCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, cluster.ClusterName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.ECSClusterDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return ecsUseCase.SyncCluster(ctx, cluster)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, ecsClusterCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync cluster
+		if err := ecsUseCase.SyncCluster(ctx, cluster); err != nil {
+			logger.Error(err, "failed to sync ECS cluster")
+			ecsClusterCR.Status.Ready = false
+			r.Status().Update(ctx, ecsClusterCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -91,12 +127,15 @@ func (r *ECSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	// Requeue to keep checking cluster status
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *ECSClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("ecscluster-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ECSCluster{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("ECSCluster", mgr.GetClient(), &infrav1alpha1.ECSCluster{}, r))
 }
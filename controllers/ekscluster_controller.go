@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const eksClusterFinalizerName = "ekscluster.aws-infra-operator.runner.codes/finalizer"
@@ -21,6 +24,7 @@ type EKSClusterReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *EKSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -64,12 +68,45 @@ func (r *EKSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Sync EKS cluster
 	cluster := mapper.CRToDomainEKSCluster(eksCR)
-	if err := eksUseCase.SyncCluster(ctx, cluster); err != nil {
-		logger.Error(err, "Failed to sync EKS cluster")
-		eksCR.Status.Ready = false
-		eksCR.Status.Status = "FAILED"
-		r.Status().Update(ctx, eksCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, eksCR, driftCheck{
+		kind:        "EKSCluster",
+		resourceID:  eksCR.Status.ARN,
+		providerRef: eksCR.Spec.ProviderRef,
+		policy:      eksCR.Spec.DriftPolicy,
+		status:      &eksCR.Status.DriftStatus,
+		desired:     mapper.EKSClusterDriftState(cluster),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetEKSRepository(ctx, eksCR.Spec.ProviderRef, eksCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, cluster.ClusterName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.EKSClusterDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return eksUseCase.SyncCluster(ctx, cluster)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, eksCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := eksUseCase.SyncCluster(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to sync EKS cluster")
+			eksCR.Status.Ready = false
+			eksCR.Status.Status = "FAILED"
+			r.Status().Update(ctx, eksCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -83,11 +120,14 @@ func (r *EKSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *EKSClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("ekscluster-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.EKSCluster{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("EKSCluster", mgr.GetClient(), &infrav1alpha1.EKSCluster{}, r))
 }
@@ -8,14 +8,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/elasticache"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const elasticacheClusterFinalizerName = "elasticachecluster.aws-infra-operator.runner.codes/finalizer"
@@ -25,6 +29,7 @@ type ElastiCacheClusterReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=elasticacheclusters,verbs=get;list;watch;create;update;patch;delete
@@ -102,12 +107,49 @@ func (r *ElastiCacheClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// Convert CR to domain model
 	cluster := mapper.CRToDomainElastiCacheCluster(elasticacheCluster, authToken)
 
-	// Sync the cluster
-	if err := elasticacheUseCase.SyncCluster(ctx, cluster); err != nil {
-		logger.Error(err, "Failed to sync ElastiCache cluster")
-		elasticacheCluster.Status.Ready = false
-		r.Status().Update(ctx, elasticacheCluster)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, elasticacheCluster, driftCheck{
+		kind:        "ElastiCacheCluster",
+		resourceID:  elasticacheCluster.Status.CacheClusterARN,
+		providerRef: elasticacheCluster.Spec.ProviderRef,
+		policy:      elasticacheCluster.Spec.DriftPolicy,
+		status:      &elasticacheCluster.Status.DriftStatus,
+		desired:     mapper.ElastiCacheClusterDriftState(cluster),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetElastiCacheRepository(ctx, elasticacheCluster.Spec.ProviderRef, elasticacheCluster.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			var current *elasticache.Cluster
+			if cluster.IsReplicationGroup() {
+				current, err = repo.GetReplicationGroup(ctx, cluster.ClusterID)
+			} else {
+				current, err = repo.GetCacheCluster(ctx, cluster.ClusterID)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return mapper.ElastiCacheClusterDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return elasticacheUseCase.SyncCluster(ctx, cluster)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, elasticacheCluster); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync the cluster
+		if err := elasticacheUseCase.SyncCluster(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to sync ElastiCache cluster")
+			elasticacheCluster.Status.Ready = false
+			r.Status().Update(ctx, elasticacheCluster)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -116,8 +158,14 @@ func (r *ElastiCacheClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, elasticacheCluster, elasticacheCluster.Spec.WriteConnectionSecretToRef, elastiCacheConnectionDetails(elasticacheCluster, authToken)); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	// Requeue after 5 minutes to check cluster status
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *ElastiCacheClusterReconciler) getSecretValue(ctx context.Context, namespace string, selector *infrav1alpha1.SecretKeySelector) (string, error) {
@@ -144,7 +192,11 @@ func (r *ElastiCacheClusterReconciler) getSecretValue(ctx context.Context, names
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ElastiCacheClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("elasticachecluster-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ElastiCacheCluster{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("ElastiCacheCluster", mgr.GetClient(), &infrav1alpha1.ElastiCacheCluster{}, r))
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const elasticIPFinalizerName = "elasticip.infra.operator.aws.io/finalizer"
@@ -22,6 +25,7 @@ type ElasticIPReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=elasticips,verbs=get;list;watch;create;update;patch;delete
@@ -76,12 +80,55 @@ func (r *ElasticIPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	// Convert CR to domain model
 	addr := mapper.CRToDomainElasticIP(eipCR)
 
-	// Sync Elastic IP
-	if err := eipUseCase.SyncAddress(ctx, addr); err != nil {
-		logger.Error(err, "failed to sync Elastic IP")
-		eipCR.Status.Ready = false
-		r.Status().Update(ctx, eipCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	// Bind to an existing AWS resource when the CR is adopted
+	if addr.AllocationID, err = adoptedID(ctx, r.Recorder, eipCR, addr.AllocationID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetElasticIPRepository(ctx, eipCR.Spec.ProviderRef, eipCR.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, eipCR, driftCheck{
+		kind:        "ElasticIP",
+		resourceID:  addr.AllocationID,
+		providerRef: eipCR.Spec.ProviderRef,
+		policy:      eipCR.Spec.DriftPolicy,
+		status:      &eipCR.Status.DriftStatus,
+		desired:     mapper.ElasticIPDriftState(addr),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetElasticIPRepository(ctx, eipCR.Spec.ProviderRef, eipCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, addr.AllocationID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.ElasticIPDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return eipUseCase.SyncAddress(ctx, addr)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, eipCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync Elastic IP
+		if err := eipUseCase.SyncAddress(ctx, addr); err != nil {
+			logger.Error(err, "failed to sync Elastic IP")
+			eipCR.Status.Ready = false
+			r.Status().Update(ctx, eipCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -91,12 +138,15 @@ func (r *ElasticIPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	}
 
 	// Requeue to keep checking status
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *ElasticIPReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("elasticip-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.ElasticIP{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("ElasticIP", mgr.GetClient(), &infrav1alpha1.ElasticIP{}, r))
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const iamRoleFinalizerName = "iamrole.aws-infra-operator.runner.codes/finalizer"
@@ -22,6 +25,7 @@ type IAMRoleReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *awsclients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=iamroles,verbs=get;list;watch;create;update;patch;delete
@@ -76,15 +80,58 @@ func (r *IAMRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// Convert CR to domain model
 	role := mapper.CRToDomainIAMRole(iamRole)
 
-	// Sync IAM role
-	if err := iamUseCase.SyncRole(ctx, role); err != nil {
-		logger.Error(err, "Failed to sync IAM role")
-		iamRole.Status.Ready = false
-		iamRole.Status.Message = err.Error()
-		if updateErr := r.Status().Update(ctx, iamRole); updateErr != nil {
-			logger.Error(updateErr, "Failed to update IAMRole status")
+	// Bind to an existing role when the CR is adopted
+	if err := adoptedName(ctx, r.Recorder, iamRole, role.RoleName, iamRole.Status.RoleArn != "", func(ctx context.Context, name string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetIAMRepository(ctx, iamRole.Spec.ProviderRef, iamRole.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, name)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, iamRole, driftCheck{
+		kind:        "IAMRole",
+		resourceID:  iamRole.Status.RoleArn,
+		providerRef: iamRole.Spec.ProviderRef,
+		policy:      iamRole.Spec.DriftPolicy,
+		status:      &iamRole.Status.DriftStatus,
+		desired:     mapper.IAMRoleDriftState(role),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetIAMRepository(ctx, iamRole.Spec.ProviderRef, iamRole.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, role.RoleName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.IAMRoleDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return iamUseCase.SyncRole(ctx, role)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, iamRole); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync IAM role
+		if err := iamUseCase.SyncRole(ctx, role); err != nil {
+			logger.Error(err, "Failed to sync IAM role")
+			iamRole.Status.Ready = false
+			iamRole.Status.Message = err.Error()
+			if updateErr := r.Status().Update(ctx, iamRole); updateErr != nil {
+				logger.Error(updateErr, "Failed to update IAMRole status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update status
@@ -98,12 +145,15 @@ func (r *IAMRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		"roleName", iamRole.Spec.RoleName,
 		"roleArn", iamRole.Status.RoleArn)
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *IAMRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("iamrole-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.IAMRole{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("IAMRole", mgr.GetClient(), &infrav1alpha1.IAMRole{}, r))
 }
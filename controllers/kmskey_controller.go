@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -12,7 +13,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const kmsKeyFinalizerName = "kmskey.aws-infra-operator.runner.codes/finalizer"
@@ -22,6 +25,7 @@ type KMSKeyReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *awsclients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=kmskeys,verbs=get;list;watch;create;update;patch;delete
@@ -76,14 +80,46 @@ func (r *KMSKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// Convert CR to domain model
 	key := mapper.CRToDomainKMSKey(kmsKey)
 
-	// Sync KMS key
-	if err := kmsUseCase.SyncKey(ctx, key); err != nil {
-		logger.Error(err, "Failed to sync KMS key")
-		kmsKey.Status.Ready = false
-		if updateErr := r.Status().Update(ctx, kmsKey); updateErr != nil {
-			logger.Error(updateErr, "Failed to update KMSKey status")
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, kmsKey, driftCheck{
+		kind:        "KMSKey",
+		resourceID:  kmsKey.Status.KeyId,
+		providerRef: kmsKey.Spec.ProviderRef,
+		policy:      kmsKey.Spec.DriftPolicy,
+		status:      &kmsKey.Status.DriftStatus,
+		desired:     mapper.KMSKeyDriftState(key),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetKMSRepository(ctx, kmsKey.Spec.ProviderRef, kmsKey.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, kmsKey.Status.KeyId)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.KMSKeyDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return kmsUseCase.SyncKey(ctx, key)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, kmsKey); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync KMS key
+		if err := kmsUseCase.SyncKey(ctx, key); err != nil {
+			logger.Error(err, "Failed to sync KMS key")
+			kmsKey.Status.Ready = false
+			if updateErr := r.Status().Update(ctx, kmsKey); updateErr != nil {
+				logger.Error(updateErr, "Failed to update KMSKey status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update status
@@ -99,11 +135,14 @@ func (r *KMSKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		"state", kmsKey.Status.KeyState)
 
 	// Requeue after 5 minutes for continuous sync
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *KMSKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("kmskey-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.KMSKey{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("KMSKey", mgr.GetClient(), &infrav1alpha1.KMSKey{}, r))
 }
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	cloudwatchadapter "infra-operator/internal/adapters/aws/cloudwatch"
+	lambdaadapter "infra-operator/internal/adapters/aws/lambda"
+	"infra-operator/internal/domain/lambda"
+	lambdausecase "infra-operator/internal/usecases/lambda"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const lambdaAliasFinalizer = "aws-infra-operator.runner.codes/lambdaalias-finalizer"
+
+// LambdaAliasReconciler reconciles a LambdaAlias object
+type LambdaAliasReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdaaliases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdaaliases/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdaaliases/finalizers,verbs=update
+
+func (r *LambdaAliasReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.LambdaAlias{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// Get AWS configuration from provider
+	awsConfig, _, err := r.AWSClientFactory.GetAWSConfigFromProviderRef(ctx, cr.Namespace, cr.Spec.ProviderRef)
+	if err != nil {
+		logger.Error(err, "failed to get AWS config from provider")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+	}
+
+	useCase := lambdausecase.NewAliasUseCase(
+		lambdaadapter.NewAliasRepository(awsConfig),
+		cloudwatchadapter.NewRepository(awsConfig),
+	)
+	alias := mapper.CRToDomainLambdaAlias(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, lambdaAliasFinalizer) {
+			// The function name resolved from a reference is kept in the status
+			if alias.FunctionName == "" {
+				alias.FunctionName = cr.Status.FunctionName
+			}
+			if !infrav1alpha1.DeletionPolicyKeepsResource(cr.Spec.DeletionPolicy) && alias.FunctionName != "" {
+				if err := useCase.DeleteAlias(ctx, alias); err != nil {
+					logger.Error(err, "failed to delete alias")
+					return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+				}
+			}
+			controllerutil.RemoveFinalizer(cr, lambdaAliasFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, lambdaAliasFinalizer) {
+		controllerutil.AddFinalizer(cr, lambdaAliasFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve the referenced function and, by default, its latest published version
+	if cr.Spec.FunctionRef != nil {
+		function, err := resolveLambdaFunctionRef(ctx, r.Client, cr.Namespace, *cr.Spec.FunctionRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		alias.FunctionName = function.Spec.FunctionName
+		if alias.FunctionVersion == "" {
+			if function.Status.PublishedVersion == "" {
+				return waitForReference(ctx, r.Recorder, cr, &refNotReadyError{
+					kind: "LambdaFunction", name: function.Name, reason: "has not published a version (set spec.publish)",
+				})
+			}
+			alias.FunctionVersion = function.Status.PublishedVersion
+		}
+	}
+
+	previousPhase := ""
+	if alias.Shift != nil {
+		previousPhase = alias.Shift.Phase
+	}
+
+	if err := useCase.SyncAlias(ctx, alias); err != nil {
+		logger.Error(err, "failed to sync alias")
+		cr.Status.Ready = false
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	// Report traffic shift transitions
+	if shift := alias.Shift; shift != nil && shift.Phase != previousPhase {
+		switch shift.Phase {
+		case lambda.ShiftPhaseProgressing:
+			r.Recorder.Eventf(cr, "Normal", "TrafficShiftStarted", "Shifting traffic from version %s to %s", shift.StableVersion, shift.TargetVersion)
+		case lambda.ShiftPhaseCompleted:
+			r.Recorder.Eventf(cr, "Normal", "TrafficShiftCompleted", "Version %s receives all traffic", shift.TargetVersion)
+		case lambda.ShiftPhaseRolledBack:
+			r.Recorder.Event(cr, "Warning", "TrafficShiftRolledBack", shift.Message)
+		}
+	}
+
+	mapper.DomainToStatusLambdaAlias(alias, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// During a shift, check the alarms every minute and move on at each interval
+	requeueAfter := 5 * time.Minute
+	if alias.ShiftInProgress() {
+		requeueAfter = min(max(alias.NextStepIn(time.Now()), time.Second), time.Minute)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// publishedVersionChanged lets through events where a referenced LambdaFunction
+// becomes Ready or publishes a new version
+var publishedVersionChanged = predicate.Or(referenceBecameReady, predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldFunction, okOld := e.ObjectOld.(*infrav1alpha1.LambdaFunction)
+		newFunction, okNew := e.ObjectNew.(*infrav1alpha1.LambdaFunction)
+		return okOld && okNew && oldFunction.Status.PublishedVersion != newFunction.Status.PublishedVersion
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+})
+
+// SetupWithManager sets up the controller with the Manager
+func (r *LambdaAliasReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("lambdaalias-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.LambdaAlias{}, func(obj client.Object) []string {
+		return refKeys("LambdaFunction", optionalRefs(obj.(*infrav1alpha1.LambdaAlias).Spec.FunctionRef)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.LambdaAlias{}).
+		Watches(&infrav1alpha1.LambdaFunction{}, enqueueReferencing(mgr.GetClient(), "LambdaFunction", &infrav1alpha1.LambdaAliasList{}), builder.WithPredicates(publishedVersionChanged)).
+		Complete(inframetrics.InstrumentReconciler("LambdaAlias", mgr.GetClient(), &infrav1alpha1.LambdaAlias{}, r))
+}
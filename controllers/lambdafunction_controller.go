@@ -2,23 +2,33 @@ package controllers
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	lambdaadapter "infra-operator/internal/adapters/aws/lambda"
+	ociadapter "infra-operator/internal/adapters/oci"
 	"infra-operator/internal/domain/lambda"
 	"infra-operator/internal/ports"
 	lambdausecase "infra-operator/internal/usecases/lambda"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const lambdaFunctionFinalizer = "aws-infra-operator.runner.codes/lambdafunction-finalizer"
@@ -28,11 +38,14 @@ type LambdaFunctionReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdafunctions,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdafunctions/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=lambdafunctions/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *LambdaFunctionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
@@ -61,7 +74,7 @@ func (r *LambdaFunctionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Create Lambda repository and use case
 	lambdaRepo := lambdaadapter.NewRepository(awsConfig)
-	lambdaUseCase := lambdausecase.NewFunctionUseCase(lambdaRepo)
+	lambdaUseCase := lambdausecase.NewFunctionUseCase(lambdaRepo, ociadapter.NewRepository())
 
 	// Convert CR to domain model
 	function := mapper.CRToDomainFunction(&lambdaFunction)
@@ -80,19 +93,59 @@ func (r *LambdaFunctionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	// Execute business logic through use case
-	if err := lambdaUseCase.SyncFunction(ctx, function); err != nil {
-		logger.Error(err, "failed to sync function")
-		lambdaFunction.Status.Ready = false
-		lambdaFunction.Status.StateReason = err.Error()
-		if statusErr := r.Status().Update(ctx, &lambdaFunction); statusErr != nil {
-			logger.Error(statusErr, "failed to update status")
+	// Read the files and registry credentials of the code
+	if err := r.resolveCode(ctx, &lambdaFunction, function); err != nil {
+		return waitForReference(ctx, r.Recorder, &lambdaFunction, err)
+	}
+
+	// Resolve the queues, tables and topics referenced by the triggers
+	if err := r.resolveTriggerRefs(ctx, &lambdaFunction, function); err != nil {
+		return waitForReference(ctx, r.Recorder, &lambdaFunction, err)
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, &lambdaFunction, driftCheck{
+		kind:        "LambdaFunction",
+		resourceID:  lambdaFunction.Status.FunctionArn,
+		providerRef: lambdaFunction.Spec.ProviderRef,
+		policy:      lambdaFunction.Spec.DriftPolicy,
+		status:      &lambdaFunction.Status.DriftStatus,
+		desired:     mapper.LambdaFunctionDriftState(function),
+		actual: func(ctx context.Context) (drift.State, error) {
+			current, err := lambdaRepo.Get(ctx, function.Name)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.LambdaFunctionDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return lambdaUseCase.SyncFunction(ctx, function)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, &lambdaFunction); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Execute business logic through use case
+		if err := lambdaUseCase.SyncFunction(ctx, function); err != nil {
+			logger.Error(err, "failed to sync function")
+			lambdaFunction.Status.Ready = false
+			lambdaFunction.Status.StateReason = err.Error()
+			if statusErr := r.Status().Update(ctx, &lambdaFunction); statusErr != nil {
+				logger.Error(statusErr, "failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update CR status with domain model state
+	driftStatus := lambdaFunction.Status.DriftStatus
 	lambdaFunction.Status = mapper.DomainFunctionToStatus(function)
+	lambdaFunction.Status.DriftStatus = driftStatus
 
 	if err := r.Status().Update(ctx, &lambdaFunction); err != nil {
 		logger.Error(err, "failed to update status")
@@ -105,8 +158,13 @@ func (r *LambdaFunctionReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		"state", function.State,
 		"runtime", function.Runtime)
 
-	// Requeue after 5 minutes for periodic sync
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	// Requeue after 5 minutes for periodic sync; a version is only published
+	// once the function is no longer being updated, so check back sooner
+	requeueAfter := 5 * time.Minute
+	if function.Publish && !function.Publishable() {
+		requeueAfter = 30 * time.Second
+	}
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(requeueAfter)}, nil
 }
 
 func (r *LambdaFunctionReconciler) handleDeletion(ctx context.Context, lambdaFunction *infrav1alpha1.LambdaFunction, function *lambda.Function, useCase ports.LambdaUseCase) (ctrl.Result, error) {
@@ -129,7 +187,7 @@ func (r *LambdaFunctionReconciler) handleDeletion(ctx context.Context, lambdaFun
 			logger.Error(err, "failed to delete function")
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
-	case "Retain":
+	case "Retain", "Orphan":
 		logger.Info("retaining function in AWS", "functionName", function.Name)
 	default:
 		logger.Info(fmt.Sprintf("unknown deletion policy %s, retaining function", deletionPolicy))
@@ -145,9 +203,157 @@ func (r *LambdaFunctionReconciler) handleDeletion(ctx context.Context, lambdaFun
 	return ctrl.Result{}, nil
 }
 
+// resolveCode sets the files of a ConfigMap and the credentials of a registry
+// pull secret on the domain model
+func (r *LambdaFunctionReconciler) resolveCode(ctx context.Context, lambdaFunction *infrav1alpha1.LambdaFunction, function *lambda.Function) error {
+	code := lambdaFunction.Spec.Code
+	if code.Path != "" {
+		return fmt.Errorf("spec.code.path is only supported by the CLI; use spec.code.configMapRef or spec.code.oci")
+	}
+
+	if ref := code.ConfigMapRef; ref != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: lambdaFunction.Namespace}, configMap); err != nil {
+			if errors.IsNotFound(err) {
+				return &refNotReadyError{kind: "ConfigMap", name: ref.Name, reason: "not found"}
+			}
+			return err
+		}
+		files := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+		for name, content := range configMap.Data {
+			files[name] = []byte(content)
+		}
+		for name, content := range configMap.BinaryData {
+			files[name] = content
+		}
+		function.Code.Files = files
+	}
+
+	if code.OCI != nil && code.OCI.PullSecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: code.OCI.PullSecretRef.Name, Namespace: lambdaFunction.Namespace}, secret); err != nil {
+			if errors.IsNotFound(err) {
+				return &refNotReadyError{kind: "Secret", name: code.OCI.PullSecretRef.Name, reason: "not found"}
+			}
+			return err
+		}
+		username, password, err := registryCredentials(secret, code.OCI.Reference)
+		if err != nil {
+			return err
+		}
+		function.Code.OCI.Username = username
+		function.Code.OCI.Password = password
+	}
+
+	return nil
+}
+
+// registryCredentials returns the credentials of a dockerconfigjson Secret
+// for the registry of an artifact reference
+func registryCredentials(secret *corev1.Secret, reference string) (string, string, error) {
+	registry, _, _, err := lambda.ParseArtifactReference(reference)
+	if err != nil {
+		return "", "", err
+	}
+
+	var config struct {
+		Auths map[string]struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+			Auth     string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+		return "", "", fmt.Errorf("secret %s is not a valid %s secret: %w", secret.Name, corev1.SecretTypeDockerConfigJson, err)
+	}
+
+	for server, auth := range config.Auths {
+		host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+		host, _, _ = strings.Cut(host, "/")
+		if host != registry && !(registry == "registry-1.docker.io" && (host == "index.docker.io" || host == "docker.io")) {
+			continue
+		}
+		if auth.Username != "" {
+			return auth.Username, auth.Password, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return "", "", fmt.Errorf("secret %s has an invalid auth for %s: %w", secret.Name, server, err)
+		}
+		username, password, _ := strings.Cut(string(decoded), ":")
+		return username, password, nil
+	}
+
+	return "", "", fmt.Errorf("secret %s has no credentials for %s", secret.Name, registry)
+}
+
+// resolveTriggerRefs sets the ARNs of the referenced event sources, on-failure
+// destinations and permission sources on the domain model
+func (r *LambdaFunctionReconciler) resolveTriggerRefs(ctx context.Context, lambdaFunction *infrav1alpha1.LambdaFunction, function *lambda.Function) error {
+	var err error
+	for i, src := range lambdaFunction.Spec.EventSources {
+		mapping := &function.EventSources[i]
+		switch {
+		case src.SQSQueueRef != nil:
+			mapping.EventSourceARN, err = resolveSQSQueueRef(ctx, r.Client, lambdaFunction.Namespace, *src.SQSQueueRef)
+		case src.DynamoDBTableRef != nil:
+			mapping.EventSourceARN, err = resolveDynamoDBStreamRef(ctx, r.Client, lambdaFunction.Namespace, *src.DynamoDBTableRef)
+		}
+		if err != nil {
+			return err
+		}
+
+		if dest := src.OnFailure; dest != nil {
+			switch {
+			case dest.SQSQueueRef != nil:
+				mapping.OnFailureDestinationARN, err = resolveSQSQueueRef(ctx, r.Client, lambdaFunction.Namespace, *dest.SQSQueueRef)
+			case dest.SNSTopicRef != nil:
+				mapping.OnFailureDestinationARN, err = resolveSNSTopicRef(ctx, r.Client, lambdaFunction.Namespace, *dest.SNSTopicRef)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, p := range lambdaFunction.Spec.Permissions {
+		if p.SNSTopicRef != nil {
+			if function.Permissions[i].SourceARN, err = resolveSNSTopicRef(ctx, r.Client, lambdaFunction.Namespace, *p.SNSTopicRef); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // SetupWithManager sets up the controller with the Manager
 func (r *LambdaFunctionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("lambdafunction-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.LambdaFunction{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.LambdaFunction)
+		keys := refKeys("ConfigMap", optionalRefs(cr.Spec.Code.ConfigMapRef)...)
+		for _, src := range cr.Spec.EventSources {
+			keys = append(keys, refKeys("SQSQueue", optionalRefs(src.SQSQueueRef)...)...)
+			keys = append(keys, refKeys("DynamoDBTable", optionalRefs(src.DynamoDBTableRef)...)...)
+			if dest := src.OnFailure; dest != nil {
+				keys = append(keys, refKeys("SQSQueue", optionalRefs(dest.SQSQueueRef)...)...)
+				keys = append(keys, refKeys("SNSTopic", optionalRefs(dest.SNSTopicRef)...)...)
+			}
+		}
+		for _, p := range cr.Spec.Permissions {
+			keys = append(keys, refKeys("SNSTopic", optionalRefs(p.SNSTopicRef)...)...)
+		}
+		return keys
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.LambdaFunction{}).
-		Complete(r)
+		Watches(&corev1.ConfigMap{}, enqueueReferencing(mgr.GetClient(), "ConfigMap", &infrav1alpha1.LambdaFunctionList{})).
+		Watches(&infrav1alpha1.SQSQueue{}, enqueueReferencing(mgr.GetClient(), "SQSQueue", &infrav1alpha1.LambdaFunctionList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.DynamoDBTable{}, enqueueReferencing(mgr.GetClient(), "DynamoDBTable", &infrav1alpha1.LambdaFunctionList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.SNSTopic{}, enqueueReferencing(mgr.GetClient(), "SNSTopic", &infrav1alpha1.LambdaFunctionList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("LambdaFunction", mgr.GetClient(), &infrav1alpha1.LambdaFunction{}, r))
 }
@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const launchTemplateFinalizer = "aws-infra-operator.runner.codes/launchtemplate-finalizer"
+
+// LaunchTemplateReconciler reconciles a LaunchTemplate object
+type LaunchTemplateReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=launchtemplates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=launchtemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=launchtemplates/finalizers,verbs=update
+
+func (r *LaunchTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.LaunchTemplate{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetLaunchTemplateUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to get LaunchTemplate use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	lt := mapper.CRToDomainLaunchTemplate(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, launchTemplateFinalizer) {
+			// Fails while an Auto Scaling group still uses the template, so retry until it is removed
+			if err := useCase.DeleteLaunchTemplate(ctx, lt); err != nil {
+				logger.Error(err, "failed to delete launch template")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			controllerutil.RemoveFinalizer(cr, launchTemplateFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, launchTemplateFinalizer) {
+		controllerutil.AddFinalizer(cr, launchTemplateFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve references to other resources in the namespace
+	if len(cr.Spec.SecurityGroupRefs) > 0 {
+		groupIDs, err := resolveSecurityGroupRefs(ctx, r.Client, cr.Namespace, cr.Spec.SecurityGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		lt.Data.SecurityGroupIDs = groupIDs
+	}
+
+	previousVersion := cr.Status.LatestVersion
+	if err := useCase.SyncLaunchTemplate(ctx, lt); err != nil {
+		logger.Error(err, "failed to sync launch template")
+		cr.Status.Ready = false
+		if lt.ID != "" {
+			// Keep the ID of a template created before the failure
+			cr.Status.LaunchTemplateID = lt.ID
+		}
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if previousVersion != 0 && lt.LatestVersion != previousVersion {
+		r.Recorder.Eventf(cr, "Normal", "VersionCreated", "Created launch template version %d", lt.LatestVersion)
+	}
+
+	mapper.DomainToStatusLaunchTemplate(lt, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *LaunchTemplateReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("launchtemplate-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.LaunchTemplate{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.LaunchTemplate)
+		return refKeys("SecurityGroup", cr.Spec.SecurityGroupRefs...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.LaunchTemplate{}).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.LaunchTemplateList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("LaunchTemplate", mgr.GetClient(), &infrav1alpha1.LaunchTemplate{}, r))
+}
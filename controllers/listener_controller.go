@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/alb"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const listenerFinalizer = "aws-infra-operator.runner.codes/listener-finalizer"
+
+// ListenerReconciler reconciles a Listener object
+type ListenerReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=listeners,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=listeners/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=listeners/finalizers,verbs=update
+
+func (r *ListenerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.Listener{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetListenerUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to get Listener use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	listener := mapper.CRToDomainListener(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, listenerFinalizer) {
+			if err := useCase.DeleteListener(ctx, listener); err != nil {
+				logger.Error(err, "failed to delete listener")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			controllerutil.RemoveFinalizer(cr, listenerFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, listenerFinalizer) {
+		controllerutil.AddFinalizer(cr, listenerFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve references to other resources in the namespace
+	if err := r.resolveReferences(ctx, cr, listener); err != nil {
+		return waitForReference(ctx, r.Recorder, cr, err)
+	}
+
+	if err := useCase.SyncListener(ctx, listener); err != nil {
+		logger.Error(err, "failed to sync listener")
+		cr.Status.Ready = false
+		if listener.ARN != "" {
+			// Keep the ARN of a listener created before the failure
+			cr.Status.ListenerARN = listener.ARN
+		}
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	mapper.DomainToStatusListener(listener, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// resolveReferences fills in the load balancer, certificates and target groups
+// given by reference
+func (r *ListenerReconciler) resolveReferences(ctx context.Context, cr *infrav1alpha1.Listener, listener *alb.Listener) error {
+	var err error
+	switch {
+	case cr.Spec.ALBRef != nil:
+		listener.LoadBalancerARN, err = resolveALBRef(ctx, r.Client, cr.Namespace, *cr.Spec.ALBRef)
+	case cr.Spec.NLBRef != nil:
+		listener.LoadBalancerARN, err = resolveNLBRef(ctx, r.Client, cr.Namespace, *cr.Spec.NLBRef)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(cr.Spec.CertificateRefs) > 0 {
+		if listener.CertificateARNs, err = resolveCertificateRefs(ctx, r.Client, cr.Namespace, cr.Spec.CertificateRefs); err != nil {
+			return err
+		}
+	}
+
+	if err := r.resolveActionTargetGroups(ctx, cr.Namespace, cr.Spec.DefaultActions, listener.DefaultActions); err != nil {
+		return err
+	}
+	for i, rule := range cr.Spec.Rules {
+		if err := r.resolveActionTargetGroups(ctx, cr.Namespace, rule.Actions, listener.Rules[i].Actions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveActionTargetGroups sets the ARN of the target groups given by reference
+// in the forward actions
+func (r *ListenerReconciler) resolveActionTargetGroups(ctx context.Context, namespace string, actions []infrav1alpha1.ListenerAction, resolved []alb.Action) error {
+	for i, action := range actions {
+		for j, tg := range action.TargetGroups {
+			if tg.TargetGroupRef == nil {
+				continue
+			}
+			arn, err := resolveTargetGroupRef(ctx, r.Client, namespace, *tg.TargetGroupRef)
+			if err != nil {
+				return err
+			}
+			resolved[i].TargetGroups[j].ARN = arn
+		}
+	}
+	return nil
+}
+
+// listenerTargetGroupRefs returns the target groups referenced by the actions of a listener
+func listenerTargetGroupRefs(cr *infrav1alpha1.Listener) []infrav1alpha1.ResourceReference {
+	var refs []infrav1alpha1.ResourceReference
+	actions := append([]infrav1alpha1.ListenerAction{}, cr.Spec.DefaultActions...)
+	for _, rule := range cr.Spec.Rules {
+		actions = append(actions, rule.Actions...)
+	}
+	for _, action := range actions {
+		for _, tg := range action.TargetGroups {
+			refs = append(refs, optionalRefs(tg.TargetGroupRef)...)
+		}
+	}
+	return refs
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *ListenerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("listener-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.Listener{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.Listener)
+		keys := refKeys("ALB", optionalRefs(cr.Spec.ALBRef)...)
+		keys = append(keys, refKeys("NLB", optionalRefs(cr.Spec.NLBRef)...)...)
+		keys = append(keys, refKeys("Certificate", cr.Spec.CertificateRefs...)...)
+		return append(keys, refKeys("TargetGroup", listenerTargetGroupRefs(cr)...)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.Listener{}).
+		Watches(&infrav1alpha1.ALB{}, enqueueReferencing(mgr.GetClient(), "ALB", &infrav1alpha1.ListenerList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.NLB{}, enqueueReferencing(mgr.GetClient(), "NLB", &infrav1alpha1.ListenerList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.Certificate{}, enqueueReferencing(mgr.GetClient(), "Certificate", &infrav1alpha1.ListenerList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.TargetGroup{}, enqueueReferencing(mgr.GetClient(), "TargetGroup", &infrav1alpha1.ListenerList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("Listener", mgr.GetClient(), &infrav1alpha1.Listener{}, r))
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,6 +23,7 @@ type NATGatewayReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *NATGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -56,10 +61,70 @@ func (r *NATGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 
 	obj := mapper.CRToDomainNATGateway(cr)
-	if err := useCase.SyncGateway(ctx, obj); err != nil {
-		cr.Status.Ready = false
-		r.Status().Update(ctx, cr)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Bind to an existing AWS resource when the CR is adopted
+	if obj.NatGatewayID, err = adoptedID(ctx, r.Recorder, cr, obj.NatGatewayID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetNATGatewayRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resolve references to other resources in the namespace
+	if cr.Spec.SubnetRef != nil {
+		subnetID, err := resolveSubnetRef(ctx, r.Client, cr.Namespace, *cr.Spec.SubnetRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		obj.SubnetID = subnetID
+	}
+	if cr.Spec.AllocationRef != nil {
+		allocationID, err := resolveElasticIPRef(ctx, r.Client, cr.Namespace, *cr.Spec.AllocationRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		obj.AllocationID = allocationID
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, cr, driftCheck{
+		kind:        "NATGateway",
+		resourceID:  obj.NatGatewayID,
+		providerRef: cr.Spec.ProviderRef,
+		policy:      cr.Spec.DriftPolicy,
+		status:      &cr.Status.DriftStatus,
+		desired:     mapper.NATGatewayDriftState(obj),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetNATGatewayRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, obj.NatGatewayID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.NATGatewayDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncGateway(ctx, obj)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncGateway(ctx, obj); err != nil {
+			cr.Status.Ready = false
+			r.Status().Update(ctx, cr)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusNATGateway(obj, cr)
@@ -67,9 +132,22 @@ func (r *NATGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *NATGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&infrav1alpha1.NATGateway{}).Complete(r)
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("natgateway-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.NATGateway{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.NATGateway)
+		return append(refKeys("Subnet", optionalRefs(cr.Spec.SubnetRef)...), refKeys("ElasticIP", optionalRefs(cr.Spec.AllocationRef)...)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.NATGateway{}).
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.NATGatewayList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.ElasticIP{}, enqueueReferencing(mgr.GetClient(), "ElasticIP", &infrav1alpha1.NATGatewayList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("NATGateway", mgr.GetClient(), &infrav1alpha1.NATGateway{}, r))
 }
@@ -5,14 +5,18 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const nlbFinalizerName = "nlb.infra.operator.aws.io/finalizer"
@@ -22,6 +26,7 @@ type NLBReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=nlbs,verbs=get;list;watch;create;update;patch;delete
@@ -65,11 +70,53 @@ func (r *NLBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	}
 
 	lb := mapper.CRToDomainNLB(nlbCR)
-	if err := nlbUseCase.SyncLoadBalancer(ctx, lb); err != nil {
-		logger.Error(err, "failed to sync NLB")
-		nlbCR.Status.Ready = false
-		r.Status().Update(ctx, nlbCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Resolve references to other resources in the namespace
+	if len(nlbCR.Spec.SubnetRefs) > 0 {
+		subnetIDs, err := resolveSubnetRefs(ctx, r.Client, nlbCR.Namespace, nlbCR.Spec.SubnetRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, nlbCR, err)
+		}
+		lb.Subnets = subnetIDs
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, nlbCR, driftCheck{
+		kind:        "NLB",
+		resourceID:  nlbCR.Status.LoadBalancerARN,
+		providerRef: nlbCR.Spec.ProviderRef,
+		policy:      nlbCR.Spec.DriftPolicy,
+		status:      &nlbCR.Status.DriftStatus,
+		desired:     mapper.NLBDriftState(lb),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetNLBRepository(ctx, nlbCR.Spec.ProviderRef, nlbCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, lb.LoadBalancerName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.NLBDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return nlbUseCase.SyncLoadBalancer(ctx, lb)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, nlbCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := nlbUseCase.SyncLoadBalancer(ctx, lb); err != nil {
+			logger.Error(err, "failed to sync NLB")
+			nlbCR.Status.Ready = false
+			r.Status().Update(ctx, nlbCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusNLB(lb, nlbCR)
@@ -77,12 +124,22 @@ func (r *NLBReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager
 func (r *NLBReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("nlb-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.NLB{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.NLB)
+		return refKeys("Subnet", cr.Spec.SubnetRefs...)
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.NLB{}).
-		Complete(r)
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.NLBList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("NLB", mgr.GetClient(), &infrav1alpha1.NLB{}, r))
 }
@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const rdsClusterFinalizerName = "rdscluster.aws-infra-operator.runner.codes/finalizer"
+
+// RDSClusterReconciler reconciles a RDSCluster object
+type RDSClusterReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdsclusters,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdsclusters/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdsclusters/finalizers,verbs=update
+
+func (r *RDSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	cr := &infrav1alpha1.RDSCluster{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetRDSClusterUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, rdsClusterFinalizerName) {
+			cluster := mapper.CRToDomainRDSCluster(cr)
+			if err := useCase.DeleteCluster(ctx, cluster); err != nil {
+				logger.Error(err, "Failed to delete RDS cluster")
+				return ctrl.Result{}, err
+			}
+			controllerutil.RemoveFinalizer(cr, rdsClusterFinalizerName)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(cr, rdsClusterFinalizerName) {
+		controllerutil.AddFinalizer(cr, rdsClusterFinalizerName)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	cluster := mapper.CRToDomainRDSCluster(cr)
+
+	// Get password from secret if specified
+	if ref := cr.Spec.MasterUserPasswordSecretRef; ref != nil {
+		password, err := r.getSecretValue(ctx, cr.Namespace, ref)
+		if err != nil {
+			logger.Error(err, "Failed to get master password secret")
+			return ctrl.Result{}, err
+		}
+		cluster.MasterPassword = password
+	}
+
+	// Resolve references to other resources in the namespace
+	if len(cr.Spec.SecurityGroupRefs) > 0 {
+		groupIDs, err := resolveSecurityGroupRefs(ctx, r.Client, cr.Namespace, cr.Spec.SecurityGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		cluster.VpcSecurityGroupIDs = groupIDs
+	}
+	if cr.Spec.DBSubnetGroupRef != nil {
+		name, err := resolveDBSubnetGroupRef(ctx, r.Client, cr.Namespace, *cr.Spec.DBSubnetGroupRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		cluster.DBSubnetGroupName = name
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, cr, driftCheck{
+		kind:        "RDSCluster",
+		resourceID:  cr.Status.DBClusterArn,
+		providerRef: cr.Spec.ProviderRef,
+		policy:      cr.Spec.DriftPolicy,
+		status:      &cr.Status.DriftStatus,
+		desired:     mapper.RDSClusterDriftState(cluster),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetRDSClusterRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, cluster.DBClusterIdentifier)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RDSClusterDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncCluster(ctx, cluster)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncCluster(ctx, cluster); err != nil {
+			logger.Error(err, "Failed to sync RDS cluster")
+			cr.Status.Ready = false
+			cr.Status.Status = "error"
+			r.Status().Update(ctx, cr)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
+	}
+
+	mapper.DomainToStatusRDSCluster(cluster, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, cr, cr.Spec.WriteConnectionSecretToRef, rdsClusterConnectionDetails(cr, cluster.MasterPassword)); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
+	// The instances are only added once the cluster is available, so a cluster
+	// still converging is checked sooner
+	requeueAfter := 5 * time.Minute
+	if !cr.Status.Ready {
+		requeueAfter = 1 * time.Minute
+	}
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(requeueAfter)}, nil
+}
+
+func (r *RDSClusterReconciler) getSecretValue(ctx context.Context, namespace string, ref *infrav1alpha1.SecretReference) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: namespace,
+	}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", namespace, ref.Name, err)
+	}
+
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s/%s", ref.Key, namespace, ref.Name)
+	}
+
+	return string(value), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RDSClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("rdscluster-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.RDSCluster{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.RDSCluster)
+		keys := refKeys("SecurityGroup", cr.Spec.SecurityGroupRefs...)
+		return append(keys, refKeys("DBSubnetGroup", optionalRefs(cr.Spec.DBSubnetGroupRef)...)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.RDSCluster{}).
+		Owns(&corev1.Secret{}).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.RDSClusterList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.DBSubnetGroup{}, enqueueReferencing(mgr.GetClient(), "DBSubnetGroup", &infrav1alpha1.RDSClusterList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("RDSCluster", mgr.GetClient(), &infrav1alpha1.RDSCluster{}, r))
+}
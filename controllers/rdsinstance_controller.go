@@ -9,15 +9,20 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/rds"
 	"infra-operator/internal/ports"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const rdsFinalizerName = "aws-infra-operator.runner.codes/rds-finalizer"
@@ -27,6 +32,7 @@ type RDSInstanceReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdsinstances,verbs=get;list;watch;create;update;patch;delete
@@ -88,18 +94,76 @@ func (r *RDSInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	instance := mapper.CRToDomainRDSInstance(rdsInstance)
 	instance.MasterPassword = password
 
-	// Sync the DB instance
-	if err := rdsUseCase.SyncDBInstance(ctx, instance); err != nil {
-		logger.Error(err, "Failed to sync RDS instance")
+	// Resolve references to other resources in the namespace
+	if len(rdsInstance.Spec.SecurityGroupRefs) > 0 {
+		groupIDs, err := resolveSecurityGroupRefs(ctx, r.Client, rdsInstance.Namespace, rdsInstance.Spec.SecurityGroupRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, rdsInstance, err)
+		}
+		instance.VpcSecurityGroupIDs = groupIDs
+	}
+	if rdsInstance.Spec.DBSubnetGroupRef != nil {
+		name, err := resolveDBSubnetGroupRef(ctx, r.Client, rdsInstance.Namespace, *rdsInstance.Spec.DBSubnetGroupRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, rdsInstance, err)
+		}
+		instance.DBSubnetGroupName = name
+	}
+	if rdsInstance.Spec.DBParameterGroupRef != nil {
+		name, err := resolveDBParameterGroupRef(ctx, r.Client, rdsInstance.Namespace, *rdsInstance.Spec.DBParameterGroupRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, rdsInstance, err)
+		}
+		instance.DBParameterGroupName = name
+	}
+	if err := r.resolveSources(ctx, rdsInstance, instance); err != nil {
+		return waitForReference(ctx, r.Recorder, rdsInstance, err)
+	}
 
-		// Update status with error
-		rdsInstance.Status.Ready = false
-		rdsInstance.Status.Status = "error"
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, rdsInstance, driftCheck{
+		kind:        "RDSInstance",
+		resourceID:  rdsInstance.Status.DBInstanceArn,
+		providerRef: rdsInstance.Spec.ProviderRef,
+		policy:      rdsInstance.Spec.DriftPolicy,
+		status:      &rdsInstance.Status.DriftStatus,
+		desired:     mapper.RDSInstanceDriftState(instance),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetRDSRepository(ctx, rdsInstance.Spec.ProviderRef, rdsInstance.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, instance.DBInstanceIdentifier)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RDSInstanceDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return rdsUseCase.SyncDBInstance(ctx, instance)
+		},
+	})
+	if outcome.pending {
 		if err := r.Status().Update(ctx, rdsInstance); err != nil {
-			logger.Error(err, "Failed to update status")
+			return ctrl.Result{}, err
 		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Sync the DB instance
+		if err := rdsUseCase.SyncDBInstance(ctx, instance); err != nil {
+			logger.Error(err, "Failed to sync RDS instance")
 
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+			// Update status with error
+			rdsInstance.Status.Ready = false
+			rdsInstance.Status.Status = "error"
+			if err := r.Status().Update(ctx, rdsInstance); err != nil {
+				logger.Error(err, "Failed to update status")
+			}
+
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update CR status from domain model
@@ -111,8 +175,14 @@ func (r *RDSInstanceReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, rdsInstance, rdsInstance.Spec.WriteConnectionSecretToRef, rdsConnectionDetails(rdsInstance, password)); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	// Requeue to check status periodically
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *RDSInstanceReconciler) handleDeletion(ctx context.Context, rdsInstance *infrav1alpha1.RDSInstance, rdsUseCase ports.RDSUseCase) (ctrl.Result, error) {
@@ -138,6 +208,47 @@ func (r *RDSInstanceReconciler) handleDeletion(ctx context.Context, rdsInstance
 	return ctrl.Result{}, nil
 }
 
+// resolveSources resolves the references of the restore and replication sources.
+// They are only needed to create the instance: afterwards the restore source is
+// ignored and only whether the instance remains a replica matters.
+func (r *RDSInstanceReconciler) resolveSources(ctx context.Context, rdsInstance *infrav1alpha1.RDSInstance, instance *rds.DBInstance) error {
+	if rdsInstance.Status.DBInstanceArn != "" {
+		instance.RestoreFrom = nil
+		if src := rdsInstance.Spec.ReplicateFrom; src != nil && instance.ReplicateFrom == "" {
+			instance.ReplicateFrom = rdsInstance.Status.ReadReplicaSourceDBInstanceIdentifier
+			if instance.ReplicateFrom == "" {
+				instance.ReplicateFrom = src.SourceRef.Name
+			}
+		}
+		return nil
+	}
+
+	if src := rdsInstance.Spec.RestoreFrom; src != nil {
+		if src.SnapshotRef != nil {
+			id, err := resolveRDSSnapshotRef(ctx, r.Client, rdsInstance.Namespace, *src.SnapshotRef)
+			if err != nil {
+				return err
+			}
+			instance.RestoreFrom.DBSnapshotIdentifier = id
+		}
+		if src.SourceRef != nil {
+			id, err := resolveRDSInstanceRef(ctx, r.Client, rdsInstance.Namespace, *src.SourceRef)
+			if err != nil {
+				return err
+			}
+			instance.RestoreFrom.SourceDBInstanceIdentifier = id
+		}
+	}
+	if src := rdsInstance.Spec.ReplicateFrom; src != nil && src.SourceRef != nil {
+		id, err := resolveRDSInstanceRef(ctx, r.Client, rdsInstance.Namespace, *src.SourceRef)
+		if err != nil {
+			return err
+		}
+		instance.ReplicateFrom = id
+	}
+	return nil
+}
+
 // getSecret retrieves a Kubernetes Secret
 func (r *RDSInstanceReconciler) getSecret(ctx context.Context, namespace, name string) (*corev1.Secret, error) {
 	secret := &corev1.Secret{}
@@ -152,7 +263,32 @@ func (r *RDSInstanceReconciler) getSecret(ctx context.Context, namespace, name s
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RDSInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("rdsinstance-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.RDSInstance{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.RDSInstance)
+		keys := refKeys("SecurityGroup", cr.Spec.SecurityGroupRefs...)
+		keys = append(keys, refKeys("DBSubnetGroup", optionalRefs(cr.Spec.DBSubnetGroupRef)...)...)
+		keys = append(keys, refKeys("DBParameterGroup", optionalRefs(cr.Spec.DBParameterGroupRef)...)...)
+		if src := cr.Spec.RestoreFrom; src != nil {
+			keys = append(keys, refKeys("RDSSnapshot", optionalRefs(src.SnapshotRef)...)...)
+			keys = append(keys, refKeys("RDSInstance", optionalRefs(src.SourceRef)...)...)
+		}
+		if src := cr.Spec.ReplicateFrom; src != nil {
+			keys = append(keys, refKeys("RDSInstance", optionalRefs(src.SourceRef)...)...)
+		}
+		return keys
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.RDSInstance{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.RDSInstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.DBSubnetGroup{}, enqueueReferencing(mgr.GetClient(), "DBSubnetGroup", &infrav1alpha1.RDSInstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.DBParameterGroup{}, enqueueReferencing(mgr.GetClient(), "DBParameterGroup", &infrav1alpha1.RDSInstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.RDSInstance{}, enqueueReferencing(mgr.GetClient(), "RDSInstance", &infrav1alpha1.RDSInstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.RDSSnapshot{}, enqueueReferencing(mgr.GetClient(), "RDSSnapshot", &infrav1alpha1.RDSInstanceList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("RDSInstance", mgr.GetClient(), &infrav1alpha1.RDSInstance{}, r))
 }
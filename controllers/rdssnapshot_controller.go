@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/rds"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const rdsSnapshotFinalizerName = "rdssnapshot.aws-infra-operator.runner.codes/finalizer"
+
+// RDSSnapshotReconciler reconciles a RDSSnapshot object
+type RDSSnapshotReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdssnapshots,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdssnapshots/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=rdssnapshots/finalizers,verbs=update
+
+func (r *RDSSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	cr := &infrav1alpha1.RDSSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetRDSSnapshotUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "Failed to get use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, rdsSnapshotFinalizerName) {
+			plan := mapper.CRToDomainDBSnapshotPlan(cr)
+			// Nothing was snapshotted if the instance was never resolved
+			if plan.DBInstanceIdentifier != "" {
+				if err := useCase.DeleteSnapshots(ctx, plan); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			controllerutil.RemoveFinalizer(cr, rdsSnapshotFinalizerName)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(cr, rdsSnapshotFinalizerName) {
+		controllerutil.AddFinalizer(cr, rdsSnapshotFinalizerName)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	plan := mapper.CRToDomainDBSnapshotPlan(cr)
+
+	// Resolve references to other resources in the namespace
+	if cr.Spec.DBInstanceRef != nil {
+		id, err := resolveRDSInstanceRef(ctx, r.Client, cr.Namespace, *cr.Spec.DBInstanceRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		plan.DBInstanceIdentifier = id
+	}
+
+	if err := useCase.SyncSnapshots(ctx, plan); err != nil {
+		logger.Error(err, "Failed to sync RDS snapshots")
+		cr.Status.Ready = false
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	mapper.DomainToStatusDBSnapshotPlan(plan, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: snapshotRequeueAfter(plan)}, nil
+}
+
+// snapshotRequeueAfter checks a snapshot being created every minute and
+// otherwise wakes up for the next scheduled snapshot
+func snapshotRequeueAfter(plan *rds.DBSnapshotPlan) time.Duration {
+	if plan.IsCreating() {
+		return 1 * time.Minute
+	}
+	if plan.NextSnapshotTime != nil {
+		if wait := time.Until(*plan.NextSnapshotTime); wait < 5*time.Minute {
+			return max(wait, time.Second)
+		}
+	}
+	return 5 * time.Minute
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RDSSnapshotReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("rdssnapshot-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.RDSSnapshot{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.RDSSnapshot)
+		return refKeys("RDSInstance", optionalRefs(cr.Spec.DBInstanceRef)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.RDSSnapshot{}).
+		Watches(&infrav1alpha1.RDSInstance{}, enqueueReferencing(mgr.GetClient(), "RDSInstance", &infrav1alpha1.RDSSnapshotList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("RDSSnapshot", mgr.GetClient(), &infrav1alpha1.RDSSnapshot{}, r))
+}
@@ -0,0 +1,353 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+)
+
+// refIndexField indexes dependents by the "Kind/name" keys of the resources they reference.
+const refIndexField = ".spec.references"
+
+// refRequeueInterval is how long a dependent waits before checking a reference again.
+const refRequeueInterval = 15 * time.Second
+
+// refNotReadyError means a referenced resource does not exist yet or is not Ready.
+type refNotReadyError struct {
+	kind   string
+	name   string
+	reason string
+}
+
+func (e *refNotReadyError) Error() string {
+	return fmt.Sprintf("referenced %s %q %s", e.kind, e.name, e.reason)
+}
+
+// refKey builds the index key for a reference to kind/name.
+func refKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// refKeys builds the index keys for a list of references to the same kind.
+func refKeys(kind string, refs ...infrav1alpha1.ResourceReference) []string {
+	keys := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		keys = append(keys, refKey(kind, ref.Name))
+	}
+	return keys
+}
+
+// optionalRefs converts an optional single reference to a list.
+func optionalRefs(ref *infrav1alpha1.ResourceReference) []infrav1alpha1.ResourceReference {
+	if ref == nil {
+		return nil
+	}
+	return []infrav1alpha1.ResourceReference{*ref}
+}
+
+// resolveRef fetches the referenced object and returns its AWS ID once it is Ready.
+func resolveRef(ctx context.Context, c client.Client, namespace, kind string, ref infrav1alpha1.ResourceReference, obj client.Object, id func() (string, bool)) (string, error) {
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", &refNotReadyError{kind: kind, name: ref.Name, reason: "not found"}
+		}
+		return "", err
+	}
+
+	value, ready := id()
+	if !ready || value == "" {
+		return "", &refNotReadyError{kind: kind, name: ref.Name, reason: "is not Ready"}
+	}
+	return value, nil
+}
+
+// resolveVPCRef returns the VPC ID of the referenced VPC.
+func resolveVPCRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	vpc := &infrav1alpha1.VPC{}
+	return resolveRef(ctx, c, namespace, "VPC", ref, vpc, func() (string, bool) {
+		return vpc.Status.VpcID, vpc.Status.Ready
+	})
+}
+
+// resolveSubnetRef returns the subnet ID of the referenced Subnet.
+func resolveSubnetRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	subnet := &infrav1alpha1.Subnet{}
+	return resolveRef(ctx, c, namespace, "Subnet", ref, subnet, func() (string, bool) {
+		return subnet.Status.SubnetID, subnet.Status.Ready
+	})
+}
+
+// resolveSubnetRefs returns the subnet IDs of the referenced Subnets, in order.
+func resolveSubnetRefs(ctx context.Context, c client.Client, namespace string, refs []infrav1alpha1.ResourceReference) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		id, err := resolveSubnetRef(ctx, c, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveSecurityGroupRefs returns the group IDs of the referenced SecurityGroups, in order.
+func resolveSecurityGroupRefs(ctx context.Context, c client.Client, namespace string, refs []infrav1alpha1.ResourceReference) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		sg := &infrav1alpha1.SecurityGroup{}
+		id, err := resolveRef(ctx, c, namespace, "SecurityGroup", ref, sg, func() (string, bool) {
+			return sg.Status.GroupID, sg.Status.Ready
+		})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// resolveElasticIPRef returns the allocation ID of the referenced ElasticIP.
+func resolveElasticIPRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	eip := &infrav1alpha1.ElasticIP{}
+	return resolveRef(ctx, c, namespace, "ElasticIP", ref, eip, func() (string, bool) {
+		return eip.Status.AllocationID, eip.Status.Ready
+	})
+}
+
+// resolveDBSubnetGroupRef returns the name of the referenced DBSubnetGroup.
+func resolveDBSubnetGroupRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	group := &infrav1alpha1.DBSubnetGroup{}
+	return resolveRef(ctx, c, namespace, "DBSubnetGroup", ref, group, func() (string, bool) {
+		return group.Spec.DBSubnetGroupName, group.Status.Ready
+	})
+}
+
+// resolveDBParameterGroupRef returns the name of the referenced DBParameterGroup.
+func resolveDBParameterGroupRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	group := &infrav1alpha1.DBParameterGroup{}
+	return resolveRef(ctx, c, namespace, "DBParameterGroup", ref, group, func() (string, bool) {
+		return group.Spec.DBParameterGroupName, group.Status.Ready
+	})
+}
+
+// resolveRDSInstanceRef returns the DB instance identifier of the referenced RDSInstance.
+func resolveRDSInstanceRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	instance := &infrav1alpha1.RDSInstance{}
+	return resolveRef(ctx, c, namespace, "RDSInstance", ref, instance, func() (string, bool) {
+		return instance.Spec.DBInstanceIdentifier, instance.Status.Ready
+	})
+}
+
+// resolveRDSSnapshotRef returns the newest available snapshot of the referenced RDSSnapshot.
+func resolveRDSSnapshotRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	snapshot := &infrav1alpha1.RDSSnapshot{}
+	return resolveRef(ctx, c, namespace, "RDSSnapshot", ref, snapshot, func() (string, bool) {
+		return snapshot.Status.LatestSnapshotIdentifier, snapshot.Status.Ready
+	})
+}
+
+// resolveLambdaFunctionRef returns the referenced LambdaFunction once it is Ready.
+func resolveLambdaFunctionRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (*infrav1alpha1.LambdaFunction, error) {
+	function := &infrav1alpha1.LambdaFunction{}
+	if _, err := resolveRef(ctx, c, namespace, "LambdaFunction", ref, function, func() (string, bool) {
+		return function.Spec.FunctionName, function.Status.Ready
+	}); err != nil {
+		return nil, err
+	}
+	return function, nil
+}
+
+// resolveSQSQueueRef returns the ARN of the referenced SQSQueue.
+func resolveSQSQueueRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	queue := &infrav1alpha1.SQSQueue{}
+	return resolveRef(ctx, c, namespace, "SQSQueue", ref, queue, func() (string, bool) {
+		return queue.Status.QueueARN, queue.Status.Ready
+	})
+}
+
+// resolveSNSTopicRef returns the ARN of the referenced SNSTopic.
+func resolveSNSTopicRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	topic := &infrav1alpha1.SNSTopic{}
+	return resolveRef(ctx, c, namespace, "SNSTopic", ref, topic, func() (string, bool) {
+		return topic.Status.TopicArn, topic.Status.Ready
+	})
+}
+
+// resolveDynamoDBStreamRef returns the stream ARN of the referenced DynamoDBTable.
+func resolveDynamoDBStreamRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	table := &infrav1alpha1.DynamoDBTable{}
+	arn, err := resolveRef(ctx, c, namespace, "DynamoDBTable", ref, table, func() (string, bool) {
+		return table.Status.StreamARN, table.Status.Ready
+	})
+	if err != nil && table.Status.Ready && !table.Spec.StreamEnabled {
+		return "", &refNotReadyError{kind: "DynamoDBTable", name: ref.Name, reason: "has no stream (set spec.streamEnabled)"}
+	}
+	return arn, err
+}
+
+// resolveEC2InstanceRef returns the instance ID of the referenced EC2Instance.
+func resolveEC2InstanceRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	instance := &infrav1alpha1.EC2Instance{}
+	return resolveRef(ctx, c, namespace, "EC2Instance", ref, instance, func() (string, bool) {
+		return instance.Status.InstanceID, instance.Status.Ready
+	})
+}
+
+// resolveALBRef returns the ARN of the referenced ALB.
+func resolveALBRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	lb := &infrav1alpha1.ALB{}
+	return resolveRef(ctx, c, namespace, "ALB", ref, lb, func() (string, bool) {
+		return lb.Status.LoadBalancerARN, lb.Status.Ready
+	})
+}
+
+// resolveNLBRef returns the ARN of the referenced NLB.
+func resolveNLBRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	lb := &infrav1alpha1.NLB{}
+	return resolveRef(ctx, c, namespace, "NLB", ref, lb, func() (string, bool) {
+		return lb.Status.LoadBalancerARN, lb.Status.Ready
+	})
+}
+
+// resolveCertificateRefs returns the ARNs of the referenced Certificates, in order.
+func resolveCertificateRefs(ctx context.Context, c client.Client, namespace string, refs []infrav1alpha1.ResourceReference) ([]string, error) {
+	arns := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		cert := &infrav1alpha1.Certificate{}
+		arn, err := resolveRef(ctx, c, namespace, "Certificate", ref, cert, func() (string, bool) {
+			return cert.Status.CertificateARN, cert.Status.Ready
+		})
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, arn)
+	}
+	return arns, nil
+}
+
+// resolveTargetGroupRef returns the ARN of the referenced TargetGroup.
+func resolveTargetGroupRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (string, error) {
+	tg := &infrav1alpha1.TargetGroup{}
+	return resolveRef(ctx, c, namespace, "TargetGroup", ref, tg, func() (string, bool) {
+		return tg.Status.TargetGroupARN, tg.Status.Ready
+	})
+}
+
+// resolveTargetGroupRefs returns the ARNs of the referenced TargetGroups, in order.
+func resolveTargetGroupRefs(ctx context.Context, c client.Client, namespace string, refs []infrav1alpha1.ResourceReference) ([]string, error) {
+	arns := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		arn, err := resolveTargetGroupRef(ctx, c, namespace, ref)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, arn)
+	}
+	return arns, nil
+}
+
+// resolveLaunchTemplateRef returns the referenced LaunchTemplate once it is Ready.
+func resolveLaunchTemplateRef(ctx context.Context, c client.Client, namespace string, ref infrav1alpha1.ResourceReference) (*infrav1alpha1.LaunchTemplate, error) {
+	lt := &infrav1alpha1.LaunchTemplate{}
+	if _, err := resolveRef(ctx, c, namespace, "LaunchTemplate", ref, lt, func() (string, bool) {
+		return lt.Status.LaunchTemplateID, lt.Status.Ready
+	}); err != nil {
+		return nil, err
+	}
+	return lt, nil
+}
+
+// waitForReference requeues the dependent while a referenced resource is not Ready.
+// Any other error is returned so the reconcile is retried with backoff.
+func waitForReference(ctx context.Context, recorder record.EventRecorder, obj client.Object, err error) (ctrl.Result, error) {
+	notReady, ok := err.(*refNotReadyError)
+	if !ok {
+		return ctrl.Result{}, err
+	}
+
+	log.FromContext(ctx).Info("Waiting for referenced resource", "reason", notReady.Error())
+	recorder.Event(obj, "Normal", "WaitingForReference", notReady.Error())
+	return ctrl.Result{RequeueAfter: refRequeueInterval}, nil
+}
+
+// indexReferences registers the reference index for a dependent kind.
+func indexReferences(mgr ctrl.Manager, obj client.Object, keys func(client.Object) []string) error {
+	return mgr.GetFieldIndexer().IndexField(context.Background(), obj, refIndexField, keys)
+}
+
+// enqueueReferencing maps a referenced object to the dependents in its namespace that reference it.
+func enqueueReferencing(c client.Client, kind string, list client.ObjectList) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return referencingRequests(ctx, c, kind, list, obj.GetNamespace(), obj.GetName())
+	})
+}
+
+// referencingRequests lists the dependents in namespace that reference kind/name.
+func referencingRequests(ctx context.Context, c client.Client, kind string, list client.ObjectList, namespace, name string) []reconcile.Request {
+	dependents := list.DeepCopyObject().(client.ObjectList)
+	if err := c.List(ctx, dependents,
+		client.InNamespace(namespace),
+		client.MatchingFields{refIndexField: refKey(kind, name)},
+	); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list dependents", "kind", kind, "name", name)
+		return nil
+	}
+
+	items, err := meta.ExtractList(dependents)
+	if err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(items))
+	for _, item := range items {
+		if o, ok := item.(client.Object); ok {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: o.GetName(), Namespace: o.GetNamespace()},
+			})
+		}
+	}
+	return requests
+}
+
+// referenceBecameReady only lets through events where a referenced object
+// is created already Ready or its status.ready flips.
+var referenceBecameReady = predicate.Funcs{
+	CreateFunc: func(e event.CreateEvent) bool {
+		return isReady(e.Object)
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return isReady(e.ObjectOld) != isReady(e.ObjectNew)
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+// isReady reads status.ready from any of the operator's resources.
+func isReady(obj client.Object) bool {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false
+	}
+	ready, _, _ := unstructured.NestedBool(u, "status", "ready")
+	return ready
+}
@@ -18,6 +18,7 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awspkg "infra-operator/pkg/aws"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const route53HostedZoneFinalizer = "route53hostedzone.aws-infra-operator.runner.codes/finalizer"
@@ -223,7 +224,7 @@ func (r *Route53HostedZoneReconciler) handleDeletion(ctx context.Context, r53Cli
 
 	if controllerutil.ContainsFinalizer(hostedZone, route53HostedZoneFinalizer) {
 		// Check deletion policy
-		if hostedZone.Spec.DeletionPolicy != "Retain" && hostedZone.Status.HostedZoneID != "" {
+		if !infrav1alpha1.DeletionPolicyKeepsResource(hostedZone.Spec.DeletionPolicy) && hostedZone.Status.HostedZoneID != "" {
 			logger.Info("Deleting Route53 hosted zone", "hostedZoneID", hostedZone.Status.HostedZoneID)
 
 			deleteInput := &route53.DeleteHostedZoneInput{
@@ -254,5 +255,5 @@ func (r *Route53HostedZoneReconciler) handleDeletion(ctx context.Context, r53Cli
 func (r *Route53HostedZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.Route53HostedZone{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("Route53HostedZone", mgr.GetClient(), &infrav1alpha1.Route53HostedZone{}, r))
 }
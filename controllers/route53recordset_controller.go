@@ -18,6 +18,7 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awspkg "infra-operator/pkg/aws"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const route53RecordSetFinalizer = "route53recordset.aws-infra-operator.runner.codes/finalizer"
@@ -324,7 +325,7 @@ func (r *Route53RecordSetReconciler) handleDeletion(ctx context.Context, r53Clie
 
 	if controllerutil.ContainsFinalizer(recordSet, route53RecordSetFinalizer) {
 		// Check deletion policy
-		if recordSet.Spec.DeletionPolicy != "Retain" {
+		if !infrav1alpha1.DeletionPolicyKeepsResource(recordSet.Spec.DeletionPolicy) {
 			logger.Info("Deleting Route53 record set", "name", recordSet.Spec.Name, "type", recordSet.Spec.Type)
 
 			hostedZoneID := recordSet.Spec.HostedZoneID
@@ -377,5 +378,5 @@ func (r *Route53RecordSetReconciler) handleDeletion(ctx context.Context, r53Clie
 func (r *Route53RecordSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.Route53RecordSet{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("Route53RecordSet", mgr.GetClient(), &infrav1alpha1.Route53RecordSet{}, r))
 }
@@ -5,14 +5,18 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const routeTableFinalizerName = "routetable.aws-infra-operator.runner.codes/finalizer"
@@ -21,6 +25,7 @@ type RouteTableReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *RouteTableReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -64,11 +69,71 @@ func (r *RouteTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Sync route table
 	rt := mapper.CRToDomainRouteTable(rtCR)
-	if err := rtUseCase.SyncRouteTable(ctx, rt); err != nil {
-		logger.Error(err, "Failed to sync route table")
-		rtCR.Status.Ready = false
-		r.Status().Update(ctx, rtCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Bind to an existing AWS resource when the CR is adopted
+	if rt.RouteTableID, err = adoptedID(ctx, r.Recorder, rtCR, rt.RouteTableID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetRouteTableRepository(ctx, rtCR.Spec.ProviderRef, rtCR.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resolve references to other resources in the namespace
+	if rtCR.Spec.VpcRef != nil {
+		vpcID, err := resolveVPCRef(ctx, r.Client, rtCR.Namespace, *rtCR.Spec.VpcRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, rtCR, err)
+		}
+		rt.VpcID = vpcID
+	}
+	if len(rtCR.Spec.SubnetRefs) > 0 {
+		subnetIDs, err := resolveSubnetRefs(ctx, r.Client, rtCR.Namespace, rtCR.Spec.SubnetRefs)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, rtCR, err)
+		}
+		rt.SubnetAssociations = subnetIDs
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, rtCR, driftCheck{
+		kind:        "RouteTable",
+		resourceID:  rt.RouteTableID,
+		providerRef: rtCR.Spec.ProviderRef,
+		policy:      rtCR.Spec.DriftPolicy,
+		status:      &rtCR.Status.DriftStatus,
+		desired:     mapper.RouteTableDriftState(rt),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetRouteTableRepository(ctx, rtCR.Spec.ProviderRef, rtCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, rt.RouteTableID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.RouteTableDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return rtUseCase.SyncRouteTable(ctx, rt)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, rtCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := rtUseCase.SyncRouteTable(ctx, rt); err != nil {
+			logger.Error(err, "Failed to sync route table")
+			rtCR.Status.Ready = false
+			r.Status().Update(ctx, rtCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
@@ -77,11 +142,22 @@ func (r *RouteTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *RouteTableReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("routetable-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.RouteTable{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.RouteTable)
+		return append(refKeys("VPC", optionalRefs(cr.Spec.VpcRef)...), refKeys("Subnet", cr.Spec.SubnetRefs...)...)
+	}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.RouteTable{}).
-		Complete(r)
+		Watches(&infrav1alpha1.VPC{}, enqueueReferencing(mgr.GetClient(), "VPC", &infrav1alpha1.RouteTableList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.Subnet{}, enqueueReferencing(mgr.GetClient(), "Subnet", &infrav1alpha1.RouteTableList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("RouteTable", mgr.GetClient(), &infrav1alpha1.RouteTable{}, r))
 }
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -11,13 +12,19 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	s3adapter "infra-operator/internal/adapters/aws/s3"
+	s3domain "infra-operator/internal/domain/s3"
+	s3usecase "infra-operator/internal/usecases/s3"
 	awspkg "infra-operator/pkg/aws"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const s3BucketFinalizer = "aws-infra-operator.runner.codes/s3bucket-finalizer"
@@ -25,7 +32,8 @@ const s3BucketFinalizer = "aws-infra-operator.runner.codes/s3bucket-finalizer"
 // S3BucketReconciler reconciles a S3Bucket object
 type S3BucketReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=s3buckets,verbs=get;list;watch;create;update;patch;delete
@@ -56,9 +64,17 @@ func (r *S3BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	// Handle deletion
 	if !bucket.ObjectMeta.DeletionTimestamp.IsZero() {
 		if controllerutil.ContainsFinalizer(bucket, s3BucketFinalizer) {
-			if err := r.deleteBucket(ctx, s3Client, bucket); err != nil {
+			if err := r.deleteBucket(ctx, awsConfig, provider, bucket); err != nil {
+				if stderrors.Is(err, s3domain.ErrBucketEmptying) {
+					// Resume emptying the bucket on the next reconcile
+					logger.Info("Emptying S3 bucket before deletion", "objectsDeleted", bucket.Status.ObjectsDeleted)
+					if _, statusErr := r.updateStatus(ctx, bucket, false, err.Error()); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+					return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+				}
 				logger.Error(err, "Failed to delete S3 bucket")
-				return ctrl.Result{}, err
+				return r.updateStatus(ctx, bucket, false, fmt.Sprintf("Delete failed: %v", err))
 			}
 
 			controllerutil.RemoveFinalizer(bucket, s3BucketFinalizer)
@@ -77,6 +93,13 @@ func (r *S3BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	// Bind to an existing bucket when the CR is adopted
+	if err := adoptedName(ctx, r.Recorder, bucket, bucket.Spec.BucketName, bucket.Status.ARN != "", func(ctx context.Context, name string) (bool, error) {
+		return r.bucketExists(ctx, s3Client, name)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Check if bucket exists
 	exists, err := r.bucketExists(ctx, s3Client, bucket.Spec.BucketName)
 	if err != nil {
@@ -239,19 +262,15 @@ func (r *S3BucketReconciler) configureBucket(ctx context.Context, s3Client *s3.C
 	return nil
 }
 
-func (r *S3BucketReconciler) deleteBucket(ctx context.Context, s3Client *s3.Client, bucket *infrav1alpha1.S3Bucket) error {
-	// Check deletion policy
-	if bucket.Spec.DeletionPolicy == "Retain" || bucket.Spec.DeletionPolicy == "Orphan" {
-		return nil // Don't delete the bucket
-	}
+// deleteBucket deletes the bucket through the S3 use case, which applies the
+// deletion policy and empties the bucket first when forceDestroy is set
+func (r *S3BucketReconciler) deleteBucket(ctx context.Context, awsConfig aws.Config, provider *infrav1alpha1.AWSProvider, bucket *infrav1alpha1.S3Bucket) error {
+	domainBucket := mapper.CRToDomainBucket(bucket)
+	mapper.SetBucketRegionFromProvider(domainBucket, provider)
 
-	// Empty the bucket first
-	// Note: In production, you'd want to list and delete all objects first
-	// This is a simplified version
-
-	_, err := s3Client.DeleteBucket(ctx, &s3.DeleteBucketInput{
-		Bucket: aws.String(bucket.Spec.BucketName),
-	})
+	useCase := s3usecase.NewBucketUseCase(s3adapter.NewRepository(awsConfig))
+	err := useCase.DeleteBucket(ctx, domainBucket)
+	bucket.Status.ObjectsDeleted = domainBucket.ObjectsDeleted
 	return err
 }
 
@@ -288,7 +307,10 @@ func (r *S3BucketReconciler) updateStatus(ctx context.Context, bucket *infrav1al
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *S3BucketReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("s3bucket-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.S3Bucket{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("S3Bucket", mgr.GetClient(), &infrav1alpha1.S3Bucket{}, r))
 }
@@ -2,12 +2,15 @@ package controllers
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -15,9 +18,12 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsadapter "infra-operator/internal/adapters/aws/s3"
+	"infra-operator/internal/domain/s3"
 	s3usecase "infra-operator/internal/usecases/s3"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const s3BucketFinalizerClean = "aws-infra-operator.runner.codes/s3bucket-finalizer-clean"
@@ -27,6 +33,7 @@ type S3BucketReconcilerClean struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=s3buckets,verbs=get;list;watch;create;update;patch;delete
@@ -73,8 +80,17 @@ func (r *S3BucketReconcilerClean) Reconcile(ctx context.Context, req ctrl.Reques
 		if controllerutil.ContainsFinalizer(bucketCR, s3BucketFinalizerClean) {
 			// Execute deletion through use case
 			if err := s3UseCase.DeleteBucket(ctx, domainBucket); err != nil {
+				bucketCR.Status.ObjectsDeleted = domainBucket.ObjectsDeleted
+				if stderrors.Is(err, s3.ErrBucketEmptying) {
+					// Resume emptying the bucket on the next reconcile
+					logger.Info("Emptying S3 bucket before deletion", "objectsDeleted", domainBucket.ObjectsDeleted)
+					if _, statusErr := r.updateStatus(ctx, bucketCR, false, err.Error()); statusErr != nil {
+						return ctrl.Result{}, statusErr
+					}
+					return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+				}
 				logger.Error(err, "Failed to delete S3 bucket")
-				return ctrl.Result{}, err
+				return r.updateStatus(ctx, bucketCR, false, fmt.Sprintf("Delete failed: %v", err))
 			}
 
 			// Remove finalizer
@@ -94,10 +110,45 @@ func (r *S3BucketReconcilerClean) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// 8. Execute business logic through use case (idempotent)
-	if err := s3UseCase.SyncBucket(ctx, domainBucket); err != nil {
-		logger.Error(err, "Failed to sync S3 bucket")
-		return r.updateStatus(ctx, bucketCR, false, fmt.Sprintf("Sync failed: %v", err))
+	// Bind to an existing bucket when the CR is adopted
+	if err := adoptedName(ctx, r.Recorder, bucketCR, domainBucket.Name, bucketCR.Status.ARN != "", func(ctx context.Context, name string) (bool, error) {
+		return s3Repo.Exists(ctx, name, domainBucket.Region)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, bucketCR, driftCheck{
+		kind:        "S3Bucket",
+		resourceID:  bucketCR.Status.ARN,
+		providerRef: bucketCR.Spec.ProviderRef,
+		policy:      bucketCR.Spec.DriftPolicy,
+		status:      &bucketCR.Status.DriftStatus,
+		desired:     mapper.S3BucketDriftState(domainBucket),
+		actual: func(ctx context.Context) (drift.State, error) {
+			current, err := s3Repo.Get(ctx, domainBucket.Name, domainBucket.Region)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.S3BucketDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return s3UseCase.SyncBucket(ctx, domainBucket)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, bucketCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// 8. Execute business logic through use case (idempotent)
+		if err := s3UseCase.SyncBucket(ctx, domainBucket); err != nil {
+			logger.Error(err, "Failed to sync S3 bucket")
+			return r.updateStatus(ctx, bucketCR, false, fmt.Sprintf("Sync failed: %v", err))
+		}
 	}
 
 	// 9. Update CR status from domain model
@@ -117,12 +168,18 @@ func (r *S3BucketReconcilerClean) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, bucketCR, bucketCR.Spec.WriteConnectionSecretToRef, s3BucketConnectionDetails(bucketCR)); err != nil {
+		logger.Error(err, "Failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("Successfully reconciled S3Bucket",
 		"bucket", domainBucket.Name,
 		"region", domainBucket.Region)
 
 	// Requeue after 5 minutes for drift detection
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 // updateStatus updates the CR status
@@ -159,7 +216,11 @@ func (r *S3BucketReconcilerClean) updateStatus(ctx context.Context, bucketCR *in
 
 // SetupWithManager sets up the controller with the Manager
 func (r *S3BucketReconcilerClean) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("s3bucket-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.S3Bucket{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("S3Bucket", mgr.GetClient(), &infrav1alpha1.S3Bucket{}, r))
 }
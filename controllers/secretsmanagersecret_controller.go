@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -15,7 +16,9 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const secretsManagerSecretFinalizerName = "secretsmanagersecret.aws-infra-operator.runner.codes/finalizer"
@@ -24,6 +27,7 @@ type SecretsManagerSecretReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *awsclients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=secretsmanagersecrets,verbs=get;list;watch;create;update;patch;delete
@@ -83,11 +87,44 @@ func (r *SecretsManagerSecretReconciler) Reconcile(ctx context.Context, req ctrl
 	}
 
 	secret := mapper.CRToDomainSecretsManagerSecret(smSecret, secretValue)
-	if err := smUseCase.SyncSecret(ctx, secret); err != nil {
-		logger.Error(err, "Failed to sync secret")
-		smSecret.Status.Ready = false
-		r.Status().Update(ctx, smSecret)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, smSecret, driftCheck{
+		kind:        "SecretsManagerSecret",
+		resourceID:  smSecret.Status.ARN,
+		providerRef: smSecret.Spec.ProviderRef,
+		policy:      smSecret.Spec.DriftPolicy,
+		status:      &smSecret.Status.DriftStatus,
+		desired:     mapper.SecretsManagerSecretDriftState(secret),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetSecretsManagerRepository(ctx, smSecret.Spec.ProviderRef, smSecret.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, secret.SecretName)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.SecretsManagerSecretDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return smUseCase.SyncSecret(ctx, secret)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, smSecret); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := smUseCase.SyncSecret(ctx, secret); err != nil {
+			logger.Error(err, "Failed to sync secret")
+			smSecret.Status.Ready = false
+			r.Status().Update(ctx, smSecret)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusSecretsManagerSecret(secret, smSecret)
@@ -97,7 +134,7 @@ func (r *SecretsManagerSecretReconciler) Reconcile(ctx context.Context, req ctrl
 	}
 
 	logger.Info("Successfully reconciled SecretsManagerSecret", "secretName", smSecret.Spec.SecretName, "arn", smSecret.Status.ARN)
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *SecretsManagerSecretReconciler) getSecretValue(ctx context.Context, smSecret *infrav1alpha1.SecretsManagerSecret) (string, error) {
@@ -122,7 +159,10 @@ func (r *SecretsManagerSecretReconciler) getSecretValue(ctx context.Context, smS
 }
 
 func (r *SecretsManagerSecretReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("secretsmanagersecret-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.SecretsManagerSecret{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("SecretsManagerSecret", mgr.GetClient(), &infrav1alpha1.SecretsManagerSecret{}, r))
 }
@@ -3,10 +3,15 @@ package controllers
 import (
 	"context"
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/securitygroup"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,6 +24,7 @@ type SecurityGroupReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *SecurityGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -59,21 +65,164 @@ func (r *SecurityGroupReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	// Sync security group
 	sg := mapper.CRToDomainSecurityGroup(sgCR)
-	if err := sgUseCase.SyncSecurityGroup(ctx, sg); err != nil {
-		sgCR.Status.Ready = false
-		r.Status().Update(ctx, sgCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Bind to an existing AWS resource when the CR is adopted
+	if sg.GroupID, err = adoptedID(ctx, r.Recorder, sgCR, sg.GroupID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetSecurityGroupRepository(ctx, sgCR.Spec.ProviderRef, sgCR.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resolve references to other resources in the namespace
+	if sgCR.Spec.VpcRef != nil {
+		vpcID, err := resolveVPCRef(ctx, r.Client, sgCR.Namespace, *sgCR.Spec.VpcRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, sgCR, err)
+		}
+		sg.VpcID = vpcID
+	}
+	pending, err := r.resolveRuleSources(ctx, sgCR, sg)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// Keep the rules of a source being resolved instead of revoking them
+	sg.RulesPending = pending != nil
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, sgCR, driftCheck{
+		kind:        "SecurityGroup",
+		resourceID:  sg.GroupID,
+		providerRef: sgCR.Spec.ProviderRef,
+		policy:      sgCR.Spec.DriftPolicy,
+		status:      &sgCR.Status.DriftStatus,
+		desired:     mapper.SecurityGroupDriftState(sg),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetSecurityGroupRepository(ctx, sgCR.Spec.ProviderRef, sgCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, sg.GroupID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.SecurityGroupDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return sgUseCase.SyncSecurityGroup(ctx, sg)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, sgCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := sgUseCase.SyncSecurityGroup(ctx, sg); err != nil {
+			sgCR.Status.Ready = false
+			if sg.GroupID != "" {
+				// Keep the ID of a group created before the failure
+				sgCR.Status.GroupID = sg.GroupID
+			}
+			r.Status().Update(ctx, sgCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	// Update status
 	mapper.DomainToStatusSecurityGroup(sg, sgCR)
+	if pending != nil {
+		// Not Ready until the rules of every referenced group are applied
+		sgCR.Status.Ready = false
+	}
 	if err := r.Status().Update(ctx, sgCR); err != nil {
 		return ctrl.Result{}, err
 	}
+	if pending != nil {
+		return waitForReference(ctx, r.Recorder, sgCR, pending)
+	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+}
+
+// resolveRuleSources sets the group ID of each sourceSecurityGroupRef on the
+// rules. A reference to this SecurityGroup is resolved by the use case once
+// the group exists. Referenced groups only need a group ID, not to be Ready,
+// so groups that reference each other can both be created: until a referenced
+// group exists its source is left out and returned as the pending reference,
+// and no rules are revoked.
+func (r *SecurityGroupReconciler) resolveRuleSources(ctx context.Context, sgCR *infrav1alpha1.SecurityGroup, sg *securitygroup.SecurityGroup) (*refNotReadyError, error) {
+	var pending *refNotReadyError
+	resolve := func(crRules []infrav1alpha1.SecurityGroupRule, rules []securitygroup.Rule) ([]securitygroup.Rule, error) {
+		resolved := make([]securitygroup.Rule, 0, len(rules))
+		for i, rule := range rules {
+			ref := crRules[i].SourceSecurityGroupRef
+			switch {
+			case ref == nil:
+			case ref.Name == sgCR.Name:
+				rule.Self = true
+				rule.SourceSecurityGroupID = sg.GroupID
+			default:
+				source := &infrav1alpha1.SecurityGroup{}
+				id, err := resolveRef(ctx, r.Client, sgCR.Namespace, "SecurityGroup", *ref, source, func() (string, bool) {
+					return source.Status.GroupID, true
+				})
+				if notReady, ok := err.(*refNotReadyError); ok {
+					pending = notReady
+					if len(rule.CidrBlocks) == 0 && len(rule.Ipv6CidrBlocks) == 0 && len(rule.PrefixListIDs) == 0 {
+						continue
+					}
+				} else if err != nil {
+					return nil, err
+				}
+				rule.SourceSecurityGroupID = id
+			}
+			resolved = append(resolved, rule)
+		}
+		return resolved, nil
+	}
+
+	var err error
+	if sg.IngressRules, err = resolve(sgCR.Spec.IngressRules, sg.IngressRules); err != nil {
+		return nil, err
+	}
+	if sg.EgressRules, err = resolve(sgCR.Spec.EgressRules, sg.EgressRules); err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// ruleRefs returns the SecurityGroups referenced by the rules of a SecurityGroup
+func ruleRefs(cr *infrav1alpha1.SecurityGroup) []infrav1alpha1.ResourceReference {
+	var refs []infrav1alpha1.ResourceReference
+	for _, rules := range [][]infrav1alpha1.SecurityGroupRule{cr.Spec.IngressRules, cr.Spec.EgressRules} {
+		for _, rule := range rules {
+			if rule.SourceSecurityGroupRef != nil && rule.SourceSecurityGroupRef.Name != cr.Name {
+				refs = append(refs, *rule.SourceSecurityGroupRef)
+			}
+		}
+	}
+	return refs
 }
 
 func (r *SecurityGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&infrav1alpha1.SecurityGroup{}).Complete(r)
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("securitygroup-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.SecurityGroup{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.SecurityGroup)
+		return append(refKeys("VPC", optionalRefs(cr.Spec.VpcRef)...), refKeys("SecurityGroup", ruleRefs(cr)...)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.SecurityGroup{}).
+		Watches(&infrav1alpha1.VPC{}, enqueueReferencing(mgr.GetClient(), "VPC", &infrav1alpha1.SecurityGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.SecurityGroup{}, enqueueReferencing(mgr.GetClient(), "SecurityGroup", &infrav1alpha1.SecurityGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("SecurityGroup", mgr.GetClient(), &infrav1alpha1.SecurityGroup{}, r))
 }
@@ -23,6 +23,7 @@ import (
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	awsclients "infra-operator/pkg/clients"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const setupEKSFinalizerName = "setupeks.aws-infra-operator.runner.codes/finalizer"
@@ -1769,8 +1770,8 @@ func (r *SetupEKSReconciler) checkAddonsReady(ctx context.Context, eksClient *ek
 func (r *SetupEKSReconciler) deleteSetupAsync(ctx context.Context, ec2Client *ec2.Client, eksClient *eks.Client, iamClient *iam.Client, elbv2Client *elasticloadbalancingv2.Client, setup *infrav1alpha1.SetupEKS) (bool, error) {
 	logger := log.FromContext(ctx)
 
-	if setup.Spec.DeletionPolicy == "Retain" {
-		logger.Info("DeletionPolicy is Retain, keeping resources in AWS")
+	if infrav1alpha1.DeletionPolicyKeepsResource(setup.Spec.DeletionPolicy) {
+		logger.Info("DeletionPolicy keeps resources in AWS", "policy", setup.Spec.DeletionPolicy)
 		return true, nil
 	}
 
@@ -2252,5 +2253,5 @@ func (r *SetupEKSReconciler) calculateSubnetCIDRs(vpcCIDR string, count int, sta
 func (r *SetupEKSReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.SetupEKS{}).
-		Complete(r)
+		Complete(inframetrics.InstrumentReconciler("SetupEKS", mgr.GetClient(), &infrav1alpha1.SetupEKS{}, r))
 }
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -18,7 +20,9 @@ import (
 	"infra-operator/internal/ports"
 	snsusecase "infra-operator/internal/usecases/sns"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const snsTopicFinalizer = "aws-infra-operator.runner.codes/snstopic-finalizer"
@@ -28,6 +32,7 @@ type SNSTopicReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=snstopics,verbs=get;list;watch;create;update;patch;delete
@@ -80,24 +85,60 @@ func (r *SNSTopicReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
-	// Execute business logic through use case
-	if err := snsUseCase.SyncTopic(ctx, topic); err != nil {
-		logger.Error(err, "failed to sync topic")
-		snsTopic.Status.Ready = false
-		if statusErr := r.Status().Update(ctx, &snsTopic); statusErr != nil {
-			logger.Error(statusErr, "failed to update status")
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, &snsTopic, driftCheck{
+		kind:        "SNSTopic",
+		resourceID:  snsTopic.Status.TopicArn,
+		providerRef: snsTopic.Spec.ProviderRef,
+		policy:      snsTopic.Spec.DriftPolicy,
+		status:      &snsTopic.Status.DriftStatus,
+		desired:     mapper.SNSTopicDriftState(topic),
+		actual: func(ctx context.Context) (drift.State, error) {
+			current, err := snsRepo.Get(ctx, snsTopic.Status.TopicArn)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.SNSTopicDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return snsUseCase.SyncTopic(ctx, topic)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, &snsTopic); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Execute business logic through use case
+		if err := snsUseCase.SyncTopic(ctx, topic); err != nil {
+			logger.Error(err, "failed to sync topic")
+			snsTopic.Status.Ready = false
+			if statusErr := r.Status().Update(ctx, &snsTopic); statusErr != nil {
+				logger.Error(statusErr, "failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update CR status with domain model state
+	driftStatus := snsTopic.Status.DriftStatus
 	snsTopic.Status = mapper.DomainTopicToStatus(topic)
+	snsTopic.Status.DriftStatus = driftStatus
 
 	if err := r.Status().Update(ctx, &snsTopic); err != nil {
 		logger.Error(err, "failed to update status")
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, &snsTopic, snsTopic.Spec.WriteConnectionSecretToRef, map[string]string{infrav1alpha1.ConnectionSecretKeyARN: snsTopic.Status.TopicArn}); err != nil {
+		logger.Error(err, "failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("successfully reconciled SNSTopic",
 		"topicName", topic.Name,
 		"topicARN", topic.ARN,
@@ -105,7 +146,7 @@ func (r *SNSTopicReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		"subscriptionsPending", topic.SubscriptionsPending)
 
 	// Requeue after 5 minutes for periodic sync
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *SNSTopicReconciler) handleDeletion(ctx context.Context, snsTopic *infrav1alpha1.SNSTopic, topic *sns.Topic, useCase ports.SNSUseCase) (ctrl.Result, error) {
@@ -128,7 +169,7 @@ func (r *SNSTopicReconciler) handleDeletion(ctx context.Context, snsTopic *infra
 			logger.Error(err, "failed to delete topic")
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
-	case "Retain":
+	case "Retain", "Orphan":
 		logger.Info("retaining topic in AWS", "topicName", topic.Name)
 	default:
 		logger.Info(fmt.Sprintf("unknown deletion policy %s, retaining topic", deletionPolicy))
@@ -146,7 +187,11 @@ func (r *SNSTopicReconciler) handleDeletion(ctx context.Context, snsTopic *infra
 
 // SetupWithManager sets up the controller with the Manager
 func (r *SNSTopicReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("snstopic-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.SNSTopic{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("SNSTopic", mgr.GetClient(), &infrav1alpha1.SNSTopic{}, r))
 }
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -18,7 +20,9 @@ import (
 	"infra-operator/internal/ports"
 	sqsusecase "infra-operator/internal/usecases/sqs"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 const sqsQueueFinalizer = "aws-infra-operator.runner.codes/sqsqueue-finalizer"
@@ -28,6 +32,7 @@ type SQSQueueReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=sqsqueues,verbs=get;list;watch;create;update;patch;delete
@@ -80,24 +85,60 @@ func (r *SQSQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
-	// Execute business logic through use case
-	if err := sqsUseCase.SyncQueue(ctx, queue); err != nil {
-		logger.Error(err, "failed to sync queue")
-		sqsQueue.Status.Ready = false
-		if statusErr := r.Status().Update(ctx, &sqsQueue); statusErr != nil {
-			logger.Error(statusErr, "failed to update status")
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, &sqsQueue, driftCheck{
+		kind:        "SQSQueue",
+		resourceID:  sqsQueue.Status.QueueURL,
+		providerRef: sqsQueue.Spec.ProviderRef,
+		policy:      sqsQueue.Spec.DriftPolicy,
+		status:      &sqsQueue.Status.DriftStatus,
+		desired:     mapper.SQSQueueDriftState(queue),
+		actual: func(ctx context.Context) (drift.State, error) {
+			current, err := sqsRepo.Get(ctx, sqsQueue.Status.QueueURL)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.SQSQueueDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return sqsUseCase.SyncQueue(ctx, queue)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, &sqsQueue); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		// Execute business logic through use case
+		if err := sqsUseCase.SyncQueue(ctx, queue); err != nil {
+			logger.Error(err, "failed to sync queue")
+			sqsQueue.Status.Ready = false
+			if statusErr := r.Status().Update(ctx, &sqsQueue); statusErr != nil {
+				logger.Error(statusErr, "failed to update status")
+			}
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 		}
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
 	}
 
 	// Update CR status with domain model state
+	driftStatus := sqsQueue.Status.DriftStatus
 	sqsQueue.Status = mapper.DomainQueueToStatus(queue)
+	sqsQueue.Status.DriftStatus = driftStatus
 
 	if err := r.Status().Update(ctx, &sqsQueue); err != nil {
 		logger.Error(err, "failed to update status")
 		return ctrl.Result{}, err
 	}
 
+	// Publish the connection details for applications
+	if err := writeConnectionSecret(ctx, r.Client, r.Scheme, &sqsQueue, sqsQueue.Spec.WriteConnectionSecretToRef, endpointConnectionDetails(sqsQueue.Status.QueueURL, sqsQueue.Status.QueueARN)); err != nil {
+		logger.Error(err, "failed to write connection secret")
+		return ctrl.Result{}, err
+	}
+
 	logger.Info("successfully reconciled SQSQueue",
 		"queueName", queue.Name,
 		"queueURL", queue.URL,
@@ -105,7 +146,7 @@ func (r *SQSQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		"messages", queue.ApproximateNumberOfMessages)
 
 	// Requeue after 5 minutes for periodic sync
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *SQSQueueReconciler) handleDeletion(ctx context.Context, sqsQueue *infrav1alpha1.SQSQueue, queue *sqs.Queue, useCase ports.SQSUseCase) (ctrl.Result, error) {
@@ -128,7 +169,7 @@ func (r *SQSQueueReconciler) handleDeletion(ctx context.Context, sqsQueue *infra
 			logger.Error(err, "failed to delete queue")
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
-	case "Retain":
+	case "Retain", "Orphan":
 		logger.Info("retaining queue in AWS", "queueName", queue.Name)
 	default:
 		logger.Info(fmt.Sprintf("unknown deletion policy %s, retaining queue", deletionPolicy))
@@ -146,7 +187,11 @@ func (r *SQSQueueReconciler) handleDeletion(ctx context.Context, sqsQueue *infra
 
 // SetupWithManager sets up the controller with the Manager
 func (r *SQSQueueReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("sqsqueue-controller")
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1alpha1.SQSQueue{}).
-		Complete(r)
+		Owns(&corev1.Secret{}).
+		Complete(inframetrics.InstrumentReconciler("SQSQueue", mgr.GetClient(), &infrav1alpha1.SQSQueue{}, r))
 }
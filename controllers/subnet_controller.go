@@ -4,9 +4,13 @@ import (
 	"context"
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -19,6 +23,7 @@ type SubnetReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -56,10 +61,63 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 
 	obj := mapper.CRToDomainSubnet(cr)
-	if err := useCase.SyncSubnet(ctx, obj); err != nil {
-		cr.Status.Ready = false
-		r.Status().Update(ctx, cr)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Bind to an existing AWS resource when the CR is adopted
+	if obj.SubnetID, err = adoptedID(ctx, r.Recorder, cr, obj.SubnetID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetSubnetRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Resolve references to other resources in the namespace
+	if cr.Spec.VpcRef != nil {
+		vpcID, err := resolveVPCRef(ctx, r.Client, cr.Namespace, *cr.Spec.VpcRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		obj.VpcID = vpcID
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, cr, driftCheck{
+		kind:        "Subnet",
+		resourceID:  obj.SubnetID,
+		providerRef: cr.Spec.ProviderRef,
+		policy:      cr.Spec.DriftPolicy,
+		status:      &cr.Status.DriftStatus,
+		desired:     mapper.SubnetDriftState(obj),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetSubnetRepository(ctx, cr.Spec.ProviderRef, cr.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, obj.SubnetID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.SubnetDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return useCase.SyncSubnet(ctx, obj)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := useCase.SyncSubnet(ctx, obj); err != nil {
+			cr.Status.Ready = false
+			r.Status().Update(ctx, cr)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusSubnet(obj, cr)
@@ -67,9 +125,21 @@ func (r *SubnetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *SubnetReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&infrav1alpha1.Subnet{}).Complete(r)
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("subnet-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.Subnet{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.Subnet)
+		return refKeys("VPC", optionalRefs(cr.Spec.VpcRef)...)
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.Subnet{}).
+		Watches(&infrav1alpha1.VPC{}, enqueueReferencing(mgr.GetClient(), "VPC", &infrav1alpha1.SubnetList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("Subnet", mgr.GetClient(), &infrav1alpha1.Subnet{}, r))
 }
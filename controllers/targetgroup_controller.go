@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const targetGroupFinalizer = "aws-infra-operator.runner.codes/targetgroup-finalizer"
+
+// TargetGroupReconciler reconciles a TargetGroup object
+type TargetGroupReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroups/finalizers,verbs=update
+
+func (r *TargetGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.TargetGroup{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetTargetGroupUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to get TargetGroup use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	tg := mapper.CRToDomainTargetGroup(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, targetGroupFinalizer) {
+			// Fails while a listener still forwards to the group, so retry until it is removed
+			if err := useCase.DeleteTargetGroup(ctx, tg); err != nil {
+				logger.Error(err, "failed to delete target group")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			controllerutil.RemoveFinalizer(cr, targetGroupFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, targetGroupFinalizer) {
+		controllerutil.AddFinalizer(cr, targetGroupFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve references to other resources in the namespace
+	if cr.Spec.VpcRef != nil {
+		vpcID, err := resolveVPCRef(ctx, r.Client, cr.Namespace, *cr.Spec.VpcRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		tg.VpcID = vpcID
+	}
+	for i, target := range cr.Spec.Targets {
+		if target.InstanceRef == nil {
+			continue
+		}
+		instanceID, err := resolveEC2InstanceRef(ctx, r.Client, cr.Namespace, *target.InstanceRef)
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		tg.Targets[i].ID = instanceID
+	}
+
+	if err := useCase.SyncTargetGroup(ctx, tg); err != nil {
+		logger.Error(err, "failed to sync target group")
+		cr.Status.Ready = false
+		if tg.ARN != "" {
+			// Keep the ARN of a group created before the failure
+			cr.Status.TargetGroupARN = tg.ARN
+		}
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	mapper.DomainToStatusTargetGroup(tg, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Follow target health more closely until every target is healthy
+	if tg.HealthyTargets() < len(tg.TargetHealth) {
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *TargetGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("targetgroup-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.TargetGroup{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.TargetGroup)
+		keys := refKeys("VPC", optionalRefs(cr.Spec.VpcRef)...)
+		for _, target := range cr.Spec.Targets {
+			keys = append(keys, refKeys("EC2Instance", optionalRefs(target.InstanceRef)...)...)
+		}
+		return keys
+	}); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.TargetGroup{}).
+		Watches(&infrav1alpha1.VPC{}, enqueueReferencing(mgr.GetClient(), "VPC", &infrav1alpha1.TargetGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&infrav1alpha1.EC2Instance{}, enqueueReferencing(mgr.GetClient(), "EC2Instance", &infrav1alpha1.TargetGroupList{}), builder.WithPredicates(referenceBecameReady)).
+		Complete(inframetrics.InstrumentReconciler("TargetGroup", mgr.GetClient(), &infrav1alpha1.TargetGroup{}, r))
+}
@@ -0,0 +1,491 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/alb"
+	"infra-operator/pkg/clients"
+	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
+)
+
+const targetGroupBindingFinalizer = "aws-infra-operator.runner.codes/targetgroupbinding-finalizer"
+
+// targetHealthGatePrefix prefixes the readiness gate pods declare to stay
+// unready until their target is healthy: target-health.aws-infra-operator.runner.codes/<binding name>
+const targetHealthGatePrefix = "target-health.aws-infra-operator.runner.codes/"
+
+// excludeFromLoadBalancersLabel excludes a node from instance bindings
+const excludeFromLoadBalancersLabel = "node.kubernetes.io/exclude-from-external-load-balancers"
+
+// bindingPollInterval is how often a binding is synced while targets are not
+// healthy yet or are draining
+const bindingPollInterval = 15 * time.Second
+
+// TargetGroupBindingReconciler reconciles a TargetGroupBinding object
+type TargetGroupBindingReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
+}
+
+// endpointTarget is a Service endpoint and, when it declares the readiness
+// gate of the binding, its pod
+type endpointTarget struct {
+	target   alb.Target
+	nodeName string
+	pod      *corev1.Pod
+}
+
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroupbindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroupbindings/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aws-infra-operator.runner.codes,resources=targetgroupbindings/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=services;nodes;pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
+
+func (r *TargetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	cr := &infrav1alpha1.TargetGroupBinding{}
+	if err := r.Get(ctx, req.NamespacedName, cr); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	useCase, err := r.AWSClientFactory.GetTargetGroupBindingUseCase(ctx, cr.Spec.ProviderRef, cr.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to get TargetGroupBinding use case")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	binding := mapper.CRToDomainTargetGroupBinding(cr)
+
+	// Handle deletion
+	if !cr.ObjectMeta.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(cr, targetGroupBindingFinalizer) {
+			if err := useCase.DeleteBinding(ctx, binding); err != nil {
+				logger.Error(err, "failed to deregister targets")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			// Keep the finalizer for the deregistration delay, so in-flight
+			// requests complete before the binding is gone
+			if draining := binding.Draining(); draining > 0 {
+				logger.Info("Waiting for targets to drain", "draining", draining)
+				return ctrl.Result{RequeueAfter: bindingPollInterval}, nil
+			}
+			controllerutil.RemoveFinalizer(cr, targetGroupBindingFinalizer)
+			if err := r.Update(ctx, cr); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(cr, targetGroupBindingFinalizer) {
+		controllerutil.AddFinalizer(cr, targetGroupBindingFinalizer)
+		if err := r.Update(ctx, cr); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Resolve the target group and, by default, its target type
+	if ref := cr.Spec.TargetGroupRef; ref != nil {
+		tg := &infrav1alpha1.TargetGroup{}
+		arn, err := resolveRef(ctx, r.Client, cr.Namespace, "TargetGroup", *ref, tg, func() (string, bool) {
+			return tg.Status.TargetGroupARN, tg.Status.Ready
+		})
+		if err != nil {
+			return waitForReference(ctx, r.Recorder, cr, err)
+		}
+		// spec.targets of the TargetGroup are authoritative and would
+		// deregister the endpoints of the Service
+		if len(tg.Spec.Targets) > 0 {
+			return r.fail(ctx, cr, fmt.Errorf("target group %s has spec.targets; leave them empty to bind a Service", ref.Name))
+		}
+		binding.TargetGroupARN = arn
+		if cr.Spec.TargetType == "" {
+			binding.TargetType = tg.Spec.TargetType
+			if binding.TargetType == "" {
+				binding.TargetType = alb.TargetTypeInstance
+			}
+		}
+	}
+
+	svc := &corev1.Service{}
+	if err := r.Get(ctx, types.NamespacedName{Name: cr.Spec.ServiceRef.Name, Namespace: cr.Namespace}, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return waitForReference(ctx, r.Recorder, cr, &refNotReadyError{kind: "Service", name: cr.Spec.ServiceRef.Name, reason: "not found"})
+		}
+		return ctrl.Result{}, err
+	}
+	svcPort, ok := findServicePort(svc, cr.Spec.ServiceRef.Port)
+	if !ok {
+		return r.fail(ctx, cr, fmt.Errorf("service %s has no port %s", svc.Name, cr.Spec.ServiceRef.Port.String()))
+	}
+
+	endpoints, waiting, err := r.serviceEndpoints(ctx, cr, svc, svcPort)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if binding.TargetType == alb.TargetTypeIP {
+		for _, endpoint := range endpoints {
+			binding.Targets = append(binding.Targets, endpoint.target)
+		}
+	} else {
+		if binding.Targets, err = r.nodeTargets(ctx, cr, svc, svcPort, endpoints); err != nil {
+			return r.fail(ctx, cr, err)
+		}
+	}
+
+	if err := useCase.SyncBinding(ctx, binding); err != nil {
+		logger.Error(err, "failed to sync target group binding")
+		cr.Status.Ready = false
+		r.Status().Update(ctx, cr)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+	}
+
+	pending := false
+	if binding.TargetType == alb.TargetTypeIP {
+		if pending, err = r.updateReadinessGates(ctx, cr, endpoints, binding); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	mapper.DomainToStatusTargetGroupBinding(binding, cr)
+	if err := r.Status().Update(ctx, cr); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Endpoint changes trigger a sync; poll only while targets settle
+	if waiting || pending || binding.Draining() > 0 || binding.HealthyTargets() < len(binding.Targets) {
+		return ctrl.Result{RequeueAfter: bindingPollInterval}, nil
+	}
+	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+}
+
+// fail reports a configuration error of the binding and retries later
+func (r *TargetGroupBindingReconciler) fail(ctx context.Context, cr *infrav1alpha1.TargetGroupBinding, err error) (ctrl.Result, error) {
+	log.FromContext(ctx).Error(err, "invalid target group binding")
+	r.Recorder.Event(cr, "Warning", "InvalidBinding", err.Error())
+	cr.Status.Ready = false
+	if updateErr := r.Status().Update(ctx, cr); updateErr != nil {
+		return ctrl.Result{}, updateErr
+	}
+	return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+}
+
+// findServicePort returns the Service port with the given name or number
+func findServicePort(svc *corev1.Service, port intstr.IntOrString) (corev1.ServicePort, bool) {
+	for _, p := range svc.Spec.Ports {
+		if (port.Type == intstr.String && p.Name == port.StrVal) || (port.Type == intstr.Int && p.Port == port.IntVal) {
+			return p, true
+		}
+	}
+	return corev1.ServicePort{}, false
+}
+
+// serviceEndpoints lists the endpoints of the Service port that should receive
+// traffic. Ready endpoints are included, and so are pods that only wait for
+// the readiness gate of the binding, since their target must be registered to
+// become healthy. Terminating endpoints are left out so they drain. waiting
+// reports gated pods whose containers are not ready yet.
+func (r *TargetGroupBindingReconciler) serviceEndpoints(ctx context.Context, cr *infrav1alpha1.TargetGroupBinding, svc *corev1.Service, svcPort corev1.ServicePort) ([]endpointTarget, bool, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := r.List(ctx, slices, client.InNamespace(svc.Namespace), client.MatchingLabels{discoveryv1.LabelServiceName: svc.Name}); err != nil {
+		return nil, false, err
+	}
+
+	gate := corev1.PodConditionType(targetHealthGatePrefix + cr.Name)
+	seen := map[string]bool{}
+	var endpoints []endpointTarget
+	waiting := false
+	for _, slice := range slices.Items {
+		if slice.AddressType != discoveryv1.AddressTypeIPv4 {
+			continue
+		}
+		port := endpointSlicePort(&slice, svcPort.Name)
+		if port == 0 {
+			continue
+		}
+
+		for _, ep := range slice.Endpoints {
+			if ep.Conditions.Terminating != nil && *ep.Conditions.Terminating {
+				continue
+			}
+
+			var pod *corev1.Pod
+			if ep.TargetRef != nil && ep.TargetRef.Kind == "Pod" {
+				p := &corev1.Pod{}
+				err := r.Get(ctx, types.NamespacedName{Name: ep.TargetRef.Name, Namespace: svc.Namespace}, p)
+				if err != nil && !apierrors.IsNotFound(err) {
+					return nil, false, err
+				}
+				if err == nil && hasReadinessGate(p, gate) {
+					pod = p
+				}
+			}
+
+			ready := ep.Conditions.Ready == nil || *ep.Conditions.Ready
+			if !ready {
+				if pod == nil {
+					continue
+				}
+				if !podConditionTrue(pod, corev1.ContainersReady) {
+					waiting = true
+					continue
+				}
+			}
+
+			nodeName := ""
+			if ep.NodeName != nil {
+				nodeName = *ep.NodeName
+			}
+			for _, address := range ep.Addresses {
+				target := alb.Target{ID: address, Port: port}
+				if seen[target.Key()] {
+					continue
+				}
+				seen[target.Key()] = true
+				endpoints = append(endpoints, endpointTarget{target: target, nodeName: nodeName, pod: pod})
+			}
+		}
+	}
+	return endpoints, waiting, nil
+}
+
+// endpointSlicePort returns the port of an EndpointSlice for the Service port name
+func endpointSlicePort(slice *discoveryv1.EndpointSlice, name string) int32 {
+	for _, port := range slice.Ports {
+		if port.Port != nil && port.Name != nil && *port.Name == name {
+			return *port.Port
+		}
+	}
+	return 0
+}
+
+// nodeTargets returns the Ready nodes with the Service node port. With
+// externalTrafficPolicy Local, only nodes running an endpoint are registered.
+func (r *TargetGroupBindingReconciler) nodeTargets(ctx context.Context, cr *infrav1alpha1.TargetGroupBinding, svc *corev1.Service, svcPort corev1.ServicePort, endpoints []endpointTarget) ([]alb.Target, error) {
+	if svcPort.NodePort == 0 {
+		return nil, fmt.Errorf("service %s has no node port; instance bindings need a NodePort or LoadBalancer Service", svc.Name)
+	}
+
+	selector := labels.Everything()
+	if cr.Spec.NodeSelector != nil {
+		var err error
+		if selector, err = metav1.LabelSelectorAsSelector(cr.Spec.NodeSelector); err != nil {
+			return nil, err
+		}
+	}
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var local map[string]bool
+	if svc.Spec.ExternalTrafficPolicy == corev1.ServiceExternalTrafficPolicyTypeLocal {
+		local = map[string]bool{}
+		for _, endpoint := range endpoints {
+			local[endpoint.nodeName] = true
+		}
+	}
+
+	var targets []alb.Target
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if _, excluded := node.Labels[excludeFromLoadBalancersLabel]; excluded || !nodeReady(node) {
+			continue
+		}
+		if local != nil && !local[node.Name] {
+			continue
+		}
+		id, err := alb.InstanceIDFromProviderID(node.Spec.ProviderID)
+		if err != nil {
+			// Fargate and non-EC2 nodes cannot be instance targets
+			continue
+		}
+		targets = append(targets, alb.Target{ID: id, Port: svcPort.NodePort})
+	}
+	return targets, nil
+}
+
+// updateReadinessGates sets the readiness gate condition of the pods that
+// declare it from the health of their target. It reports whether a gated pod
+// is still waiting for its target.
+func (r *TargetGroupBindingReconciler) updateReadinessGates(ctx context.Context, cr *infrav1alpha1.TargetGroupBinding, endpoints []endpointTarget, binding *alb.TargetGroupBinding) (bool, error) {
+	gate := corev1.PodConditionType(targetHealthGatePrefix + cr.Name)
+	pending := false
+	for _, endpoint := range endpoints {
+		pod := endpoint.pod
+		if pod == nil {
+			continue
+		}
+
+		ready, message := binding.TargetReady(endpoint.target)
+		status, reason := corev1.ConditionTrue, "TargetHealthy"
+		if !ready {
+			pending = true
+			status, reason = corev1.ConditionFalse, "TargetNotHealthy"
+		}
+
+		condition := corev1.PodCondition{
+			Type:               gate,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: metav1.Now(),
+		}
+		patch := client.StrategicMergeFrom(pod.DeepCopy())
+		if !setPodCondition(pod, condition) {
+			continue
+		}
+		if err := r.Status().Patch(ctx, pod, patch); err != nil && !apierrors.IsNotFound(err) {
+			return pending, err
+		}
+	}
+	return pending, nil
+}
+
+// hasReadinessGate reports whether the pod declares the readiness gate
+func hasReadinessGate(pod *corev1.Pod, gate corev1.PodConditionType) bool {
+	for _, g := range pod.Spec.ReadinessGates {
+		if g.ConditionType == gate {
+			return true
+		}
+	}
+	return false
+}
+
+// podConditionTrue reports whether the pod condition is True
+func podConditionTrue(pod *corev1.Pod, conditionType corev1.PodConditionType) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == conditionType {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// setPodCondition adds or updates a pod condition and reports whether it changed
+func setPodCondition(pod *corev1.Pod, condition corev1.PodCondition) bool {
+	for i, c := range pod.Status.Conditions {
+		if c.Type != condition.Type {
+			continue
+		}
+		if c.Status == condition.Status && c.Message == condition.Message {
+			return false
+		}
+		if c.Status == condition.Status {
+			condition.LastTransitionTime = c.LastTransitionTime
+		}
+		pod.Status.Conditions[i] = condition
+		return true
+	}
+	pod.Status.Conditions = append(pod.Status.Conditions, condition)
+	return true
+}
+
+// nodeReady reports whether the node is Ready and schedulable
+func nodeReady(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// nodeMembershipChanged lets through node events that can change the targets
+// of instance bindings
+var nodeMembershipChanged = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return true
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, okOld := e.ObjectOld.(*corev1.Node)
+		newNode, okNew := e.ObjectNew.(*corev1.Node)
+		return okOld && okNew && (nodeReady(oldNode) != nodeReady(newNode) ||
+			!labels.Equals(oldNode.Labels, newNode.Labels) ||
+			oldNode.Spec.ProviderID != newNode.Spec.ProviderID)
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return true
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+// SetupWithManager sets up the controller with the Manager
+func (r *TargetGroupBindingReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("targetgroupbinding-controller")
+	}
+	if err := indexReferences(mgr, &infrav1alpha1.TargetGroupBinding{}, func(obj client.Object) []string {
+		cr := obj.(*infrav1alpha1.TargetGroupBinding)
+		keys := refKeys("TargetGroup", optionalRefs(cr.Spec.TargetGroupRef)...)
+		return append(keys, refKey("Service", cr.Spec.ServiceRef.Name))
+	}); err != nil {
+		return err
+	}
+
+	c := mgr.GetClient()
+	endpointSliceToBindings := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		service := obj.GetLabels()[discoveryv1.LabelServiceName]
+		if service == "" {
+			return nil
+		}
+		return referencingRequests(ctx, c, "Service", &infrav1alpha1.TargetGroupBindingList{}, obj.GetNamespace(), service)
+	})
+	nodeToInstanceBindings := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		bindings := &infrav1alpha1.TargetGroupBindingList{}
+		if err := c.List(ctx, bindings); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to list target group bindings")
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, binding := range bindings.Items {
+			if binding.Status.TargetType == alb.TargetTypeInstance {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: binding.Name, Namespace: binding.Namespace},
+				})
+			}
+		}
+		return requests
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1alpha1.TargetGroupBinding{}).
+		Watches(&infrav1alpha1.TargetGroup{}, enqueueReferencing(c, "TargetGroup", &infrav1alpha1.TargetGroupBindingList{}), builder.WithPredicates(referenceBecameReady)).
+		Watches(&corev1.Service{}, enqueueReferencing(c, "Service", &infrav1alpha1.TargetGroupBindingList{})).
+		Watches(&discoveryv1.EndpointSlice{}, endpointSliceToBindings).
+		Watches(&corev1.Node{}, nodeToInstanceBindings, builder.WithPredicates(nodeMembershipChanged)).
+		Complete(inframetrics.InstrumentReconciler("TargetGroupBinding", mgr.GetClient(), &infrav1alpha1.TargetGroupBinding{}, r))
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/pkg/clients"
+	"infra-operator/pkg/drift"
 	"infra-operator/pkg/mapper"
+	inframetrics "infra-operator/pkg/metrics"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -19,6 +22,7 @@ type VPCReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	AWSClientFactory *clients.AWSClientFactory
+	Recorder         record.EventRecorder
 }
 
 func (r *VPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -56,10 +60,54 @@ func (r *VPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 	}
 
 	v := mapper.CRToDomainVPC(vpcCR)
-	if err := vpcUseCase.SyncVPC(ctx, v); err != nil {
-		vpcCR.Status.Ready = false
-		r.Status().Update(ctx, vpcCR)
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+
+	// Bind to an existing AWS resource when the CR is adopted
+	if v.VpcID, err = adoptedID(ctx, r.Recorder, vpcCR, v.VpcID, func(ctx context.Context, id string) (bool, error) {
+		repo, err := r.AWSClientFactory.GetVPCRepository(ctx, vpcCR.Spec.ProviderRef, vpcCR.Namespace)
+		if err != nil {
+			return false, err
+		}
+		return repo.Exists(ctx, id)
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Detect drift before syncing so alert-only changes are not overwritten
+	outcome := checkDrift(ctx, r.Client, r.Recorder, vpcCR, driftCheck{
+		kind:        "VPC",
+		resourceID:  v.VpcID,
+		providerRef: vpcCR.Spec.ProviderRef,
+		policy:      vpcCR.Spec.DriftPolicy,
+		status:      &vpcCR.Status.DriftStatus,
+		desired:     mapper.VPCDriftState(v),
+		actual: func(ctx context.Context) (drift.State, error) {
+			repo, err := r.AWSClientFactory.GetVPCRepository(ctx, vpcCR.Spec.ProviderRef, vpcCR.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			current, err := repo.Get(ctx, v.VpcID)
+			if err != nil {
+				return nil, err
+			}
+			return mapper.VPCDriftState(current), nil
+		},
+		sync: func(ctx context.Context) error {
+			return vpcUseCase.SyncVPC(ctx, v)
+		},
+	})
+	if outcome.pending {
+		if err := r.Status().Update(ctx, vpcCR); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
+	}
+
+	if !outcome.synced {
+		if err := vpcUseCase.SyncVPC(ctx, v); err != nil {
+			vpcCR.Status.Ready = false
+			r.Status().Update(ctx, vpcCR)
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, err
+		}
 	}
 
 	mapper.DomainToStatusVPC(v, vpcCR)
@@ -67,9 +115,12 @@ func (r *VPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	return ctrl.Result{RequeueAfter: outcome.requeueAfter(5 * time.Minute)}, nil
 }
 
 func (r *VPCReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).For(&infrav1alpha1.VPC{}).Complete(r)
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("vpc-controller")
+	}
+	return ctrl.NewControllerManagedBy(mgr).For(&infrav1alpha1.VPC{}).Complete(inframetrics.InstrumentReconciler("VPC", mgr.GetClient(), &infrav1alpha1.VPC{}, r))
 }
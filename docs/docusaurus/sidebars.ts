@@ -30,6 +30,7 @@ const sidebars: SidebarsConfig = {
         'services/compute/ec2',
         'services/compute/eks',
         'services/compute/lambda',
+        'services/compute/autoscaling',
         'services/compute/computestack',
       ],
     },
@@ -0,0 +1,393 @@
+package alb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"infra-operator/internal/domain/alb"
+)
+
+// GetListener retrieves a listener by ARN, or nil if it does not exist
+func (r *Repository) GetListener(ctx context.Context, listenerARN string) (*alb.Listener, error) {
+	output, err := r.client.DescribeListeners(ctx, &awselbv2.DescribeListenersInput{
+		ListenerArns: []string{listenerARN},
+	})
+	if err != nil {
+		var notFoundErr *types.ListenerNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe listener: %w", err)
+	}
+	if len(output.Listeners) == 0 {
+		return nil, nil
+	}
+	return fromListener(output.Listeners[0]), nil
+}
+
+// FindListener retrieves the listener of a load balancer on port, or nil if there is none
+func (r *Repository) FindListener(ctx context.Context, lbARN string, port int32) (*alb.Listener, error) {
+	paginator := awselbv2.NewDescribeListenersPaginator(r.client, &awselbv2.DescribeListenersInput{
+		LoadBalancerArn: aws.String(lbARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe listeners: %w", err)
+		}
+		for _, listener := range page.Listeners {
+			if aws.ToInt32(listener.Port) == port {
+				return fromListener(listener), nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// CreateListener creates a listener with its default certificate and actions
+func (r *Repository) CreateListener(ctx context.Context, listener *alb.Listener) error {
+	input := &awselbv2.CreateListenerInput{
+		LoadBalancerArn: aws.String(listener.LoadBalancerARN),
+		Protocol:        types.ProtocolEnum(listener.Protocol),
+		Port:            aws.Int32(listener.Port),
+		DefaultActions:  toActions(listener.DefaultActions),
+		Tags:            toTags(listener.Tags),
+	}
+	if listener.RequiresCertificate() {
+		input.SslPolicy = aws.String(listener.SslPolicy)
+		input.Certificates = []types.Certificate{{CertificateArn: aws.String(listener.DefaultCertificate())}}
+	}
+
+	output, err := r.client.CreateListener(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to create listener: %w", err)
+	}
+	if len(output.Listeners) > 0 {
+		listener.ARN = aws.ToString(output.Listeners[0].ListenerArn)
+	}
+	return nil
+}
+
+// ModifyListener updates the port, protocol, TLS settings and default actions of a listener
+func (r *Repository) ModifyListener(ctx context.Context, listener *alb.Listener) error {
+	input := &awselbv2.ModifyListenerInput{
+		ListenerArn:    aws.String(listener.ARN),
+		Protocol:       types.ProtocolEnum(listener.Protocol),
+		Port:           aws.Int32(listener.Port),
+		DefaultActions: toActions(listener.DefaultActions),
+	}
+	if listener.RequiresCertificate() {
+		input.SslPolicy = aws.String(listener.SslPolicy)
+		input.Certificates = []types.Certificate{{CertificateArn: aws.String(listener.DefaultCertificate())}}
+	}
+
+	if _, err := r.client.ModifyListener(ctx, input); err != nil {
+		return fmt.Errorf("failed to modify listener: %w", err)
+	}
+	return nil
+}
+
+// DeleteListener deletes a listener and its rules
+func (r *Repository) DeleteListener(ctx context.Context, listenerARN string) error {
+	_, err := r.client.DeleteListener(ctx, &awselbv2.DeleteListenerInput{
+		ListenerArn: aws.String(listenerARN),
+	})
+	if err != nil {
+		var notFoundErr *types.ListenerNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete listener: %w", err)
+	}
+	return nil
+}
+
+// ListCertificates lists the additional (SNI) certificates of a listener
+func (r *Repository) ListCertificates(ctx context.Context, listenerARN string) ([]string, error) {
+	var certificates []string
+	input := &awselbv2.DescribeListenerCertificatesInput{ListenerArn: aws.String(listenerARN)}
+	for {
+		output, err := r.client.DescribeListenerCertificates(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe listener certificates: %w", err)
+		}
+		for _, cert := range output.Certificates {
+			if !aws.ToBool(cert.IsDefault) {
+				certificates = append(certificates, aws.ToString(cert.CertificateArn))
+			}
+		}
+		if output.NextMarker == nil {
+			return certificates, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+// AddCertificates adds SNI certificates to a listener
+func (r *Repository) AddCertificates(ctx context.Context, listenerARN string, certificateARNs []string) error {
+	_, err := r.client.AddListenerCertificates(ctx, &awselbv2.AddListenerCertificatesInput{
+		ListenerArn:  aws.String(listenerARN),
+		Certificates: toCertificates(certificateARNs),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add listener certificates: %w", err)
+	}
+	return nil
+}
+
+// RemoveCertificates removes SNI certificates from a listener
+func (r *Repository) RemoveCertificates(ctx context.Context, listenerARN string, certificateARNs []string) error {
+	_, err := r.client.RemoveListenerCertificates(ctx, &awselbv2.RemoveListenerCertificatesInput{
+		ListenerArn:  aws.String(listenerARN),
+		Certificates: toCertificates(certificateARNs),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove listener certificates: %w", err)
+	}
+	return nil
+}
+
+// ListRules lists the rules of a listener, except the default rule
+func (r *Repository) ListRules(ctx context.Context, listenerARN string) ([]alb.ListenerRule, error) {
+	var rules []alb.ListenerRule
+	input := &awselbv2.DescribeRulesInput{ListenerArn: aws.String(listenerARN)}
+	for {
+		output, err := r.client.DescribeRules(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe listener rules: %w", err)
+		}
+		for _, rule := range output.Rules {
+			if aws.ToBool(rule.IsDefault) {
+				continue
+			}
+			priority, err := strconv.Atoi(aws.ToString(rule.Priority))
+			if err != nil {
+				continue
+			}
+			rules = append(rules, alb.ListenerRule{
+				ARN:        aws.ToString(rule.RuleArn),
+				Priority:   int32(priority),
+				Conditions: fromConditions(rule.Conditions),
+				Actions:    fromActions(rule.Actions),
+			})
+		}
+		if output.NextMarker == nil {
+			return rules, nil
+		}
+		input.Marker = output.NextMarker
+	}
+}
+
+// CreateRule creates a rule on a listener
+func (r *Repository) CreateRule(ctx context.Context, listenerARN string, rule *alb.ListenerRule) error {
+	output, err := r.client.CreateRule(ctx, &awselbv2.CreateRuleInput{
+		ListenerArn: aws.String(listenerARN),
+		Priority:    aws.Int32(rule.Priority),
+		Conditions:  toConditions(rule.Conditions),
+		Actions:     toActions(rule.Actions),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create listener rule %d: %w", rule.Priority, err)
+	}
+	if len(output.Rules) > 0 {
+		rule.ARN = aws.ToString(output.Rules[0].RuleArn)
+	}
+	return nil
+}
+
+// ModifyRule updates the conditions and actions of a rule
+func (r *Repository) ModifyRule(ctx context.Context, rule *alb.ListenerRule) error {
+	_, err := r.client.ModifyRule(ctx, &awselbv2.ModifyRuleInput{
+		RuleArn:    aws.String(rule.ARN),
+		Conditions: toConditions(rule.Conditions),
+		Actions:    toActions(rule.Actions),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify listener rule %d: %w", rule.Priority, err)
+	}
+	return nil
+}
+
+// DeleteRule deletes a rule
+func (r *Repository) DeleteRule(ctx context.Context, ruleARN string) error {
+	_, err := r.client.DeleteRule(ctx, &awselbv2.DeleteRuleInput{
+		RuleArn: aws.String(ruleARN),
+	})
+	if err != nil {
+		var notFoundErr *types.RuleNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete listener rule: %w", err)
+	}
+	return nil
+}
+
+func fromListener(data types.Listener) *alb.Listener {
+	listener := &alb.Listener{
+		LoadBalancerARN: aws.ToString(data.LoadBalancerArn),
+		ARN:             aws.ToString(data.ListenerArn),
+		Protocol:        string(data.Protocol),
+		Port:            aws.ToInt32(data.Port),
+		SslPolicy:       aws.ToString(data.SslPolicy),
+		DefaultActions:  fromActions(data.DefaultActions),
+	}
+	// Listeners only report their default certificate
+	for _, cert := range data.Certificates {
+		listener.CertificateARNs = append(listener.CertificateARNs, aws.ToString(cert.CertificateArn))
+	}
+	return listener
+}
+
+func toCertificates(arns []string) []types.Certificate {
+	certificates := make([]types.Certificate, 0, len(arns))
+	for _, arn := range arns {
+		certificates = append(certificates, types.Certificate{CertificateArn: aws.String(arn)})
+	}
+	return certificates
+}
+
+// toActions converts actions, numbering them in order. Forward actions always
+// use a forward config so weights are kept with more than one target group.
+func toActions(actions []alb.Action) []types.Action {
+	out := make([]types.Action, 0, len(actions))
+	for i, action := range actions {
+		a := types.Action{
+			Type:  types.ActionTypeEnum(action.Type),
+			Order: aws.Int32(int32(i + 1)),
+		}
+		switch action.Type {
+		case alb.ActionForward:
+			if len(action.TargetGroups) == 1 {
+				a.TargetGroupArn = aws.String(action.TargetGroups[0].ARN)
+				break
+			}
+			config := &types.ForwardActionConfig{}
+			for _, tg := range action.TargetGroups {
+				config.TargetGroups = append(config.TargetGroups, types.TargetGroupTuple{
+					TargetGroupArn: aws.String(tg.ARN),
+					Weight:         aws.Int32(tg.Weight),
+				})
+			}
+			a.ForwardConfig = config
+		case alb.ActionRedirect:
+			a.RedirectConfig = &types.RedirectActionConfig{
+				Protocol:   optionalString(action.Redirect.Protocol),
+				Host:       optionalString(action.Redirect.Host),
+				Port:       optionalString(action.Redirect.Port),
+				Path:       optionalString(action.Redirect.Path),
+				Query:      optionalString(action.Redirect.Query),
+				StatusCode: types.RedirectActionStatusCodeEnum(action.Redirect.StatusCode),
+			}
+		case alb.ActionFixedResponse:
+			a.FixedResponseConfig = &types.FixedResponseActionConfig{
+				StatusCode:  aws.String(action.FixedResponse.StatusCode),
+				ContentType: optionalString(action.FixedResponse.ContentType),
+				MessageBody: optionalString(action.FixedResponse.MessageBody),
+			}
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+func fromActions(actions []types.Action) []alb.Action {
+	out := make([]alb.Action, 0, len(actions))
+	for _, a := range actions {
+		action := alb.Action{Type: string(a.Type)}
+		switch {
+		case a.ForwardConfig != nil && len(a.ForwardConfig.TargetGroups) > 0:
+			for _, tg := range a.ForwardConfig.TargetGroups {
+				action.TargetGroups = append(action.TargetGroups, alb.WeightedTargetGroup{
+					ARN:    aws.ToString(tg.TargetGroupArn),
+					Weight: aws.ToInt32(tg.Weight),
+				})
+			}
+		case a.TargetGroupArn != nil:
+			action.TargetGroups = []alb.WeightedTargetGroup{{ARN: aws.ToString(a.TargetGroupArn)}}
+		}
+		if c := a.RedirectConfig; c != nil {
+			action.Redirect = &alb.RedirectAction{
+				Protocol:   aws.ToString(c.Protocol),
+				Host:       aws.ToString(c.Host),
+				Port:       aws.ToString(c.Port),
+				Path:       aws.ToString(c.Path),
+				Query:      aws.ToString(c.Query),
+				StatusCode: string(c.StatusCode),
+			}
+		}
+		if c := a.FixedResponseConfig; c != nil {
+			action.FixedResponse = &alb.FixedResponseAction{
+				StatusCode:  aws.ToString(c.StatusCode),
+				ContentType: aws.ToString(c.ContentType),
+				MessageBody: aws.ToString(c.MessageBody),
+			}
+		}
+		out = append(out, action)
+	}
+	return out
+}
+
+func toConditions(c alb.RuleConditions) []types.RuleCondition {
+	var conditions []types.RuleCondition
+	if len(c.HostHeaders) > 0 {
+		conditions = append(conditions, types.RuleCondition{
+			Field:            aws.String("host-header"),
+			HostHeaderConfig: &types.HostHeaderConditionConfig{Values: c.HostHeaders},
+		})
+	}
+	if len(c.PathPatterns) > 0 {
+		conditions = append(conditions, types.RuleCondition{
+			Field:             aws.String("path-pattern"),
+			PathPatternConfig: &types.PathPatternConditionConfig{Values: c.PathPatterns},
+		})
+	}
+	if len(c.HTTPRequestMethods) > 0 {
+		conditions = append(conditions, types.RuleCondition{
+			Field:                   aws.String("http-request-method"),
+			HttpRequestMethodConfig: &types.HttpRequestMethodConditionConfig{Values: c.HTTPRequestMethods},
+		})
+	}
+	if len(c.SourceIPs) > 0 {
+		conditions = append(conditions, types.RuleCondition{
+			Field:          aws.String("source-ip"),
+			SourceIpConfig: &types.SourceIpConditionConfig{Values: c.SourceIPs},
+		})
+	}
+	return conditions
+}
+
+func fromConditions(conditions []types.RuleCondition) alb.RuleConditions {
+	var c alb.RuleConditions
+	for _, condition := range conditions {
+		switch aws.ToString(condition.Field) {
+		case "host-header":
+			if condition.HostHeaderConfig != nil {
+				c.HostHeaders = append(c.HostHeaders, condition.HostHeaderConfig.Values...)
+			} else {
+				c.HostHeaders = append(c.HostHeaders, condition.Values...)
+			}
+		case "path-pattern":
+			if condition.PathPatternConfig != nil {
+				c.PathPatterns = append(c.PathPatterns, condition.PathPatternConfig.Values...)
+			} else {
+				c.PathPatterns = append(c.PathPatterns, condition.Values...)
+			}
+		case "http-request-method":
+			if condition.HttpRequestMethodConfig != nil {
+				c.HTTPRequestMethods = append(c.HTTPRequestMethods, condition.HttpRequestMethodConfig.Values...)
+			}
+		case "source-ip":
+			if condition.SourceIpConfig != nil {
+				c.SourceIPs = append(c.SourceIPs, condition.SourceIpConfig.Values...)
+			}
+		}
+	}
+	return c
+}
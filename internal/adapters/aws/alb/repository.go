@@ -209,7 +209,7 @@ func (r *Repository) GetAttributes(ctx context.Context, lb *alb.LoadBalancer) er
 	return nil
 }
 
-// TagResource tags the load balancer
+// TagResource tags a load balancer, target group or listener
 func (r *Repository) TagResource(ctx context.Context, lbARN string, tags map[string]string) error {
 	var tagList []types.Tag
 	for key, value := range tags {
@@ -226,7 +226,7 @@ func (r *Repository) TagResource(ctx context.Context, lbARN string, tags map[str
 
 	_, err := r.client.AddTags(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to tag load balancer: %w", err)
+		return fmt.Errorf("failed to tag resource: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,280 @@
+package alb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awselbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	"infra-operator/internal/domain/alb"
+)
+
+// deregistrationDelayAttribute is the target group attribute holding the deregistration delay
+const deregistrationDelayAttribute = "deregistration_delay.timeout_seconds"
+
+// GetTargetGroup retrieves a target group by name, or nil if it does not exist
+func (r *Repository) GetTargetGroup(ctx context.Context, name string) (*alb.TargetGroup, error) {
+	output, err := r.client.DescribeTargetGroups(ctx, &awselbv2.DescribeTargetGroupsInput{
+		Names: []string{name},
+	})
+	if err != nil {
+		var notFoundErr *types.TargetGroupNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe target group: %w", err)
+	}
+	if len(output.TargetGroups) == 0 {
+		return nil, nil
+	}
+
+	data := output.TargetGroups[0]
+	tg := &alb.TargetGroup{
+		Name:             aws.ToString(data.TargetGroupName),
+		ARN:              aws.ToString(data.TargetGroupArn),
+		Protocol:         string(data.Protocol),
+		ProtocolVersion:  aws.ToString(data.ProtocolVersion),
+		Port:             aws.ToInt32(data.Port),
+		TargetType:       string(data.TargetType),
+		VpcID:            aws.ToString(data.VpcId),
+		LoadBalancerARNs: data.LoadBalancerArns,
+		HealthCheck: &alb.HealthCheck{
+			Protocol:           string(data.HealthCheckProtocol),
+			Port:               aws.ToString(data.HealthCheckPort),
+			Path:               aws.ToString(data.HealthCheckPath),
+			IntervalSeconds:    aws.ToInt32(data.HealthCheckIntervalSeconds),
+			TimeoutSeconds:     aws.ToInt32(data.HealthCheckTimeoutSeconds),
+			HealthyThreshold:   aws.ToInt32(data.HealthyThresholdCount),
+			UnhealthyThreshold: aws.ToInt32(data.UnhealthyThresholdCount),
+		},
+	}
+	if data.Matcher != nil {
+		tg.HealthCheck.Matcher = aws.ToString(data.Matcher.HttpCode)
+		if tg.HealthCheck.Matcher == "" {
+			tg.HealthCheck.Matcher = aws.ToString(data.Matcher.GrpcCode)
+		}
+	}
+
+	attributes, err := r.client.DescribeTargetGroupAttributes(ctx, &awselbv2.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: data.TargetGroupArn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe target group attributes: %w", err)
+	}
+	for _, attr := range attributes.Attributes {
+		if aws.ToString(attr.Key) == deregistrationDelayAttribute {
+			if delay, err := strconv.Atoi(aws.ToString(attr.Value)); err == nil {
+				tg.DeregistrationDelay = aws.Int32(int32(delay))
+			}
+		}
+	}
+
+	return tg, nil
+}
+
+// CreateTargetGroup creates a target group with its health check and tags
+func (r *Repository) CreateTargetGroup(ctx context.Context, tg *alb.TargetGroup) error {
+	input := &awselbv2.CreateTargetGroupInput{
+		Name:       aws.String(tg.Name),
+		Protocol:   types.ProtocolEnum(tg.Protocol),
+		Port:       aws.Int32(tg.Port),
+		TargetType: types.TargetTypeEnum(tg.TargetType),
+		VpcId:      aws.String(tg.VpcID),
+		Tags:       toTags(tg.Tags),
+	}
+	if tg.ProtocolVersion != "" {
+		input.ProtocolVersion = aws.String(tg.ProtocolVersion)
+	}
+
+	if hc := tg.HealthCheck; hc != nil {
+		input.HealthCheckProtocol = types.ProtocolEnum(hc.Protocol)
+		input.HealthCheckPort = optionalString(hc.Port)
+		input.HealthCheckPath = optionalString(hc.Path)
+		input.HealthCheckIntervalSeconds = optionalInt32(hc.IntervalSeconds)
+		input.HealthCheckTimeoutSeconds = optionalInt32(hc.TimeoutSeconds)
+		input.HealthyThresholdCount = optionalInt32(hc.HealthyThreshold)
+		input.UnhealthyThresholdCount = optionalInt32(hc.UnhealthyThreshold)
+		input.Matcher = matcher(tg.ProtocolVersion, hc.Matcher)
+	}
+
+	output, err := r.client.CreateTargetGroup(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to create target group: %w", err)
+	}
+	if len(output.TargetGroups) > 0 {
+		tg.ARN = aws.ToString(output.TargetGroups[0].TargetGroupArn)
+	}
+
+	if tg.DeregistrationDelay != nil {
+		return r.setDeregistrationDelay(ctx, tg)
+	}
+	return nil
+}
+
+// ModifyTargetGroup updates the health check and attributes of a target group
+func (r *Repository) ModifyTargetGroup(ctx context.Context, tg *alb.TargetGroup) error {
+	if hc := tg.HealthCheck; hc != nil {
+		input := &awselbv2.ModifyTargetGroupInput{
+			TargetGroupArn:             aws.String(tg.ARN),
+			HealthCheckProtocol:        types.ProtocolEnum(hc.Protocol),
+			HealthCheckPort:            optionalString(hc.Port),
+			HealthCheckPath:            optionalString(hc.Path),
+			HealthCheckIntervalSeconds: optionalInt32(hc.IntervalSeconds),
+			HealthCheckTimeoutSeconds:  optionalInt32(hc.TimeoutSeconds),
+			HealthyThresholdCount:      optionalInt32(hc.HealthyThreshold),
+			UnhealthyThresholdCount:    optionalInt32(hc.UnhealthyThreshold),
+			Matcher:                    matcher(tg.ProtocolVersion, hc.Matcher),
+		}
+		if _, err := r.client.ModifyTargetGroup(ctx, input); err != nil {
+			return fmt.Errorf("failed to modify target group health check: %w", err)
+		}
+	}
+
+	if tg.DeregistrationDelay != nil {
+		return r.setDeregistrationDelay(ctx, tg)
+	}
+	return nil
+}
+
+// setDeregistrationDelay sets how long draining targets keep their connections
+func (r *Repository) setDeregistrationDelay(ctx context.Context, tg *alb.TargetGroup) error {
+	_, err := r.client.ModifyTargetGroupAttributes(ctx, &awselbv2.ModifyTargetGroupAttributesInput{
+		TargetGroupArn: aws.String(tg.ARN),
+		Attributes: []types.TargetGroupAttribute{{
+			Key:   aws.String(deregistrationDelayAttribute),
+			Value: aws.String(strconv.Itoa(int(*tg.DeregistrationDelay))),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify target group attributes: %w", err)
+	}
+	return nil
+}
+
+// DeleteTargetGroup deletes a target group
+func (r *Repository) DeleteTargetGroup(ctx context.Context, tgARN string) error {
+	_, err := r.client.DeleteTargetGroup(ctx, &awselbv2.DeleteTargetGroupInput{
+		TargetGroupArn: aws.String(tgARN),
+	})
+	if err != nil {
+		var notFoundErr *types.TargetGroupNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete target group: %w", err)
+	}
+	return nil
+}
+
+// DescribeTargetHealth lists the registered targets with their health, or
+// nil if the target group does not exist
+func (r *Repository) DescribeTargetHealth(ctx context.Context, tgARN string) ([]alb.TargetHealth, error) {
+	output, err := r.client.DescribeTargetHealth(ctx, &awselbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(tgARN),
+	})
+	if err != nil {
+		var notFoundErr *types.TargetGroupNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe target health: %w", err)
+	}
+
+	targets := make([]alb.TargetHealth, 0, len(output.TargetHealthDescriptions))
+	for _, desc := range output.TargetHealthDescriptions {
+		if desc.Target == nil {
+			continue
+		}
+		health := alb.TargetHealth{Target: alb.Target{
+			ID:               aws.ToString(desc.Target.Id),
+			Port:             aws.ToInt32(desc.Target.Port),
+			AvailabilityZone: aws.ToString(desc.Target.AvailabilityZone),
+		}}
+		if desc.TargetHealth != nil {
+			health.State = string(desc.TargetHealth.State)
+			health.Reason = string(desc.TargetHealth.Reason)
+		}
+		targets = append(targets, health)
+	}
+	return targets, nil
+}
+
+// RegisterTargets registers targets in a target group
+func (r *Repository) RegisterTargets(ctx context.Context, tgARN string, targets []alb.Target) error {
+	_, err := r.client.RegisterTargets(ctx, &awselbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(tgARN),
+		Targets:        toTargetDescriptions(targets),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register targets: %w", err)
+	}
+	return nil
+}
+
+// DeregisterTargets deregisters targets from a target group
+func (r *Repository) DeregisterTargets(ctx context.Context, tgARN string, targets []alb.Target) error {
+	_, err := r.client.DeregisterTargets(ctx, &awselbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(tgARN),
+		Targets:        toTargetDescriptions(targets),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to deregister targets: %w", err)
+	}
+	return nil
+}
+
+func toTargetDescriptions(targets []alb.Target) []types.TargetDescription {
+	descriptions := make([]types.TargetDescription, 0, len(targets))
+	for _, target := range targets {
+		desc := types.TargetDescription{
+			Id:               aws.String(target.ID),
+			Port:             optionalInt32(target.Port),
+			AvailabilityZone: optionalString(target.AvailabilityZone),
+		}
+		descriptions = append(descriptions, desc)
+	}
+	return descriptions
+}
+
+// matcher builds the health check success codes, which are gRPC codes for gRPC target groups
+func matcher(protocolVersion, codes string) *types.Matcher {
+	if codes == "" {
+		return nil
+	}
+	if protocolVersion == "GRPC" {
+		return &types.Matcher{GrpcCode: aws.String(codes)}
+	}
+	return &types.Matcher{HttpCode: aws.String(codes)}
+}
+
+func toTags(tags map[string]string) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	tagList := make([]types.Tag, 0, len(tags))
+	for key, value := range tags {
+		tagList = append(tagList, types.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+	return tagList
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return aws.String(value)
+}
+
+func optionalInt32(value int32) *int32 {
+	if value == 0 {
+		return nil
+	}
+	return aws.Int32(value)
+}
@@ -0,0 +1,376 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"infra-operator/internal/domain/autoscaling"
+)
+
+// tagResourceType is the resource type of Auto Scaling group tags
+const tagResourceType = "auto-scaling-group"
+
+type Repository struct {
+	client *awsautoscaling.Client
+}
+
+func NewRepository(cfg aws.Config) *Repository {
+	return &Repository{
+		client: awsautoscaling.NewFromConfig(cfg),
+	}
+}
+
+// GetGroup retrieves an Auto Scaling group by name with its instances and
+// target groups, or nil if it does not exist
+func (r *Repository) GetGroup(ctx context.Context, name string) (*autoscaling.Group, error) {
+	output, err := r.client.DescribeAutoScalingGroups(ctx, &awsautoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe auto scaling group: %w", err)
+	}
+	if len(output.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+
+	data := output.AutoScalingGroups[0]
+	group := &autoscaling.Group{
+		Name:                   aws.ToString(data.AutoScalingGroupName),
+		ARN:                    aws.ToString(data.AutoScalingGroupARN),
+		MinSize:                aws.ToInt32(data.MinSize),
+		MaxSize:                aws.ToInt32(data.MaxSize),
+		CurrentCapacity:        aws.ToInt32(data.DesiredCapacity),
+		CapacityRebalance:      aws.ToBool(data.CapacityRebalance),
+		HealthCheckType:        aws.ToString(data.HealthCheckType),
+		HealthCheckGracePeriod: data.HealthCheckGracePeriod,
+		TargetGroupARNs:        data.TargetGroupARNs,
+		Status:                 aws.ToString(data.Status),
+		Tags:                   make(map[string]string),
+	}
+
+	if zones := aws.ToString(data.VPCZoneIdentifier); zones != "" {
+		group.SubnetIDs = strings.Split(zones, ",")
+	}
+
+	if data.LaunchTemplate != nil {
+		group.LaunchTemplate = convertLaunchTemplateSpecification(data.LaunchTemplate)
+	}
+	if policy := data.MixedInstancesPolicy; policy != nil {
+		group.MixedInstancesPolicy = &autoscaling.MixedInstancesPolicy{}
+		if lt := policy.LaunchTemplate; lt != nil {
+			if lt.LaunchTemplateSpecification != nil {
+				group.LaunchTemplate = convertLaunchTemplateSpecification(lt.LaunchTemplateSpecification)
+			}
+			for _, o := range lt.Overrides {
+				group.MixedInstancesPolicy.Overrides = append(group.MixedInstancesPolicy.Overrides, autoscaling.InstanceTypeOverride{
+					InstanceType:     aws.ToString(o.InstanceType),
+					WeightedCapacity: aws.ToString(o.WeightedCapacity),
+				})
+			}
+		}
+		if d := policy.InstancesDistribution; d != nil {
+			group.MixedInstancesPolicy.OnDemandBaseCapacity = d.OnDemandBaseCapacity
+			group.MixedInstancesPolicy.OnDemandPercentageAboveBaseCapacity = d.OnDemandPercentageAboveBaseCapacity
+			group.MixedInstancesPolicy.OnDemandAllocationStrategy = aws.ToString(d.OnDemandAllocationStrategy)
+			group.MixedInstancesPolicy.SpotAllocationStrategy = aws.ToString(d.SpotAllocationStrategy)
+			group.MixedInstancesPolicy.SpotMaxPrice = aws.ToString(d.SpotMaxPrice)
+		}
+	}
+
+	for _, instance := range data.Instances {
+		group.Instances = append(group.Instances, autoscaling.Instance{
+			ID:             aws.ToString(instance.InstanceId),
+			LifecycleState: string(instance.LifecycleState),
+			HealthStatus:   aws.ToString(instance.HealthStatus),
+		})
+	}
+
+	for _, tag := range data.Tags {
+		group.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return group, nil
+}
+
+// CreateGroup creates an Auto Scaling group with its lifecycle hooks, target groups and tags
+func (r *Repository) CreateGroup(ctx context.Context, group *autoscaling.Group) error {
+	input := &awsautoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(group.Name),
+		MinSize:                aws.Int32(group.MinSize),
+		MaxSize:                aws.Int32(group.MaxSize),
+		DesiredCapacity:        group.DesiredCapacity,
+		VPCZoneIdentifier:      aws.String(strings.Join(group.SubnetIDs, ",")),
+		CapacityRebalance:      aws.Bool(group.CapacityRebalance),
+		HealthCheckType:        aws.String(group.HealthCheckType),
+		HealthCheckGracePeriod: group.HealthCheckGracePeriod,
+		TargetGroupARNs:        group.TargetGroupARNs,
+	}
+	input.LaunchTemplate, input.MixedInstancesPolicy = convertLaunchConfiguration(group)
+
+	for _, hook := range group.LifecycleHooks {
+		spec := types.LifecycleHookSpecification{
+			LifecycleHookName:   aws.String(hook.Name),
+			LifecycleTransition: aws.String(hook.Transition),
+			HeartbeatTimeout:    aws.Int32(hook.HeartbeatTimeout),
+			DefaultResult:       aws.String(hook.DefaultResult),
+		}
+		if hook.NotificationTargetARN != "" {
+			spec.NotificationTargetARN = aws.String(hook.NotificationTargetARN)
+			spec.RoleARN = aws.String(hook.RoleARN)
+		}
+		if hook.NotificationMetadata != "" {
+			spec.NotificationMetadata = aws.String(hook.NotificationMetadata)
+		}
+		input.LifecycleHookSpecificationList = append(input.LifecycleHookSpecificationList, spec)
+	}
+
+	input.Tags = convertTags(group.Name, group.Tags)
+
+	if _, err := r.client.CreateAutoScalingGroup(ctx, input); err != nil {
+		return fmt.Errorf("failed to create auto scaling group: %w", err)
+	}
+	return nil
+}
+
+// UpdateGroup updates the capacity, subnets, launch template and health checks of a group
+func (r *Repository) UpdateGroup(ctx context.Context, group *autoscaling.Group) error {
+	input := &awsautoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(group.Name),
+		MinSize:                aws.Int32(group.MinSize),
+		MaxSize:                aws.Int32(group.MaxSize),
+		DesiredCapacity:        group.DesiredCapacity,
+		VPCZoneIdentifier:      aws.String(strings.Join(group.SubnetIDs, ",")),
+		CapacityRebalance:      aws.Bool(group.CapacityRebalance),
+		HealthCheckType:        aws.String(group.HealthCheckType),
+		HealthCheckGracePeriod: group.HealthCheckGracePeriod,
+	}
+	input.LaunchTemplate, input.MixedInstancesPolicy = convertLaunchConfiguration(group)
+
+	if _, err := r.client.UpdateAutoScalingGroup(ctx, input); err != nil {
+		return fmt.Errorf("failed to update auto scaling group: %w", err)
+	}
+	return nil
+}
+
+// DeleteGroup deletes an Auto Scaling group and terminates its instances
+func (r *Repository) DeleteGroup(ctx context.Context, name string) error {
+	_, err := r.client.DeleteAutoScalingGroup(ctx, &awsautoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		ForceDelete:          aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete auto scaling group: %w", err)
+	}
+	return nil
+}
+
+// AttachTargetGroups attaches target groups to a group
+func (r *Repository) AttachTargetGroups(ctx context.Context, name string, targetGroupARNs []string) error {
+	_, err := r.client.AttachLoadBalancerTargetGroups(ctx, &awsautoscaling.AttachLoadBalancerTargetGroupsInput{
+		AutoScalingGroupName: aws.String(name),
+		TargetGroupARNs:      targetGroupARNs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach target groups: %w", err)
+	}
+	return nil
+}
+
+// DetachTargetGroups detaches target groups from a group
+func (r *Repository) DetachTargetGroups(ctx context.Context, name string, targetGroupARNs []string) error {
+	_, err := r.client.DetachLoadBalancerTargetGroups(ctx, &awsautoscaling.DetachLoadBalancerTargetGroupsInput{
+		AutoScalingGroupName: aws.String(name),
+		TargetGroupARNs:      targetGroupARNs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to detach target groups: %w", err)
+	}
+	return nil
+}
+
+// ListLifecycleHooks lists the lifecycle hooks of a group
+func (r *Repository) ListLifecycleHooks(ctx context.Context, name string) ([]autoscaling.LifecycleHook, error) {
+	output, err := r.client.DescribeLifecycleHooks(ctx, &awsautoscaling.DescribeLifecycleHooksInput{
+		AutoScalingGroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe lifecycle hooks: %w", err)
+	}
+
+	hooks := make([]autoscaling.LifecycleHook, 0, len(output.LifecycleHooks))
+	for _, hook := range output.LifecycleHooks {
+		hooks = append(hooks, autoscaling.LifecycleHook{
+			Name:                  aws.ToString(hook.LifecycleHookName),
+			Transition:            aws.ToString(hook.LifecycleTransition),
+			HeartbeatTimeout:      aws.ToInt32(hook.HeartbeatTimeout),
+			DefaultResult:         aws.ToString(hook.DefaultResult),
+			NotificationTargetARN: aws.ToString(hook.NotificationTargetARN),
+			RoleARN:               aws.ToString(hook.RoleARN),
+			NotificationMetadata:  aws.ToString(hook.NotificationMetadata),
+		})
+	}
+	return hooks, nil
+}
+
+// PutLifecycleHook creates or updates a lifecycle hook
+func (r *Repository) PutLifecycleHook(ctx context.Context, name string, hook autoscaling.LifecycleHook) error {
+	input := &awsautoscaling.PutLifecycleHookInput{
+		AutoScalingGroupName: aws.String(name),
+		LifecycleHookName:    aws.String(hook.Name),
+		LifecycleTransition:  aws.String(hook.Transition),
+		HeartbeatTimeout:     aws.Int32(hook.HeartbeatTimeout),
+		DefaultResult:        aws.String(hook.DefaultResult),
+	}
+	if hook.NotificationTargetARN != "" {
+		input.NotificationTargetARN = aws.String(hook.NotificationTargetARN)
+		input.RoleARN = aws.String(hook.RoleARN)
+	}
+	if hook.NotificationMetadata != "" {
+		input.NotificationMetadata = aws.String(hook.NotificationMetadata)
+	}
+
+	if _, err := r.client.PutLifecycleHook(ctx, input); err != nil {
+		return fmt.Errorf("failed to put lifecycle hook %s: %w", hook.Name, err)
+	}
+	return nil
+}
+
+// DeleteLifecycleHook deletes a lifecycle hook
+func (r *Repository) DeleteLifecycleHook(ctx context.Context, name, hookName string) error {
+	_, err := r.client.DeleteLifecycleHook(ctx, &awsautoscaling.DeleteLifecycleHookInput{
+		AutoScalingGroupName: aws.String(name),
+		LifecycleHookName:    aws.String(hookName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete lifecycle hook %s: %w", hookName, err)
+	}
+	return nil
+}
+
+// StartInstanceRefresh starts replacing the instances of a group and returns the refresh ID
+func (r *Repository) StartInstanceRefresh(ctx context.Context, name string, preferences *autoscaling.RefreshPreferences) (string, error) {
+	input := &awsautoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             types.RefreshStrategyRolling,
+	}
+	if preferences != nil {
+		input.Preferences = &types.RefreshPreferences{
+			MinHealthyPercentage: preferences.MinHealthyPercentage,
+			InstanceWarmup:       preferences.InstanceWarmup,
+			SkipMatching:         aws.Bool(preferences.SkipMatching),
+		}
+	}
+
+	output, err := r.client.StartInstanceRefresh(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to start instance refresh: %w", err)
+	}
+	return aws.ToString(output.InstanceRefreshId), nil
+}
+
+// GetLatestInstanceRefresh retrieves the most recent instance refresh, or nil if there is none
+func (r *Repository) GetLatestInstanceRefresh(ctx context.Context, name string) (*autoscaling.Refresh, error) {
+	output, err := r.client.DescribeInstanceRefreshes(ctx, &awsautoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance refreshes: %w", err)
+	}
+	if len(output.InstanceRefreshes) == 0 {
+		return nil, nil
+	}
+
+	data := output.InstanceRefreshes[0]
+	return &autoscaling.Refresh{
+		ID:                 aws.ToString(data.InstanceRefreshId),
+		Status:             string(data.Status),
+		StatusReason:       aws.ToString(data.StatusReason),
+		PercentageComplete: aws.ToInt32(data.PercentageComplete),
+		InstancesToUpdate:  aws.ToInt32(data.InstancesToUpdate),
+		StartTime:          data.StartTime,
+		EndTime:            data.EndTime,
+	}, nil
+}
+
+// TagResource creates or updates the tags of a group, propagated to new instances
+func (r *Repository) TagResource(ctx context.Context, name string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.CreateOrUpdateTags(ctx, &awsautoscaling.CreateOrUpdateTagsInput{
+		Tags: convertTags(name, tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag auto scaling group: %w", err)
+	}
+	return nil
+}
+
+// convertLaunchConfiguration returns the launch template of the group, or a
+// mixed instances policy wrapping it when the group has one
+func convertLaunchConfiguration(group *autoscaling.Group) (*types.LaunchTemplateSpecification, *types.MixedInstancesPolicy) {
+	spec := &types.LaunchTemplateSpecification{
+		LaunchTemplateId: aws.String(group.LaunchTemplate.ID),
+		Version:          aws.String(group.LaunchTemplate.Version),
+	}
+
+	policy := group.MixedInstancesPolicy
+	if policy == nil {
+		return spec, nil
+	}
+
+	mixed := &types.MixedInstancesPolicy{
+		LaunchTemplate: &types.LaunchTemplate{
+			LaunchTemplateSpecification: spec,
+		},
+		InstancesDistribution: &types.InstancesDistribution{
+			OnDemandBaseCapacity:                policy.OnDemandBaseCapacity,
+			OnDemandPercentageAboveBaseCapacity: policy.OnDemandPercentageAboveBaseCapacity,
+		},
+	}
+	for _, o := range policy.Overrides {
+		override := types.LaunchTemplateOverrides{InstanceType: aws.String(o.InstanceType)}
+		if o.WeightedCapacity != "" {
+			override.WeightedCapacity = aws.String(o.WeightedCapacity)
+		}
+		mixed.LaunchTemplate.Overrides = append(mixed.LaunchTemplate.Overrides, override)
+	}
+	if policy.OnDemandAllocationStrategy != "" {
+		mixed.InstancesDistribution.OnDemandAllocationStrategy = aws.String(policy.OnDemandAllocationStrategy)
+	}
+	if policy.SpotAllocationStrategy != "" {
+		mixed.InstancesDistribution.SpotAllocationStrategy = aws.String(policy.SpotAllocationStrategy)
+	}
+	if policy.SpotMaxPrice != "" {
+		mixed.InstancesDistribution.SpotMaxPrice = aws.String(policy.SpotMaxPrice)
+	}
+	return nil, mixed
+}
+
+func convertLaunchTemplateSpecification(spec *types.LaunchTemplateSpecification) autoscaling.LaunchTemplateRef {
+	return autoscaling.LaunchTemplateRef{
+		ID:      aws.ToString(spec.LaunchTemplateId),
+		Version: aws.ToString(spec.Version),
+	}
+}
+
+func convertTags(name string, tags map[string]string) []types.Tag {
+	asgTags := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		asgTags = append(asgTags, types.Tag{
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String(tagResourceType),
+			Key:               aws.String(k),
+			Value:             aws.String(v),
+			PropagateAtLaunch: aws.Bool(true),
+		})
+	}
+	return asgTags
+}
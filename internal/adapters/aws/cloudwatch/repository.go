@@ -0,0 +1,61 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+
+	"infra-operator/internal/ports"
+)
+
+// Repository implements the CloudWatch alarm repository using AWS SDK
+type Repository struct {
+	client *awscloudwatch.Client
+}
+
+// NewRepository creates a new CloudWatch alarm repository
+func NewRepository(awsConfig aws.Config) ports.CloudWatchAlarmRepository {
+	var options []func(*awscloudwatch.Options)
+
+	// Support LocalStack endpoint override
+	if endpoint := os.Getenv("AWS_ENDPOINT_URL"); endpoint != "" {
+		options = append(options, func(o *awscloudwatch.Options) {
+			o.BaseEndpoint = aws.String(endpoint)
+		})
+	}
+
+	return &Repository{
+		client: awscloudwatch.NewFromConfig(awsConfig, options...),
+	}
+}
+
+// FiringAlarm returns the first of the named alarms in ALARM state
+func (r *Repository) FiringAlarm(ctx context.Context, alarmNames []string) (string, error) {
+	if len(alarmNames) == 0 {
+		return "", nil
+	}
+
+	paginator := awscloudwatch.NewDescribeAlarmsPaginator(r.client, &awscloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
+		StateValue: types.StateValueAlarm,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to describe alarms: %w", err)
+		}
+		for _, alarm := range output.MetricAlarms {
+			return aws.ToString(alarm.AlarmName), nil
+		}
+		for _, alarm := range output.CompositeAlarms {
+			return aws.ToString(alarm.AlarmName), nil
+		}
+	}
+
+	return "", nil
+}
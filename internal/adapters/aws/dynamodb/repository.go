@@ -97,6 +97,10 @@ func (r *Repository) Create(ctx context.Context, table *dynamodb.Table) error {
 		BillingMode:          types.BillingMode(table.BillingMode),
 	}
 
+	if table.BillingMode == dynamodb.BillingModeProvisioned {
+		input.ProvisionedThroughput = toAWSThroughput(table.Throughput)
+	}
+
 	// Add GSIs if any
 	if len(table.GlobalSecondaryIndexes) > 0 {
 		var gsis []types.GlobalSecondaryIndex
@@ -124,9 +128,10 @@ func (r *Repository) Create(ctx context.Context, table *dynamodb.Table) error {
 			}
 
 			gsis = append(gsis, types.GlobalSecondaryIndex{
-				IndexName:  aws.String(gsi.IndexName),
-				KeySchema:  gsiKeySchema,
-				Projection: projection,
+				IndexName:             aws.String(gsi.IndexName),
+				KeySchema:             gsiKeySchema,
+				Projection:            projection,
+				ProvisionedThroughput: toAWSThroughput(gsi.Throughput),
 			})
 		}
 		input.GlobalSecondaryIndexes = gsis
@@ -209,18 +214,121 @@ func (r *Repository) Get(ctx context.Context, tableName string) (*dynamodb.Table
 		table.TableSizeBytes = *output.Table.TableSizeBytes
 	}
 
+	// Get provisioned capacity
+	if table.BillingMode == dynamodb.BillingModeProvisioned {
+		table.Throughput = fromAWSThroughput(output.Table.ProvisionedThroughput)
+	}
+
+	// Get global secondary indexes
+	for _, gsi := range output.Table.GlobalSecondaryIndexes {
+		index := dynamodb.GlobalSecondaryIndex{
+			IndexName: aws.ToString(gsi.IndexName),
+			Status:    string(gsi.IndexStatus),
+		}
+		for _, key := range gsi.KeySchema {
+			if key.KeyType == types.KeyTypeHash {
+				index.HashKey = aws.ToString(key.AttributeName)
+			} else if key.KeyType == types.KeyTypeRange {
+				index.RangeKey = aws.ToString(key.AttributeName)
+			}
+		}
+		if gsi.Projection != nil {
+			index.ProjectionType = string(gsi.Projection.ProjectionType)
+			index.NonKeyAttributes = gsi.Projection.NonKeyAttributes
+		}
+		if table.BillingMode == dynamodb.BillingModeProvisioned {
+			index.Throughput = fromAWSThroughput(gsi.ProvisionedThroughput)
+		}
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, index)
+	}
+
 	// Get stream ARN
 	if output.Table.LatestStreamArn != nil {
 		table.StreamARN = *output.Table.LatestStreamArn
 		table.StreamEnabled = true
 	}
 
+	// Get TTL
+	ttl, err := r.client.DescribeTimeToLive(ctx, &awsddb.DescribeTimeToLiveInput{
+		TableName: aws.String(tableName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe time to live: %w", err)
+	}
+	if desc := ttl.TimeToLiveDescription; desc != nil && desc.AttributeName != nil {
+		status := desc.TimeToLiveStatus
+		table.TimeToLive = &dynamodb.TimeToLive{
+			AttributeName: aws.ToString(desc.AttributeName),
+			Enabled:       status == types.TimeToLiveStatusEnabled || status == types.TimeToLiveStatusEnabling,
+			Status:        string(status),
+		}
+	}
+
 	return table, nil
 }
 
-// Update updates table configuration (placeholder)
-func (r *Repository) Update(ctx context.Context, table *dynamodb.Table) error {
-	// DynamoDB table updates are limited - mainly tags and streaming
+// Update applies a single UpdateTable call
+func (r *Repository) Update(ctx context.Context, tableName string, update dynamodb.TableUpdate) error {
+	input := &awsddb.UpdateTableInput{
+		TableName: aws.String(tableName),
+	}
+
+	if update.BillingMode != "" {
+		input.BillingMode = types.BillingMode(update.BillingMode)
+	}
+	if update.Throughput != nil {
+		input.ProvisionedThroughput = toAWSThroughput(update.Throughput)
+	}
+
+	for name, throughput := range update.IndexThroughput {
+		throughput := throughput
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, types.GlobalSecondaryIndexUpdate{
+			Update: &types.UpdateGlobalSecondaryIndexAction{
+				IndexName:             aws.String(name),
+				ProvisionedThroughput: toAWSThroughput(&throughput),
+			},
+		})
+	}
+
+	if update.DeleteIndex != "" {
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, types.GlobalSecondaryIndexUpdate{
+			Delete: &types.DeleteGlobalSecondaryIndexAction{IndexName: aws.String(update.DeleteIndex)},
+		})
+	}
+
+	if gsi := update.CreateIndex; gsi != nil {
+		keySchema := []types.KeySchemaElement{
+			{AttributeName: aws.String(gsi.HashKey), KeyType: types.KeyTypeHash},
+		}
+		if gsi.RangeKey != "" {
+			keySchema = append(keySchema, types.KeySchemaElement{AttributeName: aws.String(gsi.RangeKey), KeyType: types.KeyTypeRange})
+		}
+
+		projection := &types.Projection{ProjectionType: types.ProjectionType(gsi.ProjectionType)}
+		if gsi.ProjectionType == "INCLUDE" && len(gsi.NonKeyAttributes) > 0 {
+			projection.NonKeyAttributes = gsi.NonKeyAttributes
+		}
+
+		input.GlobalSecondaryIndexUpdates = append(input.GlobalSecondaryIndexUpdates, types.GlobalSecondaryIndexUpdate{
+			Create: &types.CreateGlobalSecondaryIndexAction{
+				IndexName:             aws.String(gsi.IndexName),
+				KeySchema:             keySchema,
+				Projection:            projection,
+				ProvisionedThroughput: toAWSThroughput(gsi.Throughput),
+			},
+		})
+
+		for _, attr := range update.Attributes {
+			input.AttributeDefinitions = append(input.AttributeDefinitions, types.AttributeDefinition{
+				AttributeName: aws.String(attr.Name),
+				AttributeType: types.ScalarAttributeType(attr.Type),
+			})
+		}
+	}
+
+	if _, err := r.client.UpdateTable(ctx, input); err != nil {
+		return fmt.Errorf("failed to update table: %w", err)
+	}
 	return nil
 }
 
@@ -292,3 +400,23 @@ func (r *Repository) TagResource(ctx context.Context, tableARN string, tags map[
 
 	return err
 }
+
+func toAWSThroughput(tp *dynamodb.Throughput) *types.ProvisionedThroughput {
+	if tp == nil {
+		return nil
+	}
+	return &types.ProvisionedThroughput{
+		ReadCapacityUnits:  aws.Int64(tp.ReadCapacityUnits),
+		WriteCapacityUnits: aws.Int64(tp.WriteCapacityUnits),
+	}
+}
+
+func fromAWSThroughput(tp *types.ProvisionedThroughputDescription) *dynamodb.Throughput {
+	if tp == nil {
+		return nil
+	}
+	return &dynamodb.Throughput{
+		ReadCapacityUnits:  aws.ToInt64(tp.ReadCapacityUnits),
+		WriteCapacityUnits: aws.ToInt64(tp.WriteCapacityUnits),
+	}
+}
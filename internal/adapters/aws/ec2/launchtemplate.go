@@ -0,0 +1,274 @@
+package ec2
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"infra-operator/internal/domain/ec2"
+)
+
+// launchTemplateNotFoundCode is returned when no launch template has the requested name
+const launchTemplateNotFoundCode = "InvalidLaunchTemplateName.NotFoundException"
+
+// GetLaunchTemplate retrieves a launch template by name with the data of its
+// latest version, or nil if it does not exist
+func (r *Repository) GetLaunchTemplate(ctx context.Context, name string) (*ec2.LaunchTemplate, error) {
+	output, err := r.client.DescribeLaunchTemplates(ctx, &awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []string{name},
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == launchTemplateNotFoundCode {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to describe launch template: %w", err)
+	}
+	if len(output.LaunchTemplates) == 0 {
+		return nil, nil
+	}
+
+	data := output.LaunchTemplates[0]
+	lt := &ec2.LaunchTemplate{
+		Name:           aws.ToString(data.LaunchTemplateName),
+		ID:             aws.ToString(data.LaunchTemplateId),
+		LatestVersion:  aws.ToInt64(data.LatestVersionNumber),
+		DefaultVersion: aws.ToInt64(data.DefaultVersionNumber),
+		Tags:           make(map[string]string),
+	}
+	for _, tag := range data.Tags {
+		lt.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	versions, err := r.client.DescribeLaunchTemplateVersions(ctx, &awsec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: data.LaunchTemplateId,
+		Versions:         []string{strconv.FormatInt(lt.LatestVersion, 10)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe launch template versions: %w", err)
+	}
+	if len(versions.LaunchTemplateVersions) > 0 {
+		version := versions.LaunchTemplateVersions[0]
+		lt.VersionDescription = aws.ToString(version.VersionDescription)
+		if version.LaunchTemplateData != nil {
+			lt.Data = convertResponseLaunchTemplateData(version.LaunchTemplateData)
+		}
+	}
+
+	return lt, nil
+}
+
+// CreateLaunchTemplate creates a launch template with its first version
+func (r *Repository) CreateLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error {
+	input := &awsec2.CreateLaunchTemplateInput{
+		LaunchTemplateName: aws.String(lt.Name),
+		LaunchTemplateData: convertLaunchTemplateData(lt.Data),
+	}
+	if lt.VersionDescription != "" {
+		input.VersionDescription = aws.String(lt.VersionDescription)
+	}
+	if len(lt.Tags) > 0 {
+		input.TagSpecifications = []types.TagSpecification{
+			{
+				ResourceType: types.ResourceTypeLaunchTemplate,
+				Tags:         convertTags(lt.Tags),
+			},
+		}
+	}
+
+	output, err := r.client.CreateLaunchTemplate(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to create launch template: %w", err)
+	}
+
+	lt.ID = aws.ToString(output.LaunchTemplate.LaunchTemplateId)
+	lt.LatestVersion = aws.ToInt64(output.LaunchTemplate.LatestVersionNumber)
+	lt.DefaultVersion = aws.ToInt64(output.LaunchTemplate.DefaultVersionNumber)
+	return nil
+}
+
+// CreateLaunchTemplateVersion creates a version with the launch template data
+// and returns its number
+func (r *Repository) CreateLaunchTemplateVersion(ctx context.Context, lt *ec2.LaunchTemplate) (int64, error) {
+	input := &awsec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   aws.String(lt.ID),
+		LaunchTemplateData: convertLaunchTemplateData(lt.Data),
+	}
+	if lt.VersionDescription != "" {
+		input.VersionDescription = aws.String(lt.VersionDescription)
+	}
+
+	output, err := r.client.CreateLaunchTemplateVersion(ctx, input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create launch template version: %w", err)
+	}
+	return aws.ToInt64(output.LaunchTemplateVersion.VersionNumber), nil
+}
+
+// SetDefaultVersion makes a version the default version of the launch template
+func (r *Repository) SetDefaultVersion(ctx context.Context, launchTemplateID string, version int64) error {
+	_, err := r.client.ModifyLaunchTemplate(ctx, &awsec2.ModifyLaunchTemplateInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+		DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set default launch template version: %w", err)
+	}
+	return nil
+}
+
+// DeleteLaunchTemplate deletes a launch template and all its versions
+func (r *Repository) DeleteLaunchTemplate(ctx context.Context, launchTemplateID string) error {
+	_, err := r.client.DeleteLaunchTemplate(ctx, &awsec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && strings.HasPrefix(apiErr.ErrorCode(), "InvalidLaunchTemplateId.NotFound") {
+			return nil
+		}
+		return fmt.Errorf("failed to delete launch template: %w", err)
+	}
+	return nil
+}
+
+// TagLaunchTemplate tags a launch template
+func (r *Repository) TagLaunchTemplate(ctx context.Context, launchTemplateID string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{launchTemplateID},
+		Tags:      convertTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag launch template: %w", err)
+	}
+	return nil
+}
+
+func convertLaunchTemplateData(data ec2.LaunchTemplateData) *types.RequestLaunchTemplateData {
+	request := &types.RequestLaunchTemplateData{
+		ImageId: aws.String(data.ImageID),
+	}
+
+	if data.InstanceType != "" {
+		request.InstanceType = types.InstanceType(data.InstanceType)
+	}
+	if data.KeyName != "" {
+		request.KeyName = aws.String(data.KeyName)
+	}
+	if len(data.SecurityGroupIDs) > 0 {
+		request.SecurityGroupIds = data.SecurityGroupIDs
+	}
+	if data.IAMInstanceProfile != "" {
+		request.IamInstanceProfile = &types.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+		if strings.HasPrefix(data.IAMInstanceProfile, "arn:") {
+			request.IamInstanceProfile.Arn = aws.String(data.IAMInstanceProfile)
+		} else {
+			request.IamInstanceProfile.Name = aws.String(data.IAMInstanceProfile)
+		}
+	}
+	if data.UserData != "" {
+		request.UserData = aws.String(base64.StdEncoding.EncodeToString([]byte(data.UserData)))
+	}
+	if data.Monitoring {
+		request.Monitoring = &types.LaunchTemplatesMonitoringRequest{Enabled: aws.Bool(true)}
+	}
+	if data.EBSOptimized {
+		request.EbsOptimized = aws.Bool(true)
+	}
+
+	for _, m := range data.BlockDeviceMappings {
+		mapping := types.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName: aws.String(m.DeviceName),
+		}
+		if m.EBS != nil {
+			mapping.Ebs = &types.LaunchTemplateEbsBlockDeviceRequest{
+				VolumeSize:          aws.Int32(m.EBS.VolumeSize),
+				VolumeType:          types.VolumeType(m.EBS.VolumeType),
+				DeleteOnTermination: aws.Bool(m.EBS.DeleteOnTermination),
+				Encrypted:           aws.Bool(m.EBS.Encrypted),
+			}
+			if m.EBS.IOPS > 0 {
+				mapping.Ebs.Iops = aws.Int32(m.EBS.IOPS)
+			}
+			if m.EBS.KMSKeyID != "" {
+				mapping.Ebs.KmsKeyId = aws.String(m.EBS.KMSKeyID)
+			}
+		}
+		request.BlockDeviceMappings = append(request.BlockDeviceMappings, mapping)
+	}
+
+	if len(data.InstanceTags) > 0 {
+		tags := convertTags(data.InstanceTags)
+		request.TagSpecifications = []types.LaunchTemplateTagSpecificationRequest{
+			{ResourceType: types.ResourceTypeInstance, Tags: tags},
+			{ResourceType: types.ResourceTypeVolume, Tags: tags},
+		}
+	}
+
+	return request
+}
+
+func convertResponseLaunchTemplateData(response *types.ResponseLaunchTemplateData) ec2.LaunchTemplateData {
+	data := ec2.LaunchTemplateData{
+		ImageID:          aws.ToString(response.ImageId),
+		InstanceType:     string(response.InstanceType),
+		KeyName:          aws.ToString(response.KeyName),
+		SecurityGroupIDs: response.SecurityGroupIds,
+		EBSOptimized:     aws.ToBool(response.EbsOptimized),
+	}
+
+	if profile := response.IamInstanceProfile; profile != nil {
+		data.IAMInstanceProfile = aws.ToString(profile.Arn)
+		if data.IAMInstanceProfile == "" {
+			data.IAMInstanceProfile = aws.ToString(profile.Name)
+		}
+	}
+	if response.UserData != nil {
+		if decoded, err := base64.StdEncoding.DecodeString(aws.ToString(response.UserData)); err == nil {
+			data.UserData = string(decoded)
+		}
+	}
+	if response.Monitoring != nil {
+		data.Monitoring = aws.ToBool(response.Monitoring.Enabled)
+	}
+
+	for _, m := range response.BlockDeviceMappings {
+		mapping := ec2.BlockDeviceMapping{DeviceName: aws.ToString(m.DeviceName)}
+		if m.Ebs != nil {
+			mapping.EBS = &ec2.EBSBlockDevice{
+				VolumeSize:          aws.ToInt32(m.Ebs.VolumeSize),
+				VolumeType:          string(m.Ebs.VolumeType),
+				IOPS:                aws.ToInt32(m.Ebs.Iops),
+				DeleteOnTermination: aws.ToBool(m.Ebs.DeleteOnTermination),
+				Encrypted:           aws.ToBool(m.Ebs.Encrypted),
+				KMSKeyID:            aws.ToString(m.Ebs.KmsKeyId),
+			}
+		}
+		data.BlockDeviceMappings = append(data.BlockDeviceMappings, mapping)
+	}
+
+	// Instance and volume tags are written together, so the instance ones are enough
+	for _, spec := range response.TagSpecifications {
+		if spec.ResourceType != types.ResourceTypeInstance {
+			continue
+		}
+		data.InstanceTags = make(map[string]string, len(spec.Tags))
+		for _, tag := range spec.Tags {
+			data.InstanceTags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
+	return data
+}
@@ -161,6 +161,22 @@ func (r *Repository) Get(ctx context.Context, instanceID string) (*ec2.Instance,
 		instance.LaunchTime = &t
 	}
 
+	if inst.Monitoring != nil {
+		instance.Monitoring = inst.Monitoring.State == types.MonitoringStateEnabled || inst.Monitoring.State == types.MonitoringStatePending
+	}
+
+	// Termination protection is only returned as an instance attribute
+	attr, err := r.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Attribute:  types.InstanceAttributeNameDisableApiTermination,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance attribute: %w", err)
+	}
+	if attr.DisableApiTermination != nil {
+		instance.DisableAPITermination = aws.ToBool(attr.DisableApiTermination.Value)
+	}
+
 	// Extract security groups
 	for _, sg := range inst.SecurityGroups {
 		instance.SecurityGroupIDs = append(instance.SecurityGroupIDs, aws.ToString(sg.GroupId))
@@ -215,6 +231,56 @@ func (r *Repository) TerminateInstance(ctx context.Context, instanceID string) e
 	return nil
 }
 
+func (r *Repository) ModifyInstanceType(ctx context.Context, instanceID, instanceType string) error {
+	_, err := r.client.ModifyInstanceAttribute(ctx, &awsec2.ModifyInstanceAttributeInput{
+		InstanceId:   aws.String(instanceID),
+		InstanceType: &types.AttributeValue{Value: aws.String(instanceType)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify instance type: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ModifySecurityGroups(ctx context.Context, instanceID string, groupIDs []string) error {
+	_, err := r.client.ModifyInstanceAttribute(ctx, &awsec2.ModifyInstanceAttributeInput{
+		InstanceId: aws.String(instanceID),
+		Groups:     groupIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify instance security groups: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) ModifyAPITermination(ctx context.Context, instanceID string, disable bool) error {
+	_, err := r.client.ModifyInstanceAttribute(ctx, &awsec2.ModifyInstanceAttributeInput{
+		InstanceId:            aws.String(instanceID),
+		DisableApiTermination: &types.AttributeBooleanValue{Value: aws.Bool(disable)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify instance termination protection: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) SetMonitoring(ctx context.Context, instanceID string, enabled bool) error {
+	var err error
+	if enabled {
+		_, err = r.client.MonitorInstances(ctx, &awsec2.MonitorInstancesInput{
+			InstanceIds: []string{instanceID},
+		})
+	} else {
+		_, err = r.client.UnmonitorInstances(ctx, &awsec2.UnmonitorInstancesInput{
+			InstanceIds: []string{instanceID},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set instance monitoring: %w", err)
+	}
+	return nil
+}
+
 func (r *Repository) TagResource(ctx context.Context, instanceID string, tags map[string]string) error {
 	if len(tags) == 0 {
 		return nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -98,6 +99,18 @@ func (r *Repository) Get(ctx context.Context, roleName string) (*iam.Role, error
 		Path:                     aws.ToString(output.Role.Path),
 	}
 
+	// GetRole returns the trust policy URL-encoded
+	if doc, err := url.QueryUnescape(role.AssumeRolePolicyDocument); err == nil {
+		role.AssumeRolePolicyDocument = doc
+	}
+
+	if len(output.Role.Tags) > 0 {
+		role.Tags = make(map[string]string, len(output.Role.Tags))
+		for _, tag := range output.Role.Tags {
+			role.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
 	if output.Role.PermissionsBoundary != nil {
 		role.PermissionsBoundary = aws.ToString(output.Role.PermissionsBoundary.PermissionsBoundaryArn)
 	}
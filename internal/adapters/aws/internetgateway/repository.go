@@ -71,6 +71,7 @@ func (r *Repository) Get(ctx context.Context, igwID string) (*internetgateway.Ga
 	igw := output.InternetGateways[0]
 	g := &internetgateway.Gateway{
 		InternetGatewayID: aws.ToString(igw.InternetGatewayId),
+		Tags:              make(map[string]string),
 	}
 
 	if len(igw.Attachments) > 0 {
@@ -78,6 +79,10 @@ func (r *Repository) Get(ctx context.Context, igwID string) (*internetgateway.Ga
 		g.State = string(igw.Attachments[0].State)
 	}
 
+	for _, tag := range igw.Tags {
+		g.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
 	return g, nil
 }
 
@@ -0,0 +1,136 @@
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"infra-operator/internal/domain/lambda"
+	"infra-operator/internal/ports"
+)
+
+// AliasRepository implements the LambdaAliasRepository interface using AWS SDK
+type AliasRepository struct {
+	client *awslambda.Client
+}
+
+// NewAliasRepository creates a new Lambda alias repository
+func NewAliasRepository(awsConfig aws.Config) ports.LambdaAliasRepository {
+	var options []func(*awslambda.Options)
+
+	// Support LocalStack endpoint
+	if endpoint := os.Getenv("AWS_ENDPOINT_URL"); endpoint != "" {
+		options = append(options, func(o *awslambda.Options) {
+			o.BaseEndpoint = aws.String(endpoint)
+		})
+	}
+
+	return &AliasRepository{
+		client: awslambda.NewFromConfig(awsConfig, options...),
+	}
+}
+
+// Get retrieves an alias, or nil if it does not exist
+func (r *AliasRepository) Get(ctx context.Context, functionName, name string) (*lambda.Alias, error) {
+	output, err := r.client.GetAlias(ctx, &awslambda.GetAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String(name),
+	})
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+
+	alias := &lambda.Alias{
+		FunctionName:    functionName,
+		Name:            aws.ToString(output.Name),
+		ARN:             aws.ToString(output.AliasArn),
+		Description:     aws.ToString(output.Description),
+		FunctionVersion: aws.ToString(output.FunctionVersion),
+		RevisionID:      aws.ToString(output.RevisionId),
+	}
+	if output.RoutingConfig != nil {
+		for version, weight := range output.RoutingConfig.AdditionalVersionWeights {
+			alias.Routing = &lambda.AliasRouting{Version: version, Weight: int32(math.Round(weight * 100))}
+		}
+	}
+
+	return alias, nil
+}
+
+// Create creates an alias pointing to version
+func (r *AliasRepository) Create(ctx context.Context, alias *lambda.Alias, version string, routing *lambda.AliasRouting) error {
+	output, err := r.client.CreateAlias(ctx, &awslambda.CreateAliasInput{
+		FunctionName:    aws.String(alias.FunctionName),
+		Name:            aws.String(alias.Name),
+		FunctionVersion: aws.String(version),
+		Description:     aws.String(alias.Description),
+		RoutingConfig:   routingConfig(routing),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create alias: %w", err)
+	}
+
+	alias.ARN = aws.ToString(output.AliasArn)
+	alias.RevisionID = aws.ToString(output.RevisionId)
+	return nil
+}
+
+// Update points an alias to version. The revision read last guards against
+// overwriting a concurrent change.
+func (r *AliasRepository) Update(ctx context.Context, alias *lambda.Alias, version string, routing *lambda.AliasRouting) error {
+	input := &awslambda.UpdateAliasInput{
+		FunctionName:    aws.String(alias.FunctionName),
+		Name:            aws.String(alias.Name),
+		FunctionVersion: aws.String(version),
+		Description:     aws.String(alias.Description),
+		RoutingConfig:   routingConfig(routing),
+	}
+	if alias.RevisionID != "" {
+		input.RevisionId = aws.String(alias.RevisionID)
+	}
+
+	output, err := r.client.UpdateAlias(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to update alias: %w", err)
+	}
+
+	alias.RevisionID = aws.ToString(output.RevisionId)
+	return nil
+}
+
+// Delete deletes an alias
+func (r *AliasRepository) Delete(ctx context.Context, functionName, name string) error {
+	_, err := r.client.DeleteAlias(ctx, &awslambda.DeleteAliasInput{
+		FunctionName: aws.String(functionName),
+		Name:         aws.String(name),
+	})
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	return nil
+}
+
+// routingConfig converts the routing to weights; an empty configuration
+// removes the routing of an existing alias
+func routingConfig(routing *lambda.AliasRouting) *types.AliasRoutingConfiguration {
+	weights := map[string]float64{}
+	if routing != nil {
+		weights[routing.Version] = float64(routing.Weight) / 100
+	}
+	return &types.AliasRoutingConfiguration{AdditionalVersionWeights: weights}
+}
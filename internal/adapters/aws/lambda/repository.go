@@ -2,7 +2,6 @@ package lambda
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
@@ -16,6 +15,9 @@ import (
 	"infra-operator/internal/ports"
 )
 
+// lastModifiedLayout is the format of LastModified in Lambda responses
+const lastModifiedLayout = "2006-01-02T15:04:05.000-0700"
+
 // Repository implements the LambdaRepository interface using AWS SDK
 type Repository struct {
 	client *awslambda.Client
@@ -112,7 +114,7 @@ func (r *Repository) Create(ctx context.Context, function *lambda.Function) erro
 	function.Version = aws.ToString(output.Version)
 	function.CodeSize = output.CodeSize
 	if output.LastModified != nil {
-		if t, err := time.Parse(time.RFC3339, *output.LastModified); err == nil {
+		if t, err := time.Parse(lastModifiedLayout, *output.LastModified); err == nil {
 			function.LastModified = t
 		}
 	}
@@ -184,7 +186,7 @@ func (r *Repository) UpdateConfiguration(ctx context.Context, function *lambda.F
 	function.StateReason = aws.ToString(output.StateReason)
 	function.Version = aws.ToString(output.Version)
 	if output.LastModified != nil {
-		if t, err := time.Parse(time.RFC3339, *output.LastModified); err == nil {
+		if t, err := time.Parse(lastModifiedLayout, *output.LastModified); err == nil {
 			function.LastModified = t
 		}
 	}
@@ -198,13 +200,10 @@ func (r *Repository) UpdateCode(ctx context.Context, function *lambda.Function)
 		FunctionName: aws.String(function.Name),
 	}
 
-	// Set code source based on what's provided
-	if function.Code.ZipFile != "" {
-		decoded, err := base64.StdEncoding.DecodeString(function.Code.ZipFile)
-		if err != nil {
-			return fmt.Errorf("failed to decode zip file: %w", err)
-		}
-		input.ZipFile = decoded
+	// Set code source based on what's provided; inline code, files and OCI
+	// artifacts are packaged into Archive
+	if function.Code.Archive != nil {
+		input.ZipFile = function.Code.Archive
 	} else if function.Code.S3Bucket != "" {
 		input.S3Bucket = aws.String(function.Code.S3Bucket)
 		input.S3Key = aws.String(function.Code.S3Key)
@@ -224,7 +223,7 @@ func (r *Repository) UpdateCode(ctx context.Context, function *lambda.Function)
 	function.State = string(output.State)
 	function.CodeSize = output.CodeSize
 	if output.LastModified != nil {
-		if t, err := time.Parse(time.RFC3339, *output.LastModified); err == nil {
+		if t, err := time.Parse(lastModifiedLayout, *output.LastModified); err == nil {
 			function.LastModified = t
 		}
 	}
@@ -281,17 +280,62 @@ func (r *Repository) UntagFunction(ctx context.Context, functionARN string, tagK
 	return nil
 }
 
+// PublishVersion publishes the current code and configuration as a version.
+// Lambda returns the latest version instead when nothing changed since it was
+// published; codeSha256 guards against publishing code other than the one read.
+func (r *Repository) PublishVersion(ctx context.Context, functionName, codeSha256 string) (string, error) {
+	input := &awslambda.PublishVersionInput{
+		FunctionName: aws.String(functionName),
+	}
+	if codeSha256 != "" {
+		input.CodeSha256 = aws.String(codeSha256)
+	}
+
+	output, err := r.client.PublishVersion(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to publish version: %w", err)
+	}
+
+	return aws.ToString(output.Version), nil
+}
+
+// ListVersions lists the versions of a Lambda function, including $LATEST
+func (r *Repository) ListVersions(ctx context.Context, functionName string) ([]lambda.Version, error) {
+	var versions []lambda.Version
+
+	paginator := awslambda.NewListVersionsByFunctionPaginator(r.client, &awslambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions: %w", err)
+		}
+		for _, config := range output.Versions {
+			version := lambda.Version{
+				Version:     aws.ToString(config.Version),
+				CodeSha256:  aws.ToString(config.CodeSha256),
+				Description: aws.ToString(config.Description),
+			}
+			if config.LastModified != nil {
+				if t, err := time.Parse(lastModifiedLayout, *config.LastModified); err == nil {
+					version.LastModified = t
+				}
+			}
+			versions = append(versions, version)
+		}
+	}
+
+	return versions, nil
+}
+
 // Helper functions
 
 func (r *Repository) prepareFunctionCode(code lambda.Code) (*types.FunctionCode, error) {
 	functionCode := &types.FunctionCode{}
 
-	if code.ZipFile != "" {
-		decoded, err := base64.StdEncoding.DecodeString(code.ZipFile)
-		if err != nil {
-			return nil, fmt.Errorf("failed to decode zip file: %w", err)
-		}
-		functionCode.ZipFile = decoded
+	if code.Archive != nil {
+		functionCode.ZipFile = code.Archive
 	} else if code.S3Bucket != "" {
 		functionCode.S3Bucket = aws.String(code.S3Bucket)
 		functionCode.S3Key = aws.String(code.S3Key)
@@ -319,8 +363,10 @@ func (r *Repository) mapToFunction(config *types.FunctionConfiguration, tags map
 		StateReason: aws.ToString(config.StateReason),
 		Version:     aws.ToString(config.Version),
 		CodeSize:    config.CodeSize,
+		CodeSha256:  aws.ToString(config.CodeSha256),
 		Tags:        tags,
 	}
+	function.LastUpdateStatus = string(config.LastUpdateStatus)
 
 	// Map environment variables
 	if config.Environment != nil {
@@ -345,7 +391,7 @@ func (r *Repository) mapToFunction(config *types.FunctionConfiguration, tags map
 
 	// Parse LastModified
 	if config.LastModified != nil {
-		if t, err := time.Parse(time.RFC3339, *config.LastModified); err == nil {
+		if t, err := time.Parse(lastModifiedLayout, *config.LastModified); err == nil {
 			function.LastModified = t
 		}
 	}
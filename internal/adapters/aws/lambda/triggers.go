@@ -0,0 +1,254 @@
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"infra-operator/internal/domain/lambda"
+)
+
+// accountRootPattern matches the principal Lambda stores for an account ID
+var accountRootPattern = regexp.MustCompile(`^arn:aws[a-z-]*:iam::([0-9]{12}):root$`)
+
+// ListEventSourceMappings lists the event source mappings of a Lambda function
+func (r *Repository) ListEventSourceMappings(ctx context.Context, functionName string) ([]lambda.EventSourceMapping, error) {
+	var mappings []lambda.EventSourceMapping
+
+	paginator := awslambda.NewListEventSourceMappingsPaginator(r.client, &awslambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(functionName),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list event source mappings: %w", err)
+		}
+		for _, config := range output.EventSourceMappings {
+			mapping := lambda.EventSourceMapping{
+				EventSourceARN:                 aws.ToString(config.EventSourceArn),
+				BatchSize:                      aws.ToInt32(config.BatchSize),
+				MaximumBatchingWindowInSeconds: aws.ToInt32(config.MaximumBatchingWindowInSeconds),
+				StartingPosition:               string(config.StartingPosition),
+				MaximumRetryAttempts:           config.MaximumRetryAttempts,
+				UUID:                           aws.ToString(config.UUID),
+				State:                          aws.ToString(config.State),
+				StateTransitionReason:          aws.ToString(config.StateTransitionReason),
+			}
+			mapping.Enabled = mapping.State != "Disabled" && mapping.State != "Disabling"
+			if config.FilterCriteria != nil {
+				for _, filter := range config.FilterCriteria.Filters {
+					mapping.FilterPatterns = append(mapping.FilterPatterns, aws.ToString(filter.Pattern))
+				}
+			}
+			if config.DestinationConfig != nil && config.DestinationConfig.OnFailure != nil {
+				mapping.OnFailureDestinationARN = aws.ToString(config.DestinationConfig.OnFailure.Destination)
+			}
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	return mappings, nil
+}
+
+// CreateEventSourceMapping creates an event source mapping and sets its UUID and state
+func (r *Repository) CreateEventSourceMapping(ctx context.Context, functionName string, mapping *lambda.EventSourceMapping) error {
+	input := &awslambda.CreateEventSourceMappingInput{
+		FunctionName:   aws.String(functionName),
+		EventSourceArn: aws.String(mapping.EventSourceARN),
+		Enabled:        aws.Bool(mapping.Enabled),
+		FilterCriteria: filterCriteria(mapping.FilterPatterns),
+	}
+	if mapping.BatchSize > 0 {
+		input.BatchSize = aws.Int32(mapping.BatchSize)
+	}
+	if mapping.MaximumBatchingWindowInSeconds > 0 {
+		input.MaximumBatchingWindowInSeconds = aws.Int32(mapping.MaximumBatchingWindowInSeconds)
+	}
+	if mapping.IsStream() {
+		input.StartingPosition = types.EventSourcePosition(mapping.StartingPosition)
+		input.MaximumRetryAttempts = mapping.MaximumRetryAttempts
+		if mapping.OnFailureDestinationARN != "" {
+			input.DestinationConfig = onFailureDestination(mapping.OnFailureDestinationARN)
+		}
+	}
+
+	output, err := r.client.CreateEventSourceMapping(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to create event source mapping for %s: %w", mapping.EventSourceARN, err)
+	}
+
+	mapping.UUID = aws.ToString(output.UUID)
+	mapping.State = aws.ToString(output.State)
+	mapping.StateTransitionReason = aws.ToString(output.StateTransitionReason)
+	return nil
+}
+
+// UpdateEventSourceMapping updates the event source mapping identified by mapping.UUID.
+// The starting position cannot be changed once the mapping exists.
+func (r *Repository) UpdateEventSourceMapping(ctx context.Context, functionName string, mapping *lambda.EventSourceMapping) error {
+	input := &awslambda.UpdateEventSourceMappingInput{
+		UUID:                           aws.String(mapping.UUID),
+		FunctionName:                   aws.String(functionName),
+		Enabled:                        aws.Bool(mapping.Enabled),
+		MaximumBatchingWindowInSeconds: aws.Int32(mapping.MaximumBatchingWindowInSeconds),
+		FilterCriteria:                 filterCriteria(mapping.FilterPatterns),
+	}
+	if mapping.BatchSize > 0 {
+		input.BatchSize = aws.Int32(mapping.BatchSize)
+	}
+	if mapping.IsStream() {
+		input.MaximumRetryAttempts = mapping.MaximumRetryAttempts
+		// An empty destination removes the previous one
+		input.DestinationConfig = onFailureDestination(mapping.OnFailureDestinationARN)
+	}
+
+	output, err := r.client.UpdateEventSourceMapping(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to update event source mapping for %s: %w", mapping.EventSourceARN, err)
+	}
+
+	mapping.State = aws.ToString(output.State)
+	mapping.StateTransitionReason = aws.ToString(output.StateTransitionReason)
+	return nil
+}
+
+// DeleteEventSourceMapping deletes an event source mapping
+func (r *Repository) DeleteEventSourceMapping(ctx context.Context, uuid string) error {
+	_, err := r.client.DeleteEventSourceMapping(ctx, &awslambda.DeleteEventSourceMappingInput{
+		UUID: aws.String(uuid),
+	})
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete event source mapping %s: %w", uuid, err)
+	}
+
+	return nil
+}
+
+// functionPolicy is the part of a function policy that permissions are read from
+type functionPolicy struct {
+	Statement []struct {
+		Sid       string
+		Principal json.RawMessage
+		Action    string
+		Condition map[string]map[string]string
+	}
+}
+
+// ListPermissions lists the statements of the function policy
+func (r *Repository) ListPermissions(ctx context.Context, functionName string) ([]lambda.Permission, error) {
+	output, err := r.client.GetPolicy(ctx, &awslambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	})
+	if err != nil {
+		// A function without permissions has no policy
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get function policy: %w", err)
+	}
+
+	var policy functionPolicy
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse function policy: %w", err)
+	}
+
+	permissions := make([]lambda.Permission, 0, len(policy.Statement))
+	for _, statement := range policy.Statement {
+		permissions = append(permissions, lambda.Permission{
+			StatementID:   statement.Sid,
+			Principal:     principal(statement.Principal),
+			Action:        statement.Action,
+			SourceARN:     statement.Condition["ArnLike"]["AWS:SourceArn"],
+			SourceAccount: statement.Condition["StringEquals"]["AWS:SourceAccount"],
+		})
+	}
+
+	return permissions, nil
+}
+
+// AddPermission adds a statement to the function policy
+func (r *Repository) AddPermission(ctx context.Context, functionName string, permission lambda.Permission) error {
+	input := &awslambda.AddPermissionInput{
+		FunctionName: aws.String(functionName),
+		StatementId:  aws.String(permission.StatementID),
+		Action:       aws.String(permission.Action),
+		Principal:    aws.String(permission.Principal),
+	}
+	if permission.SourceARN != "" {
+		input.SourceArn = aws.String(permission.SourceARN)
+	}
+	if permission.SourceAccount != "" {
+		input.SourceAccount = aws.String(permission.SourceAccount)
+	}
+
+	if _, err := r.client.AddPermission(ctx, input); err != nil {
+		return fmt.Errorf("failed to add permission %s: %w", permission.StatementID, err)
+	}
+
+	return nil
+}
+
+// RemovePermission removes a statement from the function policy
+func (r *Repository) RemovePermission(ctx context.Context, functionName, statementID string) error {
+	_, err := r.client.RemovePermission(ctx, &awslambda.RemovePermissionInput{
+		FunctionName: aws.String(functionName),
+		StatementId:  aws.String(statementID),
+	})
+	if err != nil {
+		var notFoundErr *types.ResourceNotFoundException
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to remove permission %s: %w", statementID, err)
+	}
+
+	return nil
+}
+
+// filterCriteria converts the filter patterns; an empty list removes the
+// filters of an existing mapping
+func filterCriteria(patterns []string) *types.FilterCriteria {
+	filters := make([]types.Filter, 0, len(patterns))
+	for _, pattern := range patterns {
+		filters = append(filters, types.Filter{Pattern: aws.String(pattern)})
+	}
+	return &types.FilterCriteria{Filters: filters}
+}
+
+func onFailureDestination(arn string) *types.DestinationConfig {
+	return &types.DestinationConfig{
+		OnFailure: &types.OnFailure{Destination: aws.String(arn)},
+	}
+}
+
+// principal reads a policy principal the way it was given to AddPermission:
+// a service name, an account ID or *
+func principal(raw json.RawMessage) string {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name
+	}
+
+	var principals map[string]string
+	if err := json.Unmarshal(raw, &principals); err != nil {
+		return ""
+	}
+	if service, ok := principals["Service"]; ok {
+		return service
+	}
+	if match := accountRootPattern.FindStringSubmatch(principals["AWS"]); match != nil {
+		return match[1]
+	}
+	return principals["AWS"]
+}
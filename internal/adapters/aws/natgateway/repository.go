@@ -91,6 +91,7 @@ func (r *Repository) Get(ctx context.Context, natGwID string) (*natgateway.Gatew
 		SubnetID:     aws.ToString(nat.SubnetId),
 		VpcID:        aws.ToString(nat.VpcId),
 		State:        string(nat.State),
+		Tags:         make(map[string]string),
 	}
 
 	if len(nat.NatGatewayAddresses) > 0 {
@@ -98,6 +99,10 @@ func (r *Repository) Get(ctx context.Context, natGwID string) (*natgateway.Gatew
 		g.PrivateIP = aws.ToString(nat.NatGatewayAddresses[0].PrivateIp)
 	}
 
+	for _, tag := range nat.Tags {
+		g.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
 	return g, nil
 }
 
@@ -103,11 +103,17 @@ func (r *Repository) Get(ctx context.Context, lbName string) (*nlb.LoadBalancer,
 		LoadBalancerName:      lbName,
 		LoadBalancerARN:       aws.ToString(lbData.LoadBalancerArn),
 		DNSName:               aws.ToString(lbData.DNSName),
+		Scheme:                string(lbData.Scheme),
+		IPAddressType:         string(lbData.IpAddressType),
 		State:                 string(lbData.State.Code),
 		VpcID:                 aws.ToString(lbData.VpcId),
 		CanonicalHostedZoneID: aws.ToString(lbData.CanonicalHostedZoneId),
 	}
 
+	for _, az := range lbData.AvailabilityZones {
+		lb.Subnets = append(lb.Subnets, aws.ToString(az.SubnetId))
+	}
+
 	return lb, nil
 }
 
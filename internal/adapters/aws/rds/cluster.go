@@ -0,0 +1,415 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type ClusterRepository struct {
+	client *awsrds.Client
+}
+
+func NewClusterRepository(awsConfig aws.Config) ports.RDSClusterRepository {
+	return &ClusterRepository{
+		client: newClient(awsConfig),
+	}
+}
+
+func (r *ClusterRepository) Exists(ctx context.Context, dbClusterIdentifier string) (bool, error) {
+	_, err := r.describe(ctx, dbClusterIdentifier)
+	if err != nil {
+		var notFoundErr *types.DBClusterNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check if DB cluster exists: %w", err)
+	}
+	return true, nil
+}
+
+// Create creates the cluster without instances. A secondary cluster joins
+// cluster.GlobalClusterIdentifier and takes the credentials and database of
+// the primary.
+func (r *ClusterRepository) Create(ctx context.Context, cluster *rds.DBCluster, secondary bool) error {
+	input := &awsrds.CreateDBClusterInput{
+		DBClusterIdentifier:   aws.String(cluster.DBClusterIdentifier),
+		Engine:                aws.String(cluster.Engine),
+		Port:                  aws.Int32(cluster.Port),
+		StorageEncrypted:      aws.Bool(cluster.StorageEncrypted),
+		BackupRetentionPeriod: aws.Int32(cluster.BackupRetentionPeriod),
+		DeletionProtection:    aws.Bool(cluster.DeletionProtection),
+		VpcSecurityGroupIds:   cluster.VpcSecurityGroupIDs,
+		Tags:                  convertTags(cluster.Tags),
+	}
+
+	if secondary {
+		input.GlobalClusterIdentifier = aws.String(cluster.GlobalClusterIdentifier)
+	} else {
+		input.MasterUsername = aws.String(cluster.MasterUsername)
+		input.MasterUserPassword = aws.String(cluster.MasterPassword)
+		if cluster.DatabaseName != "" {
+			input.DatabaseName = aws.String(cluster.DatabaseName)
+		}
+	}
+	if cluster.EngineVersion != "" {
+		input.EngineVersion = aws.String(cluster.EngineVersion)
+	}
+	if cluster.DBSubnetGroupName != "" {
+		input.DBSubnetGroupName = aws.String(cluster.DBSubnetGroupName)
+	}
+	if cluster.DBClusterParameterGroupName != "" {
+		input.DBClusterParameterGroupName = aws.String(cluster.DBClusterParameterGroupName)
+	}
+	if cluster.KmsKeyID != "" {
+		input.KmsKeyId = aws.String(cluster.KmsKeyID)
+	}
+	if cluster.PreferredBackupWindow != "" {
+		input.PreferredBackupWindow = aws.String(cluster.PreferredBackupWindow)
+	}
+	if cluster.PreferredMaintenanceWindow != "" {
+		input.PreferredMaintenanceWindow = aws.String(cluster.PreferredMaintenanceWindow)
+	}
+	if s := cluster.ServerlessV2Scaling; s != nil {
+		input.ServerlessV2ScalingConfiguration = &types.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(s.MinCapacity),
+			MaxCapacity: aws.Float64(s.MaxCapacity),
+		}
+	}
+
+	output, err := r.client.CreateDBCluster(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to create DB cluster: %w", err)
+	}
+
+	cluster.DBClusterArn = aws.ToString(output.DBCluster.DBClusterArn)
+	cluster.Status = aws.ToString(output.DBCluster.Status)
+	cluster.Endpoint = aws.ToString(output.DBCluster.Endpoint)
+	cluster.ReaderEndpoint = aws.ToString(output.DBCluster.ReaderEndpoint)
+
+	return nil
+}
+
+// Get returns the cluster with its member instances and, for a member of a
+// global database, its role in it
+func (r *ClusterRepository) Get(ctx context.Context, dbClusterIdentifier string) (*rds.DBCluster, error) {
+	c, err := r.describe(ctx, dbClusterIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB cluster: %w", err)
+	}
+	cluster := mapToDBCluster(c)
+
+	// Class and status of the members are only reported on the instances
+	writers := make(map[string]bool, len(c.DBClusterMembers))
+	for _, m := range c.DBClusterMembers {
+		writers[aws.ToString(m.DBInstanceIdentifier)] = aws.ToBool(m.IsClusterWriter)
+	}
+	paginator := awsrds.NewDescribeDBInstancesPaginator(r.client, &awsrds.DescribeDBInstancesInput{
+		Filters: []types.Filter{{Name: aws.String("db-cluster-id"), Values: []string{dbClusterIdentifier}}},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DB cluster instances: %w", err)
+		}
+		for _, db := range page.DBInstances {
+			member := rds.ClusterMember{
+				DBInstanceIdentifier: aws.ToString(db.DBInstanceIdentifier),
+				InstanceClass:        aws.ToString(db.DBInstanceClass),
+				IsWriter:             writers[aws.ToString(db.DBInstanceIdentifier)],
+				Status:               aws.ToString(db.DBInstanceStatus),
+			}
+			if p := db.PendingModifiedValues; p != nil {
+				member.PendingInstanceClass = aws.ToString(p.DBInstanceClass)
+			}
+			cluster.Members = append(cluster.Members, member)
+		}
+	}
+
+	if cluster.GlobalClusterIdentifier != "" {
+		global, err := r.GetGlobalCluster(ctx, cluster.GlobalClusterIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		if global != nil {
+			cluster.GlobalClusterRole = global.Role(cluster.DBClusterArn)
+		}
+	}
+
+	return cluster, nil
+}
+
+func (r *ClusterRepository) Modify(ctx context.Context, dbClusterIdentifier string, m *rds.ClusterModification) error {
+	input := &awsrds.ModifyDBClusterInput{
+		DBClusterIdentifier:      aws.String(dbClusterIdentifier),
+		ApplyImmediately:         aws.Bool(m.ApplyImmediately),
+		AllowMajorVersionUpgrade: aws.Bool(m.AllowMajorVersionUpgrade),
+		BackupRetentionPeriod:    m.BackupRetentionPeriod,
+		DeletionProtection:       m.DeletionProtection,
+	}
+
+	if m.EngineVersion != "" {
+		input.EngineVersion = aws.String(m.EngineVersion)
+	}
+	if m.PreferredBackupWindow != "" {
+		input.PreferredBackupWindow = aws.String(m.PreferredBackupWindow)
+	}
+	if m.PreferredMaintenanceWindow != "" {
+		input.PreferredMaintenanceWindow = aws.String(m.PreferredMaintenanceWindow)
+	}
+	if m.DBClusterParameterGroupName != "" {
+		input.DBClusterParameterGroupName = aws.String(m.DBClusterParameterGroupName)
+	}
+	if len(m.VpcSecurityGroupIDs) > 0 {
+		input.VpcSecurityGroupIds = m.VpcSecurityGroupIDs
+	}
+	if s := m.ServerlessV2Scaling; s != nil {
+		input.ServerlessV2ScalingConfiguration = &types.ServerlessV2ScalingConfiguration{
+			MinCapacity: aws.Float64(s.MinCapacity),
+			MaxCapacity: aws.Float64(s.MaxCapacity),
+		}
+	}
+
+	if _, err := r.client.ModifyDBCluster(ctx, input); err != nil {
+		return fmt.Errorf("failed to modify DB cluster: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ClusterRepository) Delete(ctx context.Context, dbClusterIdentifier string, skipFinalSnapshot bool) error {
+	input := &awsrds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(dbClusterIdentifier),
+		SkipFinalSnapshot:   aws.Bool(skipFinalSnapshot),
+	}
+
+	if !skipFinalSnapshot {
+		input.FinalDBSnapshotIdentifier = aws.String(fmt.Sprintf("%s-final-snapshot-%d", dbClusterIdentifier, time.Now().Unix()))
+	}
+
+	_, err := r.client.DeleteDBCluster(ctx, input)
+	if err != nil {
+		var notFoundErr *types.DBClusterNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete DB cluster: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ClusterRepository) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.AddTagsToResource(ctx, &awsrds.AddTagsToResourceInput{
+		ResourceName: aws.String(arn),
+		Tags:         convertTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag resource: %w", err)
+	}
+
+	return nil
+}
+
+// CreateInstance adds an instance to the cluster; storage, credentials and
+// backups belong to the cluster
+func (r *ClusterRepository) CreateInstance(ctx context.Context, cluster *rds.DBCluster, instance rds.ClusterInstance) error {
+	input := &awsrds.CreateDBInstanceInput{
+		DBInstanceIdentifier: aws.String(instance.DBInstanceIdentifier),
+		DBClusterIdentifier:  aws.String(cluster.DBClusterIdentifier),
+		Engine:               aws.String(cluster.Engine),
+		DBInstanceClass:      aws.String(instance.InstanceClass),
+		PromotionTier:        aws.Int32(instance.PromotionTier),
+		PubliclyAccessible:   aws.Bool(instance.PubliclyAccessible),
+		Tags:                 convertTags(cluster.Tags),
+	}
+
+	if instance.DBParameterGroupName != "" {
+		input.DBParameterGroupName = aws.String(instance.DBParameterGroupName)
+	}
+
+	if _, err := r.client.CreateDBInstance(ctx, input); err != nil {
+		return fmt.Errorf("failed to create DB cluster instance %s: %w", instance.DBInstanceIdentifier, err)
+	}
+
+	return nil
+}
+
+func (r *ClusterRepository) ModifyInstanceClass(ctx context.Context, dbInstanceIdentifier, instanceClass string, applyImmediately bool) error {
+	_, err := r.client.ModifyDBInstance(ctx, &awsrds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		DBInstanceClass:      aws.String(instanceClass),
+		ApplyImmediately:     aws.Bool(applyImmediately),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to modify DB cluster instance %s: %w", dbInstanceIdentifier, err)
+	}
+
+	return nil
+}
+
+// DeleteInstance deletes a cluster instance; its data lives in the cluster
+// volume, so no final snapshot is taken
+func (r *ClusterRepository) DeleteInstance(ctx context.Context, dbInstanceIdentifier string) error {
+	_, err := r.client.DeleteDBInstance(ctx, &awsrds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		SkipFinalSnapshot:    aws.Bool(true),
+	})
+	if err != nil {
+		var notFoundErr *types.DBInstanceNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete DB cluster instance %s: %w", dbInstanceIdentifier, err)
+	}
+
+	return nil
+}
+
+// GetGlobalCluster returns the global database, or nil when it does not exist
+func (r *ClusterRepository) GetGlobalCluster(ctx context.Context, globalClusterIdentifier string) (*rds.GlobalCluster, error) {
+	output, err := r.client.DescribeGlobalClusters(ctx, &awsrds.DescribeGlobalClustersInput{
+		GlobalClusterIdentifier: aws.String(globalClusterIdentifier),
+	})
+	if err != nil {
+		var notFoundErr *types.GlobalClusterNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get global cluster: %w", err)
+	}
+	if len(output.GlobalClusters) == 0 {
+		return nil, nil
+	}
+
+	g := output.GlobalClusters[0]
+	global := &rds.GlobalCluster{
+		Identifier: aws.ToString(g.GlobalClusterIdentifier),
+		ARN:        aws.ToString(g.GlobalClusterArn),
+	}
+	for _, m := range g.GlobalClusterMembers {
+		global.Members = append(global.Members, rds.GlobalClusterMember{
+			DBClusterArn: aws.ToString(m.DBClusterArn),
+			IsWriter:     aws.ToBool(m.IsWriter),
+		})
+	}
+	return global, nil
+}
+
+// CreateGlobalCluster creates a global database with the source cluster as its primary
+func (r *ClusterRepository) CreateGlobalCluster(ctx context.Context, globalClusterIdentifier, sourceDBClusterArn string) error {
+	_, err := r.client.CreateGlobalCluster(ctx, &awsrds.CreateGlobalClusterInput{
+		GlobalClusterIdentifier:   aws.String(globalClusterIdentifier),
+		SourceDBClusterIdentifier: aws.String(sourceDBClusterArn),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create global cluster: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveFromGlobalCluster detaches the cluster from the global database; a
+// secondary becomes a standalone cluster that accepts writes
+func (r *ClusterRepository) RemoveFromGlobalCluster(ctx context.Context, globalClusterIdentifier, dbClusterArn string) error {
+	_, err := r.client.RemoveFromGlobalCluster(ctx, &awsrds.RemoveFromGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(globalClusterIdentifier),
+		DbClusterIdentifier:     aws.String(dbClusterArn),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove DB cluster from global cluster: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ClusterRepository) DeleteGlobalCluster(ctx context.Context, globalClusterIdentifier string) error {
+	_, err := r.client.DeleteGlobalCluster(ctx, &awsrds.DeleteGlobalClusterInput{
+		GlobalClusterIdentifier: aws.String(globalClusterIdentifier),
+	})
+	if err != nil {
+		var notFoundErr *types.GlobalClusterNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete global cluster: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ClusterRepository) describe(ctx context.Context, dbClusterIdentifier string) (*types.DBCluster, error) {
+	output, err := r.client.DescribeDBClusters(ctx, &awsrds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(dbClusterIdentifier),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBClusters) == 0 {
+		return nil, &types.DBClusterNotFoundFault{Message: aws.String("DB cluster not found")}
+	}
+	return &output.DBClusters[0], nil
+}
+
+func mapToDBCluster(c *types.DBCluster) *rds.DBCluster {
+	cluster := &rds.DBCluster{
+		DBClusterIdentifier:         aws.ToString(c.DBClusterIdentifier),
+		DBClusterArn:                aws.ToString(c.DBClusterArn),
+		Engine:                      aws.ToString(c.Engine),
+		EngineVersion:               aws.ToString(c.EngineVersion),
+		MasterUsername:              aws.ToString(c.MasterUsername),
+		DatabaseName:                aws.ToString(c.DatabaseName),
+		Port:                        aws.ToInt32(c.Port),
+		Endpoint:                    aws.ToString(c.Endpoint),
+		ReaderEndpoint:              aws.ToString(c.ReaderEndpoint),
+		DBSubnetGroupName:           aws.ToString(c.DBSubnetGroup),
+		StorageEncrypted:            aws.ToBool(c.StorageEncrypted),
+		KmsKeyID:                    aws.ToString(c.KmsKeyId),
+		DeletionProtection:          aws.ToBool(c.DeletionProtection),
+		DBClusterParameterGroupName: aws.ToString(c.DBClusterParameterGroup),
+		BackupRetentionPeriod:       aws.ToInt32(c.BackupRetentionPeriod),
+		PreferredBackupWindow:       aws.ToString(c.PreferredBackupWindow),
+		PreferredMaintenanceWindow:  aws.ToString(c.PreferredMaintenanceWindow),
+		GlobalClusterIdentifier:     aws.ToString(c.GlobalClusterIdentifier),
+		Status:                      aws.ToString(c.Status),
+	}
+
+	for _, sg := range c.VpcSecurityGroups {
+		cluster.VpcSecurityGroupIDs = append(cluster.VpcSecurityGroupIDs, aws.ToString(sg.VpcSecurityGroupId))
+	}
+	if s := c.ServerlessV2ScalingConfiguration; s != nil {
+		cluster.ServerlessV2Scaling = &rds.ServerlessV2Scaling{
+			MinCapacity: aws.ToFloat64(s.MinCapacity),
+			MaxCapacity: aws.ToFloat64(s.MaxCapacity),
+		}
+	}
+	if p := c.PendingModifiedValues; p != nil {
+		pending := &rds.PendingModifications{
+			EngineVersion:         aws.ToString(p.EngineVersion),
+			BackupRetentionPeriod: p.BackupRetentionPeriod,
+		}
+		if !pending.IsEmpty() {
+			cluster.PendingModifications = pending
+		}
+	}
+	if len(c.TagList) > 0 {
+		cluster.Tags = make(map[string]string, len(c.TagList))
+		for _, tag := range c.TagList {
+			cluster.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
+	return cluster
+}
@@ -0,0 +1,234 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+// maxParametersPerCall is the number of parameters RDS accepts in one
+// ModifyDBParameterGroup or ResetDBParameterGroup call
+const maxParametersPerCall = 20
+
+type ParameterGroupRepository struct {
+	client *awsrds.Client
+}
+
+func NewParameterGroupRepository(awsConfig aws.Config) ports.RDSParameterGroupRepository {
+	return &ParameterGroupRepository{
+		client: newClient(awsConfig),
+	}
+}
+
+func (r *ParameterGroupRepository) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := r.describe(ctx, name)
+	if err != nil {
+		var notFoundErr *types.DBParameterGroupNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check if DB parameter group exists: %w", err)
+	}
+	return true, nil
+}
+
+func (r *ParameterGroupRepository) Create(ctx context.Context, group *rds.DBParameterGroup) error {
+	output, err := r.client.CreateDBParameterGroup(ctx, &awsrds.CreateDBParameterGroupInput{
+		DBParameterGroupName:   aws.String(group.Name),
+		DBParameterGroupFamily: aws.String(group.Family),
+		Description:            aws.String(group.Description),
+		Tags:                   convertTags(group.Tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create DB parameter group: %w", err)
+	}
+
+	group.ARN = aws.ToString(output.DBParameterGroup.DBParameterGroupArn)
+	return nil
+}
+
+// Get returns the parameter group with the parameters set by the user; engine
+// defaults are not included
+func (r *ParameterGroupRepository) Get(ctx context.Context, name string) (*rds.DBParameterGroup, error) {
+	pg, err := r.describe(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB parameter group: %w", err)
+	}
+
+	group := &rds.DBParameterGroup{
+		Name:        aws.ToString(pg.DBParameterGroupName),
+		Family:      aws.ToString(pg.DBParameterGroupFamily),
+		Description: aws.ToString(pg.Description),
+		ARN:         aws.ToString(pg.DBParameterGroupArn),
+		Parameters:  make(map[string]string),
+	}
+
+	paginator := awsrds.NewDescribeDBParametersPaginator(r.client, &awsrds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+		Source:               aws.String("user"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DB parameters: %w", err)
+		}
+		for _, p := range page.Parameters {
+			group.Parameters[aws.ToString(p.ParameterName)] = aws.ToString(p.ParameterValue)
+		}
+	}
+
+	return group, nil
+}
+
+func (r *ParameterGroupRepository) ModifyParameters(ctx context.Context, name string, parameters map[string]string) error {
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	applyTypes, err := r.applyTypes(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(parameters))
+	for parameterName := range parameters {
+		names = append(names, parameterName)
+	}
+	sort.Strings(names)
+
+	for _, batch := range batchParameters(names) {
+		params := make([]types.Parameter, 0, len(batch))
+		for _, parameterName := range batch {
+			params = append(params, types.Parameter{
+				ParameterName:  aws.String(parameterName),
+				ParameterValue: aws.String(parameters[parameterName]),
+				ApplyMethod:    applyMethod(applyTypes[parameterName]),
+			})
+		}
+		if _, err := r.client.ModifyDBParameterGroup(ctx, &awsrds.ModifyDBParameterGroupInput{
+			DBParameterGroupName: aws.String(name),
+			Parameters:           params,
+		}); err != nil {
+			return fmt.Errorf("failed to modify DB parameter group: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ParameterGroupRepository) ResetParameters(ctx context.Context, name string, parameterNames []string) error {
+	if len(parameterNames) == 0 {
+		return nil
+	}
+
+	applyTypes, err := r.applyTypes(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range batchParameters(parameterNames) {
+		params := make([]types.Parameter, 0, len(batch))
+		for _, parameterName := range batch {
+			params = append(params, types.Parameter{
+				ParameterName: aws.String(parameterName),
+				ApplyMethod:   applyMethod(applyTypes[parameterName]),
+			})
+		}
+		if _, err := r.client.ResetDBParameterGroup(ctx, &awsrds.ResetDBParameterGroupInput{
+			DBParameterGroupName: aws.String(name),
+			Parameters:           params,
+		}); err != nil {
+			return fmt.Errorf("failed to reset DB parameters: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *ParameterGroupRepository) Delete(ctx context.Context, name string) error {
+	_, err := r.client.DeleteDBParameterGroup(ctx, &awsrds.DeleteDBParameterGroupInput{
+		DBParameterGroupName: aws.String(name),
+	})
+	if err != nil {
+		var notFoundErr *types.DBParameterGroupNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete DB parameter group: %w", err)
+	}
+	return nil
+}
+
+func (r *ParameterGroupRepository) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.AddTagsToResource(ctx, &awsrds.AddTagsToResourceInput{
+		ResourceName: aws.String(arn),
+		Tags:         convertTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag resource: %w", err)
+	}
+	return nil
+}
+
+func (r *ParameterGroupRepository) describe(ctx context.Context, name string) (*types.DBParameterGroup, error) {
+	output, err := r.client.DescribeDBParameterGroups(ctx, &awsrds.DescribeDBParameterGroupsInput{
+		DBParameterGroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBParameterGroups) == 0 {
+		return nil, &types.DBParameterGroupNotFoundFault{Message: aws.String("DB parameter group not found")}
+	}
+	return &output.DBParameterGroups[0], nil
+}
+
+// applyTypes returns the apply type (static or dynamic) of every parameter of the group
+func (r *ParameterGroupRepository) applyTypes(ctx context.Context, name string) (map[string]string, error) {
+	applyTypes := make(map[string]string)
+	paginator := awsrds.NewDescribeDBParametersPaginator(r.client, &awsrds.DescribeDBParametersInput{
+		DBParameterGroupName: aws.String(name),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DB parameters: %w", err)
+		}
+		for _, p := range page.Parameters {
+			applyTypes[aws.ToString(p.ParameterName)] = aws.ToString(p.ApplyType)
+		}
+	}
+	return applyTypes, nil
+}
+
+// applyMethod applies dynamic parameters immediately; static parameters can
+// only be applied on the next reboot
+func applyMethod(applyType string) types.ApplyMethod {
+	if applyType == "static" {
+		return types.ApplyMethodPendingReboot
+	}
+	return types.ApplyMethodImmediate
+}
+
+// batchParameters splits parameter names into batches RDS accepts in one call
+func batchParameters(names []string) [][]string {
+	var batches [][]string
+	for len(names) > maxParametersPerCall {
+		batches = append(batches, names[:maxParametersPerCall])
+		names = names[maxParametersPerCall:]
+	}
+	if len(names) > 0 {
+		batches = append(batches, names)
+	}
+	return batches
+}
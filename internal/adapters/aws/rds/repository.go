@@ -20,15 +20,20 @@ type Repository struct {
 }
 
 func NewRepository(awsConfig aws.Config) ports.RDSRepository {
+	return &Repository{
+		client: newClient(awsConfig),
+	}
+}
+
+// newClient creates the RDS client, honoring AWS_ENDPOINT_URL (LocalStack)
+func newClient(awsConfig aws.Config) *awsrds.Client {
 	var options []func(*awsrds.Options)
 	if endpoint := os.Getenv("AWS_ENDPOINT_URL"); endpoint != "" {
 		options = append(options, func(o *awsrds.Options) {
 			o.BaseEndpoint = aws.String(endpoint)
 		})
 	}
-	return &Repository{
-		client: awsrds.NewFromConfig(awsConfig, options...),
-	}
+	return awsrds.NewFromConfig(awsConfig, options...)
 }
 
 func (r *Repository) Exists(ctx context.Context, dbInstanceIdentifier string) (bool, error) {
@@ -58,6 +63,7 @@ func (r *Repository) Create(ctx context.Context, instance *rds.DBInstance) error
 		PubliclyAccessible:    aws.Bool(instance.PubliclyAccessible),
 		StorageEncrypted:      aws.Bool(instance.StorageEncrypted),
 		BackupRetentionPeriod: aws.Int32(instance.BackupRetentionPeriod),
+		DeletionProtection:    aws.Bool(instance.DeletionProtection),
 		Tags:                  convertTags(instance.Tags),
 	}
 
@@ -70,6 +76,33 @@ func (r *Repository) Create(ctx context.Context, instance *rds.DBInstance) error
 	if instance.PreferredBackupWindow != "" {
 		input.PreferredBackupWindow = aws.String(instance.PreferredBackupWindow)
 	}
+	if instance.DBSubnetGroupName != "" {
+		input.DBSubnetGroupName = aws.String(instance.DBSubnetGroupName)
+	}
+	if len(instance.VpcSecurityGroupIDs) > 0 {
+		input.VpcSecurityGroupIds = instance.VpcSecurityGroupIDs
+	}
+	if instance.StorageType != "" {
+		input.StorageType = aws.String(instance.StorageType)
+	}
+	if instance.Iops != 0 {
+		input.Iops = aws.Int32(instance.Iops)
+	}
+	if instance.StorageThroughput != 0 {
+		input.StorageThroughput = aws.Int32(instance.StorageThroughput)
+	}
+	if instance.DBParameterGroupName != "" {
+		input.DBParameterGroupName = aws.String(instance.DBParameterGroupName)
+	}
+	if instance.OptionGroupName != "" {
+		input.OptionGroupName = aws.String(instance.OptionGroupName)
+	}
+	if instance.PreferredMaintenanceWindow != "" {
+		input.PreferredMaintenanceWindow = aws.String(instance.PreferredMaintenanceWindow)
+	}
+	if instance.AutoMinorVersionUpgrade != nil {
+		input.AutoMinorVersionUpgrade = instance.AutoMinorVersionUpgrade
+	}
 
 	output, err := r.client.CreateDBInstance(ctx, input)
 	if err != nil {
@@ -100,25 +133,64 @@ func (r *Repository) Get(ctx context.Context, dbInstanceIdentifier string) (*rds
 	return mapToDBInstance(&output.DBInstances[0]), nil
 }
 
-func (r *Repository) Update(ctx context.Context, instance *rds.DBInstance) error {
+func (r *Repository) Modify(ctx context.Context, dbInstanceIdentifier string, m *rds.Modification) (*rds.DBInstance, error) {
 	input := &awsrds.ModifyDBInstanceInput{
-		DBInstanceIdentifier:  aws.String(instance.DBInstanceIdentifier),
-		AllocatedStorage:      aws.Int32(instance.AllocatedStorage),
-		DBInstanceClass:       aws.String(instance.DBInstanceClass),
-		BackupRetentionPeriod: aws.Int32(instance.BackupRetentionPeriod),
-		ApplyImmediately:      aws.Bool(true),
+		DBInstanceIdentifier:     aws.String(dbInstanceIdentifier),
+		ApplyImmediately:         aws.Bool(m.ApplyImmediately),
+		AllowMajorVersionUpgrade: aws.Bool(m.AllowMajorVersionUpgrade),
+		MultiAZ:                  m.MultiAZ,
+		PubliclyAccessible:       m.PubliclyAccessible,
+		BackupRetentionPeriod:    m.BackupRetentionPeriod,
+		DeletionProtection:       m.DeletionProtection,
+		AutoMinorVersionUpgrade:  m.AutoMinorVersionUpgrade,
 	}
 
-	if instance.PreferredBackupWindow != "" {
-		input.PreferredBackupWindow = aws.String(instance.PreferredBackupWindow)
+	if m.DBInstanceClass != "" {
+		input.DBInstanceClass = aws.String(m.DBInstanceClass)
+	}
+	if m.AllocatedStorage != 0 {
+		input.AllocatedStorage = aws.Int32(m.AllocatedStorage)
+	}
+	if m.StorageType != "" {
+		input.StorageType = aws.String(m.StorageType)
+	}
+	if m.Iops != 0 {
+		input.Iops = aws.Int32(m.Iops)
+	}
+	if m.StorageThroughput != 0 {
+		input.StorageThroughput = aws.Int32(m.StorageThroughput)
+	}
+	if m.EngineVersion != "" {
+		input.EngineVersion = aws.String(m.EngineVersion)
+	}
+	if m.PreferredBackupWindow != "" {
+		input.PreferredBackupWindow = aws.String(m.PreferredBackupWindow)
+	}
+	if m.PreferredMaintenanceWindow != "" {
+		input.PreferredMaintenanceWindow = aws.String(m.PreferredMaintenanceWindow)
+	}
+	if m.DBParameterGroupName != "" {
+		input.DBParameterGroupName = aws.String(m.DBParameterGroupName)
+	}
+	if m.OptionGroupName != "" {
+		input.OptionGroupName = aws.String(m.OptionGroupName)
+	}
+	if m.DBSubnetGroupName != "" {
+		input.DBSubnetGroupName = aws.String(m.DBSubnetGroupName)
+	}
+	if len(m.VpcSecurityGroupIDs) > 0 {
+		input.VpcSecurityGroupIds = m.VpcSecurityGroupIDs
+	}
+	if m.MasterUserPassword != "" {
+		input.MasterUserPassword = aws.String(m.MasterUserPassword)
 	}
 
-	_, err := r.client.ModifyDBInstance(ctx, input)
+	output, err := r.client.ModifyDBInstance(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to update DB instance: %w", err)
+		return nil, fmt.Errorf("failed to modify DB instance: %w", err)
 	}
 
-	return nil
+	return mapToDBInstance(output.DBInstance), nil
 }
 
 func (r *Repository) Delete(ctx context.Context, dbInstanceIdentifier string, skipFinalSnapshot bool) error {
@@ -183,11 +255,48 @@ func mapToDBInstance(db *types.DBInstance) *rds.DBInstance {
 		BackupRetentionPeriod: aws.ToInt32(db.BackupRetentionPeriod),
 		PreferredBackupWindow: aws.ToString(db.PreferredBackupWindow),
 		Status:                aws.ToString(db.DBInstanceStatus),
+
+		StorageType:                aws.ToString(db.StorageType),
+		Iops:                       aws.ToInt32(db.Iops),
+		StorageThroughput:          aws.ToInt32(db.StorageThroughput),
+		DeletionProtection:         aws.ToBool(db.DeletionProtection),
+		PreferredMaintenanceWindow: aws.ToString(db.PreferredMaintenanceWindow),
+		AutoMinorVersionUpgrade:    db.AutoMinorVersionUpgrade,
+		ReadReplicaSource:          aws.ToString(db.ReadReplicaSourceDBInstanceIdentifier),
 	}
 
 	if db.Endpoint != nil {
 		instance.Endpoint = aws.ToString(db.Endpoint.Address)
 	}
+	if db.DBSubnetGroup != nil {
+		instance.DBSubnetGroupName = aws.ToString(db.DBSubnetGroup.DBSubnetGroupName)
+	}
+	for _, sg := range db.VpcSecurityGroups {
+		instance.VpcSecurityGroupIDs = append(instance.VpcSecurityGroupIDs, aws.ToString(sg.VpcSecurityGroupId))
+	}
+	if len(db.DBParameterGroups) > 0 {
+		instance.DBParameterGroupName = aws.ToString(db.DBParameterGroups[0].DBParameterGroupName)
+		instance.ParameterApplyStatus = aws.ToString(db.DBParameterGroups[0].ParameterApplyStatus)
+	}
+	if len(db.OptionGroupMemberships) > 0 {
+		instance.OptionGroupName = aws.ToString(db.OptionGroupMemberships[0].OptionGroupName)
+	}
+	if p := db.PendingModifiedValues; p != nil {
+		pending := &rds.PendingModifications{
+			DBInstanceClass:       aws.ToString(p.DBInstanceClass),
+			AllocatedStorage:      aws.ToInt32(p.AllocatedStorage),
+			StorageType:           aws.ToString(p.StorageType),
+			Iops:                  aws.ToInt32(p.Iops),
+			StorageThroughput:     aws.ToInt32(p.StorageThroughput),
+			EngineVersion:         aws.ToString(p.EngineVersion),
+			MultiAZ:               p.MultiAZ,
+			BackupRetentionPeriod: p.BackupRetentionPeriod,
+			DBSubnetGroupName:     aws.ToString(p.DBSubnetGroupName),
+		}
+		if !pending.IsEmpty() {
+			instance.PendingModifications = pending
+		}
+	}
 
 	return instance
 }
@@ -0,0 +1,84 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"infra-operator/internal/domain/rds"
+)
+
+func (r *Repository) CreateSnapshot(ctx context.Context, dbInstanceIdentifier, snapshotIdentifier string, tags map[string]string) (*rds.DBSnapshot, error) {
+	output, err := r.client.CreateDBSnapshot(ctx, &awsrds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		DBSnapshotIdentifier: aws.String(snapshotIdentifier),
+		Tags:                 convertTags(tags),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DB snapshot: %w", err)
+	}
+
+	return mapToDBSnapshot(output.DBSnapshot), nil
+}
+
+// ListSnapshots returns the manual snapshots of the instance
+func (r *Repository) ListSnapshots(ctx context.Context, dbInstanceIdentifier string) ([]*rds.DBSnapshot, error) {
+	var snapshots []*rds.DBSnapshot
+
+	paginator := awsrds.NewDescribeDBSnapshotsPaginator(r.client, &awsrds.DescribeDBSnapshotsInput{
+		DBInstanceIdentifier: aws.String(dbInstanceIdentifier),
+		SnapshotType:         aws.String("manual"),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DB snapshots: %w", err)
+		}
+		for i := range page.DBSnapshots {
+			snapshots = append(snapshots, mapToDBSnapshot(&page.DBSnapshots[i]))
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (r *Repository) DeleteSnapshot(ctx context.Context, snapshotIdentifier string) error {
+	_, err := r.client.DeleteDBSnapshot(ctx, &awsrds.DeleteDBSnapshotInput{
+		DBSnapshotIdentifier: aws.String(snapshotIdentifier),
+	})
+	if err != nil {
+		var notFoundErr *types.DBSnapshotNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete DB snapshot: %w", err)
+	}
+
+	return nil
+}
+
+func mapToDBSnapshot(s *types.DBSnapshot) *rds.DBSnapshot {
+	snapshot := &rds.DBSnapshot{
+		Identifier:           aws.ToString(s.DBSnapshotIdentifier),
+		DBInstanceIdentifier: aws.ToString(s.DBInstanceIdentifier),
+		ARN:                  aws.ToString(s.DBSnapshotArn),
+		Status:               aws.ToString(s.Status),
+		Engine:               aws.ToString(s.Engine),
+		EngineVersion:        aws.ToString(s.EngineVersion),
+		AllocatedStorage:     aws.ToInt32(s.AllocatedStorage),
+		CreateTime:           s.SnapshotCreateTime,
+	}
+
+	if len(s.TagList) > 0 {
+		snapshot.Tags = make(map[string]string, len(s.TagList))
+		for _, tag := range s.TagList {
+			snapshot.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+	}
+
+	return snapshot
+}
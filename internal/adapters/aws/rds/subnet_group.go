@@ -0,0 +1,133 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type SubnetGroupRepository struct {
+	client *awsrds.Client
+}
+
+func NewSubnetGroupRepository(awsConfig aws.Config) ports.RDSSubnetGroupRepository {
+	return &SubnetGroupRepository{
+		client: newClient(awsConfig),
+	}
+}
+
+func (r *SubnetGroupRepository) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := r.describe(ctx, name)
+	if err != nil {
+		var notFoundErr *types.DBSubnetGroupNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check if DB subnet group exists: %w", err)
+	}
+	return true, nil
+}
+
+func (r *SubnetGroupRepository) Create(ctx context.Context, group *rds.DBSubnetGroup) error {
+	output, err := r.client.CreateDBSubnetGroup(ctx, &awsrds.CreateDBSubnetGroupInput{
+		DBSubnetGroupName:        aws.String(group.Name),
+		DBSubnetGroupDescription: aws.String(group.Description),
+		SubnetIds:                group.SubnetIDs,
+		Tags:                     convertTags(group.Tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create DB subnet group: %w", err)
+	}
+
+	mapSubnetGroupStatus(output.DBSubnetGroup, group)
+	return nil
+}
+
+func (r *SubnetGroupRepository) Get(ctx context.Context, name string) (*rds.DBSubnetGroup, error) {
+	sg, err := r.describe(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB subnet group: %w", err)
+	}
+
+	group := &rds.DBSubnetGroup{
+		Name:        aws.ToString(sg.DBSubnetGroupName),
+		Description: aws.ToString(sg.DBSubnetGroupDescription),
+	}
+	for _, subnet := range sg.Subnets {
+		group.SubnetIDs = append(group.SubnetIDs, aws.ToString(subnet.SubnetIdentifier))
+	}
+	mapSubnetGroupStatus(sg, group)
+	return group, nil
+}
+
+func (r *SubnetGroupRepository) Update(ctx context.Context, group *rds.DBSubnetGroup) error {
+	output, err := r.client.ModifyDBSubnetGroup(ctx, &awsrds.ModifyDBSubnetGroupInput{
+		DBSubnetGroupName:        aws.String(group.Name),
+		DBSubnetGroupDescription: aws.String(group.Description),
+		SubnetIds:                group.SubnetIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update DB subnet group: %w", err)
+	}
+
+	mapSubnetGroupStatus(output.DBSubnetGroup, group)
+	return nil
+}
+
+func (r *SubnetGroupRepository) Delete(ctx context.Context, name string) error {
+	_, err := r.client.DeleteDBSubnetGroup(ctx, &awsrds.DeleteDBSubnetGroupInput{
+		DBSubnetGroupName: aws.String(name),
+	})
+	if err != nil {
+		var notFoundErr *types.DBSubnetGroupNotFoundFault
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete DB subnet group: %w", err)
+	}
+	return nil
+}
+
+func (r *SubnetGroupRepository) TagResource(ctx context.Context, arn string, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	_, err := r.client.AddTagsToResource(ctx, &awsrds.AddTagsToResourceInput{
+		ResourceName: aws.String(arn),
+		Tags:         convertTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag resource: %w", err)
+	}
+	return nil
+}
+
+func (r *SubnetGroupRepository) describe(ctx context.Context, name string) (*types.DBSubnetGroup, error) {
+	output, err := r.client.DescribeDBSubnetGroups(ctx, &awsrds.DescribeDBSubnetGroupsInput{
+		DBSubnetGroupName: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBSubnetGroups) == 0 {
+		return nil, &types.DBSubnetGroupNotFoundFault{Message: aws.String("DB subnet group not found")}
+	}
+	return &output.DBSubnetGroups[0], nil
+}
+
+func mapSubnetGroupStatus(sg *types.DBSubnetGroup, group *rds.DBSubnetGroup) {
+	if sg == nil {
+		return
+	}
+	group.ARN = aws.ToString(sg.DBSubnetGroupArn)
+	group.VpcID = aws.ToString(sg.VpcId)
+	group.Status = aws.ToString(sg.SubnetGroupStatus)
+}
@@ -0,0 +1,465 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"infra-operator/internal/domain/s3"
+)
+
+// ConfigurePolicy applies the bucket policy when it differs from the current
+// one, and deletes it when no policy is given
+func (r *Repository) ConfigurePolicy(ctx context.Context, name, region, policy string) error {
+	if policy == "" {
+		if _, err := r.getPolicy(ctx, name); err != nil {
+			if isNotConfigured(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get bucket policy: %w", err)
+		}
+		if _, err := r.client.DeleteBucketPolicy(ctx, &awss3.DeleteBucketPolicyInput{Bucket: aws.String(name)}); err != nil {
+			return fmt.Errorf("failed to delete bucket policy: %w", err)
+		}
+		return nil
+	}
+
+	if current, err := r.getPolicy(ctx, name); err == nil && s3.PoliciesEqual(policy, current) {
+		return nil
+	}
+
+	_, err := r.client.PutBucketPolicy(ctx, &awss3.PutBucketPolicyInput{
+		Bucket: aws.String(name),
+		Policy: aws.String(policy),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure bucket policy: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigureNotifications replaces the event notifications when they differ
+// from the current ones; without notifications the configuration is cleared
+func (r *Repository) ConfigureNotifications(ctx context.Context, name, region string, notifications []s3.NotificationConfig) error {
+	if current, err := r.getNotifications(ctx, name); err == nil && s3.NotificationsEqual(notifications, current) {
+		return nil
+	}
+
+	config := &types.NotificationConfiguration{}
+	for _, n := range notifications {
+		events := make([]types.Event, 0, len(n.Events))
+		for _, e := range n.Events {
+			events = append(events, types.Event(e))
+		}
+		filter := notificationFilter(n.FilterPrefix, n.FilterSuffix)
+
+		switch {
+		case n.QueueARN != "":
+			config.QueueConfigurations = append(config.QueueConfigurations, types.QueueConfiguration{
+				Id: aws.String(n.ID), Events: events, Filter: filter, QueueArn: aws.String(n.QueueARN),
+			})
+		case n.TopicARN != "":
+			config.TopicConfigurations = append(config.TopicConfigurations, types.TopicConfiguration{
+				Id: aws.String(n.ID), Events: events, Filter: filter, TopicArn: aws.String(n.TopicARN),
+			})
+		case n.LambdaFunctionARN != "":
+			config.LambdaFunctionConfigurations = append(config.LambdaFunctionConfigurations, types.LambdaFunctionConfiguration{
+				Id: aws.String(n.ID), Events: events, Filter: filter, LambdaFunctionArn: aws.String(n.LambdaFunctionARN),
+			})
+		}
+	}
+
+	_, err := r.client.PutBucketNotificationConfiguration(ctx, &awss3.PutBucketNotificationConfigurationInput{
+		Bucket:                    aws.String(name),
+		NotificationConfiguration: config,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure notifications: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigureReplication applies the replication rules when they differ from
+// the current ones, and deletes them when no configuration is given
+func (r *Repository) ConfigureReplication(ctx context.Context, name, region string, config *s3.ReplicationConfig) error {
+	if config == nil {
+		if current, err := r.getReplication(ctx, name); err != nil {
+			if isNotConfigured(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get replication: %w", err)
+		} else if current == nil {
+			return nil
+		}
+		if _, err := r.client.DeleteBucketReplication(ctx, &awss3.DeleteBucketReplicationInput{Bucket: aws.String(name)}); err != nil {
+			return fmt.Errorf("failed to delete replication: %w", err)
+		}
+		return nil
+	}
+
+	if current, err := r.getReplication(ctx, name); err == nil && s3.ReplicationEqual(config, current) {
+		return nil
+	}
+
+	rules := make([]types.ReplicationRule, 0, len(config.Rules))
+	for _, rule := range config.Rules {
+		awsRule := types.ReplicationRule{
+			ID:       aws.String(rule.ID),
+			Status:   types.ReplicationRuleStatusEnabled,
+			Priority: aws.Int32(rule.Priority),
+			Filter:   &types.ReplicationRuleFilterMemberPrefix{Value: rule.Prefix},
+			Destination: &types.Destination{
+				Bucket:       aws.String(rule.DestinationBucketARN),
+				StorageClass: types.StorageClass(rule.StorageClass),
+			},
+			DeleteMarkerReplication: &types.DeleteMarkerReplication{
+				Status: types.DeleteMarkerReplicationStatusDisabled,
+			},
+		}
+
+		if !rule.Enabled {
+			awsRule.Status = types.ReplicationRuleStatusDisabled
+		}
+
+		if rule.ReplicateDeleteMarkers {
+			awsRule.DeleteMarkerReplication.Status = types.DeleteMarkerReplicationStatusEnabled
+		}
+
+		rules = append(rules, awsRule)
+	}
+
+	_, err := r.client.PutBucketReplication(ctx, &awss3.PutBucketReplicationInput{
+		Bucket: aws.String(name),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Role:  aws.String(config.Role),
+			Rules: rules,
+		},
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure replication: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigureLogging enables server access logging when the target differs
+// from the current one, and disables it when no configuration is given
+func (r *Repository) ConfigureLogging(ctx context.Context, name, region string, config *s3.LoggingConfig) error {
+	current, err := r.getLogging(ctx, name)
+	if config == nil {
+		if err != nil {
+			return fmt.Errorf("failed to get logging: %w", err)
+		}
+		if current == nil {
+			return nil
+		}
+	} else if err == nil && current != nil && *current == *config {
+		return nil
+	}
+
+	status := &types.BucketLoggingStatus{}
+	if config != nil {
+		status.LoggingEnabled = &types.LoggingEnabled{
+			TargetBucket: aws.String(config.TargetBucket),
+			TargetPrefix: aws.String(config.TargetPrefix),
+		}
+	}
+
+	_, err = r.client.PutBucketLogging(ctx, &awss3.PutBucketLoggingInput{
+		Bucket:              aws.String(name),
+		BucketLoggingStatus: status,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure logging: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigureObjectLock enables Object Lock and applies the default retention
+// when it differs from the current one
+func (r *Repository) ConfigureObjectLock(ctx context.Context, name, region string, config *s3.ObjectLockConfig) error {
+	if current, err := r.getObjectLock(ctx, name); err == nil && current != nil && *current == *config {
+		return nil
+	}
+
+	lock := &types.ObjectLockConfiguration{
+		ObjectLockEnabled: types.ObjectLockEnabledEnabled,
+	}
+	if config.Mode != "" {
+		retention := &types.DefaultRetention{Mode: types.ObjectLockRetentionMode(config.Mode)}
+		if config.Days > 0 {
+			retention.Days = aws.Int32(config.Days)
+		}
+		if config.Years > 0 {
+			retention.Years = aws.Int32(config.Years)
+		}
+		lock.Rule = &types.ObjectLockRule{DefaultRetention: retention}
+	}
+
+	_, err := r.client.PutObjectLockConfiguration(ctx, &awss3.PutObjectLockConfigurationInput{
+		Bucket:                  aws.String(name),
+		ObjectLockConfiguration: lock,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure object lock: %w", err)
+	}
+
+	return nil
+}
+
+// ConfigureWebsite applies the website configuration when it differs from
+// the current one, and deletes it when no configuration is given
+func (r *Repository) ConfigureWebsite(ctx context.Context, name, region string, config *s3.WebsiteConfig) error {
+	if config == nil {
+		if _, err := r.getWebsite(ctx, name); err != nil {
+			if isNotConfigured(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get website: %w", err)
+		}
+		if _, err := r.client.DeleteBucketWebsite(ctx, &awss3.DeleteBucketWebsiteInput{Bucket: aws.String(name)}); err != nil {
+			return fmt.Errorf("failed to delete website: %w", err)
+		}
+		return nil
+	}
+
+	if current, err := r.getWebsite(ctx, name); err == nil && current != nil && *current == *config {
+		return nil
+	}
+
+	website := &types.WebsiteConfiguration{}
+	if config.RedirectHostName != "" {
+		website.RedirectAllRequestsTo = &types.RedirectAllRequestsTo{
+			HostName: aws.String(config.RedirectHostName),
+			Protocol: types.Protocol(config.RedirectProtocol),
+		}
+	} else {
+		website.IndexDocument = &types.IndexDocument{Suffix: aws.String(config.IndexDocument)}
+		if config.ErrorDocument != "" {
+			website.ErrorDocument = &types.ErrorDocument{Key: aws.String(config.ErrorDocument)}
+		}
+	}
+
+	_, err := r.client.PutBucketWebsite(ctx, &awss3.PutBucketWebsiteInput{
+		Bucket:               aws.String(name),
+		WebsiteConfiguration: website,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to configure website: %w", err)
+	}
+
+	return nil
+}
+
+// isNotConfigured reports whether a Get* call failed because the bucket has
+// no such configuration
+func isNotConfigured(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "NoSuchBucketPolicy", "ReplicationConfigurationNotFoundError", "NoSuchWebsiteConfiguration":
+		return true
+	}
+	return false
+}
+
+// notificationFilter builds the key filter of a notification, nil without
+// prefix and suffix
+func notificationFilter(prefix, suffix string) *types.NotificationConfigurationFilter {
+	var rules []types.FilterRule
+	if prefix != "" {
+		rules = append(rules, types.FilterRule{Name: types.FilterRuleNamePrefix, Value: aws.String(prefix)})
+	}
+	if suffix != "" {
+		rules = append(rules, types.FilterRule{Name: types.FilterRuleNameSuffix, Value: aws.String(suffix)})
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	return &types.NotificationConfigurationFilter{Key: &types.S3KeyFilter{FilterRules: rules}}
+}
+
+// notificationFromAWS converts the common fields of a notification read from AWS
+func notificationFromAWS(id *string, events []types.Event, filter *types.NotificationConfigurationFilter) s3.NotificationConfig {
+	n := s3.NotificationConfig{ID: aws.ToString(id)}
+	for _, e := range events {
+		n.Events = append(n.Events, string(e))
+	}
+	if filter != nil && filter.Key != nil {
+		for _, rule := range filter.Key.FilterRules {
+			switch {
+			case strings.EqualFold(string(rule.Name), string(types.FilterRuleNamePrefix)):
+				n.FilterPrefix = aws.ToString(rule.Value)
+			case strings.EqualFold(string(rule.Name), string(types.FilterRuleNameSuffix)):
+				n.FilterSuffix = aws.ToString(rule.Value)
+			}
+		}
+	}
+	return n
+}
+
+func (r *Repository) getPolicy(ctx context.Context, name string) (string, error) {
+	output, err := r.client.GetBucketPolicy(ctx, &awss3.GetBucketPolicyInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.Policy), nil
+}
+
+func (r *Repository) getNotifications(ctx context.Context, name string) ([]s3.NotificationConfig, error) {
+	output, err := r.client.GetBucketNotificationConfiguration(ctx, &awss3.GetBucketNotificationConfigurationInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []s3.NotificationConfig
+	for _, c := range output.QueueConfigurations {
+		n := notificationFromAWS(c.Id, c.Events, c.Filter)
+		n.QueueARN = aws.ToString(c.QueueArn)
+		notifications = append(notifications, n)
+	}
+	for _, c := range output.TopicConfigurations {
+		n := notificationFromAWS(c.Id, c.Events, c.Filter)
+		n.TopicARN = aws.ToString(c.TopicArn)
+		notifications = append(notifications, n)
+	}
+	for _, c := range output.LambdaFunctionConfigurations {
+		n := notificationFromAWS(c.Id, c.Events, c.Filter)
+		n.LambdaFunctionARN = aws.ToString(c.LambdaFunctionArn)
+		notifications = append(notifications, n)
+	}
+
+	return notifications, nil
+}
+
+func (r *Repository) getReplication(ctx context.Context, name string) (*s3.ReplicationConfig, error) {
+	output, err := r.client.GetBucketReplication(ctx, &awss3.GetBucketReplicationInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.ReplicationConfiguration == nil {
+		return nil, nil
+	}
+
+	config := &s3.ReplicationConfig{Role: aws.ToString(output.ReplicationConfiguration.Role)}
+	for _, rule := range output.ReplicationConfiguration.Rules {
+		domainRule := s3.ReplicationRule{
+			ID:       aws.ToString(rule.ID),
+			Enabled:  rule.Status == types.ReplicationRuleStatusEnabled,
+			Priority: aws.ToInt32(rule.Priority),
+			Prefix:   aws.ToString(rule.Prefix),
+		}
+
+		if prefix, ok := rule.Filter.(*types.ReplicationRuleFilterMemberPrefix); ok {
+			domainRule.Prefix = prefix.Value
+		}
+
+		if rule.Destination != nil {
+			domainRule.DestinationBucketARN = aws.ToString(rule.Destination.Bucket)
+			domainRule.StorageClass = string(rule.Destination.StorageClass)
+		}
+
+		if rule.DeleteMarkerReplication != nil {
+			domainRule.ReplicateDeleteMarkers = rule.DeleteMarkerReplication.Status == types.DeleteMarkerReplicationStatusEnabled
+		}
+
+		config.Rules = append(config.Rules, domainRule)
+	}
+
+	return config, nil
+}
+
+func (r *Repository) getLogging(ctx context.Context, name string) (*s3.LoggingConfig, error) {
+	output, err := r.client.GetBucketLogging(ctx, &awss3.GetBucketLoggingInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.LoggingEnabled == nil {
+		return nil, nil
+	}
+
+	return &s3.LoggingConfig{
+		TargetBucket: aws.ToString(output.LoggingEnabled.TargetBucket),
+		TargetPrefix: aws.ToString(output.LoggingEnabled.TargetPrefix),
+	}, nil
+}
+
+func (r *Repository) getObjectLock(ctx context.Context, name string) (*s3.ObjectLockConfig, error) {
+	output, err := r.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	lock := output.ObjectLockConfiguration
+	if lock == nil || lock.ObjectLockEnabled != types.ObjectLockEnabledEnabled {
+		return nil, nil
+	}
+
+	config := &s3.ObjectLockConfig{}
+	if lock.Rule != nil && lock.Rule.DefaultRetention != nil {
+		config.Mode = string(lock.Rule.DefaultRetention.Mode)
+		config.Days = aws.ToInt32(lock.Rule.DefaultRetention.Days)
+		config.Years = aws.ToInt32(lock.Rule.DefaultRetention.Years)
+	}
+
+	return config, nil
+}
+
+func (r *Repository) getWebsite(ctx context.Context, name string) (*s3.WebsiteConfig, error) {
+	output, err := r.client.GetBucketWebsite(ctx, &awss3.GetBucketWebsiteInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &s3.WebsiteConfig{}
+	if output.IndexDocument != nil {
+		config.IndexDocument = aws.ToString(output.IndexDocument.Suffix)
+	}
+	if output.ErrorDocument != nil {
+		config.ErrorDocument = aws.ToString(output.ErrorDocument.Key)
+	}
+	if output.RedirectAllRequestsTo != nil {
+		config.RedirectHostName = aws.ToString(output.RedirectAllRequestsTo.HostName)
+		config.RedirectProtocol = string(output.RedirectAllRequestsTo.Protocol)
+	}
+
+	return config, nil
+}
@@ -2,12 +2,15 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
 
 	"infra-operator/internal/domain/s3"
 	"infra-operator/internal/ports"
@@ -59,6 +62,11 @@ func (r *Repository) Create(ctx context.Context, bucket *s3.Bucket) error {
 		Bucket: aws.String(bucket.Name),
 	}
 
+	// Object Lock is turned on at creation; the retention is applied by Configure
+	if bucket.ObjectLock != nil {
+		input.ObjectLockEnabledForBucket = aws.Bool(true)
+	}
+
 	// Set location constraint if not us-east-1
 	if bucket.Region != "us-east-1" {
 		input.CreateBucketConfiguration = &types.CreateBucketConfiguration{
@@ -105,6 +113,61 @@ func (r *Repository) Get(ctx context.Context, name, region string) (*s3.Bucket,
 		bucket.Encryption = encryption
 	}
 
+	// Get lifecycle rules
+	lifecycleRules, err := r.getLifecycle(ctx, name)
+	if err == nil {
+		bucket.LifecycleRules = lifecycleRules
+	}
+
+	// Get CORS rules
+	corsRules, err := r.getCORS(ctx, name)
+	if err == nil {
+		bucket.CORSRules = corsRules
+	}
+
+	// Get public access block
+	publicAccessBlock, err := r.getPublicAccessBlock(ctx, name)
+	if err == nil {
+		bucket.PublicAccessBlock = publicAccessBlock
+	}
+
+	// Get policy
+	policy, err := r.getPolicy(ctx, name)
+	if err == nil {
+		bucket.Policy = policy
+	}
+
+	// Get notifications
+	notifications, err := r.getNotifications(ctx, name)
+	if err == nil {
+		bucket.Notifications = notifications
+	}
+
+	// Get replication
+	replication, err := r.getReplication(ctx, name)
+	if err == nil {
+		bucket.Replication = replication
+	}
+
+	// Get logging
+	logging, err := r.getLogging(ctx, name)
+	if err == nil {
+		bucket.Logging = logging
+	}
+
+	// Get object lock
+	objectLock, err := r.getObjectLock(ctx, name)
+	if err == nil {
+		bucket.ObjectLock = objectLock
+	}
+
+	// Get website
+	website, err := r.getWebsite(ctx, name)
+	if err == nil {
+		bucket.Website = website
+		bucket.WebsiteEndpoint = s3.WebsiteEndpoint(name, region)
+	}
+
 	// Get tags
 	tags, err := r.getTags(ctx, name)
 	if err == nil {
@@ -126,13 +189,74 @@ func (r *Repository) Delete(ctx context.Context, name, region string) error {
 	})
 
 	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) && apiErr.ErrorCode() == "BucketNotEmpty" {
+			return s3.ErrBucketNotEmpty
+		}
 		return fmt.Errorf("failed to delete bucket: %w", err)
 	}
 
 	return nil
 }
 
-// Configure applies all configurations to bucket
+// DeleteObjectVersions removes one page of object versions and delete markers.
+// Each call lists from the start of the bucket, as the previous page is gone.
+func (r *Repository) DeleteObjectVersions(ctx context.Context, name, region string, max int32, bypassGovernance bool) (int, bool, error) {
+	output, err := r.client.ListObjectVersions(ctx, &awss3.ListObjectVersionsInput{
+		Bucket:  aws.String(name),
+		MaxKeys: aws.Int32(max),
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list object versions: %w", err)
+	}
+
+	objects := make([]types.ObjectIdentifier, 0, len(output.Versions)+len(output.DeleteMarkers))
+	for _, v := range output.Versions {
+		objects = append(objects, types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range output.DeleteMarkers {
+		objects = append(objects, types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+	if len(objects) == 0 {
+		return 0, false, nil
+	}
+
+	deleted := 0
+	for start := 0; start < len(objects); start += int(max) {
+		end := start + int(max)
+		if end > len(objects) {
+			end = len(objects)
+		}
+		input := &awss3.DeleteObjectsInput{
+			Bucket: aws.String(name),
+			Delete: &types.Delete{Objects: objects[start:end], Quiet: aws.Bool(true)},
+		}
+		if bypassGovernance {
+			input.BypassGovernanceRetention = aws.Bool(true)
+		}
+		result, err := r.client.DeleteObjects(ctx, input)
+		if err != nil {
+			return deleted, true, fmt.Errorf("failed to delete objects: %w", err)
+		}
+		failed := make([]s3.ObjectError, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			failed = append(failed, s3.ObjectError{
+				Key: aws.ToString(e.Key), VersionID: aws.ToString(e.VersionId), Message: aws.ToString(e.Message),
+			})
+		}
+		n, err := s3.DeletedObjects(end-start, failed)
+		deleted += n
+		if err != nil {
+			return deleted, true, err
+		}
+	}
+
+	return deleted, aws.ToBool(output.IsTruncated), nil
+}
+
+// Configure applies all configurations to bucket. Each Configure* method
+// compares the desired configuration with the bucket first, so only changed
+// configuration is applied.
 func (r *Repository) Configure(ctx context.Context, bucket *s3.Bucket) error {
 	// Configure versioning
 	if bucket.Versioning != nil {
@@ -169,6 +293,52 @@ func (r *Repository) Configure(ctx context.Context, bucket *s3.Bucket) error {
 		}
 	}
 
+	// Configure object lock
+	if bucket.ObjectLock != nil {
+		if err := r.ConfigureObjectLock(ctx, bucket.Name, bucket.Region, bucket.ObjectLock); err != nil {
+			return err
+		}
+	}
+
+	// Configure policy, removing it once dropped from the spec
+	if bucket.Policy != "" || bucket.RemovesConfiguration(s3.ConfigurationPolicy) {
+		if err := r.ConfigurePolicy(ctx, bucket.Name, bucket.Region, bucket.Policy); err != nil {
+			return err
+		}
+	}
+
+	// Configure notifications, removing them once dropped from the spec
+	if len(bucket.Notifications) > 0 || bucket.RemovesConfiguration(s3.ConfigurationNotifications) {
+		if err := r.ConfigureNotifications(ctx, bucket.Name, bucket.Region, bucket.Notifications); err != nil {
+			return err
+		}
+	}
+
+	// Configure replication, removing it once dropped from the spec
+	if bucket.Replication != nil || bucket.RemovesConfiguration(s3.ConfigurationReplication) {
+		if err := r.ConfigureReplication(ctx, bucket.Name, bucket.Region, bucket.Replication); err != nil {
+			return err
+		}
+	}
+
+	// Configure logging, removing it once dropped from the spec
+	if bucket.Logging != nil || bucket.RemovesConfiguration(s3.ConfigurationLogging) {
+		if err := r.ConfigureLogging(ctx, bucket.Name, bucket.Region, bucket.Logging); err != nil {
+			return err
+		}
+	}
+
+	// Configure website, removing it once dropped from the spec
+	if bucket.Website != nil || bucket.RemovesConfiguration(s3.ConfigurationWebsite) {
+		if err := r.ConfigureWebsite(ctx, bucket.Name, bucket.Region, bucket.Website); err != nil {
+			return err
+		}
+	}
+	if bucket.Website != nil {
+		bucket.WebsiteEndpoint = s3.WebsiteEndpoint(bucket.Name, bucket.Region)
+	}
+	bucket.ManagedConfigurations = bucket.DesiredConfigurations()
+
 	// Configure tags
 	if len(bucket.Tags) > 0 {
 		if err := r.ConfigureTags(ctx, bucket.Name, bucket.Region, bucket.Tags); err != nil {
@@ -181,6 +351,10 @@ func (r *Repository) Configure(ctx context.Context, bucket *s3.Bucket) error {
 
 // ConfigureVersioning configures versioning
 func (r *Repository) ConfigureVersioning(ctx context.Context, name, region string, config *s3.VersioningConfig) error {
+	if current, err := r.getVersioning(ctx, name); err == nil && current.Enabled == config.Enabled {
+		return nil
+	}
+
 	status := types.BucketVersioningStatusSuspended
 	if config.Enabled {
 		status = types.BucketVersioningStatusEnabled
@@ -202,15 +376,23 @@ func (r *Repository) ConfigureVersioning(ctx context.Context, name, region strin
 
 // ConfigureEncryption configures encryption
 func (r *Repository) ConfigureEncryption(ctx context.Context, name, region string, config *s3.EncryptionConfig) error {
+	// The CRD spells the KMS algorithm aws_kms
+	algorithm := strings.Replace(config.Algorithm, "aws_kms", "aws:kms", 1)
+
+	if current, err := r.getEncryption(ctx, name); err == nil && current != nil &&
+		current.Algorithm == algorithm && (algorithm != "aws:kms" || current.KMSKeyID == config.KMSKeyID) {
+		return nil
+	}
+
 	var rule types.ServerSideEncryptionRule
 
-	if config.Algorithm == "AES256" {
+	if algorithm == "AES256" {
 		rule = types.ServerSideEncryptionRule{
 			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
 				SSEAlgorithm: types.ServerSideEncryptionAes256,
 			},
 		}
-	} else if config.Algorithm == "aws:kms" {
+	} else if algorithm == "aws:kms" {
 		rule = types.ServerSideEncryptionRule{
 			ApplyServerSideEncryptionByDefault: &types.ServerSideEncryptionByDefault{
 				SSEAlgorithm:   types.ServerSideEncryptionAwsKms,
@@ -235,6 +417,10 @@ func (r *Repository) ConfigureEncryption(ctx context.Context, name, region strin
 
 // ConfigureLifecycle configures lifecycle rules
 func (r *Repository) ConfigureLifecycle(ctx context.Context, name, region string, rules []s3.LifecycleRule) error {
+	if current, err := r.getLifecycle(ctx, name); err == nil && s3.LifecycleRulesEqual(rules, current) {
+		return nil
+	}
+
 	// Convert domain rules to AWS types
 	var awsRules []types.LifecycleRule
 
@@ -290,6 +476,10 @@ func (r *Repository) ConfigureLifecycle(ctx context.Context, name, region string
 
 // ConfigureCORS configures CORS rules
 func (r *Repository) ConfigureCORS(ctx context.Context, name, region string, rules []s3.CORSRule) error {
+	if current, err := r.getCORS(ctx, name); err == nil && s3.CORSRulesEqual(rules, current) {
+		return nil
+	}
+
 	var awsRules []types.CORSRule
 
 	for _, rule := range rules {
@@ -318,6 +508,10 @@ func (r *Repository) ConfigureCORS(ctx context.Context, name, region string, rul
 
 // ConfigurePublicAccessBlock configures public access block
 func (r *Repository) ConfigurePublicAccessBlock(ctx context.Context, name, region string, config *s3.PublicAccessBlockConfig) error {
+	if current, err := r.getPublicAccessBlock(ctx, name); err == nil && *current == *config {
+		return nil
+	}
+
 	_, err := r.client.PutPublicAccessBlock(ctx, &awss3.PutPublicAccessBlockInput{
 		Bucket: aws.String(name),
 		PublicAccessBlockConfiguration: &types.PublicAccessBlockConfiguration{
@@ -337,6 +531,10 @@ func (r *Repository) ConfigurePublicAccessBlock(ctx context.Context, name, regio
 
 // ConfigureTags applies tags
 func (r *Repository) ConfigureTags(ctx context.Context, name, region string, tags map[string]string) error {
+	if current, err := r.getTags(ctx, name); err == nil && s3.TagsEqual(tags, current) {
+		return nil
+	}
+
 	var awsTags []types.Tag
 	for k, v := range tags {
 		awsTags = append(awsTags, types.Tag{
@@ -418,3 +616,86 @@ func (r *Repository) getTags(ctx context.Context, name string) (map[string]strin
 
 	return tags, nil
 }
+
+func (r *Repository) getLifecycle(ctx context.Context, name string) ([]s3.LifecycleRule, error) {
+	output, err := r.client.GetBucketLifecycleConfiguration(ctx, &awss3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []s3.LifecycleRule
+	for _, awsRule := range output.Rules {
+		rule := s3.LifecycleRule{
+			ID:      aws.ToString(awsRule.ID),
+			Enabled: awsRule.Status == types.ExpirationStatusEnabled,
+			Prefix:  aws.ToString(awsRule.Prefix),
+		}
+
+		if prefix, ok := awsRule.Filter.(*types.LifecycleRuleFilterMemberPrefix); ok {
+			rule.Prefix = prefix.Value
+		}
+
+		if awsRule.Expiration != nil && awsRule.Expiration.Days != nil {
+			rule.Expiration = &s3.Expiration{Days: *awsRule.Expiration.Days}
+		}
+
+		for _, t := range awsRule.Transitions {
+			rule.Transitions = append(rule.Transitions, s3.Transition{
+				Days:         aws.ToInt32(t.Days),
+				StorageClass: string(t.StorageClass),
+			})
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) getCORS(ctx context.Context, name string) ([]s3.CORSRule, error) {
+	output, err := r.client.GetBucketCors(ctx, &awss3.GetBucketCorsInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []s3.CORSRule
+	for _, rule := range output.CORSRules {
+		rules = append(rules, s3.CORSRule{
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  aws.ToInt32(rule.MaxAgeSeconds),
+		})
+	}
+
+	return rules, nil
+}
+
+func (r *Repository) getPublicAccessBlock(ctx context.Context, name string) (*s3.PublicAccessBlockConfig, error) {
+	output, err := r.client.GetPublicAccessBlock(ctx, &awss3.GetPublicAccessBlockInput{
+		Bucket: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := output.PublicAccessBlockConfiguration
+	if config == nil {
+		return &s3.PublicAccessBlockConfig{}, nil
+	}
+
+	return &s3.PublicAccessBlockConfig{
+		BlockPublicAcls:       aws.ToBool(config.BlockPublicAcls),
+		IgnorePublicAcls:      aws.ToBool(config.IgnorePublicAcls),
+		BlockPublicPolicy:     aws.ToBool(config.BlockPublicPolicy),
+		RestrictPublicBuckets: aws.ToBool(config.RestrictPublicBuckets),
+	}, nil
+}
@@ -42,34 +42,8 @@ func (r *Repository) Create(ctx context.Context, sg *securitygroup.SecurityGroup
 
 	sg.GroupID = aws.ToString(output.GroupId)
 
-	// Add ingress rules if specified
-	if len(sg.IngressRules) > 0 {
-		if err := r.AuthorizeIngress(ctx, sg.GroupID, sg.IngressRules); err != nil {
-			return fmt.Errorf("failed to authorize ingress rules: %w", err)
-		}
-	}
-
-	// Add egress rules if specified (AWS creates default allow-all egress, so we need to revoke it first if custom rules are specified)
-	if len(sg.EgressRules) > 0 {
-		// Revoke default egress rule (0.0.0.0/0 all traffic)
-		if _, err := r.client.RevokeSecurityGroupEgress(ctx, &awsec2.RevokeSecurityGroupEgressInput{
-			GroupId: aws.String(sg.GroupID),
-			IpPermissions: []types.IpPermission{
-				{
-					IpProtocol: aws.String("-1"),
-					IpRanges: []types.IpRange{
-						{CidrIp: aws.String("0.0.0.0/0")},
-					},
-				},
-			},
-		}); err != nil {
-			// Ignore error if rule doesn't exist
-		}
-
-		if err := r.AuthorizeEgress(ctx, sg.GroupID, sg.EgressRules); err != nil {
-			return fmt.Errorf("failed to authorize egress rules: %w", err)
-		}
-	}
+	// Rules are reconciled by the use case once the group exists, which also
+	// revokes the default allow-all egress rule when egress rules are declared
 
 	// Apply tags
 	if len(sg.Tags) > 0 {
@@ -161,6 +135,66 @@ func (r *Repository) AuthorizeEgress(ctx context.Context, groupID string, rules
 	return nil
 }
 
+func (r *Repository) RevokeIngress(ctx context.Context, groupID string, rules []securitygroup.Rule) error {
+	permissions := convertRulesToPermissions(withoutDescriptions(rules))
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	if _, err := r.client.RevokeSecurityGroupIngress(ctx, &awsec2.RevokeSecurityGroupIngressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: permissions,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) RevokeEgress(ctx context.Context, groupID string, rules []securitygroup.Rule) error {
+	permissions := convertRulesToPermissions(withoutDescriptions(rules))
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	if _, err := r.client.RevokeSecurityGroupEgress(ctx, &awsec2.RevokeSecurityGroupEgressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: permissions,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) UpdateIngressDescriptions(ctx context.Context, groupID string, rules []securitygroup.Rule) error {
+	permissions := convertRulesToPermissions(rules)
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	if _, err := r.client.UpdateSecurityGroupRuleDescriptionsIngress(ctx, &awsec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: permissions,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) UpdateEgressDescriptions(ctx context.Context, groupID string, rules []securitygroup.Rule) error {
+	permissions := convertRulesToPermissions(rules)
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	if _, err := r.client.UpdateSecurityGroupRuleDescriptionsEgress(ctx, &awsec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: permissions,
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *Repository) TagResource(ctx context.Context, groupID string, tags map[string]string) error {
 	if len(tags) == 0 {
 		return nil
@@ -193,19 +227,22 @@ func convertRulesToPermissions(rules []securitygroup.Rule) []types.IpPermission
 			IpProtocol: aws.String(rule.IpProtocol),
 		}
 
-		// Set ports if not -1 (all)
-		if rule.FromPort > 0 {
+		// All traffic (-1) has no ports
+		if rule.IpProtocol != "-1" {
 			perm.FromPort = aws.Int32(rule.FromPort)
-		}
-		if rule.ToPort > 0 {
 			perm.ToPort = aws.Int32(rule.ToPort)
 		}
 
+		description := aws.String(rule.Description)
+		if rule.Description == "" {
+			description = nil
+		}
+
 		// Add CIDR blocks
 		for _, cidr := range rule.CidrBlocks {
 			perm.IpRanges = append(perm.IpRanges, types.IpRange{
 				CidrIp:      aws.String(cidr),
-				Description: aws.String(rule.Description),
+				Description: description,
 			})
 		}
 
@@ -213,7 +250,15 @@ func convertRulesToPermissions(rules []securitygroup.Rule) []types.IpPermission
 		for _, cidr := range rule.Ipv6CidrBlocks {
 			perm.Ipv6Ranges = append(perm.Ipv6Ranges, types.Ipv6Range{
 				CidrIpv6:    aws.String(cidr),
-				Description: aws.String(rule.Description),
+				Description: description,
+			})
+		}
+
+		// Add prefix lists
+		for _, id := range rule.PrefixListIDs {
+			perm.PrefixListIds = append(perm.PrefixListIds, types.PrefixListId{
+				PrefixListId: aws.String(id),
+				Description:  description,
 			})
 		}
 
@@ -221,7 +266,7 @@ func convertRulesToPermissions(rules []securitygroup.Rule) []types.IpPermission
 		if rule.SourceSecurityGroupID != "" {
 			perm.UserIdGroupPairs = append(perm.UserIdGroupPairs, types.UserIdGroupPair{
 				GroupId:     aws.String(rule.SourceSecurityGroupID),
-				Description: aws.String(rule.Description),
+				Description: description,
 			})
 		}
 
@@ -267,6 +312,17 @@ func convertPermissionToRule(perm types.IpPermission) []securitygroup.Rule {
 		})
 	}
 
+	// Create rules for each prefix list
+	for _, prefixList := range perm.PrefixListIds {
+		rules = append(rules, securitygroup.Rule{
+			IpProtocol:    protocol,
+			FromPort:      fromPort,
+			ToPort:        toPort,
+			PrefixListIDs: []string{aws.ToString(prefixList.PrefixListId)},
+			Description:   aws.ToString(prefixList.Description),
+		})
+	}
+
 	// Create rules for each source security group
 	for _, pair := range perm.UserIdGroupPairs {
 		rules = append(rules, securitygroup.Rule{
@@ -280,3 +336,14 @@ func convertPermissionToRule(perm types.IpPermission) []securitygroup.Rule {
 
 	return rules
 }
+
+// withoutDescriptions copies rules without their descriptions, which are not
+// needed to identify the rules to revoke
+func withoutDescriptions(rules []securitygroup.Rule) []securitygroup.Rule {
+	out := make([]securitygroup.Rule, len(rules))
+	for i, rule := range rules {
+		rule.Description = ""
+		out[i] = rule
+	}
+	return out
+}
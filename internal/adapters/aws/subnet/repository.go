@@ -86,14 +86,22 @@ func (r *Repository) Get(ctx context.Context, subnetID string) (*subnet.Subnet,
 	}
 
 	s := output.Subnets[0]
-	return &subnet.Subnet{
+	result := &subnet.Subnet{
 		SubnetID:                aws.ToString(s.SubnetId),
 		VpcID:                   aws.ToString(s.VpcId),
 		CidrBlock:               aws.ToString(s.CidrBlock),
 		AvailabilityZone:        aws.ToString(s.AvailabilityZone),
+		MapPublicIpOnLaunch:     aws.ToBool(s.MapPublicIpOnLaunch),
 		State:                   string(s.State),
 		AvailableIpAddressCount: aws.ToInt32(s.AvailableIpAddressCount),
-	}, nil
+		Tags:                    make(map[string]string),
+	}
+
+	for _, tag := range s.Tags {
+		result.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return result, nil
 }
 
 func (r *Repository) Delete(ctx context.Context, subnetID string) error {
@@ -93,12 +93,20 @@ func (r *Repository) Get(ctx context.Context, vpcID string) (*vpc.VPC, error) {
 	}
 
 	v := output.Vpcs[0]
-	return &vpc.VPC{
-		VpcID:     aws.ToString(v.VpcId),
-		CidrBlock: aws.ToString(v.CidrBlock),
-		State:     string(v.State),
-		IsDefault: aws.ToBool(v.IsDefault),
-	}, nil
+	result := &vpc.VPC{
+		VpcID:           aws.ToString(v.VpcId),
+		CidrBlock:       aws.ToString(v.CidrBlock),
+		InstanceTenancy: string(v.InstanceTenancy),
+		State:           string(v.State),
+		IsDefault:       aws.ToBool(v.IsDefault),
+		Tags:            make(map[string]string),
+	}
+
+	for _, tag := range v.Tags {
+		result.Tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+
+	return result, nil
 }
 
 func (r *Repository) Delete(ctx context.Context, vpcID string) error {
@@ -0,0 +1,275 @@
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"infra-operator/internal/domain/lambda"
+	"infra-operator/internal/ports"
+)
+
+// manifestMediaTypes are the manifest formats accepted from registries
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// challengeParam matches the key="value" pairs of a WWW-Authenticate header
+var challengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// Repository fetches artifacts from OCI registries using the distribution API
+type Repository struct {
+	client *http.Client
+}
+
+// NewRepository creates a new OCI artifact repository
+func NewRepository() ports.LambdaCodeArtifactRepository {
+	return &Repository{
+		client: &http.Client{Timeout: 5 * time.Minute},
+	}
+}
+
+type manifest struct {
+	Layers []descriptor `json:"layers"`
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ResolveDigest returns the manifest digest the artifact reference points
+// to, without a request when the reference is pinned by digest
+func (r *Repository) ResolveDigest(ctx context.Context, artifact lambda.OCIArtifact) (string, error) {
+	_, _, reference, err := lambda.ParseArtifactReference(artifact.Reference)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(reference, "sha256:") {
+		return reference, nil
+	}
+
+	data, err := r.newSession(artifact).manifest(ctx)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// FetchLayer downloads the artifact layer that holds the deployment package
+func (r *Repository) FetchLayer(ctx context.Context, artifact lambda.OCIArtifact) ([]byte, error) {
+	s := r.newSession(artifact)
+	data, err := s.manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var m manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest of %s: %w", artifact.Reference, err)
+	}
+
+	layer, err := packageLayer(m.Layers)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", artifact.Reference, err)
+	}
+	if layer.Size > lambda.MaxArchiveSize {
+		return nil, lambda.ErrArchiveTooLarge
+	}
+
+	data, err = s.get(ctx, s.base+"/blobs/"+layer.Digest, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to download layer of %s: %w", artifact.Reference, err)
+	}
+	if digest, ok := strings.CutPrefix(layer.Digest, "sha256:"); ok {
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != digest {
+			return nil, fmt.Errorf("layer of %s does not match digest %s", artifact.Reference, layer.Digest)
+		}
+	}
+
+	return data, nil
+}
+
+// packageLayer picks the layer that holds the deployment package: the only
+// layer, or the one titled *.zip
+func packageLayer(layers []descriptor) (descriptor, error) {
+	if len(layers) == 1 {
+		return layers[0], nil
+	}
+	for _, layer := range layers {
+		if strings.HasSuffix(layer.Annotations["org.opencontainers.image.title"], ".zip") {
+			return layer, nil
+		}
+	}
+	return descriptor{}, errors.New("artifact must have a single layer or a layer titled *.zip")
+}
+
+// session sends the requests for one artifact, authenticating once when
+// the registry asks for it
+type session struct {
+	client     *http.Client
+	artifact   lambda.OCIArtifact
+	base       string
+	repository string
+	reference  string
+	token      string
+	basic      bool
+	err        error
+}
+
+func (r *Repository) newSession(artifact lambda.OCIArtifact) *session {
+	registry, repository, reference, err := lambda.ParseArtifactReference(artifact.Reference)
+	scheme := "https"
+	if artifact.PlainHTTP {
+		scheme = "http"
+	}
+	return &session{
+		client:     r.client,
+		artifact:   artifact,
+		base:       fmt.Sprintf("%s://%s/v2/%s", scheme, registry, repository),
+		repository: repository,
+		reference:  reference,
+		err:        err,
+	}
+}
+
+// manifest downloads the manifest the artifact reference points to
+func (s *session) manifest(ctx context.Context) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	data, err := s.get(ctx, s.base+"/manifests/"+s.reference, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest of %s: %w", s.artifact.Reference, err)
+	}
+	return data, nil
+}
+
+func (s *session) get(ctx context.Context, target, accept string) ([]byte, error) {
+	resp, err := s.do(ctx, target, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && s.token == "" && !s.basic {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := s.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = s.do(ctx, target, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("registry returned %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, lambda.MaxArchiveSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > lambda.MaxArchiveSize {
+		return nil, lambda.ErrArchiveTooLarge
+	}
+	return data, nil
+}
+
+func (s *session) do(ctx context.Context, target, accept string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	switch {
+	case s.token != "":
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	case s.basic:
+		req.SetBasicAuth(s.artifact.Username, s.artifact.Password)
+	}
+	return s.client.Do(req)
+}
+
+// authenticate answers a registry challenge with basic auth, or with a token
+// from the registry token service, anonymous when there are no credentials
+func (s *session) authenticate(ctx context.Context, challenge string) error {
+	scheme, rest, _ := strings.Cut(challenge, " ")
+	params := map[string]string{}
+	for _, match := range challengeParam.FindAllStringSubmatch(rest, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if s.artifact.Username == "" {
+			return errors.New("registry requires credentials")
+		}
+		s.basic = true
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported registry authentication %q", scheme)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("invalid registry token realm %q", params["realm"])
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + s.repository + ":pull"
+	}
+	query.Set("scope", scope)
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+	if s.artifact.Username != "" {
+		req.SetBasicAuth(s.artifact.Username, s.artifact.Password)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get registry token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry token service returned %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("failed to parse registry token: %w", err)
+	}
+	s.token = token.Token
+	if s.token == "" {
+		s.token = token.AccessToken
+	}
+	if s.token == "" {
+		return errors.New("registry token service returned no token")
+	}
+	return nil
+}
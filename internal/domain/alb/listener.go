@@ -0,0 +1,333 @@
+package alb
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidLoadBalancerARN = errors.New("listener load balancer ARN cannot be empty")
+	ErrMissingCertificate     = errors.New("HTTPS and TLS listeners require a certificate")
+	ErrInvalidAction          = errors.New("invalid listener action")
+	ErrInvalidRule            = errors.New("invalid listener rule")
+)
+
+// Action types
+const (
+	ActionForward       = "forward"
+	ActionRedirect      = "redirect"
+	ActionFixedResponse = "fixed-response"
+)
+
+// Listener represents an ALB or NLB listener with its rules
+type Listener struct {
+	// Identification
+	LoadBalancerARN string
+	ARN             string
+
+	// Configuration
+	Protocol  string // HTTP or HTTPS for ALB; TCP, TLS, UDP or TCP_UDP for NLB
+	Port      int32
+	SslPolicy string
+
+	// CertificateARNs are the certificates of HTTPS and TLS listeners. The
+	// first one is the default certificate, the others are served by SNI.
+	CertificateARNs []string
+
+	// DefaultActions run when no rule matches
+	DefaultActions []Action
+
+	// Rules are the ALB routing rules, keyed by priority. They are
+	// authoritative: rules in AWS that are not declared are deleted.
+	Rules []ListenerRule
+
+	// Tags
+	Tags map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	LastSyncTime *time.Time
+}
+
+// Action is what a listener or rule does with a request
+type Action struct {
+	Type string // forward, redirect or fixed-response
+
+	// TargetGroups receive the requests of a forward action by weight
+	TargetGroups []WeightedTargetGroup
+
+	Redirect      *RedirectAction
+	FixedResponse *FixedResponseAction
+}
+
+// WeightedTargetGroup is a target group of a forward action. The weight is
+// only used when forwarding to more than one target group.
+type WeightedTargetGroup struct {
+	ARN    string
+	Weight int32
+}
+
+// RedirectAction redirects requests. Empty fields keep the value of the request.
+type RedirectAction struct {
+	Protocol   string
+	Host       string
+	Port       string
+	Path       string
+	Query      string
+	StatusCode string // HTTP_301 or HTTP_302
+}
+
+// FixedResponseAction answers requests without forwarding them
+type FixedResponseAction struct {
+	StatusCode  string
+	ContentType string
+	MessageBody string
+}
+
+// ListenerRule routes the requests matching all its conditions
+type ListenerRule struct {
+	ARN        string
+	Priority   int32
+	Conditions RuleConditions
+	Actions    []Action
+}
+
+// RuleConditions are the conditions of a rule. A rule matches when every
+// condition set matches one of its values.
+type RuleConditions struct {
+	HostHeaders        []string
+	PathPatterns       []string
+	HTTPRequestMethods []string
+	SourceIPs          []string
+}
+
+// ListenerRuleChanges holds what must change on a listener for its rules to
+// match the desired rules
+type ListenerRuleChanges struct {
+	Create []ListenerRule
+	Modify []ListenerRule
+	Delete []ListenerRule
+}
+
+// Validate checks if the listener configuration is valid
+func (l *Listener) Validate() error {
+	if l.LoadBalancerARN == "" {
+		return ErrInvalidLoadBalancerARN
+	}
+	if !protocols[l.Protocol] {
+		return ErrInvalidProtocol
+	}
+	if l.Port < 1 || l.Port > 65535 {
+		return ErrInvalidPort
+	}
+	if l.RequiresCertificate() && len(l.CertificateARNs) == 0 {
+		return ErrMissingCertificate
+	}
+
+	if len(l.DefaultActions) == 0 {
+		return fmt.Errorf("%w: at least one default action is required", ErrInvalidAction)
+	}
+	for _, action := range l.DefaultActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+	}
+
+	priorities := map[int32]bool{}
+	for _, rule := range l.Rules {
+		if rule.Priority < 1 || rule.Priority > 50000 {
+			return fmt.Errorf("%w: priority %d must be between 1 and 50000", ErrInvalidRule, rule.Priority)
+		}
+		if priorities[rule.Priority] {
+			return fmt.Errorf("%w: priority %d is used by more than one rule", ErrInvalidRule, rule.Priority)
+		}
+		priorities[rule.Priority] = true
+
+		c := rule.Conditions
+		if len(c.HostHeaders) == 0 && len(c.PathPatterns) == 0 && len(c.HTTPRequestMethods) == 0 && len(c.SourceIPs) == 0 {
+			return fmt.Errorf("%w: rule %d has no conditions", ErrInvalidRule, rule.Priority)
+		}
+		if len(rule.Actions) == 0 {
+			return fmt.Errorf("%w: rule %d has no actions", ErrInvalidRule, rule.Priority)
+		}
+		for _, action := range rule.Actions {
+			if err := action.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks an action has the configuration of its type
+func (a Action) Validate() error {
+	switch a.Type {
+	case ActionForward:
+		if len(a.TargetGroups) == 0 {
+			return fmt.Errorf("%w: forward requires a target group", ErrInvalidAction)
+		}
+		for _, tg := range a.TargetGroups {
+			if tg.ARN == "" {
+				return fmt.Errorf("%w: forward target group ARN cannot be empty", ErrInvalidAction)
+			}
+		}
+	case ActionRedirect:
+		if a.Redirect == nil {
+			return fmt.Errorf("%w: redirect requires a redirect configuration", ErrInvalidAction)
+		}
+	case ActionFixedResponse:
+		if a.FixedResponse == nil || a.FixedResponse.StatusCode == "" {
+			return fmt.Errorf("%w: fixed-response requires a status code", ErrInvalidAction)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidAction, a.Type)
+	}
+	return nil
+}
+
+// SetDefaults sets default values for optional fields
+func (l *Listener) SetDefaults() {
+	if l.RequiresCertificate() && l.SslPolicy == "" {
+		l.SslPolicy = "ELBSecurityPolicy-TLS13-1-2-2021-06"
+	}
+
+	if l.DeletionPolicy == "" {
+		l.DeletionPolicy = "Delete"
+	}
+
+	if l.Tags == nil {
+		l.Tags = make(map[string]string)
+	}
+}
+
+// ShouldDelete returns true if the listener should be deleted when the CR is deleted
+func (l *Listener) ShouldDelete() bool {
+	return l.DeletionPolicy != "Retain" && l.DeletionPolicy != "Orphan"
+}
+
+// RequiresCertificate reports whether the listener terminates TLS
+func (l *Listener) RequiresCertificate() bool {
+	return l.Protocol == "HTTPS" || l.Protocol == "TLS"
+}
+
+// DefaultCertificate returns the default certificate, if any
+func (l *Listener) DefaultCertificate() string {
+	if len(l.CertificateARNs) == 0 {
+		return ""
+	}
+	return l.CertificateARNs[0]
+}
+
+// AdditionalCertificates returns the certificates served by SNI
+func (l *Listener) AdditionalCertificates() []string {
+	if len(l.CertificateARNs) < 2 {
+		return nil
+	}
+	return l.CertificateARNs[1:]
+}
+
+// ConfigurationChanged reports whether the listener settings changed
+// compared with the listener in AWS. Rules and additional certificates are
+// reconciled separately.
+func (l *Listener) ConfigurationChanged(current *Listener) bool {
+	return l.Protocol != current.Protocol ||
+		l.Port != current.Port ||
+		(l.RequiresCertificate() && l.SslPolicy != current.SslPolicy) ||
+		l.DefaultCertificate() != current.DefaultCertificate() ||
+		!ActionsEqual(l.DefaultActions, current.DefaultActions)
+}
+
+// ActionsEqual reports whether two action lists do the same, ignoring the
+// defaults AWS fills in
+func ActionsEqual(a, b []Action) bool {
+	return reflect.DeepEqual(normalizeActions(a), normalizeActions(b))
+}
+
+// DiffRules compares the desired rules with the rules of the listener in AWS
+// by priority. The ARNs of existing rules are copied to the rules to modify.
+func DiffRules(desired, current []ListenerRule) ListenerRuleChanges {
+	existing := make(map[int32]ListenerRule, len(current))
+	for _, rule := range current {
+		existing[rule.Priority] = rule
+	}
+	wanted := make(map[int32]bool, len(desired))
+
+	var changes ListenerRuleChanges
+	for _, rule := range desired {
+		wanted[rule.Priority] = true
+		cur, ok := existing[rule.Priority]
+		switch {
+		case !ok:
+			changes.Create = append(changes.Create, rule)
+		case !reflect.DeepEqual(normalizeConditions(rule.Conditions), normalizeConditions(cur.Conditions)) ||
+			!ActionsEqual(rule.Actions, cur.Actions):
+			rule.ARN = cur.ARN
+			changes.Modify = append(changes.Modify, rule)
+		}
+	}
+	for _, rule := range current {
+		if !wanted[rule.Priority] {
+			changes.Delete = append(changes.Delete, rule)
+		}
+	}
+	return changes
+}
+
+// normalizeActions fills in the redirect defaults and drops the weight of
+// single target group forwards, which AWS reports as 1
+func normalizeActions(actions []Action) []Action {
+	out := make([]Action, 0, len(actions))
+	for _, action := range actions {
+		if len(action.TargetGroups) == 1 {
+			action.TargetGroups = []WeightedTargetGroup{{ARN: action.TargetGroups[0].ARN}}
+		} else if len(action.TargetGroups) > 1 {
+			groups := append([]WeightedTargetGroup{}, action.TargetGroups...)
+			sort.Slice(groups, func(i, j int) bool { return groups[i].ARN < groups[j].ARN })
+			action.TargetGroups = groups
+		} else {
+			action.TargetGroups = nil
+		}
+		if r := action.Redirect; r != nil {
+			redirect := *r
+			redirect.Protocol = orDefault(redirect.Protocol, "#{protocol}")
+			redirect.Host = orDefault(redirect.Host, "#{host}")
+			redirect.Port = orDefault(redirect.Port, "#{port}")
+			redirect.Path = orDefault(redirect.Path, "/#{path}")
+			redirect.Query = orDefault(redirect.Query, "#{query}")
+			action.Redirect = &redirect
+		}
+		out = append(out, action)
+	}
+	return out
+}
+
+// normalizeConditions sorts condition values, which AWS does not keep in order
+func normalizeConditions(c RuleConditions) RuleConditions {
+	sorted := func(values []string) []string {
+		if len(values) == 0 {
+			return nil
+		}
+		out := append([]string{}, values...)
+		sort.Strings(out)
+		return out
+	}
+	return RuleConditions{
+		HostHeaders:        sorted(c.HostHeaders),
+		PathPatterns:       sorted(c.PathPatterns),
+		HTTPRequestMethods: sorted(c.HTTPRequestMethods),
+		SourceIPs:          sorted(c.SourceIPs),
+	}
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,129 @@
+package alb_test
+
+import (
+	"errors"
+	"testing"
+
+	"infra-operator/internal/domain/alb"
+)
+
+func forward(arns ...string) []alb.Action {
+	action := alb.Action{Type: alb.ActionForward}
+	for _, arn := range arns {
+		action.TargetGroups = append(action.TargetGroups, alb.WeightedTargetGroup{ARN: arn, Weight: 1})
+	}
+	return []alb.Action{action}
+}
+
+func TestListener_Validate(t *testing.T) {
+	paths := alb.RuleConditions{PathPatterns: []string{"/api/*"}}
+
+	tests := []struct {
+		name     string
+		listener *alb.Listener
+		wantErr  error
+	}{
+		{
+			name:     "valid HTTP",
+			listener: &alb.Listener{Protocol: "HTTP", DefaultActions: forward("arn:tg")},
+		},
+		{
+			name: "valid HTTPS with rules",
+			listener: &alb.Listener{
+				Protocol:        "HTTPS",
+				CertificateARNs: []string{"arn:cert"},
+				DefaultActions:  []alb.Action{{Type: alb.ActionFixedResponse, FixedResponse: &alb.FixedResponseAction{StatusCode: "404"}}},
+				Rules:           []alb.ListenerRule{{Priority: 10, Conditions: paths, Actions: forward("arn:tg")}},
+			},
+		},
+		{
+			name:     "HTTPS without certificate",
+			listener: &alb.Listener{Protocol: "HTTPS", DefaultActions: forward("arn:tg")},
+			wantErr:  alb.ErrMissingCertificate,
+		},
+		{
+			name:     "no default action",
+			listener: &alb.Listener{Protocol: "TCP"},
+			wantErr:  alb.ErrInvalidAction,
+		},
+		{
+			name:     "redirect without configuration",
+			listener: &alb.Listener{Protocol: "HTTP", DefaultActions: []alb.Action{{Type: alb.ActionRedirect}}},
+			wantErr:  alb.ErrInvalidAction,
+		},
+		{
+			name: "duplicate priority",
+			listener: &alb.Listener{Protocol: "HTTP", DefaultActions: forward("arn:tg"), Rules: []alb.ListenerRule{
+				{Priority: 10, Conditions: paths, Actions: forward("arn:a")},
+				{Priority: 10, Conditions: paths, Actions: forward("arn:b")},
+			}},
+			wantErr: alb.ErrInvalidRule,
+		},
+		{
+			name: "rule without conditions",
+			listener: &alb.Listener{Protocol: "HTTP", DefaultActions: forward("arn:tg"), Rules: []alb.ListenerRule{
+				{Priority: 10, Actions: forward("arn:a")},
+			}},
+			wantErr: alb.ErrInvalidRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.listener.LoadBalancerARN = "arn:lb"
+			tt.listener.Port = 443
+			tt.listener.SetDefaults()
+
+			if err := tt.listener.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestActionsEqual(t *testing.T) {
+	// AWS reports a weight of 1 for single target group forwards
+	if !alb.ActionsEqual([]alb.Action{{Type: alb.ActionForward, TargetGroups: []alb.WeightedTargetGroup{{ARN: "arn:tg"}}}}, forward("arn:tg")) {
+		t.Error("single target group forwards should ignore the weight")
+	}
+
+	// AWS fills in the redirect defaults
+	desired := []alb.Action{{Type: alb.ActionRedirect, Redirect: &alb.RedirectAction{Protocol: "HTTPS", Port: "443", StatusCode: "HTTP_301"}}}
+	current := []alb.Action{{Type: alb.ActionRedirect, Redirect: &alb.RedirectAction{
+		Protocol: "HTTPS", Host: "#{host}", Port: "443", Path: "/#{path}", Query: "#{query}", StatusCode: "HTTP_301",
+	}}}
+	if !alb.ActionsEqual(desired, current) {
+		t.Error("redirects should be equal once defaults are filled in")
+	}
+
+	weighted := []alb.Action{{Type: alb.ActionForward, TargetGroups: []alb.WeightedTargetGroup{{ARN: "arn:blue", Weight: 90}, {ARN: "arn:green", Weight: 10}}}}
+	shifted := []alb.Action{{Type: alb.ActionForward, TargetGroups: []alb.WeightedTargetGroup{{ARN: "arn:green", Weight: 50}, {ARN: "arn:blue", Weight: 50}}}}
+	if alb.ActionsEqual(weighted, shifted) {
+		t.Error("forwards with different weights should not be equal")
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	desired := []alb.ListenerRule{
+		{Priority: 10, Conditions: alb.RuleConditions{PathPatterns: []string{"/api/*", "/v1/*"}}, Actions: forward("arn:api")},
+		{Priority: 20, Conditions: alb.RuleConditions{HostHeaders: []string{"admin.example.com"}}, Actions: forward("arn:admin")},
+		{Priority: 30, Conditions: alb.RuleConditions{PathPatterns: []string{"/static/*"}}, Actions: forward("arn:static")},
+	}
+	current := []alb.ListenerRule{
+		{ARN: "arn:rule/10", Priority: 10, Conditions: alb.RuleConditions{PathPatterns: []string{"/v1/*", "/api/*"}}, Actions: forward("arn:api")},
+		{ARN: "arn:rule/20", Priority: 20, Conditions: alb.RuleConditions{HostHeaders: []string{"admin.example.com"}}, Actions: forward("arn:old")},
+		{ARN: "arn:rule/40", Priority: 40, Conditions: alb.RuleConditions{PathPatterns: []string{"/legacy/*"}}, Actions: forward("arn:legacy")},
+	}
+
+	changes := alb.DiffRules(desired, current)
+
+	if len(changes.Create) != 1 || changes.Create[0].Priority != 30 {
+		t.Errorf("Create = %v, want rule 30", changes.Create)
+	}
+	if len(changes.Modify) != 1 || changes.Modify[0].Priority != 20 || changes.Modify[0].ARN != "arn:rule/20" {
+		t.Errorf("Modify = %v, want rule 20 with its ARN", changes.Modify)
+	}
+	if len(changes.Delete) != 1 || changes.Delete[0].ARN != "arn:rule/40" {
+		t.Errorf("Delete = %v, want rule 40", changes.Delete)
+	}
+}
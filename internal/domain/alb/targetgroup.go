@@ -0,0 +1,245 @@
+package alb
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTargetGroupName = errors.New("target group name must have 1-32 alphanumeric characters or hyphens")
+	ErrInvalidProtocol        = errors.New("protocol must be HTTP, HTTPS, TCP, TLS, UDP or TCP_UDP")
+	ErrInvalidPort            = errors.New("port must be between 1 and 65535")
+	ErrInvalidTargetType      = errors.New("target type must be 'instance' or 'ip'")
+	ErrInvalidVpcID           = errors.New("target group VPC ID cannot be empty")
+	ErrInvalidHealthCheck     = errors.New("invalid health check")
+	ErrInvalidTarget          = errors.New("invalid target")
+)
+
+// Target types
+const (
+	TargetTypeInstance = "instance"
+	TargetTypeIP       = "ip"
+)
+
+var targetGroupNamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?$`)
+
+// protocols lists the protocols of ALB (HTTP, HTTPS) and NLB (the others) listeners and target groups
+var protocols = map[string]bool{
+	"HTTP":    true,
+	"HTTPS":   true,
+	"TCP":     true,
+	"TLS":     true,
+	"UDP":     true,
+	"TCP_UDP": true,
+}
+
+// TargetGroup represents an ELBv2 target group. Target groups are shared by
+// ALB and NLB listeners.
+type TargetGroup struct {
+	// Identification
+	Name string
+	ARN  string
+
+	// Configuration (immutable once created)
+	Protocol        string // HTTP, HTTPS, TCP, TLS, UDP or TCP_UDP
+	ProtocolVersion string // HTTP1, HTTP2 or GRPC, for HTTP and HTTPS only
+	Port            int32
+	TargetType      string // instance or ip
+	VpcID           string
+
+	// HealthCheck overrides the AWS health check defaults
+	HealthCheck *HealthCheck
+
+	// DeregistrationDelay is how long draining targets keep their connections
+	DeregistrationDelay *int32
+
+	// Targets are registered in the group. They are only managed when
+	// declared, so targets registered by something else (such as an Auto
+	// Scaling group) are left alone otherwise.
+	Targets []Target
+
+	// Tags
+	Tags map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	LoadBalancerARNs []string
+	TargetHealth     []TargetHealth
+	LastSyncTime     *time.Time
+}
+
+// HealthCheck configures how targets are checked. Empty fields keep the AWS
+// defaults for the protocol.
+type HealthCheck struct {
+	Protocol           string
+	Port               string
+	Path               string
+	Matcher            string
+	IntervalSeconds    int32
+	TimeoutSeconds     int32
+	HealthyThreshold   int32
+	UnhealthyThreshold int32
+}
+
+// Target is an instance ID or IP address registered in a target group. A
+// zero port means the target group port.
+type Target struct {
+	ID               string
+	Port             int32
+	AvailabilityZone string
+}
+
+// TargetHealth is the health of a registered target
+type TargetHealth struct {
+	Target
+	State  string
+	Reason string
+}
+
+// Validate checks if the target group configuration is valid
+func (tg *TargetGroup) Validate() error {
+	if !targetGroupNamePattern.MatchString(tg.Name) {
+		return ErrInvalidTargetGroupName
+	}
+	if !protocols[tg.Protocol] {
+		return ErrInvalidProtocol
+	}
+	if tg.Port < 1 || tg.Port > 65535 {
+		return ErrInvalidPort
+	}
+	if tg.TargetType != TargetTypeInstance && tg.TargetType != TargetTypeIP {
+		return ErrInvalidTargetType
+	}
+	if tg.VpcID == "" {
+		return ErrInvalidVpcID
+	}
+
+	if hc := tg.HealthCheck; hc != nil {
+		if hc.IntervalSeconds != 0 && (hc.IntervalSeconds < 5 || hc.IntervalSeconds > 300) {
+			return fmt.Errorf("%w: interval must be between 5 and 300 seconds", ErrInvalidHealthCheck)
+		}
+		if hc.TimeoutSeconds != 0 && hc.IntervalSeconds != 0 && hc.TimeoutSeconds >= hc.IntervalSeconds {
+			return fmt.Errorf("%w: timeout must be less than the interval", ErrInvalidHealthCheck)
+		}
+		if hc.Path != "" && !strings.HasPrefix(hc.Path, "/") {
+			return fmt.Errorf("%w: path must start with /", ErrInvalidHealthCheck)
+		}
+	}
+
+	for _, target := range tg.Targets {
+		switch {
+		case tg.TargetType == TargetTypeInstance && !strings.HasPrefix(target.ID, "i-"):
+			return fmt.Errorf("%w: %q is not an instance ID", ErrInvalidTarget, target.ID)
+		case tg.TargetType == TargetTypeIP && net.ParseIP(target.ID) == nil:
+			return fmt.Errorf("%w: %q is not an IP address", ErrInvalidTarget, target.ID)
+		case target.Port < 0 || target.Port > 65535:
+			return fmt.Errorf("%w: port %d of %s", ErrInvalidTarget, target.Port, target.ID)
+		}
+	}
+
+	return nil
+}
+
+// SetDefaults sets default values for optional fields
+func (tg *TargetGroup) SetDefaults() {
+	if tg.TargetType == "" {
+		tg.TargetType = TargetTypeInstance
+	}
+
+	if tg.DeletionPolicy == "" {
+		tg.DeletionPolicy = "Delete"
+	}
+
+	if tg.Tags == nil {
+		tg.Tags = make(map[string]string)
+	}
+}
+
+// ShouldDelete returns true if the target group should be deleted when the CR is deleted
+func (tg *TargetGroup) ShouldDelete() bool {
+	return tg.DeletionPolicy != "Retain" && tg.DeletionPolicy != "Orphan"
+}
+
+// ManagesTargets reports whether the registered targets are reconciled
+func (tg *TargetGroup) ManagesTargets() bool {
+	return len(tg.Targets) > 0
+}
+
+// HealthCheckChanged reports whether the desired health check differs from
+// the current one. Only the fields set in the desired health check are compared.
+func (tg *TargetGroup) HealthCheckChanged(current *HealthCheck) bool {
+	desired := tg.HealthCheck
+	if desired == nil {
+		return false
+	}
+	if current == nil {
+		return true
+	}
+	return (desired.Protocol != "" && desired.Protocol != current.Protocol) ||
+		(desired.Port != "" && desired.Port != current.Port) ||
+		(desired.Path != "" && desired.Path != current.Path) ||
+		(desired.Matcher != "" && desired.Matcher != current.Matcher) ||
+		(desired.IntervalSeconds != 0 && desired.IntervalSeconds != current.IntervalSeconds) ||
+		(desired.TimeoutSeconds != 0 && desired.TimeoutSeconds != current.TimeoutSeconds) ||
+		(desired.HealthyThreshold != 0 && desired.HealthyThreshold != current.HealthyThreshold) ||
+		(desired.UnhealthyThreshold != 0 && desired.UnhealthyThreshold != current.UnhealthyThreshold)
+}
+
+// HealthyTargets returns how many registered targets are healthy
+func (tg *TargetGroup) HealthyTargets() int {
+	healthy := 0
+	for _, target := range tg.TargetHealth {
+		if target.State == TargetStateHealthy {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+// Key identifies a target by ID and port
+func (t Target) Key() string {
+	return fmt.Sprintf("%s:%d", t.ID, t.Port)
+}
+
+// DiffTargets compares the desired targets with the registered ones, using
+// port as the port of targets without one. Targets missing from the group are
+// registered and targets not desired are deregistered.
+func DiffTargets(desired, current []Target, port int32) (register, deregister []Target) {
+	withPort := func(targets []Target) map[string]Target {
+		byKey := make(map[string]Target, len(targets))
+		for _, target := range targets {
+			if target.Port == 0 {
+				target.Port = port
+			}
+			byKey[target.Key()] = target
+		}
+		return byKey
+	}
+	wanted, existing := withPort(desired), withPort(current)
+
+	for key, target := range wanted {
+		if _, ok := existing[key]; !ok {
+			register = append(register, target)
+		}
+	}
+	for key, target := range existing {
+		if _, ok := wanted[key]; !ok {
+			deregister = append(deregister, target)
+		}
+	}
+
+	sortTargets(register)
+	sortTargets(deregister)
+	return register, deregister
+}
+
+func sortTargets(targets []Target) {
+	sort.Slice(targets, func(i, j int) bool { return targets[i].Key() < targets[j].Key() })
+}
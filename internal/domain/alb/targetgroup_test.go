@@ -0,0 +1,110 @@
+package alb_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"infra-operator/internal/domain/alb"
+)
+
+func TestTargetGroup_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tg      *alb.TargetGroup
+		wantErr error
+	}{
+		{
+			name: "valid instance targets",
+			tg:   &alb.TargetGroup{Targets: []alb.Target{{ID: "i-0123456789abcdef0"}, {ID: "i-0fedcba9876543210", Port: 8080}}},
+		},
+		{
+			name:    "invalid name",
+			tg:      &alb.TargetGroup{Name: "web_tg"},
+			wantErr: alb.ErrInvalidTargetGroupName,
+		},
+		{
+			name:    "invalid protocol",
+			tg:      &alb.TargetGroup{Protocol: "GRPC"},
+			wantErr: alb.ErrInvalidProtocol,
+		},
+		{
+			name:    "missing VPC",
+			tg:      &alb.TargetGroup{VpcID: "-"},
+			wantErr: alb.ErrInvalidVpcID,
+		},
+		{
+			name:    "health check timeout above interval",
+			tg:      &alb.TargetGroup{HealthCheck: &alb.HealthCheck{IntervalSeconds: 10, TimeoutSeconds: 10}},
+			wantErr: alb.ErrInvalidHealthCheck,
+		},
+		{
+			name:    "IP target in instance group",
+			tg:      &alb.TargetGroup{Targets: []alb.Target{{ID: "10.0.1.15"}}},
+			wantErr: alb.ErrInvalidTarget,
+		},
+		{
+			name:    "instance target in IP group",
+			tg:      &alb.TargetGroup{TargetType: alb.TargetTypeIP, Targets: []alb.Target{{ID: "i-0123456789abcdef0"}}},
+			wantErr: alb.ErrInvalidTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.tg.Name == "" {
+				tt.tg.Name = "web-tg"
+			}
+			if tt.tg.Protocol == "" {
+				tt.tg.Protocol = "HTTP"
+			}
+			tt.tg.Port = 80
+			switch tt.tg.VpcID {
+			case "":
+				tt.tg.VpcID = "vpc-123"
+			case "-":
+				tt.tg.VpcID = ""
+			}
+			tt.tg.SetDefaults()
+
+			if err := tt.tg.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDiffTargets(t *testing.T) {
+	desired := []alb.Target{{ID: "i-1"}, {ID: "i-2", Port: 8080}, {ID: "i-3"}}
+	current := []alb.Target{{ID: "i-1", Port: 80}, {ID: "i-2", Port: 80}, {ID: "i-4", Port: 80}}
+
+	register, deregister := alb.DiffTargets(desired, current, 80)
+
+	wantRegister := []alb.Target{{ID: "i-2", Port: 8080}, {ID: "i-3", Port: 80}}
+	wantDeregister := []alb.Target{{ID: "i-2", Port: 80}, {ID: "i-4", Port: 80}}
+	if !reflect.DeepEqual(register, wantRegister) {
+		t.Errorf("register = %v, want %v", register, wantRegister)
+	}
+	if !reflect.DeepEqual(deregister, wantDeregister) {
+		t.Errorf("deregister = %v, want %v", deregister, wantDeregister)
+	}
+}
+
+func TestTargetGroup_HealthCheckChanged(t *testing.T) {
+	current := &alb.HealthCheck{Protocol: "HTTP", Port: "traffic-port", Path: "/", IntervalSeconds: 30, TimeoutSeconds: 5}
+
+	tg := &alb.TargetGroup{}
+	if tg.HealthCheckChanged(current) {
+		t.Error("no desired health check should keep the current one")
+	}
+
+	tg.HealthCheck = &alb.HealthCheck{Path: "/"}
+	if tg.HealthCheckChanged(current) {
+		t.Error("unset fields should not be compared")
+	}
+
+	tg.HealthCheck.Path = "/healthz"
+	if !tg.HealthCheckChanged(current) {
+		t.Error("a different path should change the health check")
+	}
+}
@@ -0,0 +1,174 @@
+package alb
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTargetGroupARN = errors.New("target group ARN cannot be empty")
+	ErrInvalidProviderID     = errors.New("node provider ID does not name an EC2 instance")
+	ErrTargetGroupNotFound   = errors.New("target group not found")
+)
+
+// Target health states reported by ELBv2
+const (
+	TargetStateHealthy  = "healthy"
+	TargetStateUnused   = "unused"
+	TargetStateDraining = "draining"
+)
+
+// TargetGroupBinding registers the endpoints of a Kubernetes Service in a
+// target group: pod IPs for ip target groups, node instance IDs with the
+// Service node port for instance target groups. Only the targets the binding
+// registered are deregistered, so the group can be shared with other bindings.
+type TargetGroupBinding struct {
+	TargetGroupARN string
+	TargetType     string // instance or ip
+
+	// Targets are the endpoints that should receive traffic
+	Targets []Target
+
+	// ManagedTargets are the targets registered by the binding, including
+	// deregistered ones that are still draining
+	ManagedTargets []Target
+
+	// Status
+	TargetHealth []TargetHealth
+	LastSyncTime *time.Time
+}
+
+// Validate checks if the binding configuration is valid
+func (b *TargetGroupBinding) Validate() error {
+	if b.TargetGroupARN == "" {
+		return ErrInvalidTargetGroupARN
+	}
+	if b.TargetType != TargetTypeInstance && b.TargetType != TargetTypeIP {
+		return ErrInvalidTargetType
+	}
+
+	for _, target := range b.Targets {
+		switch {
+		case b.TargetType == TargetTypeInstance && !strings.HasPrefix(target.ID, "i-"):
+			return fmt.Errorf("%w: %q is not an instance ID", ErrInvalidTarget, target.ID)
+		case b.TargetType == TargetTypeIP && net.ParseIP(target.ID) == nil:
+			return fmt.Errorf("%w: %q is not an IP address", ErrInvalidTarget, target.ID)
+		case target.Port < 1 || target.Port > 65535:
+			return fmt.Errorf("%w: port %d of %s", ErrInvalidTarget, target.Port, target.ID)
+		}
+	}
+
+	return nil
+}
+
+// DiffTargets compares the targets of the binding with the health of the
+// group. Targets missing from the group are registered; targets the binding
+// registered that are no longer desired are deregistered.
+func (b *TargetGroupBinding) DiffTargets(health []TargetHealth) (register, deregister []Target) {
+	registered := make([]Target, 0, len(health))
+	for _, target := range health {
+		// Draining targets are already being deregistered
+		if target.State != TargetStateDraining {
+			registered = append(registered, target.Target)
+		}
+	}
+
+	register, deregister = DiffTargets(b.Targets, registered, 0)
+	// Targets registered by other means are left alone
+	deregister = slices.DeleteFunc(deregister, func(target Target) bool { return !b.manages(target) })
+	return register, deregister
+}
+
+// RecordTargets records the targets of the binding and their health. Targets
+// the binding deregistered stay managed while the group still reports them.
+func (b *TargetGroupBinding) RecordTargets(health []TargetHealth) {
+	present := make(map[string]bool, len(health))
+	for _, target := range health {
+		present[target.Key()] = true
+	}
+
+	managed := slices.Clone(b.Targets)
+	for _, target := range b.ManagedTargets {
+		if present[target.Key()] && !b.wants(target) {
+			managed = append(managed, target)
+		}
+	}
+	sortTargets(managed)
+	b.ManagedTargets = managed
+
+	b.TargetHealth = nil
+	for _, target := range health {
+		if b.manages(target.Target) {
+			b.TargetHealth = append(b.TargetHealth, target)
+		}
+	}
+}
+
+func (b *TargetGroupBinding) manages(target Target) bool {
+	return slices.ContainsFunc(b.ManagedTargets, func(t Target) bool { return t.Key() == target.Key() })
+}
+
+func (b *TargetGroupBinding) wants(target Target) bool {
+	return slices.ContainsFunc(b.Targets, func(t Target) bool { return t.Key() == target.Key() })
+}
+
+// HealthyTargets returns how many registered targets are healthy
+func (b *TargetGroupBinding) HealthyTargets() int {
+	healthy := 0
+	for _, target := range b.TargetHealth {
+		if target.State == TargetStateHealthy {
+			healthy++
+		}
+	}
+	return healthy
+}
+
+// Draining returns how many targets are still draining after being deregistered
+func (b *TargetGroupBinding) Draining() int {
+	draining := 0
+	for _, target := range b.TargetHealth {
+		if target.State == TargetStateDraining {
+			draining++
+		}
+	}
+	return draining
+}
+
+// TargetReady reports whether a target passes its readiness gate: it is
+// healthy, or no load balancer uses the target group so there is no health
+// check to wait for. The reason explains a target that is not ready.
+func (b *TargetGroupBinding) TargetReady(target Target) (bool, string) {
+	for _, health := range b.TargetHealth {
+		if health.Key() != target.Key() {
+			continue
+		}
+		switch {
+		case health.State == TargetStateHealthy:
+			return true, ""
+		case health.State == TargetStateUnused && health.Reason == "Target.NotInUse":
+			return true, ""
+		case health.Reason != "":
+			return false, fmt.Sprintf("target is %s: %s", health.State, health.Reason)
+		default:
+			return false, fmt.Sprintf("target is %s", health.State)
+		}
+	}
+	return false, "target is not registered"
+}
+
+// InstanceIDFromProviderID extracts the EC2 instance ID from the provider ID
+// of a Kubernetes node (aws:///<availability-zone>/<instance-id>)
+func InstanceIDFromProviderID(providerID string) (string, error) {
+	if !strings.HasPrefix(providerID, "aws://") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidProviderID, providerID)
+	}
+	id := providerID[strings.LastIndex(providerID, "/")+1:]
+	if !strings.HasPrefix(id, "i-") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidProviderID, providerID)
+	}
+	return id, nil
+}
@@ -0,0 +1,170 @@
+package alb_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"infra-operator/internal/domain/alb"
+)
+
+func TestTargetGroupBinding_Validate(t *testing.T) {
+	arn := "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/api/abc"
+	tests := []struct {
+		name    string
+		binding *alb.TargetGroupBinding
+		wantErr error
+	}{
+		{
+			name:    "valid ip targets",
+			binding: &alb.TargetGroupBinding{TargetGroupARN: arn, TargetType: "ip", Targets: []alb.Target{{ID: "10.0.1.10", Port: 8080}}},
+		},
+		{
+			name:    "no targets",
+			binding: &alb.TargetGroupBinding{TargetGroupARN: arn, TargetType: "instance"},
+		},
+		{
+			name:    "missing ARN",
+			binding: &alb.TargetGroupBinding{TargetType: "ip"},
+			wantErr: alb.ErrInvalidTargetGroupARN,
+		},
+		{
+			name:    "instance ID in ip binding",
+			binding: &alb.TargetGroupBinding{TargetGroupARN: arn, TargetType: "ip", Targets: []alb.Target{{ID: "i-0123456789abcdef0", Port: 8080}}},
+			wantErr: alb.ErrInvalidTarget,
+		},
+		{
+			name:    "target without port",
+			binding: &alb.TargetGroupBinding{TargetGroupARN: arn, TargetType: "instance", Targets: []alb.Target{{ID: "i-0123456789abcdef0"}}},
+			wantErr: alb.ErrInvalidTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.binding.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTargetGroupBinding_TargetReady(t *testing.T) {
+	binding := &alb.TargetGroupBinding{
+		TargetHealth: []alb.TargetHealth{
+			{Target: alb.Target{ID: "10.0.1.10", Port: 8080}, State: "healthy"},
+			{Target: alb.Target{ID: "10.0.1.11", Port: 8080}, State: "initial", Reason: "Elb.RegistrationInProgress"},
+			{Target: alb.Target{ID: "10.0.1.12", Port: 8080}, State: "unused", Reason: "Target.NotInUse"},
+			{Target: alb.Target{ID: "10.0.1.13", Port: 8080}, State: "draining", Reason: "Target.DeregistrationInProgress"},
+		},
+	}
+
+	tests := []struct {
+		target alb.Target
+		want   bool
+	}{
+		{alb.Target{ID: "10.0.1.10", Port: 8080}, true},
+		{alb.Target{ID: "10.0.1.11", Port: 8080}, false},
+		{alb.Target{ID: "10.0.1.12", Port: 8080}, true},
+		{alb.Target{ID: "10.0.1.10", Port: 9090}, false},
+		{alb.Target{ID: "10.0.1.20", Port: 8080}, false},
+	}
+
+	for _, tt := range tests {
+		ready, reason := binding.TargetReady(tt.target)
+		if ready != tt.want {
+			t.Errorf("TargetReady(%s) = %v (%s), want %v", tt.target.Key(), ready, reason, tt.want)
+		}
+		if !ready && reason == "" {
+			t.Errorf("TargetReady(%s) returned no reason", tt.target.Key())
+		}
+	}
+
+	if got := binding.HealthyTargets(); got != 1 {
+		t.Errorf("HealthyTargets() = %d, want 1", got)
+	}
+	if got := binding.Draining(); got != 1 {
+		t.Errorf("Draining() = %d, want 1", got)
+	}
+}
+
+func TestTargetGroupBinding_DiffTargets(t *testing.T) {
+	binding := &alb.TargetGroupBinding{
+		Targets:        []alb.Target{{ID: "10.0.1.10", Port: 8080}, {ID: "10.0.1.12", Port: 8080}},
+		ManagedTargets: []alb.Target{{ID: "10.0.1.10", Port: 8080}, {ID: "10.0.1.11", Port: 8080}},
+	}
+	health := []alb.TargetHealth{
+		{Target: alb.Target{ID: "10.0.1.10", Port: 8080}, State: "healthy"},
+		{Target: alb.Target{ID: "10.0.1.11", Port: 8080}, State: "healthy"},
+		// Registered by another binding
+		{Target: alb.Target{ID: "10.0.2.10", Port: 8080}, State: "healthy"},
+	}
+
+	register, deregister := binding.DiffTargets(health)
+
+	wantRegister := []alb.Target{{ID: "10.0.1.12", Port: 8080}}
+	wantDeregister := []alb.Target{{ID: "10.0.1.11", Port: 8080}}
+	if !reflect.DeepEqual(register, wantRegister) {
+		t.Errorf("register = %v, want %v", register, wantRegister)
+	}
+	if !reflect.DeepEqual(deregister, wantDeregister) {
+		t.Errorf("deregister = %v, want %v", deregister, wantDeregister)
+	}
+
+	// Deleting the binding leaves the targets of the other binding alone
+	binding.Targets = nil
+	_, deregister = binding.DiffTargets(health)
+	wantDeregister = []alb.Target{{ID: "10.0.1.10", Port: 8080}, {ID: "10.0.1.11", Port: 8080}}
+	if !reflect.DeepEqual(deregister, wantDeregister) {
+		t.Errorf("deregister on delete = %v, want %v", deregister, wantDeregister)
+	}
+}
+
+func TestTargetGroupBinding_RecordTargets(t *testing.T) {
+	binding := &alb.TargetGroupBinding{
+		Targets:        []alb.Target{{ID: "10.0.1.12", Port: 8080}},
+		ManagedTargets: []alb.Target{{ID: "10.0.1.10", Port: 8080}, {ID: "10.0.1.11", Port: 8080}},
+	}
+	binding.RecordTargets([]alb.TargetHealth{
+		{Target: alb.Target{ID: "10.0.1.10", Port: 8080}, State: "draining"},
+		{Target: alb.Target{ID: "10.0.1.12", Port: 8080}, State: "initial"},
+		{Target: alb.Target{ID: "10.0.2.10", Port: 8080}, State: "healthy"},
+	})
+
+	// The draining target stays managed until it leaves the group
+	wantManaged := []alb.Target{{ID: "10.0.1.10", Port: 8080}, {ID: "10.0.1.12", Port: 8080}}
+	if !reflect.DeepEqual(binding.ManagedTargets, wantManaged) {
+		t.Errorf("ManagedTargets = %v, want %v", binding.ManagedTargets, wantManaged)
+	}
+	if len(binding.TargetHealth) != 2 {
+		t.Errorf("TargetHealth = %v, want only the targets of the binding", binding.TargetHealth)
+	}
+	if got := binding.Draining(); got != 1 {
+		t.Errorf("Draining() = %d, want 1", got)
+	}
+}
+
+func TestInstanceIDFromProviderID(t *testing.T) {
+	tests := []struct {
+		providerID string
+		want       string
+		wantErr    bool
+	}{
+		{providerID: "aws:///us-east-1a/i-0123456789abcdef0", want: "i-0123456789abcdef0"},
+		{providerID: "aws:///us-east-1a/fargate-ip-10-0-1-10.ec2.internal", wantErr: true},
+		{providerID: "gce://project/zone/node-1", wantErr: true},
+		{providerID: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := alb.InstanceIDFromProviderID(tt.providerID)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("InstanceIDFromProviderID(%q) error = %v, wantErr %v", tt.providerID, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("InstanceIDFromProviderID(%q) = %q, want %q", tt.providerID, got, tt.want)
+		}
+	}
+}
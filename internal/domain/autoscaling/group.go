@@ -0,0 +1,405 @@
+package autoscaling
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidGroupName      = errors.New("auto scaling group name must have 1-255 characters")
+	ErrInvalidSize           = errors.New("sizes must satisfy 0 <= minSize <= desiredCapacity <= maxSize")
+	ErrInvalidSubnets        = errors.New("at least one subnet is required")
+	ErrInvalidLaunchTemplate = errors.New("launch template ID is required")
+	ErrInvalidMixedInstances = errors.New("invalid mixed instances policy")
+	ErrInvalidLifecycleHook  = errors.New("invalid lifecycle hook")
+	ErrInvalidHealthCheck    = errors.New("health check type must be 'EC2' or 'ELB'")
+	ErrInvalidRefresh        = errors.New("invalid instance refresh preferences")
+)
+
+// Lifecycle transitions
+const (
+	TransitionLaunching   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+	TransitionTerminating = "autoscaling:EC2_INSTANCE_TERMINATING"
+)
+
+// Instance refresh statuses
+const (
+	RefreshPending    = "Pending"
+	RefreshInProgress = "InProgress"
+	RefreshCancelling = "Cancelling"
+	RefreshBaking     = "Baking"
+	RefreshSuccessful = "Successful"
+)
+
+// LifecycleStateInService is the lifecycle state of instances serving traffic
+const LifecycleStateInService = "InService"
+
+// StatusDeleteInProgress is the status of groups being deleted
+const StatusDeleteInProgress = "Delete in progress"
+
+// spotAllocationStrategies lists the allocation strategies of Spot Instances
+var spotAllocationStrategies = map[string]bool{
+	"lowest-price":                   true,
+	"capacity-optimized":             true,
+	"capacity-optimized-prioritized": true,
+	"price-capacity-optimized":       true,
+}
+
+// Group represents an EC2 Auto Scaling group
+type Group struct {
+	// Identification
+	Name string
+	ARN  string
+
+	// Capacity. A nil desired capacity leaves the current capacity alone, so
+	// scaling policies can change it.
+	MinSize         int32
+	MaxSize         int32
+	DesiredCapacity *int32
+
+	// SubnetIDs are the subnets instances are launched in
+	SubnetIDs []string
+
+	// LaunchTemplate launches the instances. With a mixed instances policy
+	// it is the template the overrides apply to.
+	LaunchTemplate LaunchTemplateRef
+
+	// MixedInstancesPolicy combines instance types and Spot Instances
+	MixedInstancesPolicy *MixedInstancesPolicy
+
+	// CapacityRebalance replaces Spot Instances at elevated risk of interruption
+	CapacityRebalance bool
+
+	// Health checks
+	HealthCheckType        string // EC2 or ELB
+	HealthCheckGracePeriod *int32
+
+	// TargetGroupARNs are the target groups instances are registered in
+	TargetGroupARNs []string
+
+	// LifecycleHooks pause instances while they launch or terminate
+	LifecycleHooks []LifecycleHook
+
+	// InstanceRefresh replaces instances when the launch template changes
+	InstanceRefresh *RefreshPreferences
+
+	// Tags are propagated to the instances
+	Tags map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	Status            string
+	CurrentCapacity   int32
+	Instances         []Instance
+	LatestRefresh     *Refresh
+	RefreshedTemplate string // launch template key the last refresh rolled out
+	RefreshStarted    bool
+	LastSyncTime      *time.Time
+}
+
+// LaunchTemplateRef selects a launch template version. Version is a number,
+// $Latest or $Default.
+type LaunchTemplateRef struct {
+	ID      string
+	Version string
+}
+
+// MixedInstancesPolicy launches several instance types with a mix of
+// On-Demand and Spot Instances. Unset fields keep the AWS defaults.
+type MixedInstancesPolicy struct {
+	Overrides []InstanceTypeOverride
+
+	OnDemandBaseCapacity                *int32
+	OnDemandPercentageAboveBaseCapacity *int32
+	OnDemandAllocationStrategy          string
+	SpotAllocationStrategy              string
+	SpotMaxPrice                        string
+}
+
+// InstanceTypeOverride is an instance type of a mixed instances policy
+type InstanceTypeOverride struct {
+	InstanceType     string
+	WeightedCapacity string
+}
+
+// LifecycleHook pauses instances in a lifecycle transition until the hook
+// completes or times out
+type LifecycleHook struct {
+	Name                  string
+	Transition            string
+	HeartbeatTimeout      int32
+	DefaultResult         string // CONTINUE or ABANDON
+	NotificationTargetARN string
+	RoleARN               string
+	NotificationMetadata  string
+}
+
+// RefreshPreferences configures instance refreshes
+type RefreshPreferences struct {
+	MinHealthyPercentage *int32
+	InstanceWarmup       *int32
+	SkipMatching         bool
+}
+
+// Refresh is the progress of an instance refresh
+type Refresh struct {
+	ID                 string
+	Status             string
+	StatusReason       string
+	PercentageComplete int32
+	InstancesToUpdate  int32
+	StartTime          *time.Time
+	EndTime            *time.Time
+}
+
+// Instance is an instance of the group
+type Instance struct {
+	ID             string
+	LifecycleState string
+	HealthStatus   string
+}
+
+// SetDefaults sets default values for optional fields
+func (g *Group) SetDefaults() {
+	if g.LaunchTemplate.Version == "" {
+		g.LaunchTemplate.Version = "$Latest"
+	}
+	if g.HealthCheckType == "" {
+		g.HealthCheckType = "EC2"
+	}
+	if g.DeletionPolicy == "" {
+		g.DeletionPolicy = "Delete"
+	}
+	if g.Tags == nil {
+		g.Tags = make(map[string]string)
+	}
+	for i := range g.LifecycleHooks {
+		if g.LifecycleHooks[i].DefaultResult == "" {
+			g.LifecycleHooks[i].DefaultResult = "ABANDON"
+		}
+		if g.LifecycleHooks[i].HeartbeatTimeout == 0 {
+			g.LifecycleHooks[i].HeartbeatTimeout = 3600
+		}
+	}
+}
+
+// Validate checks if the group configuration is valid
+func (g *Group) Validate() error {
+	if g.Name == "" || len(g.Name) > 255 {
+		return ErrInvalidGroupName
+	}
+	if g.MinSize < 0 || g.MaxSize < g.MinSize {
+		return ErrInvalidSize
+	}
+	if d := g.DesiredCapacity; d != nil && (*d < g.MinSize || *d > g.MaxSize) {
+		return ErrInvalidSize
+	}
+	if len(g.SubnetIDs) == 0 {
+		return ErrInvalidSubnets
+	}
+	if g.LaunchTemplate.ID == "" {
+		return ErrInvalidLaunchTemplate
+	}
+	if g.HealthCheckType != "EC2" && g.HealthCheckType != "ELB" {
+		return ErrInvalidHealthCheck
+	}
+
+	if p := g.MixedInstancesPolicy; p != nil {
+		if len(p.Overrides) == 0 {
+			return fmt.Errorf("%w: at least one instance type is required", ErrInvalidMixedInstances)
+		}
+		for _, o := range p.Overrides {
+			if o.InstanceType == "" {
+				return fmt.Errorf("%w: instance type is required", ErrInvalidMixedInstances)
+			}
+		}
+		if pct := p.OnDemandPercentageAboveBaseCapacity; pct != nil && (*pct < 0 || *pct > 100) {
+			return fmt.Errorf("%w: on-demand percentage must be between 0 and 100", ErrInvalidMixedInstances)
+		}
+		if p.SpotAllocationStrategy != "" && !spotAllocationStrategies[p.SpotAllocationStrategy] {
+			return fmt.Errorf("%w: unknown spot allocation strategy %q", ErrInvalidMixedInstances, p.SpotAllocationStrategy)
+		}
+		if p.OnDemandAllocationStrategy != "" && p.OnDemandAllocationStrategy != "prioritized" && p.OnDemandAllocationStrategy != "lowest-price" {
+			return fmt.Errorf("%w: on-demand allocation strategy must be 'prioritized' or 'lowest-price'", ErrInvalidMixedInstances)
+		}
+	}
+
+	seen := make(map[string]bool, len(g.LifecycleHooks))
+	for _, hook := range g.LifecycleHooks {
+		switch {
+		case hook.Name == "":
+			return fmt.Errorf("%w: name is required", ErrInvalidLifecycleHook)
+		case seen[hook.Name]:
+			return fmt.Errorf("%w: duplicate name %q", ErrInvalidLifecycleHook, hook.Name)
+		case hook.Transition != TransitionLaunching && hook.Transition != TransitionTerminating:
+			return fmt.Errorf("%w: %s has unknown transition %q", ErrInvalidLifecycleHook, hook.Name, hook.Transition)
+		case hook.HeartbeatTimeout < 30 || hook.HeartbeatTimeout > 7200:
+			return fmt.Errorf("%w: %s heartbeat timeout must be between 30 and 7200 seconds", ErrInvalidLifecycleHook, hook.Name)
+		case hook.DefaultResult != "CONTINUE" && hook.DefaultResult != "ABANDON":
+			return fmt.Errorf("%w: %s default result must be CONTINUE or ABANDON", ErrInvalidLifecycleHook, hook.Name)
+		case (hook.NotificationTargetARN == "") != (hook.RoleARN == ""):
+			return fmt.Errorf("%w: %s needs both a notification target and a role", ErrInvalidLifecycleHook, hook.Name)
+		}
+		seen[hook.Name] = true
+	}
+
+	if r := g.InstanceRefresh; r != nil {
+		if pct := r.MinHealthyPercentage; pct != nil && (*pct < 0 || *pct > 100) {
+			return fmt.Errorf("%w: minimum healthy percentage must be between 0 and 100", ErrInvalidRefresh)
+		}
+	}
+
+	return nil
+}
+
+// ShouldDelete returns true if the group should be deleted when the CR is deleted
+func (g *Group) ShouldDelete() bool {
+	return g.DeletionPolicy != "Retain" && g.DeletionPolicy != "Orphan"
+}
+
+// Deleting reports whether the group is being deleted
+func (g *Group) Deleting() bool {
+	return g.Status == StatusDeleteInProgress
+}
+
+// Key identifies a launch template version
+func (ref LaunchTemplateRef) Key() string {
+	return ref.ID + ":" + ref.Version
+}
+
+// ConfigChanged reports whether the group must be updated to match the
+// desired configuration. Unset optional fields are not compared.
+func (g *Group) ConfigChanged(current *Group) bool {
+	if g.MinSize != current.MinSize || g.MaxSize != current.MaxSize ||
+		(g.DesiredCapacity != nil && *g.DesiredCapacity != current.CurrentCapacity) ||
+		!sameStrings(g.SubnetIDs, current.SubnetIDs) ||
+		g.LaunchTemplate.Key() != current.LaunchTemplate.Key() ||
+		g.CapacityRebalance != current.CapacityRebalance ||
+		g.HealthCheckType != current.HealthCheckType ||
+		(g.HealthCheckGracePeriod != nil && (current.HealthCheckGracePeriod == nil || *g.HealthCheckGracePeriod != *current.HealthCheckGracePeriod)) {
+		return true
+	}
+
+	desired, actual := g.MixedInstancesPolicy, current.MixedInstancesPolicy
+	if (desired == nil) != (actual == nil) {
+		return true
+	}
+	if desired == nil {
+		return false
+	}
+	if len(desired.Overrides) != len(actual.Overrides) {
+		return true
+	}
+	for i := range desired.Overrides {
+		if desired.Overrides[i] != actual.Overrides[i] {
+			return true
+		}
+	}
+	return changedInt32(desired.OnDemandBaseCapacity, actual.OnDemandBaseCapacity) ||
+		changedInt32(desired.OnDemandPercentageAboveBaseCapacity, actual.OnDemandPercentageAboveBaseCapacity) ||
+		(desired.OnDemandAllocationStrategy != "" && desired.OnDemandAllocationStrategy != actual.OnDemandAllocationStrategy) ||
+		(desired.SpotAllocationStrategy != "" && desired.SpotAllocationStrategy != actual.SpotAllocationStrategy) ||
+		desired.SpotMaxPrice != actual.SpotMaxPrice
+}
+
+// NeedsRefresh reports whether instances must be replaced to roll out the
+// launch template: it changed in this sync, or a refresh was deferred while
+// another one was running.
+func (g *Group) NeedsRefresh(current *Group) bool {
+	if g.InstanceRefresh == nil {
+		return false
+	}
+	if g.LaunchTemplate.Key() != current.LaunchTemplate.Key() {
+		return true
+	}
+	return g.RefreshedTemplate != "" && g.RefreshedTemplate != g.LaunchTemplate.Key()
+}
+
+// RefreshActive reports whether the latest instance refresh is still running
+func (g *Group) RefreshActive() bool {
+	if g.LatestRefresh == nil {
+		return false
+	}
+	switch g.LatestRefresh.Status {
+	case RefreshPending, RefreshInProgress, RefreshCancelling, RefreshBaking:
+		return true
+	}
+	return false
+}
+
+// InServiceInstances returns how many instances are in service
+func (g *Group) InServiceInstances() int32 {
+	var inService int32
+	for _, instance := range g.Instances {
+		if instance.LifecycleState == LifecycleStateInService {
+			inService++
+		}
+	}
+	return inService
+}
+
+// DiffTargetGroups returns the target groups to attach and to detach
+func (g *Group) DiffTargetGroups(current []string) (attach, detach []string) {
+	return diffStrings(g.TargetGroupARNs, current)
+}
+
+// DiffLifecycleHooks returns the hooks to create or update and the names of
+// the hooks not declared any more
+func (g *Group) DiffLifecycleHooks(current []LifecycleHook) (put []LifecycleHook, remove []string) {
+	existing := make(map[string]LifecycleHook, len(current))
+	for _, hook := range current {
+		existing[hook.Name] = hook
+	}
+	declared := make(map[string]bool, len(g.LifecycleHooks))
+	for _, hook := range g.LifecycleHooks {
+		declared[hook.Name] = true
+		if actual, ok := existing[hook.Name]; !ok || actual != hook {
+			put = append(put, hook)
+		}
+	}
+	for _, hook := range current {
+		if !declared[hook.Name] {
+			remove = append(remove, hook.Name)
+		}
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+func changedInt32(desired, current *int32) bool {
+	return desired != nil && (current == nil || *desired != *current)
+}
+
+// sameStrings compares two lists ignoring order
+func sameStrings(a, b []string) bool {
+	add, remove := diffStrings(a, b)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// diffStrings returns the values of desired missing from current and the
+// values of current missing from desired
+func diffStrings(desired, current []string) (add, remove []string) {
+	have := make(map[string]bool, len(current))
+	for _, value := range current {
+		have[value] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, value := range desired {
+		want[value] = true
+		if !have[value] {
+			add = append(add, value)
+		}
+	}
+	for _, value := range current {
+		if !want[value] {
+			remove = append(remove, value)
+		}
+	}
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
@@ -0,0 +1,217 @@
+package autoscaling_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"infra-operator/internal/domain/autoscaling"
+)
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func validGroup() *autoscaling.Group {
+	g := &autoscaling.Group{
+		Name:            "web",
+		MinSize:         1,
+		MaxSize:         4,
+		DesiredCapacity: int32Ptr(2),
+		SubnetIDs:       []string{"subnet-a", "subnet-b"},
+		LaunchTemplate:  autoscaling.LaunchTemplateRef{ID: "lt-0123456789abcdef0", Version: "3"},
+	}
+	g.SetDefaults()
+	return g
+}
+
+func TestGroup_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(g *autoscaling.Group)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(g *autoscaling.Group) {},
+		},
+		{
+			name:    "desired above max",
+			modify:  func(g *autoscaling.Group) { g.DesiredCapacity = int32Ptr(5) },
+			wantErr: autoscaling.ErrInvalidSize,
+		},
+		{
+			name:    "no subnets",
+			modify:  func(g *autoscaling.Group) { g.SubnetIDs = nil },
+			wantErr: autoscaling.ErrInvalidSubnets,
+		},
+		{
+			name:    "no launch template",
+			modify:  func(g *autoscaling.Group) { g.LaunchTemplate.ID = "" },
+			wantErr: autoscaling.ErrInvalidLaunchTemplate,
+		},
+		{
+			name: "mixed instances without instance types",
+			modify: func(g *autoscaling.Group) {
+				g.MixedInstancesPolicy = &autoscaling.MixedInstancesPolicy{SpotAllocationStrategy: "price-capacity-optimized"}
+			},
+			wantErr: autoscaling.ErrInvalidMixedInstances,
+		},
+		{
+			name: "unknown spot allocation strategy",
+			modify: func(g *autoscaling.Group) {
+				g.MixedInstancesPolicy = &autoscaling.MixedInstancesPolicy{
+					Overrides:              []autoscaling.InstanceTypeOverride{{InstanceType: "m5.large"}},
+					SpotAllocationStrategy: "cheapest",
+				}
+			},
+			wantErr: autoscaling.ErrInvalidMixedInstances,
+		},
+		{
+			name: "lifecycle hook with unknown transition",
+			modify: func(g *autoscaling.Group) {
+				g.LifecycleHooks = []autoscaling.LifecycleHook{{Name: "drain", Transition: "autoscaling:EC2_INSTANCE_STOPPING", HeartbeatTimeout: 300, DefaultResult: "CONTINUE"}}
+			},
+			wantErr: autoscaling.ErrInvalidLifecycleHook,
+		},
+		{
+			name: "lifecycle hook with target but no role",
+			modify: func(g *autoscaling.Group) {
+				g.LifecycleHooks = []autoscaling.LifecycleHook{{
+					Name:                  "drain",
+					Transition:            autoscaling.TransitionTerminating,
+					HeartbeatTimeout:      300,
+					DefaultResult:         "CONTINUE",
+					NotificationTargetARN: "arn:aws:sqs:us-east-1:123456789012:drain",
+				}}
+			},
+			wantErr: autoscaling.ErrInvalidLifecycleHook,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := validGroup()
+			tt.modify(g)
+			if err := g.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGroup_ConfigChanged(t *testing.T) {
+	current := validGroup()
+	current.CurrentCapacity = 2
+	current.HealthCheckGracePeriod = int32Ptr(300)
+
+	desired := validGroup()
+	desired.DesiredCapacity = nil
+	if desired.ConfigChanged(current) {
+		t.Error("ConfigChanged() = true for an unchanged group")
+	}
+
+	desired.SubnetIDs = []string{"subnet-b", "subnet-a"}
+	if desired.ConfigChanged(current) {
+		t.Error("ConfigChanged() = true for reordered subnets")
+	}
+
+	desired.LaunchTemplate.Version = "4"
+	if !desired.ConfigChanged(current) {
+		t.Error("ConfigChanged() = false for a new launch template version")
+	}
+
+	desired = validGroup()
+	desired.MixedInstancesPolicy = &autoscaling.MixedInstancesPolicy{
+		Overrides: []autoscaling.InstanceTypeOverride{{InstanceType: "m5.large"}},
+	}
+	if !desired.ConfigChanged(current) {
+		t.Error("ConfigChanged() = false for a new mixed instances policy")
+	}
+}
+
+func TestGroup_NeedsRefresh(t *testing.T) {
+	current := validGroup()
+
+	tests := []struct {
+		name   string
+		modify func(g *autoscaling.Group)
+		want   bool
+	}{
+		{
+			name:   "refresh disabled",
+			modify: func(g *autoscaling.Group) { g.LaunchTemplate.Version = "4"; g.InstanceRefresh = nil },
+		},
+		{
+			name:   "launch template unchanged",
+			modify: func(g *autoscaling.Group) {},
+		},
+		{
+			name:   "new launch template version",
+			modify: func(g *autoscaling.Group) { g.LaunchTemplate.Version = "4" },
+			want:   true,
+		},
+		{
+			name:   "deferred refresh",
+			modify: func(g *autoscaling.Group) { g.RefreshedTemplate = "lt-0123456789abcdef0:2" },
+			want:   true,
+		},
+		{
+			name:   "already refreshed",
+			modify: func(g *autoscaling.Group) { g.RefreshedTemplate = "lt-0123456789abcdef0:3" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := validGroup()
+			g.InstanceRefresh = &autoscaling.RefreshPreferences{SkipMatching: true}
+			tt.modify(g)
+			if got := g.NeedsRefresh(current); got != tt.want {
+				t.Errorf("NeedsRefresh() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroup_RefreshActive(t *testing.T) {
+	g := &autoscaling.Group{}
+	if g.RefreshActive() {
+		t.Error("RefreshActive() = true without a refresh")
+	}
+	g.LatestRefresh = &autoscaling.Refresh{Status: autoscaling.RefreshInProgress}
+	if !g.RefreshActive() {
+		t.Error("RefreshActive() = false for a refresh in progress")
+	}
+	g.LatestRefresh.Status = autoscaling.RefreshSuccessful
+	if g.RefreshActive() {
+		t.Error("RefreshActive() = true for a finished refresh")
+	}
+}
+
+func TestGroup_DiffTargetGroups(t *testing.T) {
+	g := &autoscaling.Group{TargetGroupARNs: []string{"tg-a", "tg-b"}}
+	attach, detach := g.DiffTargetGroups([]string{"tg-b", "tg-c"})
+	if !reflect.DeepEqual(attach, []string{"tg-a"}) {
+		t.Errorf("attach = %v, want [tg-a]", attach)
+	}
+	if !reflect.DeepEqual(detach, []string{"tg-c"}) {
+		t.Errorf("detach = %v, want [tg-c]", detach)
+	}
+}
+
+func TestGroup_DiffLifecycleHooks(t *testing.T) {
+	launch := autoscaling.LifecycleHook{Name: "bootstrap", Transition: autoscaling.TransitionLaunching, HeartbeatTimeout: 300, DefaultResult: "ABANDON"}
+	drain := autoscaling.LifecycleHook{Name: "drain", Transition: autoscaling.TransitionTerminating, HeartbeatTimeout: 600, DefaultResult: "CONTINUE"}
+	old := autoscaling.LifecycleHook{Name: "old", Transition: autoscaling.TransitionTerminating, HeartbeatTimeout: 60, DefaultResult: "CONTINUE"}
+
+	g := &autoscaling.Group{LifecycleHooks: []autoscaling.LifecycleHook{launch, drain}}
+	changedDrain := drain
+	changedDrain.HeartbeatTimeout = 300
+
+	put, remove := g.DiffLifecycleHooks([]autoscaling.LifecycleHook{launch, changedDrain, old})
+	if !reflect.DeepEqual(put, []autoscaling.LifecycleHook{drain}) {
+		t.Errorf("put = %v, want [drain]", put)
+	}
+	if !reflect.DeepEqual(remove, []string{"old"}) {
+		t.Errorf("remove = %v, want [old]", remove)
+	}
+}
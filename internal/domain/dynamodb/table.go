@@ -2,6 +2,7 @@ package dynamodb
 
 import (
 	"errors"
+	"sort"
 	"time"
 )
 
@@ -9,6 +10,21 @@ var (
 	ErrTableNotFound    = errors.New("table not found")
 	ErrInvalidTableName = errors.New("invalid table name")
 	ErrInvalidKey       = errors.New("invalid key configuration")
+	ErrInvalidCapacity  = errors.New("provisioned billing mode requires read and write capacity")
+)
+
+const (
+	BillingModeProvisioned   = "PROVISIONED"
+	BillingModePayPerRequest = "PAY_PER_REQUEST"
+
+	// StatusActive is the table and index status in which updates are accepted
+	StatusActive = "ACTIVE"
+
+	// Time to live statuses
+	TimeToLiveEnabled   = "ENABLED"
+	TimeToLiveEnabling  = "ENABLING"
+	TimeToLiveDisabled  = "DISABLED"
+	TimeToLiveDisabling = "DISABLING"
 )
 
 // Table represents a DynamoDB table in the domain
@@ -19,7 +35,9 @@ type Table struct {
 	RangeKey               *AttributeDefinition
 	Attributes             []AttributeDefinition
 	BillingMode            string
+	Throughput             *Throughput
 	GlobalSecondaryIndexes []GlobalSecondaryIndex
+	TimeToLive             *TimeToLive
 	StreamEnabled          bool
 	StreamViewType         string
 	StreamARN              string
@@ -31,6 +49,10 @@ type Table struct {
 	CreationTime           *time.Time
 	LastSyncTime           *time.Time
 	DeletionPolicy         string
+
+	// UpdatePending is set while changes remain to be applied or the table or
+	// one of its indexes is not ACTIVE
+	UpdatePending bool
 }
 
 // AttributeDefinition represents a DynamoDB attribute
@@ -39,6 +61,12 @@ type AttributeDefinition struct {
 	Type string // S, N, B
 }
 
+// Throughput is the provisioned capacity of a table or index
+type Throughput struct {
+	ReadCapacityUnits  int64
+	WriteCapacityUnits int64
+}
+
 // GlobalSecondaryIndex represents a GSI
 type GlobalSecondaryIndex struct {
 	IndexName        string
@@ -46,6 +74,44 @@ type GlobalSecondaryIndex struct {
 	RangeKey         string
 	ProjectionType   string
 	NonKeyAttributes []string
+	Throughput       *Throughput
+	Status           string
+}
+
+// TimeToLive is the TTL configuration of a table
+type TimeToLive struct {
+	AttributeName string
+	Enabled       bool
+
+	// Status is only set on the state read from AWS
+	Status string
+}
+
+// transitioning reports whether TTL is being enabled or disabled, when
+// DynamoDB rejects further TTL changes
+func (ttl *TimeToLive) transitioning() bool {
+	return ttl.Status == TimeToLiveEnabling || ttl.Status == TimeToLiveDisabling
+}
+
+// TableUpdate is a single UpdateTable call. DynamoDB accepts at most one index
+// creation or deletion per call and rejects updates while the table or any of
+// its indexes is not ACTIVE, so changes are applied one TableUpdate at a time.
+type TableUpdate struct {
+	// BillingMode switches the table billing mode when set
+	BillingMode string
+
+	// Throughput sets the table capacity (provisioned mode only)
+	Throughput *Throughput
+
+	// IndexThroughput sets the capacity of existing indexes by name
+	IndexThroughput map[string]Throughput
+
+	// CreateIndex creates an index; Attributes defines its key attributes
+	CreateIndex *GlobalSecondaryIndex
+	Attributes  []AttributeDefinition
+
+	// DeleteIndex deletes the named index
+	DeleteIndex string
 }
 
 // Validate validates the table configuration
@@ -72,11 +138,21 @@ func (t *Table) Validate() error {
 
 	// Validate billing mode
 	if t.BillingMode == "" {
-		t.BillingMode = "PAY_PER_REQUEST"
+		t.BillingMode = BillingModePayPerRequest
 	}
-	if t.BillingMode != "PAY_PER_REQUEST" && t.BillingMode != "PROVISIONED" {
+	if t.BillingMode != BillingModePayPerRequest && t.BillingMode != BillingModeProvisioned {
 		return errors.New("invalid billing mode")
 	}
+	if t.BillingMode == BillingModeProvisioned {
+		if !t.Throughput.valid() {
+			return ErrInvalidCapacity
+		}
+		for _, gsi := range t.GlobalSecondaryIndexes {
+			if !gsi.Throughput.valid() {
+				return ErrInvalidCapacity
+			}
+		}
+	}
 
 	// Validate stream config
 	if t.StreamEnabled && t.StreamViewType == "" {
@@ -88,5 +164,184 @@ func (t *Table) Validate() error {
 
 // IsReady returns true if the table is in ACTIVE status
 func (t *Table) IsReady() bool {
-	return t.Status == "ACTIVE"
+	return t.Status == StatusActive
+}
+
+// IsUpdatable returns true if the table and all of its indexes are ACTIVE
+func (t *Table) IsUpdatable() bool {
+	if !t.IsReady() {
+		return false
+	}
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		if gsi.Status != "" && gsi.Status != StatusActive {
+			return false
+		}
+	}
+	return true
+}
+
+// ShouldDelete returns true if the table should be deleted when the CR is deleted
+func (t *Table) ShouldDelete() bool {
+	return t.DeletionPolicy != "Retain" && t.DeletionPolicy != "Orphan"
+}
+
+// Updates returns the UpdateTable calls that bring current to the desired
+// table, in order: index deletions (including indexes whose keys or projection
+// changed, which cannot be modified in place), then billing mode and throughput,
+// then index creations.
+func (t *Table) Updates(current *Table) []TableUpdate {
+	var updates []TableUpdate
+
+	desiredIndexes := make(map[string]GlobalSecondaryIndex, len(t.GlobalSecondaryIndexes))
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		desiredIndexes[gsi.IndexName] = gsi
+	}
+
+	kept := make(map[string]GlobalSecondaryIndex)
+	for _, gsi := range current.GlobalSecondaryIndexes {
+		desired, ok := desiredIndexes[gsi.IndexName]
+		if ok && desired.sameSchema(gsi) {
+			kept[gsi.IndexName] = gsi
+			continue
+		}
+		updates = append(updates, TableUpdate{DeleteIndex: gsi.IndexName})
+	}
+
+	// Billing mode and capacity of the table and the indexes that are kept
+	provisioned := t.BillingMode == BillingModeProvisioned
+	capacity := TableUpdate{}
+	if t.BillingMode != current.BillingMode {
+		capacity.BillingMode = t.BillingMode
+	}
+	if provisioned && (capacity.BillingMode != "" || !t.Throughput.equal(current.Throughput)) {
+		capacity.Throughput = t.Throughput
+	}
+	if provisioned {
+		for _, name := range sortedIndexNames(kept) {
+			desired := desiredIndexes[name]
+			if capacity.BillingMode != "" || !desired.Throughput.equal(kept[name].Throughput) {
+				if capacity.IndexThroughput == nil {
+					capacity.IndexThroughput = make(map[string]Throughput)
+				}
+				capacity.IndexThroughput[name] = *desired.Throughput
+			}
+		}
+	}
+	if capacity.BillingMode != "" || capacity.Throughput != nil || len(capacity.IndexThroughput) > 0 {
+		updates = append(updates, capacity)
+	}
+
+	for _, gsi := range t.GlobalSecondaryIndexes {
+		if _, ok := kept[gsi.IndexName]; ok {
+			continue
+		}
+		index := gsi
+		if !provisioned {
+			index.Throughput = nil
+		}
+		updates = append(updates, TableUpdate{
+			CreateIndex: &index,
+			Attributes:  t.keyAttributes(index),
+		})
+	}
+
+	return updates
+}
+
+// TimeToLiveUpdate returns the next UpdateTimeToLive call that brings current
+// to the desired TTL, or nil when there is none. TTL can't be moved to another
+// attribute directly, so TTL on the current attribute is disabled first.
+// pending reports whether more calls are needed once TTL settles.
+func (t *Table) TimeToLiveUpdate(current *Table) (update *TimeToLive, pending bool) {
+	desired, actual := t.TimeToLive, current.TimeToLive
+	if desired == nil {
+		return nil, false
+	}
+	if actual == nil {
+		actual = &TimeToLive{}
+	}
+
+	// Matches the desired TTL, or settles into it
+	if desired.Enabled == actual.Enabled && (!desired.Enabled || desired.AttributeName == actual.AttributeName) {
+		return nil, false
+	}
+
+	// Wait for the running change before making another one
+	if actual.transitioning() {
+		return nil, true
+	}
+
+	switch {
+	case actual.Enabled && desired.Enabled:
+		return &TimeToLive{AttributeName: actual.AttributeName, Enabled: false}, true
+	case actual.Enabled:
+		return &TimeToLive{AttributeName: actual.AttributeName, Enabled: false}, false
+	default:
+		return &TimeToLive{AttributeName: desired.AttributeName, Enabled: true}, false
+	}
+}
+
+// keyAttributes returns the definitions of the key attributes of an index
+func (t *Table) keyAttributes(gsi GlobalSecondaryIndex) []AttributeDefinition {
+	all := append([]AttributeDefinition{t.HashKey}, t.Attributes...)
+	if t.RangeKey != nil {
+		all = append(all, *t.RangeKey)
+	}
+
+	var attrs []AttributeDefinition
+	for _, name := range []string{gsi.HashKey, gsi.RangeKey} {
+		if name == "" {
+			continue
+		}
+		for _, attr := range all {
+			if attr.Name == name {
+				attrs = append(attrs, attr)
+				break
+			}
+		}
+	}
+	return attrs
+}
+
+// sameSchema reports whether two indexes have the same keys and projection
+func (g GlobalSecondaryIndex) sameSchema(other GlobalSecondaryIndex) bool {
+	if g.HashKey != other.HashKey || g.RangeKey != other.RangeKey || g.ProjectionType != other.ProjectionType {
+		return false
+	}
+	if g.ProjectionType != "INCLUDE" {
+		return true
+	}
+	if len(g.NonKeyAttributes) != len(other.NonKeyAttributes) {
+		return false
+	}
+	seen := make(map[string]bool, len(other.NonKeyAttributes))
+	for _, attr := range other.NonKeyAttributes {
+		seen[attr] = true
+	}
+	for _, attr := range g.NonKeyAttributes {
+		if !seen[attr] {
+			return false
+		}
+	}
+	return true
+}
+
+func (tp *Throughput) valid() bool {
+	return tp != nil && tp.ReadCapacityUnits > 0 && tp.WriteCapacityUnits > 0
+}
+
+func (tp *Throughput) equal(other *Throughput) bool {
+	if tp == nil || other == nil {
+		return tp == other
+	}
+	return *tp == *other
+}
+
+func sortedIndexNames(indexes map[string]GlobalSecondaryIndex) []string {
+	names := make([]string, 0, len(indexes))
+	for name := range indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
@@ -8,3 +8,155 @@ func TestTable_Validate(t *testing.T) {
 	}
 	for _, tt := range tests {t.Run(tt.name, func(t *testing.T) {if err := tt.tbl.Validate(); err != tt.wantErr {t.Errorf("got %v, want %v", err, tt.wantErr)}})}
 }
+
+func TestTable_ValidateProvisioned(t *testing.T) {
+	tbl := &dynamodb.Table{Name: "test", HashKey: dynamodb.AttributeDefinition{Name: "id", Type: "S"}, BillingMode: dynamodb.BillingModeProvisioned}
+	if err := tbl.Validate(); err != dynamodb.ErrInvalidCapacity {
+		t.Errorf("got %v, want %v", err, dynamodb.ErrInvalidCapacity)
+	}
+
+	tbl.Throughput = &dynamodb.Throughput{ReadCapacityUnits: 5, WriteCapacityUnits: 5}
+	tbl.GlobalSecondaryIndexes = []dynamodb.GlobalSecondaryIndex{{IndexName: "by-email", HashKey: "email", ProjectionType: "ALL"}}
+	if err := tbl.Validate(); err != dynamodb.ErrInvalidCapacity {
+		t.Errorf("index without capacity: got %v, want %v", err, dynamodb.ErrInvalidCapacity)
+	}
+
+	tbl.GlobalSecondaryIndexes[0].Throughput = &dynamodb.Throughput{ReadCapacityUnits: 1, WriteCapacityUnits: 1}
+	if err := tbl.Validate(); err != nil {
+		t.Errorf("got %v, want nil", err)
+	}
+}
+
+func TestTable_Updates(t *testing.T) {
+	byEmail := dynamodb.GlobalSecondaryIndex{IndexName: "by-email", HashKey: "email", ProjectionType: "ALL"}
+	byDate := dynamodb.GlobalSecondaryIndex{IndexName: "by-date", HashKey: "date", ProjectionType: "KEYS_ONLY"}
+	base := func(gsis ...dynamodb.GlobalSecondaryIndex) *dynamodb.Table {
+		return &dynamodb.Table{
+			Name:                   "test",
+			HashKey:                dynamodb.AttributeDefinition{Name: "id", Type: "S"},
+			Attributes:             []dynamodb.AttributeDefinition{{Name: "email", Type: "S"}, {Name: "date", Type: "N"}},
+			BillingMode:            dynamodb.BillingModePayPerRequest,
+			GlobalSecondaryIndexes: gsis,
+			Status:                 dynamodb.StatusActive,
+		}
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		if updates := base(byEmail).Updates(base(byEmail)); len(updates) != 0 {
+			t.Errorf("got %+v, want no updates", updates)
+		}
+	})
+
+	t.Run("add and remove indexes one at a time", func(t *testing.T) {
+		updates := base(byDate).Updates(base(byEmail))
+		if len(updates) != 2 {
+			t.Fatalf("got %d updates, want 2: %+v", len(updates), updates)
+		}
+		if updates[0].DeleteIndex != "by-email" || updates[0].CreateIndex != nil {
+			t.Errorf("first update should delete by-email, got %+v", updates[0])
+		}
+		if updates[1].CreateIndex == nil || updates[1].CreateIndex.IndexName != "by-date" || updates[1].DeleteIndex != "" {
+			t.Fatalf("second update should create by-date, got %+v", updates[1])
+		}
+		if len(updates[1].Attributes) != 1 || updates[1].Attributes[0] != (dynamodb.AttributeDefinition{Name: "date", Type: "N"}) {
+			t.Errorf("got attributes %+v, want date:N", updates[1].Attributes)
+		}
+	})
+
+	t.Run("changed index schema is recreated", func(t *testing.T) {
+		changed := byEmail
+		changed.ProjectionType = "KEYS_ONLY"
+		updates := base(changed).Updates(base(byEmail))
+		if len(updates) != 2 || updates[0].DeleteIndex != "by-email" || updates[1].CreateIndex == nil {
+			t.Errorf("got %+v, want delete then create of by-email", updates)
+		}
+	})
+
+	t.Run("switch to provisioned", func(t *testing.T) {
+		desired := base(byEmail)
+		desired.BillingMode = dynamodb.BillingModeProvisioned
+		desired.Throughput = &dynamodb.Throughput{ReadCapacityUnits: 10, WriteCapacityUnits: 5}
+		desired.GlobalSecondaryIndexes[0].Throughput = &dynamodb.Throughput{ReadCapacityUnits: 2, WriteCapacityUnits: 1}
+
+		updates := desired.Updates(base(byEmail))
+		if len(updates) != 1 {
+			t.Fatalf("got %d updates, want 1: %+v", len(updates), updates)
+		}
+		u := updates[0]
+		if u.BillingMode != dynamodb.BillingModeProvisioned || u.Throughput == nil || u.Throughput.ReadCapacityUnits != 10 {
+			t.Errorf("got %+v, want billing mode switch with table throughput", u)
+		}
+		if tp, ok := u.IndexThroughput["by-email"]; !ok || tp.ReadCapacityUnits != 2 {
+			t.Errorf("got index throughput %+v, want by-email 2/1", u.IndexThroughput)
+		}
+	})
+
+	t.Run("throughput change", func(t *testing.T) {
+		current := base()
+		current.BillingMode = dynamodb.BillingModeProvisioned
+		current.Throughput = &dynamodb.Throughput{ReadCapacityUnits: 5, WriteCapacityUnits: 5}
+		desired := base()
+		desired.BillingMode = dynamodb.BillingModeProvisioned
+		desired.Throughput = &dynamodb.Throughput{ReadCapacityUnits: 20, WriteCapacityUnits: 5}
+
+		updates := desired.Updates(current)
+		if len(updates) != 1 || updates[0].BillingMode != "" || updates[0].Throughput.ReadCapacityUnits != 20 {
+			t.Errorf("got %+v, want a throughput-only update", updates)
+		}
+	})
+}
+
+func TestTable_IsUpdatable(t *testing.T) {
+	tbl := &dynamodb.Table{Status: dynamodb.StatusActive, GlobalSecondaryIndexes: []dynamodb.GlobalSecondaryIndex{{IndexName: "by-email", Status: "CREATING"}}}
+	if tbl.IsUpdatable() {
+		t.Error("table with a CREATING index should not be updatable")
+	}
+	tbl.GlobalSecondaryIndexes[0].Status = dynamodb.StatusActive
+	if !tbl.IsUpdatable() {
+		t.Error("table with ACTIVE indexes should be updatable")
+	}
+}
+
+func TestTable_ShouldDelete(t *testing.T) {
+	for policy, want := range map[string]bool{"": true, "Delete": true, "Retain": false, "Orphan": false} {
+		if got := (&dynamodb.Table{DeletionPolicy: policy}).ShouldDelete(); got != want {
+			t.Errorf("ShouldDelete() with %q = %v, want %v", policy, got, want)
+		}
+	}
+}
+
+func TestTable_TimeToLiveUpdate(t *testing.T) {
+	ttl := func(name string, enabled bool, status string) *dynamodb.TimeToLive {
+		return &dynamodb.TimeToLive{AttributeName: name, Enabled: enabled, Status: status}
+	}
+	tests := []struct {
+		name        string
+		desired     *dynamodb.TimeToLive
+		actual      *dynamodb.TimeToLive
+		want        *dynamodb.TimeToLive
+		wantPending bool
+	}{
+		{"not managed", nil, ttl("expiresAt", true, dynamodb.TimeToLiveEnabled), nil, false},
+		{"enable", ttl("expiresAt", true, ""), nil, ttl("expiresAt", true, ""), false},
+		{"already enabled", ttl("expiresAt", true, ""), ttl("expiresAt", true, dynamodb.TimeToLiveEnabled), nil, false},
+		{"enabling", ttl("expiresAt", true, ""), ttl("expiresAt", true, dynamodb.TimeToLiveEnabling), nil, false},
+		{"disable", ttl("expiresAt", false, ""), ttl("expiresAt", true, dynamodb.TimeToLiveEnabled), ttl("expiresAt", false, ""), false},
+		{"already disabled", ttl("expiresAt", false, ""), ttl("expiresAt", false, dynamodb.TimeToLiveDisabled), nil, false},
+		{"change attribute disables first", ttl("ttl", true, ""), ttl("expiresAt", true, dynamodb.TimeToLiveEnabled), ttl("expiresAt", false, ""), true},
+		{"change attribute waits for disabling", ttl("ttl", true, ""), ttl("expiresAt", false, dynamodb.TimeToLiveDisabling), nil, true},
+		{"change attribute after disabled", ttl("ttl", true, ""), ttl("expiresAt", false, dynamodb.TimeToLiveDisabled), ttl("ttl", true, ""), false},
+		{"disable waits for enabling", ttl("expiresAt", false, ""), ttl("expiresAt", true, dynamodb.TimeToLiveEnabling), nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &dynamodb.Table{TimeToLive: tt.desired}
+			got, pending := desired.TimeToLiveUpdate(&dynamodb.Table{TimeToLive: tt.actual})
+			if pending != tt.wantPending {
+				t.Errorf("pending = %v, want %v", pending, tt.wantPending)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && (got.AttributeName != tt.want.AttributeName || got.Enabled != tt.want.Enabled)) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,17 @@ var (
 	ErrInvalidInstanceName = errors.New("instance name is required")
 	ErrInvalidInstanceType = errors.New("instance type is required")
 	ErrInvalidImageID      = errors.New("image ID is required")
+	ErrInvalidDesiredState = errors.New("desired state must be 'running' or 'stopped'")
+)
+
+// Instance states
+const (
+	StatePending      = "pending"
+	StateRunning      = "running"
+	StateStopping     = "stopping"
+	StateStopped      = "stopped"
+	StateShuttingDown = "shutting-down"
+	StateTerminated   = "terminated"
 )
 
 type Instance struct {
@@ -27,14 +38,30 @@ type Instance struct {
 	EBSOptimized          bool
 	Tags                  map[string]string
 	DeletionPolicy        string
-	InstanceState         string
-	PrivateIP             string
-	PublicIP              string
-	PrivateDNS            string
-	PublicDNS             string
-	AvailabilityZone      string
-	LaunchTime            *time.Time
-	LastSyncTime          *time.Time
+
+	// DesiredState is running or stopped
+	DesiredState string
+
+	// AllowRestart allows stopping a running instance to change its type
+	AllowRestart bool
+
+	// State
+	InstanceState    string
+	PrivateIP        string
+	PublicIP         string
+	PrivateDNS       string
+	PublicDNS        string
+	AvailabilityZone string
+	LaunchTime       *time.Time
+	LastSyncTime     *time.Time
+
+	// ReplacementFields lists the spec fields that differ from the instance
+	// and can only be applied by replacing it
+	ReplacementFields []string
+
+	// RestartRequired is set when the instance type changed on a running
+	// instance and AllowRestart is false
+	RestartRequired bool
 }
 
 type BlockDeviceMapping struct {
@@ -55,6 +82,9 @@ func (i *Instance) SetDefaults() {
 	if i.DeletionPolicy == "" {
 		i.DeletionPolicy = "Delete"
 	}
+	if i.DesiredState == "" {
+		i.DesiredState = StateRunning
+	}
 	if i.Tags == nil {
 		i.Tags = make(map[string]string)
 	}
@@ -78,6 +108,9 @@ func (i *Instance) Validate() error {
 	if i.ImageID == "" {
 		return ErrInvalidImageID
 	}
+	if i.DesiredState != "" && i.DesiredState != StateRunning && i.DesiredState != StateStopped {
+		return ErrInvalidDesiredState
+	}
 	return nil
 }
 
@@ -100,3 +133,103 @@ func (i *Instance) IsStopped() bool {
 func (i *Instance) IsTerminated() bool {
 	return i.InstanceState == "terminated"
 }
+
+// IsTransitioning reports whether the instance is between states, when it
+// can be neither modified nor started or stopped
+func (i *Instance) IsTransitioning() bool {
+	switch i.InstanceState {
+	case StatePending, StateStopping, StateShuttingDown:
+		return true
+	}
+	return false
+}
+
+// InDesiredState reports whether the instance reached its desired state
+func (i *Instance) InDesiredState() bool {
+	return i.InstanceState == i.desiredState()
+}
+
+func (i *Instance) desiredState() string {
+	if i.DesiredState == "" {
+		return StateRunning
+	}
+	return i.DesiredState
+}
+
+// StatePlan lists the calls that bring an instance to its desired type and
+// state on this reconcile
+type StatePlan struct {
+	// Wait is set while the instance is transitioning or terminated, when
+	// nothing can be modified
+	Wait bool
+
+	ModifyType bool
+	Stop       bool
+	Start      bool
+
+	// RestartRequired is set when the type of a running instance changed
+	// and AllowRestart is false
+	RestartRequired bool
+}
+
+// Plan returns the calls that bring current to the desired instance type and
+// state. The type can only change while the instance is stopped: a running
+// instance is stopped first when AllowRestart is set, its type is modified on
+// a later reconcile and the desired state starts it again.
+func (i *Instance) Plan(current *Instance) StatePlan {
+	if current.IsTransitioning() || current.IsTerminated() {
+		return StatePlan{Wait: true}
+	}
+
+	desired := i.desiredState()
+	var plan StatePlan
+	if i.InstanceTypeChanged(current) {
+		switch {
+		case current.IsStopped():
+			plan.ModifyType = true
+		case desired == StateStopped:
+			// Modified on the next reconcile, once the instance is stopped
+		case i.AllowRestart:
+			return StatePlan{Stop: true}
+		default:
+			plan.RestartRequired = true
+		}
+	}
+
+	switch {
+	case desired == StateRunning && current.IsStopped():
+		plan.Start = true
+	case desired == StateStopped && current.IsRunning():
+		plan.Stop = true
+	}
+	return plan
+}
+
+// ImmutableChanges returns the spec fields that differ from the current
+// instance and require replacing it. Fields left empty in the spec are
+// not compared.
+func (i *Instance) ImmutableChanges(current *Instance) []string {
+	var fields []string
+	if i.ImageID != current.ImageID {
+		fields = append(fields, "imageID")
+	}
+	if i.SubnetID != "" && i.SubnetID != current.SubnetID {
+		fields = append(fields, "subnetID")
+	}
+	if i.KeyName != "" && i.KeyName != current.KeyName {
+		fields = append(fields, "keyName")
+	}
+	return fields
+}
+
+// InstanceTypeChanged reports whether the instance type must be modified,
+// which requires the instance to be stopped
+func (i *Instance) InstanceTypeChanged(current *Instance) bool {
+	return i.InstanceType != current.InstanceType
+}
+
+// SecurityGroupsChanged reports whether the security groups must be
+// modified. An empty list keeps the current groups.
+func (i *Instance) SecurityGroupsChanged(current *Instance) bool {
+	return len(i.SecurityGroupIDs) > 0 && !sameStrings(i.SecurityGroupIDs, current.SecurityGroupIDs)
+}
@@ -11,3 +11,76 @@ func TestInstance_SetDefaults(t *testing.T) {
 func TestInstance_ShouldDelete(t *testing.T) {
 	if !(&ec2.Instance{DeletionPolicy: "Delete"}).ShouldDelete() {t.Error("failed")}
 }
+func TestInstance_ValidateDesiredState(t *testing.T) {
+	i := &ec2.Instance{InstanceName: "test", ImageID: "ami-123", InstanceType: "t3.micro", DesiredState: "hibernated"}
+	if err := i.Validate(); err != ec2.ErrInvalidDesiredState {t.Errorf("expected ErrInvalidDesiredState, got %v", err)}
+	i = &ec2.Instance{InstanceName: "test", ImageID: "ami-123", InstanceType: "t3.micro"}; i.SetDefaults()
+	if i.DesiredState != ec2.StateRunning || i.Validate() != nil {t.Error("desired state should default to running")}
+}
+func TestInstance_InDesiredState(t *testing.T) {
+	if !(&ec2.Instance{DesiredState: "stopped", InstanceState: "stopped"}).InDesiredState() {t.Error("stopped instance should be in desired state")}
+	if (&ec2.Instance{DesiredState: "running", InstanceState: "stopped"}).InDesiredState() {t.Error("stopped instance should not be running")}
+	if !(&ec2.Instance{InstanceState: "pending"}).IsTransitioning() || (&ec2.Instance{InstanceState: "stopped"}).IsTransitioning() {t.Error("IsTransitioning failed")}
+}
+func TestInstance_ImmutableChanges(t *testing.T) {
+	current := &ec2.Instance{ImageID: "ami-1", SubnetID: "subnet-1", KeyName: "key", InstanceType: "t3.micro", SecurityGroupIDs: []string{"sg-1", "sg-2"}}
+	desired := &ec2.Instance{ImageID: "ami-1", InstanceType: "t3.micro", SecurityGroupIDs: []string{"sg-2", "sg-1"}}
+	if fields := desired.ImmutableChanges(current); len(fields) != 0 {t.Errorf("expected no changes, got %v", fields)}
+	if desired.InstanceTypeChanged(current) || desired.SecurityGroupsChanged(current) {t.Error("expected no in-place changes")}
+	desired = &ec2.Instance{ImageID: "ami-2", SubnetID: "subnet-2", KeyName: "key", InstanceType: "t3.large", SecurityGroupIDs: []string{"sg-3"}}
+	if fields := desired.ImmutableChanges(current); len(fields) != 2 || fields[0] != "imageID" || fields[1] != "subnetID" {t.Errorf("unexpected changes %v", fields)}
+	if !desired.InstanceTypeChanged(current) || !desired.SecurityGroupsChanged(current) {t.Error("expected in-place changes")}
+}
+func TestInstance_Plan(t *testing.T) {
+	tests := []struct {
+		name         string
+		state        string
+		desiredState string
+		instanceType string
+		imageID      string
+		allowRestart bool
+		want         ec2.StatePlan
+		inDesired    bool
+		immutable    int
+	}{
+		{name: "running, up to date", state: "running", desiredState: "running", inDesired: true},
+		{name: "running, defaults to running", state: "running", inDesired: true},
+		{name: "running, type changed", state: "running", desiredState: "running", instanceType: "t3.large", want: ec2.StatePlan{RestartRequired: true}, inDesired: true},
+		{name: "running, type changed with allowRestart", state: "running", desiredState: "running", instanceType: "t3.large", allowRestart: true, want: ec2.StatePlan{Stop: true}, inDesired: true},
+		{name: "running, type changed and desired stopped", state: "running", desiredState: "stopped", instanceType: "t3.large", want: ec2.StatePlan{Stop: true}},
+		{name: "running, desired stopped", state: "running", desiredState: "stopped", want: ec2.StatePlan{Stop: true}},
+		{name: "running, image changed", state: "running", desiredState: "running", imageID: "ami-2", inDesired: true, immutable: 1},
+		{name: "stopped, up to date", state: "stopped", desiredState: "stopped", inDesired: true},
+		{name: "stopped, desired running", state: "stopped", desiredState: "running", want: ec2.StatePlan{Start: true}},
+		{name: "stopped, type changed", state: "stopped", desiredState: "stopped", instanceType: "t3.large", want: ec2.StatePlan{ModifyType: true}, inDesired: true},
+		{name: "stopped for a type change, desired running", state: "stopped", desiredState: "running", instanceType: "t3.large", allowRestart: true, want: ec2.StatePlan{ModifyType: true, Start: true}},
+		{name: "pending, type changed", state: "pending", desiredState: "running", instanceType: "t3.large", allowRestart: true, want: ec2.StatePlan{Wait: true}},
+		{name: "stopping, desired running", state: "stopping", desiredState: "running", want: ec2.StatePlan{Wait: true}},
+		{name: "stopping, image changed", state: "stopping", desiredState: "stopped", imageID: "ami-2", want: ec2.StatePlan{Wait: true}, immutable: 1},
+		{name: "terminated", state: "terminated", desiredState: "running", want: ec2.StatePlan{Wait: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := &ec2.Instance{ImageID: "ami-1", InstanceType: "t3.micro", InstanceState: tt.state}
+			desired := &ec2.Instance{ImageID: "ami-1", InstanceType: "t3.micro", DesiredState: tt.desiredState, AllowRestart: tt.allowRestart, InstanceState: tt.state}
+			if tt.instanceType != "" {
+				desired.InstanceType = tt.instanceType
+			}
+			if tt.imageID != "" {
+				desired.ImageID = tt.imageID
+			}
+			if got := desired.Plan(current); got != tt.want {
+				t.Errorf("Plan() = %+v, want %+v", got, tt.want)
+			}
+			if got := desired.InstanceTypeChanged(current); got != (tt.instanceType != "") {
+				t.Errorf("InstanceTypeChanged() = %v", got)
+			}
+			if got := desired.InDesiredState(); got != tt.inDesired {
+				t.Errorf("InDesiredState() = %v, want %v", got, tt.inDesired)
+			}
+			if got := desired.ImmutableChanges(current); len(got) != tt.immutable {
+				t.Errorf("ImmutableChanges() = %v, want %d fields", got, tt.immutable)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package ec2
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidLaunchTemplateName = errors.New("launch template name must have 3-128 characters: letters, numbers, ( ) . / _ or -")
+)
+
+var launchTemplateNamePattern = regexp.MustCompile(`^[a-zA-Z0-9().\-/_]{3,128}$`)
+
+// LaunchTemplate is a versioned EC2 launch template. Every change of Data
+// creates a new version.
+type LaunchTemplate struct {
+	// Identification
+	Name string
+	ID   string
+
+	// Data is the launch configuration of the latest version
+	Data LaunchTemplateData
+
+	// VersionDescription describes the versions created by the operator
+	VersionDescription string
+
+	// UpdateDefaultVersion makes each new version the default version
+	UpdateDefaultVersion bool
+
+	// Tags of the launch template itself
+	Tags map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	LatestVersion  int64
+	DefaultVersion int64
+	LastSyncTime   *time.Time
+}
+
+// LaunchTemplateData is the instance configuration of a launch template
+// version. Empty fields are left out of the version.
+type LaunchTemplateData struct {
+	ImageID             string
+	InstanceType        string
+	KeyName             string
+	SecurityGroupIDs    []string
+	IAMInstanceProfile  string
+	UserData            string
+	BlockDeviceMappings []BlockDeviceMapping
+	Monitoring          bool
+	EBSOptimized        bool
+
+	// InstanceTags are applied to the instances and volumes launched from the template
+	InstanceTags map[string]string
+}
+
+// SetDefaults sets default values for optional fields
+func (lt *LaunchTemplate) SetDefaults() {
+	if lt.DeletionPolicy == "" {
+		lt.DeletionPolicy = "Delete"
+	}
+	if lt.Tags == nil {
+		lt.Tags = make(map[string]string)
+	}
+	for idx := range lt.Data.BlockDeviceMappings {
+		if ebs := lt.Data.BlockDeviceMappings[idx].EBS; ebs != nil && ebs.VolumeType == "" {
+			ebs.VolumeType = "gp3"
+		}
+	}
+}
+
+// Validate checks if the launch template configuration is valid
+func (lt *LaunchTemplate) Validate() error {
+	if !launchTemplateNamePattern.MatchString(lt.Name) {
+		return ErrInvalidLaunchTemplateName
+	}
+	if lt.Data.ImageID == "" {
+		return ErrInvalidImageID
+	}
+	return nil
+}
+
+// ShouldDelete returns true if the launch template should be deleted when the CR is deleted
+func (lt *LaunchTemplate) ShouldDelete() bool {
+	return lt.DeletionPolicy != "Retain" && lt.DeletionPolicy != "Orphan"
+}
+
+// DataChanged reports whether the desired launch configuration differs from
+// the one of an existing version
+func (lt *LaunchTemplate) DataChanged(current LaunchTemplateData) bool {
+	desired := lt.Data
+	return desired.ImageID != current.ImageID ||
+		desired.InstanceType != current.InstanceType ||
+		desired.KeyName != current.KeyName ||
+		!sameStrings(desired.SecurityGroupIDs, current.SecurityGroupIDs) ||
+		desired.IAMInstanceProfile != current.IAMInstanceProfile ||
+		desired.UserData != current.UserData ||
+		!sameBlockDeviceMappings(desired.BlockDeviceMappings, current.BlockDeviceMappings) ||
+		desired.Monitoring != current.Monitoring ||
+		desired.EBSOptimized != current.EBSOptimized ||
+		!sameTags(desired.InstanceTags, current.InstanceTags)
+}
+
+// sameStrings compares two lists ignoring order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sameBlockDeviceMappings(a, b []BlockDeviceMapping) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byDevice := make(map[string]*EBSBlockDevice, len(b))
+	for _, m := range b {
+		byDevice[m.DeviceName] = m.EBS
+	}
+	for _, m := range a {
+		current, ok := byDevice[m.DeviceName]
+		if !ok {
+			return false
+		}
+		if (m.EBS == nil) != (current == nil) || (m.EBS != nil && *m.EBS != *current) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameTags(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if current, ok := b[k]; !ok || current != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package ec2_test
+
+import (
+	"errors"
+	"testing"
+
+	"infra-operator/internal/domain/ec2"
+)
+
+func TestLaunchTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		lt      *ec2.LaunchTemplate
+		wantErr error
+	}{
+		{
+			name: "valid",
+			lt:   &ec2.LaunchTemplate{Name: "web-v1", Data: ec2.LaunchTemplateData{ImageID: "ami-123"}},
+		},
+		{
+			name:    "name too short",
+			lt:      &ec2.LaunchTemplate{Name: "ab", Data: ec2.LaunchTemplateData{ImageID: "ami-123"}},
+			wantErr: ec2.ErrInvalidLaunchTemplateName,
+		},
+		{
+			name:    "invalid character",
+			lt:      &ec2.LaunchTemplate{Name: "web template", Data: ec2.LaunchTemplateData{ImageID: "ami-123"}},
+			wantErr: ec2.ErrInvalidLaunchTemplateName,
+		},
+		{
+			name:    "missing image",
+			lt:      &ec2.LaunchTemplate{Name: "web"},
+			wantErr: ec2.ErrInvalidImageID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.lt.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLaunchTemplate_DataChanged(t *testing.T) {
+	current := ec2.LaunchTemplateData{
+		ImageID:          "ami-123",
+		InstanceType:     "t3.small",
+		SecurityGroupIDs: []string{"sg-a", "sg-b"},
+		BlockDeviceMappings: []ec2.BlockDeviceMapping{
+			{DeviceName: "/dev/xvda", EBS: &ec2.EBSBlockDevice{VolumeSize: 20, VolumeType: "gp3", DeleteOnTermination: true}},
+		},
+		InstanceTags: map[string]string{"team": "web"},
+	}
+
+	lt := &ec2.LaunchTemplate{Name: "web", Data: current}
+	lt.Data.SecurityGroupIDs = []string{"sg-b", "sg-a"}
+	if lt.DataChanged(current) {
+		t.Error("DataChanged() = true for reordered security groups")
+	}
+
+	lt.Data.ImageID = "ami-456"
+	if !lt.DataChanged(current) {
+		t.Error("DataChanged() = false for a new image")
+	}
+
+	lt.Data = current
+	lt.Data.BlockDeviceMappings = []ec2.BlockDeviceMapping{
+		{DeviceName: "/dev/xvda", EBS: &ec2.EBSBlockDevice{VolumeSize: 30, VolumeType: "gp3", DeleteOnTermination: true}},
+	}
+	if !lt.DataChanged(current) {
+		t.Error("DataChanged() = false for a resized volume")
+	}
+
+	lt.Data = current
+	lt.Data.InstanceTags = map[string]string{"team": "api"}
+	if !lt.DataChanged(current) {
+		t.Error("DataChanged() = false for new instance tags")
+	}
+}
+
+func TestLaunchTemplate_SetDefaults(t *testing.T) {
+	lt := &ec2.LaunchTemplate{Data: ec2.LaunchTemplateData{
+		BlockDeviceMappings: []ec2.BlockDeviceMapping{{DeviceName: "/dev/xvda", EBS: &ec2.EBSBlockDevice{VolumeSize: 20}}},
+	}}
+	lt.SetDefaults()
+	if lt.DeletionPolicy != "Delete" || lt.Tags == nil {
+		t.Error("SetDefaults() did not set the deletion policy and tags")
+	}
+	if got := lt.Data.BlockDeviceMappings[0].EBS.VolumeType; got != "gp3" {
+		t.Errorf("volume type = %q, want gp3", got)
+	}
+}
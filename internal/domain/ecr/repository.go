@@ -89,3 +89,8 @@ func (r *Repository) SetDefaults() {
 func (r *Repository) IsReady() bool {
 	return r.RepositoryArn != "" && r.RepositoryUri != ""
 }
+
+// ShouldDelete returns true if the repository should be deleted when the CR is deleted
+func (r *Repository) ShouldDelete() bool {
+	return r.DeletionPolicy != "Retain" && r.DeletionPolicy != "Orphan"
+}
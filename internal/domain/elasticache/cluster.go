@@ -128,6 +128,12 @@ func (c *Cluster) IsClusterMode() bool {
 	return c.IsRedis() && c.NumNodeGroups > 0
 }
 
+// IsReplicationGroup reports whether the cluster is managed as a Redis replication
+// group instead of a single cache cluster
+func (c *Cluster) IsReplicationGroup() bool {
+	return c.IsRedis() && (c.NumNodeGroups > 0 || c.NumCacheNodes > 1 || c.AutomaticFailoverEnabled)
+}
+
 func (c *Cluster) IsAvailable() bool {
 	return c.ClusterStatus == "available"
 }
@@ -0,0 +1,213 @@
+package lambda
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var (
+	ErrInvalidAliasName      = errors.New("alias name cannot be empty, $LATEST or a version number")
+	ErrInvalidAliasVersion   = errors.New("alias function version must be a published version number")
+	ErrInvalidRoutingWeight  = errors.New("routing weight must be between 1 and 99 percent")
+	ErrInvalidRoutingVersion = errors.New("routing version must be a published version other than the function version")
+	ErrInvalidShiftSteps     = errors.New("traffic shift steps must be increasing percentages between 1 and 99")
+	ErrInvalidShiftInterval  = errors.New("traffic shift interval must be at least one minute")
+	ErrShiftWithRouting      = errors.New("traffic shift and routing cannot be used together")
+)
+
+// Traffic shift phases
+const (
+	ShiftPhaseProgressing = "Progressing"
+	ShiftPhaseCompleted   = "Completed"
+	ShiftPhaseRolledBack  = "RolledBack"
+)
+
+var versionPattern = regexp.MustCompile(`^[0-9]+$`)
+
+// Alias represents a Lambda alias in the domain model
+type Alias struct {
+	// Identification
+	FunctionName string
+	Name         string
+	ARN          string
+	Description  string
+
+	// FunctionVersion is the version the alias points to
+	FunctionVersion string
+
+	// Routing sends a share of the traffic to an additional version
+	Routing *AliasRouting
+
+	// TrafficShift moves the traffic to a new FunctionVersion in steps
+	TrafficShift *TrafficShift
+
+	// Shift is the state of the last traffic shift
+	Shift *ShiftState
+
+	// ActiveVersion and ActiveRouting are the version and routing in AWS,
+	// which differ from the desired ones during a traffic shift
+	ActiveVersion string
+	ActiveRouting *AliasRouting
+
+	// State
+	RevisionID     string
+	DeletionPolicy string
+	LastSyncTime   *time.Time
+}
+
+// AliasRouting sends Weight percent of the traffic to Version
+type AliasRouting struct {
+	Version string
+	Weight  int32
+}
+
+// TrafficShift configures a progressive shift to a new version
+type TrafficShift struct {
+	// Steps are the increasing percentages of traffic sent to the new version
+	Steps []int32
+
+	// Interval is the time spent on each step
+	Interval time.Duration
+
+	// AlarmNames are CloudWatch alarms that roll the shift back when in ALARM
+	AlarmNames []string
+}
+
+// ShiftState tracks a traffic shift from StableVersion to TargetVersion
+type ShiftState struct {
+	StableVersion      string
+	TargetVersion      string
+	Step               int
+	Phase              string
+	Message            string
+	LastTransitionTime time.Time
+}
+
+// Validate checks if the alias configuration is valid
+func (a *Alias) Validate() error {
+	if a.FunctionName == "" {
+		return ErrInvalidFunctionName
+	}
+
+	if a.Name == "" || a.Name == "$LATEST" || versionPattern.MatchString(a.Name) {
+		return ErrInvalidAliasName
+	}
+
+	// $LATEST cannot take part in weighted routing
+	if a.FunctionVersion != "$LATEST" && !versionPattern.MatchString(a.FunctionVersion) {
+		return ErrInvalidAliasVersion
+	}
+	if a.FunctionVersion == "$LATEST" && (a.Routing != nil || a.TrafficShift != nil) {
+		return ErrInvalidAliasVersion
+	}
+
+	if a.Routing != nil {
+		if a.TrafficShift != nil {
+			return ErrShiftWithRouting
+		}
+		if !versionPattern.MatchString(a.Routing.Version) || a.Routing.Version == a.FunctionVersion {
+			return ErrInvalidRoutingVersion
+		}
+		if a.Routing.Weight < 1 || a.Routing.Weight > 99 {
+			return ErrInvalidRoutingWeight
+		}
+	}
+
+	if s := a.TrafficShift; s != nil {
+		if len(s.Steps) == 0 {
+			return ErrInvalidShiftSteps
+		}
+		for i, step := range s.Steps {
+			if step < 1 || step > 99 || (i > 0 && step <= s.Steps[i-1]) {
+				return ErrInvalidShiftSteps
+			}
+		}
+		if s.Interval < time.Minute {
+			return ErrInvalidShiftInterval
+		}
+	}
+
+	return nil
+}
+
+// SetDefaults sets default values for optional fields
+func (a *Alias) SetDefaults() {
+	if a.DeletionPolicy == "" {
+		a.DeletionPolicy = "Delete"
+	}
+}
+
+// ShouldDelete returns true if the alias should be deleted when the CR is deleted
+func (a *Alias) ShouldDelete() bool {
+	return a.DeletionPolicy != "Retain" && a.DeletionPolicy != "Orphan"
+}
+
+// ShiftInProgress reports whether a shift to the desired version is under way
+func (a *Alias) ShiftInProgress() bool {
+	return a.TrafficShift != nil && a.Shift != nil &&
+		a.Shift.Phase == ShiftPhaseProgressing && a.Shift.TargetVersion == a.FunctionVersion
+}
+
+// NextStepIn returns the time left on the current step of a shift in progress
+func (a *Alias) NextStepIn(now time.Time) time.Duration {
+	if !a.ShiftInProgress() {
+		return 0
+	}
+	return max(a.Shift.LastTransitionTime.Add(a.TrafficShift.Interval).Sub(now), 0)
+}
+
+// Target returns the version and routing the alias should have now, given
+// the alias in AWS. Without a TrafficShift it is the desired version and
+// routing. With one, changing FunctionVersion starts a shift from the current
+// version that sends each step's share of traffic to the new version, one
+// step per interval, until it gets all of it. firingAlarm names a shift
+// alarm in ALARM, which rolls the shift back to the stable version; a rolled
+// back version is not shifted to again until FunctionVersion changes.
+func (a *Alias) Target(current *Alias, firingAlarm string, now time.Time) (string, *AliasRouting) {
+	if a.TrafficShift == nil || current == nil {
+		a.Shift = nil
+		return a.FunctionVersion, a.Routing
+	}
+
+	// A new desired version replaces the shift to the previous one
+	shift := a.Shift
+	if shift == nil || shift.TargetVersion != a.FunctionVersion {
+		if a.FunctionVersion == current.FunctionVersion {
+			a.Shift = nil
+			return a.FunctionVersion, nil
+		}
+		a.Shift = &ShiftState{
+			StableVersion:      current.FunctionVersion,
+			TargetVersion:      a.FunctionVersion,
+			Phase:              ShiftPhaseProgressing,
+			LastTransitionTime: now,
+		}
+		return a.Shift.StableVersion, &AliasRouting{Version: a.FunctionVersion, Weight: a.TrafficShift.Steps[0]}
+	}
+
+	switch shift.Phase {
+	case ShiftPhaseCompleted:
+		return shift.TargetVersion, nil
+	case ShiftPhaseRolledBack:
+		return shift.StableVersion, nil
+	}
+
+	if firingAlarm != "" {
+		shift.Phase = ShiftPhaseRolledBack
+		shift.Message = fmt.Sprintf("alarm %s is in ALARM, traffic returned to version %s", firingAlarm, shift.StableVersion)
+		shift.LastTransitionTime = now
+		return shift.StableVersion, nil
+	}
+
+	if now.Sub(shift.LastTransitionTime) >= a.TrafficShift.Interval {
+		shift.Step++
+		shift.LastTransitionTime = now
+		if shift.Step >= len(a.TrafficShift.Steps) {
+			shift.Phase = ShiftPhaseCompleted
+			return shift.TargetVersion, nil
+		}
+	}
+	return shift.StableVersion, &AliasRouting{Version: shift.TargetVersion, Weight: a.TrafficShift.Steps[shift.Step]}
+}
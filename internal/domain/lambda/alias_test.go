@@ -0,0 +1,124 @@
+package lambda_test
+
+import (
+	"testing"
+	"time"
+
+	"infra-operator/internal/domain/lambda"
+)
+
+func TestAlias_Validate(t *testing.T) {
+	shift := &lambda.TrafficShift{Steps: []int32{10, 50}, Interval: 5 * time.Minute}
+	tests := []struct {
+		name    string
+		alias   *lambda.Alias
+		wantErr error
+	}{
+		{"valid", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3"}, nil},
+		{"latest", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "$LATEST"}, nil},
+		{"numeric name", &lambda.Alias{FunctionName: "api", Name: "1", FunctionVersion: "3"}, lambda.ErrInvalidAliasName},
+		{"no version", &lambda.Alias{FunctionName: "api", Name: "live"}, lambda.ErrInvalidAliasVersion},
+		{"routing", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", Routing: &lambda.AliasRouting{Version: "4", Weight: 10}}, nil},
+		{"routing to same version", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", Routing: &lambda.AliasRouting{Version: "3", Weight: 10}}, lambda.ErrInvalidRoutingVersion},
+		{"routing weight", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", Routing: &lambda.AliasRouting{Version: "4", Weight: 100}}, lambda.ErrInvalidRoutingWeight},
+		{"routing from latest", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "$LATEST", Routing: &lambda.AliasRouting{Version: "4", Weight: 10}}, lambda.ErrInvalidAliasVersion},
+		{"shift", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", TrafficShift: shift}, nil},
+		{"shift and routing", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", TrafficShift: shift, Routing: &lambda.AliasRouting{Version: "4", Weight: 10}}, lambda.ErrShiftWithRouting},
+		{"decreasing steps", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", TrafficShift: &lambda.TrafficShift{Steps: []int32{50, 10}, Interval: time.Minute}}, lambda.ErrInvalidShiftSteps},
+		{"short interval", &lambda.Alias{FunctionName: "api", Name: "live", FunctionVersion: "3", TrafficShift: &lambda.TrafficShift{Steps: []int32{10}, Interval: time.Second}}, lambda.ErrInvalidShiftInterval},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.alias.Validate(); err != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAlias_TargetWithoutShift(t *testing.T) {
+	routing := &lambda.AliasRouting{Version: "4", Weight: 20}
+	alias := &lambda.Alias{FunctionVersion: "3", Routing: routing}
+
+	version, got := alias.Target(&lambda.Alias{FunctionVersion: "2"}, "", time.Now())
+	if version != "3" || got != routing {
+		t.Errorf("got %s %+v, want the desired version and routing", version, got)
+	}
+}
+
+func TestAlias_TargetShift(t *testing.T) {
+	start := time.Date(2025, 11, 22, 10, 0, 0, 0, time.UTC)
+	alias := &lambda.Alias{
+		FunctionVersion: "4",
+		TrafficShift:    &lambda.TrafficShift{Steps: []int32{10, 50}, Interval: 5 * time.Minute},
+	}
+	current := &lambda.Alias{FunctionVersion: "3"}
+
+	steps := []struct {
+		at          time.Duration
+		wantVersion string
+		wantWeight  int32
+		wantPhase   string
+	}{
+		{0, "3", 10, lambda.ShiftPhaseProgressing},
+		{time.Minute, "3", 10, lambda.ShiftPhaseProgressing},
+		{5 * time.Minute, "3", 50, lambda.ShiftPhaseProgressing},
+		{10 * time.Minute, "4", 0, lambda.ShiftPhaseCompleted},
+		{15 * time.Minute, "4", 0, lambda.ShiftPhaseCompleted},
+	}
+	for _, step := range steps {
+		version, routing := alias.Target(current, "", start.Add(step.at))
+		var weight int32
+		if routing != nil {
+			weight = routing.Weight
+			if routing.Version != "4" {
+				t.Errorf("at %s: routing to %s, want 4", step.at, routing.Version)
+			}
+		}
+		if version != step.wantVersion || weight != step.wantWeight || alias.Shift.Phase != step.wantPhase {
+			t.Errorf("at %s: got %s/%d %s, want %s/%d %s", step.at, version, weight, alias.Shift.Phase, step.wantVersion, step.wantWeight, step.wantPhase)
+		}
+		current = &lambda.Alias{FunctionVersion: version}
+	}
+}
+
+func TestAlias_TargetRollback(t *testing.T) {
+	now := time.Now()
+	alias := &lambda.Alias{
+		FunctionVersion: "4",
+		TrafficShift:    &lambda.TrafficShift{Steps: []int32{10, 50}, Interval: 5 * time.Minute, AlarmNames: []string{"api-errors"}},
+	}
+	current := &lambda.Alias{FunctionVersion: "3"}
+
+	alias.Target(current, "", now)
+	if !alias.ShiftInProgress() {
+		t.Fatal("expected the shift to be in progress")
+	}
+
+	version, routing := alias.Target(current, "api-errors", now.Add(time.Minute))
+	if version != "3" || routing != nil || alias.Shift.Phase != lambda.ShiftPhaseRolledBack {
+		t.Fatalf("got %s %+v %s, want a rollback to 3", version, routing, alias.Shift.Phase)
+	}
+
+	// The rolled back version is not shifted to again
+	version, routing = alias.Target(current, "", now.Add(time.Hour))
+	if version != "3" || routing != nil {
+		t.Errorf("got %s %+v, want to stay on 3", version, routing)
+	}
+
+	// A new version starts a new shift
+	alias.FunctionVersion = "5"
+	version, routing = alias.Target(current, "", now.Add(time.Hour))
+	if version != "3" || routing == nil || routing.Version != "5" || alias.Shift.Phase != lambda.ShiftPhaseProgressing {
+		t.Errorf("got %s %+v, want a shift from 3 to 5", version, routing)
+	}
+}
+
+func TestNewestVersions(t *testing.T) {
+	versions := []lambda.Version{{Version: "$LATEST"}, {Version: "2"}, {Version: "10"}, {Version: "1"}}
+
+	got := lambda.NewestVersions(versions, 2)
+	if len(got) != 2 || got[0].Version != "10" || got[1].Version != "2" {
+		t.Errorf("got %+v, want versions 10 and 2", got)
+	}
+}
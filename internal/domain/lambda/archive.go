@@ -0,0 +1,176 @@
+package lambda
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxArchiveSize is the largest deployment package Lambda accepts in a direct upload
+const MaxArchiveSize = 50 << 20
+
+var (
+	ErrArchiveTooLarge  = fmt.Errorf("deployment package exceeds %d MB; upload it to S3 instead", MaxArchiveSize>>20)
+	ErrEmptyArchive     = errors.New("deployment package has no files")
+	ErrInvalidReference = errors.New("OCI artifact reference must be registry/repository with a tag or digest")
+)
+
+// archiveModTime is the modification time of every file in a built archive,
+// so the same files always produce the same archive and hash
+var archiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// OCIArtifact is an OCI artifact whose layer is the deployment package
+type OCIArtifact struct {
+	Reference string
+	Username  string
+	Password  string
+	PlainHTTP bool
+}
+
+// Pinned returns the artifact referenced by the given manifest digest, so
+// the manifest that was resolved is downloaded even if its tag moves
+func (a OCIArtifact) Pinned(digest string) OCIArtifact {
+	name := a.Reference
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	a.Reference = name + "@" + digest
+	return a
+}
+
+// ParseArtifactReference splits an artifact reference such as
+// ghcr.io/acme/orders:1.4.0 or ghcr.io/acme/orders@sha256:... into its
+// registry, repository and tag or digest. References without a registry
+// are Docker Hub repositories.
+func ParseArtifactReference(reference string) (registry, repository, tagOrDigest string, err error) {
+	name := reference
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, tagOrDigest = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, tagOrDigest = name[:i], name[i+1:]
+	}
+	if name == "" || tagOrDigest == "" {
+		return "", "", "", ErrInvalidReference
+	}
+
+	registry, repository = "registry-1.docker.io", name
+	if i := strings.Index(name, "/"); i >= 0 {
+		host := name[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			registry, repository = host, name[i+1:]
+		}
+	}
+	if registry == "registry-1.docker.io" && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+	if repository == "" {
+		return "", "", "", ErrInvalidReference
+	}
+	return registry, repository, tagOrDigest, nil
+}
+
+// BuildArchive zips files keyed by their path in the package. Files are
+// sorted and timestamps fixed, so the archive only changes with the files.
+// A bootstrap file, the entry point of custom runtimes, is made executable.
+func BuildArchive(files map[string][]byte) ([]byte, error) {
+	if len(files) == 0 {
+		return nil, ErrEmptyArchive
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range names {
+		header := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: archiveModTime}
+		header.SetMode(0o644)
+		if path.Base(name) == "bootstrap" {
+			header.SetMode(0o755)
+		}
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add %s to deployment package: %w", name, err)
+		}
+		if _, err := f.Write(files[name]); err != nil {
+			return nil, fmt.Errorf("failed to add %s to deployment package: %w", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to build deployment package: %w", err)
+	}
+
+	if buf.Len() > MaxArchiveSize {
+		return nil, ErrArchiveTooLarge
+	}
+	return buf.Bytes(), nil
+}
+
+// ArchiveFromLayer returns the deployment package stored in an artifact
+// layer: a zip file as is, or the files of a tar or tar.gz layer zipped
+func ArchiveFromLayer(layer []byte) ([]byte, error) {
+	if bytes.HasPrefix(layer, []byte("PK\x03\x04")) {
+		if len(layer) > MaxArchiveSize {
+			return nil, ErrArchiveTooLarge
+		}
+		return layer, nil
+	}
+
+	var r io.Reader = bytes.NewReader(layer)
+	if bytes.HasPrefix(layer, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact layer: %w", err)
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	files := map[string][]byte{}
+	size := 0
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("artifact layer is not a zip, tar or tar.gz file: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if size += int(header.Size); size > MaxArchiveSize {
+			return nil, ErrArchiveTooLarge
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from artifact layer: %w", header.Name, err)
+		}
+		files[strings.TrimPrefix(path.Clean(header.Name), "/")] = data
+	}
+
+	return BuildArchive(files)
+}
+
+// ArchiveHash returns the base64 SHA-256 of a deployment package, the format
+// Lambda reports in CodeSha256
+func ArchiveHash(archive []byte) string {
+	sum := sha256.Sum256(archive)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,162 @@
+package lambda_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"infra-operator/internal/domain/lambda"
+)
+
+func TestBuildArchive_Deterministic(t *testing.T) {
+	files := map[string][]byte{
+		"index.py":      []byte("def handler(event, context):\n    return 'ok'\n"),
+		"lib/helper.py": []byte("VALUE = 1\n"),
+	}
+
+	first, err := lambda.BuildArchive(files)
+	if err != nil {
+		t.Fatalf("BuildArchive() error = %v", err)
+	}
+	second, err := lambda.BuildArchive(files)
+	if err != nil {
+		t.Fatalf("BuildArchive() error = %v", err)
+	}
+	if lambda.ArchiveHash(first) != lambda.ArchiveHash(second) {
+		t.Error("same files produced different archives")
+	}
+
+	files["index.py"] = []byte("def handler(event, context):\n    return 'changed'\n")
+	changed, err := lambda.BuildArchive(files)
+	if err != nil {
+		t.Fatalf("BuildArchive() error = %v", err)
+	}
+	if lambda.ArchiveHash(first) == lambda.ArchiveHash(changed) {
+		t.Error("changed files produced the same hash")
+	}
+
+	if _, err := lambda.BuildArchive(nil); err != lambda.ErrEmptyArchive {
+		t.Errorf("BuildArchive(nil) error = %v, want %v", err, lambda.ErrEmptyArchive)
+	}
+}
+
+func TestBuildArchive_BootstrapExecutable(t *testing.T) {
+	archive, err := lambda.BuildArchive(map[string][]byte{"bootstrap": []byte("#!/bin/sh\n"), "data.json": []byte("{}")})
+	if err != nil {
+		t.Fatalf("BuildArchive() error = %v", err)
+	}
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	for _, f := range r.File {
+		executable := f.Mode()&0o111 != 0
+		if executable != (f.Name == "bootstrap") {
+			t.Errorf("%s executable = %v", f.Name, executable)
+		}
+	}
+}
+
+func TestArchiveFromLayer(t *testing.T) {
+	zipped, err := lambda.BuildArchive(map[string][]byte{"index.js": []byte("exports.handler = async () => 'ok'\n")})
+	if err != nil {
+		t.Fatalf("BuildArchive() error = %v", err)
+	}
+	got, err := lambda.ArchiveFromLayer(zipped)
+	if err != nil || !bytes.Equal(got, zipped) {
+		t.Errorf("zip layer was not used as is (err = %v)", err)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	content := []byte("exports.handler = async () => 'ok'\n")
+	tw.WriteHeader(&tar.Header{Name: "./index.js", Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+	tw.Write(content)
+	tw.Close()
+	gz.Close()
+
+	got, err = lambda.ArchiveFromLayer(buf.Bytes())
+	if err != nil {
+		t.Fatalf("ArchiveFromLayer(tar.gz) error = %v", err)
+	}
+	if lambda.ArchiveHash(got) != lambda.ArchiveHash(zipped) {
+		t.Error("tar.gz layer did not produce the same package as its files")
+	}
+
+	if _, err := lambda.ArchiveFromLayer([]byte("not an archive")); err == nil {
+		t.Error("ArchiveFromLayer() accepted an invalid layer")
+	}
+}
+
+func TestParseArtifactReference(t *testing.T) {
+	tests := []struct {
+		reference                         string
+		registry, repository, tagOrDigest string
+		wantErr                           bool
+	}{
+		{reference: "ghcr.io/acme/orders:1.4.0", registry: "ghcr.io", repository: "acme/orders", tagOrDigest: "1.4.0"},
+		{reference: "localhost:5000/orders@sha256:abc", registry: "localhost:5000", repository: "orders", tagOrDigest: "sha256:abc"},
+		{reference: "acme/orders:latest", registry: "registry-1.docker.io", repository: "acme/orders", tagOrDigest: "latest"},
+		{reference: "orders:1", registry: "registry-1.docker.io", repository: "library/orders", tagOrDigest: "1"},
+		{reference: "localhost:5000/orders", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			registry, repository, tagOrDigest, err := lambda.ParseArtifactReference(tt.reference)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if registry != tt.registry || repository != tt.repository || tagOrDigest != tt.tagOrDigest {
+				t.Errorf("got %s %s %s", registry, repository, tagOrDigest)
+			}
+		})
+	}
+}
+
+func TestOCIArtifact_Pinned(t *testing.T) {
+	tests := map[string]string{
+		"ghcr.io/acme/orders:1.4.0":         "ghcr.io/acme/orders@sha256:def",
+		"localhost:5000/orders@sha256:abc":  "localhost:5000/orders@sha256:def",
+		"localhost:5000/acme/orders:latest": "localhost:5000/acme/orders@sha256:def",
+	}
+	for reference, want := range tests {
+		artifact := lambda.OCIArtifact{Reference: reference, Username: "ci"}
+		if got := artifact.Pinned("sha256:def"); got.Reference != want || got.Username != "ci" {
+			t.Errorf("Pinned(%s) = %+v, want %s", reference, got, want)
+		}
+	}
+}
+
+func TestCode_Package(t *testing.T) {
+	code := &lambda.Code{Files: map[string][]byte{"index.py": []byte("print('ok')\n")}}
+	if err := code.Package(nil); err != nil {
+		t.Fatalf("Package() error = %v", err)
+	}
+	if code.Archive == nil || code.Hash != lambda.ArchiveHash(code.Archive) {
+		t.Error("files were not packaged")
+	}
+
+	s3 := &lambda.Code{S3Bucket: "artifacts", S3Key: "orders.zip"}
+	if err := s3.Package(nil); err != nil {
+		t.Fatalf("Package() error = %v", err)
+	}
+	moved := &lambda.Code{S3Bucket: "artifacts", S3Key: "orders-v2.zip"}
+	moved.Package(nil)
+	if s3.Archive != nil || s3.Hash == "" || s3.Hash == moved.Hash {
+		t.Error("S3 code should be identified by its object reference")
+	}
+
+	oci := &lambda.Code{OCI: &lambda.OCIArtifact{Reference: "ghcr.io/acme/orders:1.4.0"}}
+	oci.SetDigest("sha256:abc")
+	hash := oci.Hash
+	layer, _ := lambda.BuildArchive(map[string][]byte{"index.js": []byte("exports.handler = async () => 'ok'\n")})
+	if err := oci.Package(layer); err != nil {
+		t.Fatalf("Package() error = %v", err)
+	}
+	if oci.Archive == nil || oci.Hash != hash || hash == lambda.ArchiveHash(oci.Archive) {
+		t.Error("OCI code should be identified by its manifest digest")
+	}
+}
@@ -1,7 +1,11 @@
 package lambda
 
 import (
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -16,6 +20,9 @@ var (
 	ErrInvalidMemory       = errors.New("memory size must be between 128 and 10240 MB")
 )
 
+// MaxListedVersions is the number of published versions kept in the status
+const MaxListedVersions = 10
+
 // Function represents a Lambda function in the domain model
 type Function struct {
 	// Identification
@@ -39,13 +46,39 @@ type Function struct {
 	// VPC Configuration
 	VpcConfig *VpcConfig
 
+	// Publish publishes a version after every change
+	Publish bool
+
+	// Triggers
+	EventSources []EventSourceMapping
+	Permissions  []Permission
+
+	// ManagedEventSources and ManagedPermissions are the event source ARNs and
+	// statement IDs created by earlier syncs, which are removed once they are
+	// no longer desired
+	ManagedEventSources []string
+	ManagedPermissions  []string
+
 	// State
-	State          string
-	StateReason    string
-	LastModified   time.Time
-	Version        string
-	CodeSize       int64
-	DeletionPolicy string
+	State            string
+	StateReason      string
+	LastUpdateStatus string
+	LastModified     time.Time
+	Version          string
+	CodeSize         int64
+	CodeSha256       string
+	CodeHash         string
+	PublishedVersion string
+	Versions         []Version
+	DeletionPolicy   string
+}
+
+// Version is a published version of a Lambda function
+type Version struct {
+	Version      string
+	CodeSha256   string
+	Description  string
+	LastModified time.Time
 }
 
 // Code represents the Lambda function code
@@ -53,6 +86,12 @@ type Code struct {
 	// For inline code (base64 zip)
 	ZipFile string
 
+	// For packages built from files, keyed by their path in the package
+	Files map[string][]byte
+
+	// For packages pulled from an OCI artifact
+	OCI *OCIArtifact
+
 	// For S3-based code
 	S3Bucket        string
 	S3Key           string
@@ -60,6 +99,17 @@ type Code struct {
 
 	// For container images
 	ImageUri string
+
+	// Archive is the deployment package uploaded for inline code, files and
+	// OCI artifacts
+	Archive []byte
+
+	// Digest is the manifest digest of the OCI artifact, resolved by the caller
+	Digest string
+
+	// Hash identifies the code: the hash of Archive, of the OCI manifest
+	// digest, or of the S3 object or image reference
+	Hash string
 }
 
 // VpcConfig represents VPC configuration for Lambda
@@ -88,7 +138,7 @@ func (f *Function) Validate() error {
 	}
 
 	// Validate code source - at least one must be specified
-	if f.Code.ZipFile == "" && f.Code.S3Bucket == "" && f.Code.ImageUri == "" {
+	if f.Code.ZipFile == "" && f.Code.Files == nil && f.Code.OCI == nil && f.Code.S3Bucket == "" && f.Code.ImageUri == "" {
 		return ErrInvalidCode
 	}
 
@@ -117,6 +167,45 @@ func (f *Function) Validate() error {
 		}
 	}
 
+	return f.validateTriggers()
+}
+
+// SetDigest identifies OCI artifact code by its manifest digest, so an
+// unchanged artifact is recognized before its layer is downloaded
+func (c *Code) SetDigest(digest string) {
+	c.Digest = digest
+	c.Hash = ArchiveHash([]byte(digest))
+}
+
+// Package sets the deployment package and hash of the code. Files are zipped,
+// inline code is decoded and an OCI artifact layer, fetched by the caller,
+// is converted; S3 objects and images are identified by their reference.
+func (c *Code) Package(layer []byte) error {
+	var err error
+	switch {
+	case c.OCI != nil:
+		// The hash was set from the manifest digest by SetDigest
+		c.Archive, err = ArchiveFromLayer(layer)
+		return err
+	case c.Files != nil:
+		c.Archive, err = BuildArchive(c.Files)
+	case c.ZipFile != "":
+		c.Archive, err = base64.StdEncoding.DecodeString(c.ZipFile)
+		if err != nil {
+			err = fmt.Errorf("failed to decode zip file: %w", err)
+		}
+	case c.S3Bucket != "":
+		c.Hash = ArchiveHash([]byte(fmt.Sprintf("s3://%s/%s?versionId=%s", c.S3Bucket, c.S3Key, c.S3ObjectVersion)))
+		return nil
+	default:
+		c.Hash = ArchiveHash([]byte(c.ImageUri))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	c.Hash = ArchiveHash(c.Archive)
 	return nil
 }
 
@@ -125,6 +214,17 @@ func (f *Function) IsActive() bool {
 	return f.State == "Active"
 }
 
+// Publishable checks if a version can be published, which Lambda rejects
+// while the function is being created or updated
+func (f *Function) Publishable() bool {
+	return f.IsActive() && f.LastUpdateStatus != "InProgress"
+}
+
+// ShouldDelete returns true if the function should be deleted when the CR is deleted
+func (f *Function) ShouldDelete() bool {
+	return f.DeletionPolicy != "Retain" && f.DeletionPolicy != "Orphan"
+}
+
 // SetDefaults sets default values for optional fields
 func (f *Function) SetDefaults() {
 	if f.Timeout == 0 {
@@ -136,4 +236,28 @@ func (f *Function) SetDefaults() {
 	if f.DeletionPolicy == "" {
 		f.DeletionPolicy = "Delete"
 	}
+	for i := range f.Permissions {
+		if f.Permissions[i].Action == "" {
+			f.Permissions[i].Action = DefaultPermissionAction
+		}
+	}
+}
+
+// NewestVersions returns at most n published versions, newest first
+func NewestVersions(versions []Version, n int) []Version {
+	published := make([]Version, 0, len(versions))
+	for _, v := range versions {
+		if _, err := strconv.Atoi(v.Version); err == nil {
+			published = append(published, v)
+		}
+	}
+	sort.Slice(published, func(i, j int) bool {
+		a, _ := strconv.Atoi(published[i].Version)
+		b, _ := strconv.Atoi(published[j].Version)
+		return a > b
+	})
+	if len(published) > n {
+		published = published[:n]
+	}
+	return published
 }
@@ -8,3 +8,54 @@ func TestFunction_Validate(t *testing.T) {
 	for _, tt := range tests {t.Run(tt.name, func(t *testing.T) {if err := tt.f.Validate(); err != tt.wantErr {t.Errorf("got %v, want %v", err, tt.wantErr)}})}
 }
 func TestFunction_SetDefaults(t *testing.T) {f := &lambda.Function{}; f.SetDefaults(); if f.DeletionPolicy != "Delete" {t.Error("failed")}}
+
+func TestFunction_ValidateTriggers(t *testing.T) {
+	base := func() *lambda.Function {
+		return &lambda.Function{Name: "test", Runtime: "python3.12", Handler: "index.handler", Role: "arn:aws:iam::123:role/test", Code: lambda.Code{ZipFile: "code"}}
+	}
+	retries := int32(2)
+	tests := []struct {
+		name    string
+		mutate  func(f *lambda.Function)
+		wantErr error
+	}{
+		{"queue", func(f *lambda.Function) {
+			f.EventSources = []lambda.EventSourceMapping{{EventSourceARN: "arn:aws:sqs:us-east-1:123:orders"}}
+		}, nil},
+		{"stream", func(f *lambda.Function) {
+			f.EventSources = []lambda.EventSourceMapping{{EventSourceARN: "arn:aws:dynamodb:us-east-1:123:table/orders/stream/2024", StartingPosition: "LATEST", MaximumRetryAttempts: &retries}}
+		}, nil},
+		{"empty source", func(f *lambda.Function) {
+			f.EventSources = []lambda.EventSourceMapping{{}}
+		}, lambda.ErrInvalidEventSource},
+		{"stream without starting position", func(f *lambda.Function) {
+			f.EventSources = []lambda.EventSourceMapping{{EventSourceARN: "arn:aws:kinesis:us-east-1:123:stream/clicks"}}
+		}, lambda.ErrInvalidStartingPosition},
+		{"queue with destination", func(f *lambda.Function) {
+			f.EventSources = []lambda.EventSourceMapping{{EventSourceARN: "arn:aws:sqs:us-east-1:123:orders", OnFailureDestinationARN: "arn:aws:sqs:us-east-1:123:dlq"}}
+		}, lambda.ErrInvalidStreamOption},
+		{"permission without principal", func(f *lambda.Function) {
+			f.Permissions = []lambda.Permission{{StatementID: "api"}}
+		}, lambda.ErrInvalidPermission},
+		{"duplicate permission", func(f *lambda.Function) {
+			f.Permissions = []lambda.Permission{{StatementID: "api", Principal: "apigateway.amazonaws.com"}, {StatementID: "api", Principal: "sns.amazonaws.com"}}
+		}, lambda.ErrDuplicatePermission},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := base()
+			tt.mutate(f)
+			if err := f.Validate(); err != tt.wantErr {
+				t.Errorf("got %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFunction_SetDefaultsPermissionAction(t *testing.T) {
+	f := &lambda.Function{Permissions: []lambda.Permission{{StatementID: "api", Principal: "apigateway.amazonaws.com"}}}
+	f.SetDefaults()
+	if f.Permissions[0].Action != lambda.DefaultPermissionAction {
+		t.Errorf("got %q, want %q", f.Permissions[0].Action, lambda.DefaultPermissionAction)
+	}
+}
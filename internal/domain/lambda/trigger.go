@@ -0,0 +1,103 @@
+package lambda
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidEventSource      = errors.New("event source ARN cannot be empty")
+	ErrInvalidStartingPosition = errors.New("starting position is required for streams and not supported for queues")
+	ErrInvalidStreamOption     = errors.New("on-failure destination and retry attempts are only supported for streams")
+	ErrInvalidPermission       = errors.New("permission statement ID and principal cannot be empty")
+	ErrDuplicatePermission     = errors.New("permission statement IDs must be unique")
+)
+
+// DefaultPermissionAction is the action granted by a permission without one
+const DefaultPermissionAction = "lambda:InvokeFunction"
+
+// EventSourceMapping is a queue or stream that invokes the function
+type EventSourceMapping struct {
+	// EventSourceARN identifies the mapping of a function
+	EventSourceARN string
+
+	// Configuration
+	BatchSize                      int32
+	MaximumBatchingWindowInSeconds int32
+	StartingPosition               string
+	Enabled                        bool
+	FilterPatterns                 []string
+	MaximumRetryAttempts           *int32
+	OnFailureDestinationARN        string
+
+	// State
+	UUID                  string
+	State                 string
+	StateTransitionReason string
+}
+
+// Permission is a statement of the function policy that allows a principal to invoke it
+type Permission struct {
+	StatementID   string
+	Principal     string
+	Action        string
+	SourceARN     string
+	SourceAccount string
+}
+
+// IsStream checks if the event source is a DynamoDB or Kinesis stream, which
+// are read from a starting position and support on-failure destinations
+func (m *EventSourceMapping) IsStream() bool {
+	return strings.Contains(m.EventSourceARN, ":dynamodb:") || strings.Contains(m.EventSourceARN, ":kinesis:")
+}
+
+// Busy checks if the mapping is being created, updated or deleted, during
+// which Lambda rejects changes
+func (m *EventSourceMapping) Busy() bool {
+	switch m.State {
+	case "Creating", "Updating", "Deleting", "Enabling", "Disabling":
+		return true
+	}
+	return false
+}
+
+// Validate checks if the event source mapping configuration is valid
+func (m *EventSourceMapping) Validate() error {
+	if m.EventSourceARN == "" {
+		return ErrInvalidEventSource
+	}
+	if m.IsStream() != (m.StartingPosition != "") {
+		return ErrInvalidStartingPosition
+	}
+	if !m.IsStream() && (m.OnFailureDestinationARN != "" || m.MaximumRetryAttempts != nil) {
+		return ErrInvalidStreamOption
+	}
+	return nil
+}
+
+// validateTriggers checks the event sources and permissions of a function
+func (f *Function) validateTriggers() error {
+	sources := make(map[string]bool, len(f.EventSources))
+	for i := range f.EventSources {
+		if err := f.EventSources[i].Validate(); err != nil {
+			return err
+		}
+		if sources[f.EventSources[i].EventSourceARN] {
+			return fmt.Errorf("event source %s is mapped more than once", f.EventSources[i].EventSourceARN)
+		}
+		sources[f.EventSources[i].EventSourceARN] = true
+	}
+
+	statements := make(map[string]bool, len(f.Permissions))
+	for _, p := range f.Permissions {
+		if p.StatementID == "" || p.Principal == "" {
+			return ErrInvalidPermission
+		}
+		if statements[p.StatementID] {
+			return ErrDuplicatePermission
+		}
+		statements[p.StatementID] = true
+	}
+	return nil
+}
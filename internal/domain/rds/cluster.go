@@ -0,0 +1,466 @@
+package rds
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidClusterIdentifier = errors.New("DB cluster identifier cannot be empty")
+	ErrInvalidClusterEngine     = errors.New("engine must be aurora-postgresql or aurora-mysql")
+	ErrInvalidWriterClass       = errors.New("writer instance class cannot be empty")
+	ErrInvalidReaderCount       = errors.New("reader count must be between 0 and 15")
+	ErrInvalidServerlessScaling = errors.New("serverless v2 scaling needs a minCapacity between 0 and maxCapacity, and a maxCapacity between 1 and 256 ACUs")
+	ErrServerlessScalingMissing = errors.New("db.serverless instances require serverlessV2Scaling")
+	ErrGlobalClusterChanged     = errors.New("a cluster cannot move to another global database; remove it from the current one first")
+	ErrGlobalClusterJoin        = errors.New("an existing cluster can only become the primary of a new global database")
+	ErrClusterDeletionProtected = errors.New("deletion protection is enabled on the DB cluster")
+)
+
+const (
+	EngineAuroraPostgreSQL = "aurora-postgresql"
+	EngineAuroraMySQL      = "aurora-mysql"
+
+	// InstanceClassServerless is the instance class of Aurora Serverless v2 instances
+	InstanceClassServerless = "db.serverless"
+
+	GlobalClusterRolePrimary   = "primary"
+	GlobalClusterRoleSecondary = "secondary"
+
+	// MaxClusterReaders is the number of Aurora Replicas a cluster supports
+	MaxClusterReaders = 15
+)
+
+// DBCluster represents an Aurora DB cluster and the instances it runs
+type DBCluster struct {
+	// Identification
+	DBClusterIdentifier string
+	DBClusterArn        string
+
+	// Engine configuration
+	Engine        string
+	EngineVersion string
+
+	// Authentication
+	MasterUsername string
+	MasterPassword string
+
+	// Database
+	DatabaseName string
+	Port         int32
+
+	// Network and access
+	Endpoint            string
+	ReaderEndpoint      string
+	DBSubnetGroupName   string
+	VpcSecurityGroupIDs []string
+
+	// Security
+	StorageEncrypted   bool
+	KmsKeyID           string
+	DeletionProtection bool
+
+	// Parameter group of the cluster
+	DBClusterParameterGroupName string
+
+	// Backup
+	BackupRetentionPeriod int32
+	PreferredBackupWindow string
+
+	// Maintenance
+	PreferredMaintenanceWindow string
+	AllowMajorVersionUpgrade   bool
+
+	// ApplyImmediately applies modifications now instead of during the next
+	// maintenance window
+	ApplyImmediately bool
+
+	// PendingModifications are the cluster modifications AWS will apply during
+	// the next maintenance window
+	PendingModifications *PendingModifications
+
+	// ServerlessV2Scaling is the capacity range of db.serverless instances
+	ServerlessV2Scaling *ServerlessV2Scaling
+
+	// GlobalClusterIdentifier is the global database the cluster belongs to
+	GlobalClusterIdentifier string
+
+	// GlobalClusterRole is primary or secondary, as reported by AWS
+	GlobalClusterRole string
+
+	// Instance sets: a single writer and Readers.Count readers
+	Writer  ClusterInstanceSet
+	Readers ClusterInstanceSet
+
+	// Members are the instances AWS reports in the cluster
+	Members []ClusterMember
+
+	// Deletion
+	SkipFinalSnapshot bool
+	DeletionPolicy    string
+
+	// Tags
+	Tags map[string]string
+
+	// State
+	Status       string
+	LastSyncTime *time.Time
+}
+
+// ServerlessV2Scaling is the capacity range, in Aurora capacity units, of the
+// db.serverless instances of a cluster
+type ServerlessV2Scaling struct {
+	MinCapacity float64
+	MaxCapacity float64
+}
+
+// ClusterInstanceSet describes instances of the cluster that share a configuration
+type ClusterInstanceSet struct {
+	InstanceClass        string
+	Count                int32
+	PubliclyAccessible   bool
+	DBParameterGroupName string
+}
+
+// ClusterInstance is a DB instance the cluster should run
+type ClusterInstance struct {
+	DBInstanceIdentifier string
+	InstanceClass        string
+	PromotionTier        int32
+	PubliclyAccessible   bool
+	DBParameterGroupName string
+}
+
+// ClusterMember is a DB instance AWS reports in the cluster
+type ClusterMember struct {
+	DBInstanceIdentifier string
+	InstanceClass        string
+	IsWriter             bool
+	Status               string
+
+	// PendingInstanceClass is a class change waiting for the maintenance window
+	PendingInstanceClass string
+}
+
+// effectiveInstanceClass returns the class the instance has once its pending
+// modifications are applied
+func (m ClusterMember) effectiveInstanceClass() string {
+	if m.PendingInstanceClass != "" {
+		return m.PendingInstanceClass
+	}
+	return m.InstanceClass
+}
+
+// GlobalCluster is an Aurora global database
+type GlobalCluster struct {
+	Identifier string
+	ARN        string
+	Members    []GlobalClusterMember
+}
+
+// GlobalClusterMember is a cluster of a global database
+type GlobalClusterMember struct {
+	DBClusterArn string
+	IsWriter     bool
+}
+
+// Role returns the role of the cluster in the global database, or "" when it
+// is not a member
+func (g *GlobalCluster) Role(clusterArn string) string {
+	for _, m := range g.Members {
+		if m.DBClusterArn != clusterArn {
+			continue
+		}
+		if m.IsWriter {
+			return GlobalClusterRolePrimary
+		}
+		return GlobalClusterRoleSecondary
+	}
+	return ""
+}
+
+// HasOtherMembers reports whether clusters other than clusterArn belong to the global database
+func (g *GlobalCluster) HasOtherMembers(clusterArn string) bool {
+	for _, m := range g.Members {
+		if m.DBClusterArn != clusterArn {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks if the DB cluster configuration is valid
+func (c *DBCluster) Validate() error {
+	if c.DBClusterIdentifier == "" {
+		return ErrInvalidClusterIdentifier
+	}
+
+	if c.Engine != EngineAuroraPostgreSQL && c.Engine != EngineAuroraMySQL {
+		return ErrInvalidClusterEngine
+	}
+
+	if c.Writer.InstanceClass == "" {
+		return ErrInvalidWriterClass
+	}
+
+	if c.Readers.Count < 0 || c.Readers.Count > MaxClusterReaders {
+		return ErrInvalidReaderCount
+	}
+
+	if s := c.ServerlessV2Scaling; s != nil {
+		if s.MaxCapacity < 1 || s.MaxCapacity > 256 || s.MinCapacity < 0 || s.MinCapacity > s.MaxCapacity {
+			return ErrInvalidServerlessScaling
+		}
+	} else if c.IsServerless() {
+		return ErrServerlessScalingMissing
+	}
+
+	// Validate backup retention period (1-35 days, Aurora always keeps backups)
+	if c.BackupRetentionPeriod < 1 || c.BackupRetentionPeriod > 35 {
+		return errors.New("backup retention period must be between 1 and 35 days")
+	}
+
+	return nil
+}
+
+// ValidateCredentials checks the master credentials of a cluster that is
+// created standalone or as the primary of a global database; secondaries take
+// them from the primary
+func (c *DBCluster) ValidateCredentials() error {
+	if c.MasterUsername == "" {
+		return ErrInvalidMasterUser
+	}
+	if c.MasterPassword == "" {
+		return ErrInvalidPassword
+	}
+	return nil
+}
+
+// IsAvailable checks if the DB cluster is available
+func (c *DBCluster) IsAvailable() bool {
+	return c.Status == StatusAvailable
+}
+
+// IsServerless reports whether any instance set uses db.serverless
+func (c *DBCluster) IsServerless() bool {
+	return c.Writer.InstanceClass == InstanceClassServerless ||
+		(c.Readers.Count > 0 && c.Readers.InstanceClass == InstanceClassServerless)
+}
+
+// ShouldDelete reports whether the cluster is deleted with the resource.
+// Delete and Snapshot both delete it, Retain and Orphan keep it in AWS.
+func (c *DBCluster) ShouldDelete() bool {
+	return c.DeletionPolicy != "Retain" && c.DeletionPolicy != "Orphan"
+}
+
+// SetDefaults sets default values for optional fields
+func (c *DBCluster) SetDefaults() {
+	if c.Port == 0 {
+		if c.Engine == EngineAuroraPostgreSQL {
+			c.Port = 5432
+		} else {
+			c.Port = 3306
+		}
+	}
+
+	if c.BackupRetentionPeriod == 0 {
+		c.BackupRetentionPeriod = 7 // 7 days default
+	}
+
+	if c.Readers.InstanceClass == "" {
+		c.Readers.InstanceClass = c.Writer.InstanceClass
+	}
+
+	if c.DeletionPolicy == "" {
+		c.DeletionPolicy = "Delete"
+	}
+}
+
+// InstanceIdentifier returns the identifier of the nth instance of the
+// cluster, counting from 1
+func (c *DBCluster) InstanceIdentifier(n int) string {
+	return fmt.Sprintf("%s-%d", c.DBClusterIdentifier, n)
+}
+
+// instanceNumber returns n for an instance named by InstanceIdentifier, or 0
+// for an instance the cluster does not manage
+func (c *DBCluster) instanceNumber(dbInstanceIdentifier string) int {
+	suffix, ok := strings.CutPrefix(dbInstanceIdentifier, c.DBClusterIdentifier+"-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.Atoi(suffix)
+	if err != nil || n < 1 || strconv.Itoa(n) != suffix {
+		return 0
+	}
+	return n
+}
+
+// DesiredInstances returns the instances the cluster should run: instance 1
+// is the preferred writer, with promotion tier 0, and the next ones are the
+// readers, with tier 1, so a failover moves the writer back to the writer set
+// configuration when possible
+func (c *DBCluster) DesiredInstances() []ClusterInstance {
+	instances := []ClusterInstance{{
+		DBInstanceIdentifier: c.InstanceIdentifier(1),
+		InstanceClass:        c.Writer.InstanceClass,
+		PromotionTier:        0,
+		PubliclyAccessible:   c.Writer.PubliclyAccessible,
+		DBParameterGroupName: c.Writer.DBParameterGroupName,
+	}}
+	for i := 0; i < int(c.Readers.Count); i++ {
+		instances = append(instances, ClusterInstance{
+			DBInstanceIdentifier: c.InstanceIdentifier(i + 2),
+			InstanceClass:        c.Readers.InstanceClass,
+			PromotionTier:        1,
+			PubliclyAccessible:   c.Readers.PubliclyAccessible,
+			DBParameterGroupName: c.Readers.DBParameterGroupName,
+		})
+	}
+	return instances
+}
+
+// InstanceChanges compares the desired instances with the current members of
+// the cluster and returns the instances to create, the instances whose class
+// changed and the instances to delete. Only instances named by
+// InstanceIdentifier are managed, and the current writer is never deleted:
+// scaling in past it waits until it fails over.
+func (c *DBCluster) InstanceChanges(members []ClusterMember) (create, modify []ClusterInstance, remove []string) {
+	current := make(map[string]ClusterMember, len(members))
+	for _, m := range members {
+		current[m.DBInstanceIdentifier] = m
+	}
+
+	desired := c.DesiredInstances()
+	for _, instance := range desired {
+		member, ok := current[instance.DBInstanceIdentifier]
+		switch {
+		case !ok:
+			create = append(create, instance)
+		case member.effectiveInstanceClass() != instance.InstanceClass:
+			modify = append(modify, instance)
+		}
+	}
+
+	for _, m := range members {
+		n := c.instanceNumber(m.DBInstanceIdentifier)
+		if n > len(desired) && !m.IsWriter {
+			remove = append(remove, m.DBInstanceIdentifier)
+		}
+	}
+	sort.Strings(remove)
+
+	return create, modify, remove
+}
+
+// InstancesReady reports whether every desired instance is an available member
+func (c *DBCluster) InstancesReady() bool {
+	available := make(map[string]bool, len(c.Members))
+	for _, m := range c.Members {
+		available[m.DBInstanceIdentifier] = m.Status == StatusAvailable
+	}
+	for _, instance := range c.DesiredInstances() {
+		if !available[instance.DBInstanceIdentifier] {
+			return false
+		}
+	}
+	return true
+}
+
+// WriterInstance returns the identifier of the current writer instance
+func (c *DBCluster) WriterInstance() string {
+	for _, m := range c.Members {
+		if m.IsWriter {
+			return m.DBInstanceIdentifier
+		}
+	}
+	return ""
+}
+
+// ClusterModification is a ModifyDBCluster request; only the set fields are changed
+type ClusterModification struct {
+	EngineVersion               string
+	AllowMajorVersionUpgrade    bool
+	BackupRetentionPeriod       *int32
+	PreferredBackupWindow       string
+	PreferredMaintenanceWindow  string
+	DBClusterParameterGroupName string
+	VpcSecurityGroupIDs         []string
+	DeletionProtection          *bool
+	ServerlessV2Scaling         *ServerlessV2Scaling
+	ApplyImmediately            bool
+
+	// Fields lists the spec fields that changed, for events and logs
+	Fields []string
+}
+
+// Modifications compares the desired cluster with current, as returned by
+// AWS, and returns the modification that converges them, or nil when nothing
+// changed. As for instances, pending values are not requested again and the
+// engine version is only upgraded.
+func (c *DBCluster) Modifications(current *DBCluster) (*ClusterModification, error) {
+	effective := *current
+	if p := current.PendingModifications; p != nil {
+		if p.EngineVersion != "" {
+			effective.EngineVersion = p.EngineVersion
+		}
+		if p.BackupRetentionPeriod != nil {
+			effective.BackupRetentionPeriod = *p.BackupRetentionPeriod
+		}
+	}
+	m := &ClusterModification{ApplyImmediately: c.ApplyImmediately}
+
+	// Secondaries of a global database are upgraded through the global database
+	if c.EngineVersion != "" && current.GlobalClusterRole != GlobalClusterRoleSecondary &&
+		!versionMatches(c.EngineVersion, effective.EngineVersion) &&
+		compareVersions(c.EngineVersion, effective.EngineVersion) > 0 {
+		if majorVersion(c.Engine, c.EngineVersion) != majorVersion(c.Engine, effective.EngineVersion) {
+			if !c.AllowMajorVersionUpgrade {
+				return nil, ErrMajorVersionUpgrade
+			}
+			m.AllowMajorVersionUpgrade = true
+		}
+		m.EngineVersion = c.EngineVersion
+		m.Fields = append(m.Fields, "engineVersion")
+	}
+
+	if c.BackupRetentionPeriod != effective.BackupRetentionPeriod {
+		m.BackupRetentionPeriod = &c.BackupRetentionPeriod
+		m.Fields = append(m.Fields, "backupRetentionPeriod")
+	}
+	if c.PreferredBackupWindow != "" && c.PreferredBackupWindow != effective.PreferredBackupWindow {
+		m.PreferredBackupWindow = c.PreferredBackupWindow
+		m.Fields = append(m.Fields, "preferredBackupWindow")
+	}
+	// AWS returns the maintenance window in lower case
+	if c.PreferredMaintenanceWindow != "" && !strings.EqualFold(c.PreferredMaintenanceWindow, effective.PreferredMaintenanceWindow) {
+		m.PreferredMaintenanceWindow = c.PreferredMaintenanceWindow
+		m.Fields = append(m.Fields, "preferredMaintenanceWindow")
+	}
+	if c.DBClusterParameterGroupName != "" && c.DBClusterParameterGroupName != effective.DBClusterParameterGroupName {
+		m.DBClusterParameterGroupName = c.DBClusterParameterGroupName
+		m.Fields = append(m.Fields, "dbClusterParameterGroupName")
+	}
+	if len(c.VpcSecurityGroupIDs) > 0 && !sameStrings(c.VpcSecurityGroupIDs, effective.VpcSecurityGroupIDs) {
+		m.VpcSecurityGroupIDs = c.VpcSecurityGroupIDs
+		m.Fields = append(m.Fields, "vpcSecurityGroupIDs")
+	}
+	if c.DeletionProtection != effective.DeletionProtection {
+		m.DeletionProtection = &c.DeletionProtection
+		m.Fields = append(m.Fields, "deletionProtection")
+	}
+	if c.ServerlessV2Scaling != nil &&
+		(effective.ServerlessV2Scaling == nil || *c.ServerlessV2Scaling != *effective.ServerlessV2Scaling) {
+		m.ServerlessV2Scaling = c.ServerlessV2Scaling
+		m.Fields = append(m.Fields, "serverlessV2Scaling")
+	}
+
+	if len(m.Fields) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
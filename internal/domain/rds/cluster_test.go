@@ -0,0 +1,155 @@
+package rds_test
+
+import (
+	"reflect"
+	"testing"
+
+	"infra-operator/internal/domain/rds"
+)
+
+func validCluster() *rds.DBCluster {
+	c := &rds.DBCluster{
+		DBClusterIdentifier: "app",
+		Engine:              rds.EngineAuroraPostgreSQL,
+		Writer:              rds.ClusterInstanceSet{InstanceClass: "db.r6g.large"},
+		Readers:             rds.ClusterInstanceSet{Count: 2},
+	}
+	c.SetDefaults()
+	return c
+}
+
+func TestDBCluster_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(c *rds.DBCluster)
+		wantErr error
+	}{
+		{"valid", func(c *rds.DBCluster) {}, nil},
+		{"no identifier", func(c *rds.DBCluster) { c.DBClusterIdentifier = "" }, rds.ErrInvalidClusterIdentifier},
+		{"non aurora engine", func(c *rds.DBCluster) { c.Engine = "postgres" }, rds.ErrInvalidClusterEngine},
+		{"no writer class", func(c *rds.DBCluster) { c.Writer.InstanceClass = "" }, rds.ErrInvalidWriterClass},
+		{"too many readers", func(c *rds.DBCluster) { c.Readers.Count = 16 }, rds.ErrInvalidReaderCount},
+		{"serverless without scaling", func(c *rds.DBCluster) { c.Writer.InstanceClass = rds.InstanceClassServerless }, rds.ErrServerlessScalingMissing},
+		{"serverless", func(c *rds.DBCluster) {
+			c.Writer.InstanceClass = rds.InstanceClassServerless
+			c.ServerlessV2Scaling = &rds.ServerlessV2Scaling{MinCapacity: 0.5, MaxCapacity: 16}
+		}, nil},
+		{"min above max", func(c *rds.DBCluster) {
+			c.ServerlessV2Scaling = &rds.ServerlessV2Scaling{MinCapacity: 8, MaxCapacity: 4}
+		}, rds.ErrInvalidServerlessScaling},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validCluster()
+			tt.mutate(c)
+			if err := c.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDBCluster_DesiredInstances(t *testing.T) {
+	c := validCluster()
+	c.Readers.InstanceClass = "db.r6g.xlarge"
+
+	instances := c.DesiredInstances()
+	if len(instances) != 3 {
+		t.Fatalf("expected writer and 2 readers, got %d instances", len(instances))
+	}
+	if instances[0].DBInstanceIdentifier != "app-1" || instances[0].InstanceClass != "db.r6g.large" || instances[0].PromotionTier != 0 {
+		t.Errorf("unexpected writer instance: %+v", instances[0])
+	}
+	if instances[2].DBInstanceIdentifier != "app-3" || instances[2].InstanceClass != "db.r6g.xlarge" || instances[2].PromotionTier != 1 {
+		t.Errorf("unexpected reader instance: %+v", instances[2])
+	}
+}
+
+func TestDBCluster_InstanceChanges(t *testing.T) {
+	c := validCluster()
+	c.Readers.Count = 1
+
+	members := []rds.ClusterMember{
+		{DBInstanceIdentifier: "app-1", InstanceClass: "db.r6g.large", IsWriter: true},
+		{DBInstanceIdentifier: "app-3", InstanceClass: "db.r6g.large"},
+		{DBInstanceIdentifier: "app-4", InstanceClass: "db.r6g.large"},
+		{DBInstanceIdentifier: "app-reporting", InstanceClass: "db.r6g.large"},
+	}
+	create, modify, remove := c.InstanceChanges(members)
+	if len(create) != 1 || create[0].DBInstanceIdentifier != "app-2" {
+		t.Errorf("expected app-2 to be created, got %+v", create)
+	}
+	if len(modify) != 0 {
+		t.Errorf("expected no modification, got %+v", modify)
+	}
+	if !reflect.DeepEqual(remove, []string{"app-3", "app-4"}) {
+		t.Errorf("expected app-3 and app-4 to be removed, got %v", remove)
+	}
+
+	// The writer is kept after a failover, and a changed class is modified
+	c.Writer.InstanceClass = "db.r6g.xlarge"
+	members[1].IsWriter, members[0].IsWriter = true, false
+	_, modify, remove = c.InstanceChanges(members)
+	if len(modify) != 1 || modify[0].DBInstanceIdentifier != "app-1" {
+		t.Errorf("expected app-1 class to be modified, got %+v", modify)
+	}
+	if !reflect.DeepEqual(remove, []string{"app-4"}) {
+		t.Errorf("expected only app-4 to be removed, got %v", remove)
+	}
+
+	// A class change waiting for the maintenance window is not requested again
+	members[0].PendingInstanceClass = "db.r6g.xlarge"
+	if _, modify, _ = c.InstanceChanges(members); len(modify) != 0 {
+		t.Errorf("expected the pending class change not to be modified again, got %+v", modify)
+	}
+
+	// A pending class that differs from the spec is replaced
+	members[0].PendingInstanceClass = "db.r6g.2xlarge"
+	if _, modify, _ = c.InstanceChanges(members); len(modify) != 1 || modify[0].InstanceClass != "db.r6g.xlarge" {
+		t.Errorf("expected app-1 class to be modified, got %+v", modify)
+	}
+}
+
+func TestDBCluster_Modifications(t *testing.T) {
+	desired := validCluster()
+	desired.EngineVersion = "16.2"
+
+	current := validCluster()
+	current.EngineVersion = "15.4"
+
+	if _, err := desired.Modifications(current); err != rds.ErrMajorVersionUpgrade {
+		t.Errorf("expected ErrMajorVersionUpgrade, got %v", err)
+	}
+
+	desired.AllowMajorVersionUpgrade = true
+	m, err := desired.Modifications(current)
+	if err != nil || m == nil || m.EngineVersion != "16.2" || !m.AllowMajorVersionUpgrade {
+		t.Errorf("expected a major version upgrade, got %+v (%v)", m, err)
+	}
+
+	// Secondaries follow the engine version of the global database
+	current.GlobalClusterRole = rds.GlobalClusterRoleSecondary
+	if m, err := desired.Modifications(current); err != nil || m != nil {
+		t.Errorf("expected no modification on a secondary, got %+v (%v)", m, err)
+	}
+
+	desired.EngineVersion = ""
+	desired.ServerlessV2Scaling = &rds.ServerlessV2Scaling{MinCapacity: 0.5, MaxCapacity: 8}
+	m, err = desired.Modifications(current)
+	if err != nil || m == nil || !reflect.DeepEqual(m.Fields, []string{"serverlessV2Scaling"}) {
+		t.Errorf("expected a scaling modification, got %+v (%v)", m, err)
+	}
+}
+
+func TestGlobalCluster_Role(t *testing.T) {
+	g := &rds.GlobalCluster{Members: []rds.GlobalClusterMember{
+		{DBClusterArn: "arn:primary", IsWriter: true},
+		{DBClusterArn: "arn:secondary"},
+	}}
+	if g.Role("arn:primary") != rds.GlobalClusterRolePrimary || g.Role("arn:secondary") != rds.GlobalClusterRoleSecondary || g.Role("arn:other") != "" {
+		t.Error("unexpected global cluster roles")
+	}
+	if !g.HasOtherMembers("arn:primary") {
+		t.Error("expected the secondary to be another member")
+	}
+}
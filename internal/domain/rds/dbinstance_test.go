@@ -8,3 +8,147 @@ func TestDBInstance_Validate(t *testing.T) {
 	for _, tt := range tests {t.Run(tt.name, func(t *testing.T) {if err := tt.db.Validate(); err != tt.wantErr {t.Errorf("got %v, want %v", err, tt.wantErr)}})}
 }
 func TestDBInstance_SetDefaults(t *testing.T) {db := &rds.DBInstance{Engine: "postgres"}; db.SetDefaults(); if db.DeletionPolicy != "Delete" {t.Error("failed")}}
+func TestDBInstance_Validate_Iops(t *testing.T) {
+	db := &rds.DBInstance{DBInstanceIdentifier: "test", Engine: "postgres", DBInstanceClass: "db.t3.micro", AllocatedStorage: 100, MasterUsername: "admin", MasterPassword: "password", StorageType: "io1"}
+	if err := db.Validate(); err != rds.ErrInvalidIops {
+		t.Errorf("got %v, want %v", err, rds.ErrInvalidIops)
+	}
+}
+func TestDBInstance_Modifications(t *testing.T) {
+	current := &rds.DBInstance{Engine: "postgres", EngineVersion: "15.4", DBInstanceClass: "db.t3.micro", AllocatedStorage: 20, StorageType: "gp2", BackupRetentionPeriod: 7, PreferredMaintenanceWindow: "sun:05:00-sun:06:00", DBParameterGroupName: "default.postgres15"}
+	desired := func() *rds.DBInstance {
+		return &rds.DBInstance{Engine: "postgres", EngineVersion: "15", DBInstanceClass: "db.t3.micro", AllocatedStorage: 20, BackupRetentionPeriod: 7, PreferredMaintenanceWindow: "Sun:05:00-Sun:06:00"}
+	}
+
+	if m, err := desired().Modifications(current); err != nil || m != nil {
+		t.Fatalf("expected no modification, got %+v, %v", m, err)
+	}
+
+	db := desired()
+	db.DBInstanceClass = "db.t3.large"
+	db.StorageType = "gp3"
+	db.DBParameterGroupName = "app-postgres15"
+	db.ApplyImmediately = true
+	m, err := db.Modifications(current)
+	if err != nil || m == nil {
+		t.Fatalf("expected modification, got %v", err)
+	}
+	if m.DBInstanceClass != "db.t3.large" || m.StorageType != "gp3" || m.AllocatedStorage != 20 || m.DBParameterGroupName != "app-postgres15" || !m.ApplyImmediately {
+		t.Errorf("unexpected modification %+v", m)
+	}
+	if len(m.Fields) != 3 {
+		t.Errorf("expected 3 changed fields, got %v", m.Fields)
+	}
+
+	// Storage never shrinks
+	db = desired()
+	db.AllocatedStorage = 10
+	if m, _ := db.Modifications(current); m != nil {
+		t.Errorf("expected storage decrease to be ignored, got %v", m.Fields)
+	}
+}
+func TestDBInstance_Modifications_Pending(t *testing.T) {
+	current := &rds.DBInstance{Engine: "mysql", EngineVersion: "8.0.35", DBInstanceClass: "db.t3.micro", AllocatedStorage: 20, BackupRetentionPeriod: 7,
+		PendingModifications: &rds.PendingModifications{DBInstanceClass: "db.t3.large"}}
+	db := &rds.DBInstance{Engine: "mysql", EngineVersion: "8.0.35", DBInstanceClass: "db.t3.large", AllocatedStorage: 20, BackupRetentionPeriod: 7}
+	if m, err := db.Modifications(current); err != nil || m != nil {
+		t.Errorf("expected pending class not to be requested again, got %+v, %v", m, err)
+	}
+	if current.PendingModifications.IsEmpty() || !(&rds.PendingModifications{}).IsEmpty() {
+		t.Error("IsEmpty failed")
+	}
+}
+func TestDBInstance_Modifications_EngineVersion(t *testing.T) {
+	current := &rds.DBInstance{Engine: "postgres", EngineVersion: "15.4", BackupRetentionPeriod: 7}
+	tests := []struct {
+		name      string
+		version   string
+		allow     bool
+		want      string
+		wantMajor bool
+		wantErr   error
+	}{
+		{"same", "15.4", false, "", false, nil},
+		{"major only", "15", false, "", false, nil},
+		{"downgrade", "15.2", false, "", false, nil},
+		{"minor upgrade", "15.5", false, "15.5", false, nil},
+		{"major without allow", "16.1", false, "", false, rds.ErrMajorVersionUpgrade},
+		{"major upgrade", "16.1", true, "16.1", true, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &rds.DBInstance{Engine: "postgres", EngineVersion: tt.version, AllowMajorVersionUpgrade: tt.allow, BackupRetentionPeriod: 7}
+			m, err := db.Modifications(current)
+			if err != tt.wantErr {
+				t.Fatalf("got %v, want %v", err, tt.wantErr)
+			}
+			got, major := "", false
+			if m != nil {
+				got, major = m.EngineVersion, m.AllowMajorVersionUpgrade
+			}
+			if got != tt.want || major != tt.wantMajor {
+				t.Errorf("got %q (major %v), want %q (major %v)", got, major, tt.want, tt.wantMajor)
+			}
+		})
+	}
+}
+func TestDBInstance_Validate_Sources(t *testing.T) {
+	restore := &rds.DBInstance{DBInstanceIdentifier: "staging", Engine: "postgres", DBInstanceClass: "db.t3.micro", AllocatedStorage: 20, RestoreFrom: &rds.RestoreSource{DBSnapshotIdentifier: "prod-nightly"}}
+	if err := restore.Validate(); err != nil {
+		t.Errorf("restored instance without credentials: got %v", err)
+	}
+	restore.RestoreFrom.SourceDBInstanceIdentifier = "prod"
+	if err := restore.Validate(); err != rds.ErrInvalidRestoreSource {
+		t.Errorf("got %v, want %v", err, rds.ErrInvalidRestoreSource)
+	}
+	restore.RestoreFrom = &rds.RestoreSource{DBSnapshotIdentifier: "prod-nightly", UseLatestRestorableTime: true}
+	if err := restore.Validate(); err != rds.ErrInvalidRestoreTime {
+		t.Errorf("got %v, want %v", err, rds.ErrInvalidRestoreTime)
+	}
+	replica := &rds.DBInstance{DBInstanceIdentifier: "prod-ro", Engine: "postgres", DBInstanceClass: "db.t3.micro", AllocatedStorage: 20, ReplicateFrom: "prod", RestoreFrom: &rds.RestoreSource{DBSnapshotIdentifier: "prod-nightly"}}
+	if err := replica.Validate(); err != rds.ErrRestoreAndReplica {
+		t.Errorf("got %v, want %v", err, rds.ErrRestoreAndReplica)
+	}
+}
+func TestDBInstance_NeedsPromotion(t *testing.T) {
+	current := &rds.DBInstance{ReadReplicaSource: "prod"}
+	if (&rds.DBInstance{ReplicateFrom: "prod", ReadReplicaSource: "prod"}).NeedsPromotion(current) {
+		t.Error("expected no promotion while replicateFrom is set")
+	}
+	if !(&rds.DBInstance{ReadReplicaSource: "prod"}).NeedsPromotion(current) {
+		t.Error("expected promotion when replicateFrom is removed")
+	}
+	if (&rds.DBInstance{}).NeedsPromotion(&rds.DBInstance{}) {
+		t.Error("expected no promotion of a standalone instance")
+	}
+}
+func TestDBInstance_NeedsPromotion_AdoptedReplica(t *testing.T) {
+	// A replica created outside the operator, bound without replicateFrom
+	adopted := &rds.DBInstance{DBInstanceIdentifier: "reporting", DBInstanceArn: "arn:aws:rds:us-east-1:123456789012:db:reporting"}
+	current := &rds.DBInstance{ReadReplicaSource: "prod"}
+	if adopted.NeedsPromotion(current) {
+		t.Error("expected no promotion of an adopted replica")
+	}
+	adopted.RecordReplicaSource(current)
+	if adopted.ReadReplicaSource != "" || adopted.NeedsPromotion(current) {
+		t.Error("expected the source of an adopted replica not to be recorded")
+	}
+
+	managed := &rds.DBInstance{ReplicateFrom: "prod"}
+	managed.RecordReplicaSource(current)
+	managed.ReplicateFrom = ""
+	if !managed.NeedsPromotion(current) {
+		t.Error("expected promotion once replicateFrom is removed from a managed replica")
+	}
+	managed.RecordReplicaSource(&rds.DBInstance{})
+	if managed.ReadReplicaSource != "" {
+		t.Error("expected the source to be cleared once promoted")
+	}
+}
+func TestDBInstance_ShouldDelete(t *testing.T) {
+	for policy, want := range map[string]bool{"Delete": true, "Snapshot": true, "Retain": false, "Orphan": false} {
+		if got := (&rds.DBInstance{DeletionPolicy: policy}).ShouldDelete(); got != want {
+			t.Errorf("ShouldDelete() with %s = %v, want %v", policy, got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package rds_test
+
+import (
+	"reflect"
+	"testing"
+
+	"infra-operator/internal/domain/rds"
+)
+
+func TestDBSubnetGroup_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       *rds.DBSubnetGroup
+		wantErr error
+	}{
+		{"valid", &rds.DBSubnetGroup{Name: "app", SubnetIDs: []string{"subnet-a", "subnet-b"}}, nil},
+		{"no name", &rds.DBSubnetGroup{SubnetIDs: []string{"subnet-a", "subnet-b"}}, rds.ErrInvalidSubnetGroupName},
+		{"one subnet", &rds.DBSubnetGroup{Name: "app", SubnetIDs: []string{"subnet-a"}}, rds.ErrInvalidSubnetGroupSubnets},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.g.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDBSubnetGroup_NeedsUpdate(t *testing.T) {
+	current := &rds.DBSubnetGroup{Description: "app", SubnetIDs: []string{"subnet-b", "subnet-a"}}
+	if (&rds.DBSubnetGroup{Description: "app", SubnetIDs: []string{"subnet-a", "subnet-b"}}).NeedsUpdate(current) {
+		t.Error("expected no update when only the subnet order differs")
+	}
+	if !(&rds.DBSubnetGroup{Description: "app", SubnetIDs: []string{"subnet-a", "subnet-c"}}).NeedsUpdate(current) {
+		t.Error("expected update when subnets differ")
+	}
+}
+
+func TestDBParameterGroup_Validate(t *testing.T) {
+	if err := (&rds.DBParameterGroup{Name: "app"}).Validate(); err != rds.ErrInvalidParameterGroupFamily {
+		t.Errorf("got %v, want %v", err, rds.ErrInvalidParameterGroupFamily)
+	}
+	g := &rds.DBParameterGroup{Name: "app", Family: "postgres15"}
+	g.SetDefaults()
+	if err := g.Validate(); err != nil || g.Description == "" || !g.ShouldDelete() {
+		t.Errorf("SetDefaults() failed: %v", err)
+	}
+}
+
+func TestDBParameterGroup_ParameterChanges(t *testing.T) {
+	g := &rds.DBParameterGroup{Parameters: map[string]string{"max_connections": "200", "log_min_duration_statement": "500"}}
+	modify, reset := g.ParameterChanges(map[string]string{"max_connections": "100", "log_min_duration_statement": "500", "work_mem": "8192", "shared_buffers": "1024"})
+
+	if !reflect.DeepEqual(modify, map[string]string{"max_connections": "200"}) {
+		t.Errorf("modify = %v", modify)
+	}
+	if !reflect.DeepEqual(reset, []string{"shared_buffers", "work_mem"}) {
+		t.Errorf("reset = %v", reset)
+	}
+}
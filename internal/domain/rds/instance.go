@@ -12,6 +12,20 @@ var (
 	ErrInvalidStorage       = errors.New("allocated storage must be at least 20 GB")
 	ErrInvalidMasterUser    = errors.New("master username cannot be empty")
 	ErrInvalidPassword      = errors.New("master password must be provided")
+	ErrInvalidIops          = errors.New("iops must be set for io1 and io2 storage")
+	ErrMajorVersionUpgrade  = errors.New("engine major version upgrade requires allowMajorVersionUpgrade")
+	ErrDeletionProtected    = errors.New("deletion protection is enabled on the DB instance")
+	ErrRestoreAndReplica    = errors.New("restoreFrom and replicateFrom are mutually exclusive")
+	ErrInvalidRestoreSource = errors.New("restoreFrom needs exactly one of a snapshot or a source DB instance")
+	ErrInvalidRestoreTime   = errors.New("restoreTime and useLatestRestorableTime only apply to, and are exclusive for, a point-in-time restore")
+)
+
+const (
+	StatusAvailable = "available"
+
+	// ParameterApplyPendingReboot is reported when static parameters of the
+	// parameter group only take effect after a reboot
+	ParameterApplyPendingReboot = "pending-reboot"
 )
 
 // DBInstance represents an RDS database instance in the domain model
@@ -25,8 +39,11 @@ type DBInstance struct {
 	EngineVersion string
 
 	// Compute and storage
-	DBInstanceClass  string
-	AllocatedStorage int32
+	DBInstanceClass   string
+	AllocatedStorage  int32
+	StorageType       string
+	Iops              int32
+	StorageThroughput int32
 
 	// Authentication
 	MasterUsername string
@@ -37,17 +54,55 @@ type DBInstance struct {
 	Port   int32
 
 	// Network and access
-	Endpoint           string
-	MultiAZ            bool
-	PubliclyAccessible bool
+	Endpoint            string
+	MultiAZ             bool
+	PubliclyAccessible  bool
+	DBSubnetGroupName   string
+	VpcSecurityGroupIDs []string
 
 	// Security
-	StorageEncrypted bool
+	StorageEncrypted   bool
+	DeletionProtection bool
+
+	// Parameter and option groups
+	DBParameterGroupName string
+	ParameterApplyStatus string
+	OptionGroupName      string
 
 	// Backup
 	BackupRetentionPeriod int32
 	PreferredBackupWindow string
 
+	// Maintenance
+	PreferredMaintenanceWindow string
+	AllowMajorVersionUpgrade   bool
+	AutoMinorVersionUpgrade    *bool
+
+	// ApplyImmediately applies modifications now instead of during the next
+	// maintenance window
+	ApplyImmediately bool
+
+	// PendingModifications are the modifications AWS will apply during the
+	// next maintenance window (or is applying now)
+	PendingModifications *PendingModifications
+
+	// RestoreFrom creates the instance from a snapshot or a point in time
+	// instead of an empty database
+	RestoreFrom *RestoreSource
+
+	// ReplicateFrom is the identifier (or ARN, for cross-region replicas) of
+	// the instance this one replicates. Clearing it promotes the replica.
+	ReplicateFrom string
+
+	// ReadReplicaSource is the source instance AWS reports for a replica
+	// managed through ReplicateFrom. It is not recorded for replicas created
+	// outside the operator, so those are never promoted.
+	ReadReplicaSource string
+
+	// MasterPasswordPending means the master password still has to be set on a
+	// restored instance, which starts with the credentials of its source
+	MasterPasswordPending bool
+
 	// Deletion
 	SkipFinalSnapshot bool
 	DeletionPolicy    string
@@ -60,6 +115,35 @@ type DBInstance struct {
 	LastSyncTime *time.Time
 }
 
+// RestoreSource is where a new DB instance is restored from: a snapshot, or a
+// point in time of a source instance
+type RestoreSource struct {
+	DBSnapshotIdentifier       string
+	SourceDBInstanceIdentifier string
+	RestoreTime                *time.Time
+	UseLatestRestorableTime    bool
+}
+
+// IsPointInTime reports whether the source is a point-in-time restore
+func (s *RestoreSource) IsPointInTime() bool {
+	return s.SourceDBInstanceIdentifier != ""
+}
+
+// Validate checks that the source names exactly one origin
+func (s *RestoreSource) Validate() error {
+	if (s.DBSnapshotIdentifier == "") == (s.SourceDBInstanceIdentifier == "") {
+		return ErrInvalidRestoreSource
+	}
+	if s.IsPointInTime() {
+		if s.RestoreTime != nil && s.UseLatestRestorableTime {
+			return ErrInvalidRestoreTime
+		}
+	} else if s.RestoreTime != nil || s.UseLatestRestorableTime {
+		return ErrInvalidRestoreTime
+	}
+	return nil
+}
+
 // Validate checks if the DB instance configuration is valid
 func (db *DBInstance) Validate() error {
 	if db.DBInstanceIdentifier == "" {
@@ -78,12 +162,25 @@ func (db *DBInstance) Validate() error {
 		return ErrInvalidStorage
 	}
 
-	if db.MasterUsername == "" {
-		return ErrInvalidMasterUser
+	if db.RestoreFrom != nil && db.ReplicateFrom != "" {
+		return ErrRestoreAndReplica
+	}
+	if db.RestoreFrom != nil {
+		if err := db.RestoreFrom.Validate(); err != nil {
+			return err
+		}
 	}
 
-	if db.MasterPassword == "" {
-		return ErrInvalidPassword
+	// Restored instances and replicas take the credentials of their source, and
+	// a promoted replica keeps them
+	if db.CreatesEmptyDatabase() {
+		if db.MasterUsername == "" {
+			return ErrInvalidMasterUser
+		}
+
+		if db.MasterPassword == "" {
+			return ErrInvalidPassword
+		}
 	}
 
 	// Validate backup retention period (0-35 days)
@@ -91,12 +188,50 @@ func (db *DBInstance) Validate() error {
 		return errors.New("backup retention period must be between 0 and 35 days")
 	}
 
+	if (db.StorageType == "io1" || db.StorageType == "io2") && db.Iops == 0 {
+		return ErrInvalidIops
+	}
+
 	return nil
 }
 
+// CreatesEmptyDatabase reports whether the instance is created empty, rather
+// than restored or replicated from another instance. Only an instance that was
+// not created yet can be one.
+func (db *DBInstance) CreatesEmptyDatabase() bool {
+	return db.RestoreFrom == nil && db.ReplicateFrom == "" && db.DBInstanceArn == ""
+}
+
+// IsReadReplica reports whether AWS reports the instance as a read replica
+func (db *DBInstance) IsReadReplica() bool {
+	return db.ReadReplicaSource != ""
+}
+
+// NeedsPromotion reports whether ReplicateFrom was removed from a replica the
+// operator manages: the source recorded from an earlier ReplicateFrom is set
+// and current is still a replica. Replicas adopted or created outside the
+// operator are never promoted, as promotion cannot be undone.
+func (db *DBInstance) NeedsPromotion(current *DBInstance) bool {
+	return db.ReplicateFrom == "" && db.ReadReplicaSource != "" && current.IsReadReplica()
+}
+
+// RecordReplicaSource records the source AWS reports for current while the
+// replica is managed through ReplicateFrom or waits for its promotion
+func (db *DBInstance) RecordReplicaSource(current *DBInstance) {
+	if db.ReplicateFrom != "" || db.ReadReplicaSource != "" {
+		db.ReadReplicaSource = current.ReadReplicaSource
+	}
+}
+
 // IsAvailable checks if the DB instance is available
 func (db *DBInstance) IsAvailable() bool {
-	return db.Status == "available"
+	return db.Status == StatusAvailable
+}
+
+// ShouldDelete reports whether the instance is deleted with the resource.
+// Delete and Snapshot both delete it, Retain and Orphan keep it in AWS.
+func (db *DBInstance) ShouldDelete() bool {
+	return db.DeletionPolicy != "Retain" && db.DeletionPolicy != "Orphan"
 }
 
 // SetDefaults sets default values for optional fields
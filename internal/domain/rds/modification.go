@@ -0,0 +1,258 @@
+package rds
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PendingModifications are the modified values AWS accepted but has not
+// applied yet, usually because they wait for the maintenance window
+type PendingModifications struct {
+	DBInstanceClass       string
+	AllocatedStorage      int32
+	StorageType           string
+	Iops                  int32
+	StorageThroughput     int32
+	EngineVersion         string
+	MultiAZ               *bool
+	BackupRetentionPeriod *int32
+	DBSubnetGroupName     string
+}
+
+// IsEmpty reports whether no modification is pending
+func (p *PendingModifications) IsEmpty() bool {
+	return p == nil || *p == PendingModifications{}
+}
+
+// Modification is a ModifyDBInstance request; only the set fields are changed
+type Modification struct {
+	DBInstanceClass            string
+	AllocatedStorage           int32
+	StorageType                string
+	Iops                       int32
+	StorageThroughput          int32
+	EngineVersion              string
+	AllowMajorVersionUpgrade   bool
+	MultiAZ                    *bool
+	PubliclyAccessible         *bool
+	BackupRetentionPeriod      *int32
+	PreferredBackupWindow      string
+	PreferredMaintenanceWindow string
+	DBParameterGroupName       string
+	OptionGroupName            string
+	DBSubnetGroupName          string
+	VpcSecurityGroupIDs        []string
+	DeletionProtection         *bool
+	AutoMinorVersionUpgrade    *bool
+	MasterUserPassword         string
+	ApplyImmediately           bool
+
+	// Fields lists the spec fields that changed, for events and logs
+	Fields []string
+}
+
+// Modifications compares the desired instance with current, as returned by
+// AWS, and returns the modification that converges them, or nil when nothing
+// changed. Values already pending on current are not requested again, so a
+// modification deferred to the maintenance window is only issued once.
+func (db *DBInstance) Modifications(current *DBInstance) (*Modification, error) {
+	effective := current.withPending()
+	m := &Modification{ApplyImmediately: db.ApplyImmediately}
+
+	if db.DBInstanceClass != "" && db.DBInstanceClass != effective.DBInstanceClass {
+		m.DBInstanceClass = db.DBInstanceClass
+		m.Fields = append(m.Fields, "dbInstanceClass")
+	}
+
+	// Storage never shrinks: storage autoscaling may have grown it past the spec
+	storageChanged := false
+	if db.AllocatedStorage > effective.AllocatedStorage {
+		storageChanged = true
+		m.Fields = append(m.Fields, "allocatedStorage")
+	}
+	if db.StorageType != "" && db.StorageType != effective.StorageType {
+		storageChanged = true
+		m.StorageType = db.StorageType
+		m.Fields = append(m.Fields, "storageType")
+	}
+	if db.Iops != 0 && db.Iops != effective.Iops {
+		storageChanged = true
+		m.Iops = db.Iops
+		m.Fields = append(m.Fields, "iops")
+	}
+	if db.StorageThroughput != 0 && db.StorageThroughput != effective.StorageThroughput {
+		storageChanged = true
+		m.StorageThroughput = db.StorageThroughput
+		m.Fields = append(m.Fields, "storageThroughput")
+	}
+	if storageChanged {
+		// AWS expects the allocated storage with every storage modification
+		m.AllocatedStorage = max(db.AllocatedStorage, effective.AllocatedStorage)
+	}
+
+	// Only upgrades are requested: RDS cannot downgrade, and automatic minor
+	// upgrades may have moved the instance past the spec
+	if db.EngineVersion != "" && !versionMatches(db.EngineVersion, effective.EngineVersion) &&
+		compareVersions(db.EngineVersion, effective.EngineVersion) > 0 {
+		if majorVersion(db.Engine, db.EngineVersion) != majorVersion(db.Engine, effective.EngineVersion) {
+			if !db.AllowMajorVersionUpgrade {
+				return nil, ErrMajorVersionUpgrade
+			}
+			m.AllowMajorVersionUpgrade = true
+		}
+		m.EngineVersion = db.EngineVersion
+		m.Fields = append(m.Fields, "engineVersion")
+	}
+
+	if db.MultiAZ != effective.MultiAZ {
+		m.MultiAZ = &db.MultiAZ
+		m.Fields = append(m.Fields, "multiAZ")
+	}
+	if db.PubliclyAccessible != effective.PubliclyAccessible {
+		m.PubliclyAccessible = &db.PubliclyAccessible
+		m.Fields = append(m.Fields, "publiclyAccessible")
+	}
+	// Not every engine supports backups on read replicas; they are set on promotion
+	if !current.IsReadReplica() {
+		if db.BackupRetentionPeriod != effective.BackupRetentionPeriod {
+			m.BackupRetentionPeriod = &db.BackupRetentionPeriod
+			m.Fields = append(m.Fields, "backupRetentionPeriod")
+		}
+		if db.PreferredBackupWindow != "" && db.PreferredBackupWindow != effective.PreferredBackupWindow {
+			m.PreferredBackupWindow = db.PreferredBackupWindow
+			m.Fields = append(m.Fields, "preferredBackupWindow")
+		}
+	}
+	// AWS returns the maintenance window in lower case
+	if db.PreferredMaintenanceWindow != "" && !strings.EqualFold(db.PreferredMaintenanceWindow, effective.PreferredMaintenanceWindow) {
+		m.PreferredMaintenanceWindow = db.PreferredMaintenanceWindow
+		m.Fields = append(m.Fields, "preferredMaintenanceWindow")
+	}
+	if db.DBParameterGroupName != "" && db.DBParameterGroupName != effective.DBParameterGroupName {
+		m.DBParameterGroupName = db.DBParameterGroupName
+		m.Fields = append(m.Fields, "dbParameterGroupName")
+	}
+	if db.OptionGroupName != "" && db.OptionGroupName != effective.OptionGroupName {
+		m.OptionGroupName = db.OptionGroupName
+		m.Fields = append(m.Fields, "optionGroupName")
+	}
+	if db.DBSubnetGroupName != "" && db.DBSubnetGroupName != effective.DBSubnetGroupName {
+		m.DBSubnetGroupName = db.DBSubnetGroupName
+		m.Fields = append(m.Fields, "dbSubnetGroupName")
+	}
+	if len(db.VpcSecurityGroupIDs) > 0 && !sameStrings(db.VpcSecurityGroupIDs, effective.VpcSecurityGroupIDs) {
+		m.VpcSecurityGroupIDs = db.VpcSecurityGroupIDs
+		m.Fields = append(m.Fields, "vpcSecurityGroupIDs")
+	}
+	if db.DeletionProtection != effective.DeletionProtection {
+		m.DeletionProtection = &db.DeletionProtection
+		m.Fields = append(m.Fields, "deletionProtection")
+	}
+	if db.AutoMinorVersionUpgrade != nil &&
+		(effective.AutoMinorVersionUpgrade == nil || *db.AutoMinorVersionUpgrade != *effective.AutoMinorVersionUpgrade) {
+		m.AutoMinorVersionUpgrade = db.AutoMinorVersionUpgrade
+		m.Fields = append(m.Fields, "autoMinorVersionUpgrade")
+	}
+
+	if len(m.Fields) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// withPending returns a copy of the instance with its pending modifications applied
+func (db *DBInstance) withPending() DBInstance {
+	effective := *db
+	p := db.PendingModifications
+	if p == nil {
+		return effective
+	}
+
+	if p.DBInstanceClass != "" {
+		effective.DBInstanceClass = p.DBInstanceClass
+	}
+	if p.AllocatedStorage != 0 {
+		effective.AllocatedStorage = p.AllocatedStorage
+	}
+	if p.StorageType != "" {
+		effective.StorageType = p.StorageType
+	}
+	if p.Iops != 0 {
+		effective.Iops = p.Iops
+	}
+	if p.StorageThroughput != 0 {
+		effective.StorageThroughput = p.StorageThroughput
+	}
+	if p.EngineVersion != "" {
+		effective.EngineVersion = p.EngineVersion
+	}
+	if p.MultiAZ != nil {
+		effective.MultiAZ = *p.MultiAZ
+	}
+	if p.BackupRetentionPeriod != nil {
+		effective.BackupRetentionPeriod = *p.BackupRetentionPeriod
+	}
+	if p.DBSubnetGroupName != "" {
+		effective.DBSubnetGroupName = p.DBSubnetGroupName
+	}
+	return effective
+}
+
+// versionMatches reports whether current is the desired version, or a minor
+// release of it when desired only names the major version (e.g. 15 and 15.4)
+func versionMatches(desired, current string) bool {
+	return desired == current || strings.HasPrefix(current, desired+".")
+}
+
+// compareVersions compares dotted versions segment by segment, numerically when
+// both segments are numbers
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil && an != bn:
+			if an < bn {
+				return -1
+			}
+			return 1
+		case (aErr != nil || bErr != nil) && as[i] != bs[i]:
+			return strings.Compare(as[i], bs[i])
+		}
+	}
+	return len(as) - len(bs)
+}
+
+// majorVersion returns the major version of an engine version. PostgreSQL 10
+// and later use a single number (15.4 -> 15); the other engines use two (8.0.35 -> 8.0).
+func majorVersion(engine, version string) string {
+	parts := strings.Split(version, ".")
+	if strings.Contains(engine, "postgres") {
+		if n, err := strconv.Atoi(parts[0]); err == nil && n >= 10 {
+			return parts[0]
+		}
+	}
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// sameStrings reports whether a and b hold the same values, in any order
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string(nil), a...)
+	sb := append([]string(nil), b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package rds
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidParameterGroupName   = errors.New("DB parameter group name cannot be empty")
+	ErrInvalidParameterGroupFamily = errors.New("DB parameter group family cannot be empty")
+)
+
+// DBParameterGroup represents an RDS DB parameter group in the domain model
+type DBParameterGroup struct {
+	Name        string
+	Family      string
+	Description string
+
+	// Parameters are the values set on the group; parameters not listed keep
+	// the engine default
+	Parameters map[string]string
+
+	Tags map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	ARN          string
+	LastSyncTime *time.Time
+}
+
+// SetDefaults sets default values for optional fields
+func (g *DBParameterGroup) SetDefaults() {
+	if g.Description == "" {
+		g.Description = "Managed by infra-operator"
+	}
+	if g.DeletionPolicy == "" {
+		g.DeletionPolicy = "Delete"
+	}
+}
+
+// Validate checks if the DB parameter group configuration is valid
+func (g *DBParameterGroup) Validate() error {
+	if g.Name == "" {
+		return ErrInvalidParameterGroupName
+	}
+	if g.Family == "" {
+		return ErrInvalidParameterGroupFamily
+	}
+	return nil
+}
+
+// ParameterChanges compares the desired parameters with the values currently
+// set on the group and returns the parameters to modify and the names, sorted,
+// of the ones to reset to the engine default
+func (g *DBParameterGroup) ParameterChanges(current map[string]string) (modify map[string]string, reset []string) {
+	modify = make(map[string]string)
+	for name, value := range g.Parameters {
+		if currentValue, ok := current[name]; !ok || currentValue != value {
+			modify[name] = value
+		}
+	}
+	for name := range current {
+		if _, ok := g.Parameters[name]; !ok {
+			reset = append(reset, name)
+		}
+	}
+	sort.Strings(reset)
+	return modify, reset
+}
+
+// ShouldDelete reports whether the parameter group is deleted with the resource
+func (g *DBParameterGroup) ShouldDelete() bool {
+	return g.DeletionPolicy == "Delete"
+}
@@ -0,0 +1,206 @@
+package rds
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSnapshotIdentifier = errors.New("DB snapshot identifier must start with a letter and contain only letters, digits and single hyphens")
+	ErrInvalidSnapshotInstance   = errors.New("DB instance identifier of the snapshot cannot be empty")
+	ErrInvalidSnapshotRetention  = errors.New("snapshot retention requires a schedule interval")
+)
+
+const (
+	SnapshotStatusAvailable = "available"
+	SnapshotStatusCreating  = "creating"
+
+	// snapshotTimeFormat is appended to the identifier of scheduled snapshots
+	snapshotTimeFormat = "20060102-1504"
+)
+
+var snapshotIdentifierPattern = regexp.MustCompile(`^[a-zA-Z](-?[a-zA-Z0-9])*$`)
+
+// DBSnapshot is a manual snapshot of a DB instance
+type DBSnapshot struct {
+	Identifier           string
+	DBInstanceIdentifier string
+	ARN                  string
+	Status               string
+	Engine               string
+	EngineVersion        string
+	AllocatedStorage     int32
+	CreateTime           *time.Time
+	Tags                 map[string]string
+}
+
+// IsAvailable checks if the snapshot can be restored
+func (s *DBSnapshot) IsAvailable() bool {
+	return s.Status == SnapshotStatusAvailable
+}
+
+// DBSnapshotPlan describes the manual snapshots of a DB instance managed by one
+// RDSSnapshot: a single on-demand snapshot named Identifier or, when Interval
+// is set, one snapshot every Interval named Identifier-<yyyymmdd-hhmm>
+type DBSnapshotPlan struct {
+	Identifier           string
+	DBInstanceIdentifier string
+
+	// Interval between scheduled snapshots; zero takes a single snapshot
+	Interval time.Duration
+
+	// RetentionCount is the number of scheduled snapshots kept; zero keeps all
+	RetentionCount int32
+
+	// RetentionPeriod is how long scheduled snapshots are kept; zero keeps them forever
+	RetentionPeriod time.Duration
+
+	Tags           map[string]string
+	DeletionPolicy string
+
+	// State: the snapshots of the plan, newest first
+	Snapshots        []*DBSnapshot
+	NextSnapshotTime *time.Time
+	LastSyncTime     *time.Time
+}
+
+// SetDefaults sets default values for optional fields
+func (p *DBSnapshotPlan) SetDefaults() {
+	if p.DeletionPolicy == "" {
+		p.DeletionPolicy = "Delete"
+	}
+}
+
+// Validate checks if the snapshot plan is valid
+func (p *DBSnapshotPlan) Validate() error {
+	if len(p.Identifier) > 255 || !snapshotIdentifierPattern.MatchString(p.Identifier) {
+		return ErrInvalidSnapshotIdentifier
+	}
+	if p.DBInstanceIdentifier == "" {
+		return ErrInvalidSnapshotInstance
+	}
+	if !p.IsScheduled() && (p.RetentionCount != 0 || p.RetentionPeriod != 0) {
+		return ErrInvalidSnapshotRetention
+	}
+	return nil
+}
+
+// IsScheduled reports whether snapshots are taken periodically
+func (p *DBSnapshotPlan) IsScheduled() bool {
+	return p.Interval > 0
+}
+
+// SnapshotIdentifier returns the identifier of a snapshot taken at t
+func (p *DBSnapshotPlan) SnapshotIdentifier(t time.Time) string {
+	if !p.IsScheduled() {
+		return p.Identifier
+	}
+	return p.Identifier + "-" + t.UTC().Format(snapshotTimeFormat)
+}
+
+// Owns reports whether the snapshot identifier belongs to the plan
+func (p *DBSnapshotPlan) Owns(identifier string) bool {
+	if !p.IsScheduled() {
+		return identifier == p.Identifier
+	}
+	suffix, ok := strings.CutPrefix(identifier, p.Identifier+"-")
+	if !ok {
+		return false
+	}
+	_, err := time.Parse(snapshotTimeFormat, suffix)
+	return err == nil
+}
+
+// SetSnapshots keeps the snapshots owned by the plan, newest first. Snapshots
+// still being created have no creation time and sort first.
+func (p *DBSnapshotPlan) SetSnapshots(snapshots []*DBSnapshot) {
+	p.Snapshots = nil
+	for _, s := range snapshots {
+		if p.Owns(s.Identifier) {
+			p.Snapshots = append(p.Snapshots, s)
+		}
+	}
+	sort.SliceStable(p.Snapshots, func(i, j int) bool {
+		a, b := p.Snapshots[i].CreateTime, p.Snapshots[j].CreateTime
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.After(*b)
+	})
+}
+
+// Latest returns the newest snapshot of the plan, or nil
+func (p *DBSnapshotPlan) Latest() *DBSnapshot {
+	if len(p.Snapshots) == 0 {
+		return nil
+	}
+	return p.Snapshots[0]
+}
+
+// LatestAvailable returns the newest snapshot that can be restored, or nil
+func (p *DBSnapshotPlan) LatestAvailable() *DBSnapshot {
+	for _, s := range p.Snapshots {
+		if s.IsAvailable() {
+			return s
+		}
+	}
+	return nil
+}
+
+// IsCreating reports whether a snapshot of the plan is being created
+func (p *DBSnapshotPlan) IsCreating() bool {
+	for _, s := range p.Snapshots {
+		if s.Status == SnapshotStatusCreating {
+			return true
+		}
+	}
+	return false
+}
+
+// SnapshotDue reports whether a new snapshot should be taken at now. An
+// on-demand plan takes its snapshot once; a scheduled plan takes one when the
+// interval has passed since the latest snapshot.
+func (p *DBSnapshotPlan) SnapshotDue(now time.Time) bool {
+	latest := p.Latest()
+	if latest == nil {
+		return true
+	}
+	if !p.IsScheduled() || p.IsCreating() || latest.CreateTime == nil {
+		return false
+	}
+	return !now.Before(latest.CreateTime.Add(p.Interval))
+}
+
+// Expired returns the available snapshots past the retention at now. The
+// newest available snapshot is always kept.
+func (p *DBSnapshotPlan) Expired(now time.Time) []*DBSnapshot {
+	if !p.IsScheduled() {
+		return nil
+	}
+
+	var expired []*DBSnapshot
+	kept := int32(0)
+	for _, s := range p.Snapshots {
+		if !s.IsAvailable() {
+			continue
+		}
+		kept++
+		if kept == 1 {
+			continue
+		}
+		tooMany := p.RetentionCount > 0 && kept > p.RetentionCount
+		tooOld := p.RetentionPeriod > 0 && s.CreateTime != nil && now.Sub(*s.CreateTime) > p.RetentionPeriod
+		if tooMany || tooOld {
+			expired = append(expired, s)
+		}
+	}
+	return expired
+}
+
+// ShouldDelete returns true if the snapshots should be deleted with the resource
+func (p *DBSnapshotPlan) ShouldDelete() bool {
+	return p.DeletionPolicy != "Retain" && p.DeletionPolicy != "Orphan"
+}
@@ -0,0 +1,108 @@
+package rds_test
+
+import (
+	"testing"
+	"time"
+
+	"infra-operator/internal/domain/rds"
+)
+
+func snapshotAt(id, status string, t time.Time) *rds.DBSnapshot {
+	return &rds.DBSnapshot{Identifier: id, Status: status, CreateTime: &t}
+}
+
+func TestDBSnapshotPlan_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *rds.DBSnapshotPlan
+		wantErr error
+	}{
+		{"valid", &rds.DBSnapshotPlan{Identifier: "prod-before-migration", DBInstanceIdentifier: "prod"}, nil},
+		{"invalid identifier", &rds.DBSnapshotPlan{Identifier: "1-prod", DBInstanceIdentifier: "prod"}, rds.ErrInvalidSnapshotIdentifier},
+		{"double hyphen", &rds.DBSnapshotPlan{Identifier: "prod--nightly", DBInstanceIdentifier: "prod"}, rds.ErrInvalidSnapshotIdentifier},
+		{"no instance", &rds.DBSnapshotPlan{Identifier: "prod-nightly"}, rds.ErrInvalidSnapshotInstance},
+		{"retention without schedule", &rds.DBSnapshotPlan{Identifier: "prod-nightly", DBInstanceIdentifier: "prod", RetentionCount: 7}, rds.ErrInvalidSnapshotRetention},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.p.Validate(); err != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDBSnapshotPlan_Owns(t *testing.T) {
+	onDemand := &rds.DBSnapshotPlan{Identifier: "prod-nightly"}
+	if !onDemand.Owns("prod-nightly") || onDemand.Owns("prod-nightly-20260101-0300") {
+		t.Error("on-demand plan should only own its identifier")
+	}
+
+	scheduled := &rds.DBSnapshotPlan{Identifier: "prod-nightly", Interval: 24 * time.Hour}
+	now := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	if id := scheduled.SnapshotIdentifier(now); id != "prod-nightly-20260102-0304" {
+		t.Errorf("SnapshotIdentifier() = %s", id)
+	}
+	if !scheduled.Owns("prod-nightly-20260102-0304") {
+		t.Error("scheduled plan should own its timestamped snapshots")
+	}
+	if scheduled.Owns("prod-nightly") || scheduled.Owns("prod-nightly-manual") {
+		t.Error("scheduled plan should not own other snapshots")
+	}
+}
+
+func TestDBSnapshotPlan_SnapshotDue(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+
+	onDemand := &rds.DBSnapshotPlan{Identifier: "prod-nightly"}
+	if !onDemand.SnapshotDue(now) {
+		t.Error("expected the on-demand snapshot to be due")
+	}
+	onDemand.SetSnapshots([]*rds.DBSnapshot{snapshotAt("prod-nightly", "available", now.Add(-48*time.Hour))})
+	if onDemand.SnapshotDue(now) {
+		t.Error("expected the on-demand snapshot to be taken once")
+	}
+
+	scheduled := &rds.DBSnapshotPlan{Identifier: "prod-nightly", Interval: 24 * time.Hour}
+	scheduled.SetSnapshots([]*rds.DBSnapshot{snapshotAt("prod-nightly-20260101-0400", "available", now.Add(-23*time.Hour))})
+	if scheduled.SnapshotDue(now) {
+		t.Error("expected no snapshot before the interval")
+	}
+	if !scheduled.SnapshotDue(now.Add(time.Hour)) {
+		t.Error("expected a snapshot after the interval")
+	}
+
+	scheduled.SetSnapshots(append(scheduled.Snapshots, &rds.DBSnapshot{Identifier: "prod-nightly-20260102-0400", Status: "creating"}))
+	if scheduled.SnapshotDue(now.Add(time.Hour)) {
+		t.Error("expected no snapshot while one is being created")
+	}
+}
+
+func TestDBSnapshotPlan_Expired(t *testing.T) {
+	now := time.Date(2026, 1, 10, 3, 0, 0, 0, time.UTC)
+	plan := &rds.DBSnapshotPlan{Identifier: "prod-nightly", Interval: 24 * time.Hour, RetentionCount: 2}
+	plan.SetSnapshots([]*rds.DBSnapshot{
+		snapshotAt("prod-nightly-20260107-0300", "available", now.Add(-72*time.Hour)),
+		snapshotAt("prod-nightly-20260109-0300", "available", now.Add(-24*time.Hour)),
+		snapshotAt("prod-nightly-20260108-0300", "available", now.Add(-48*time.Hour)),
+		{Identifier: "prod-nightly-20260110-0300", Status: "creating"},
+	})
+
+	if latest := plan.Latest(); latest.Identifier != "prod-nightly-20260110-0300" {
+		t.Errorf("Latest() = %s, want the snapshot being created", latest.Identifier)
+	}
+	if available := plan.LatestAvailable(); available.Identifier != "prod-nightly-20260109-0300" {
+		t.Errorf("LatestAvailable() = %s", available.Identifier)
+	}
+
+	expired := plan.Expired(now)
+	if len(expired) != 1 || expired[0].Identifier != "prod-nightly-20260107-0300" {
+		t.Errorf("Expired() by count = %v", expired)
+	}
+
+	plan.RetentionCount = 0
+	plan.RetentionPeriod = time.Hour
+	if expired := plan.Expired(now); len(expired) != 2 {
+		t.Errorf("Expired() by period = %d snapshots, want 2 (the newest is kept)", len(expired))
+	}
+}
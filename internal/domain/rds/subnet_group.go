@@ -0,0 +1,64 @@
+package rds
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidSubnetGroupName    = errors.New("DB subnet group name cannot be empty")
+	ErrInvalidSubnetGroupSubnets = errors.New("DB subnet group needs at least two subnets in different availability zones")
+)
+
+// DBSubnetGroup represents an RDS DB subnet group in the domain model
+type DBSubnetGroup struct {
+	Name        string
+	Description string
+	SubnetIDs   []string
+	Tags        map[string]string
+
+	// Deletion
+	DeletionPolicy string
+
+	// State
+	ARN          string
+	VpcID        string
+	Status       string
+	LastSyncTime *time.Time
+}
+
+// SetDefaults sets default values for optional fields
+func (g *DBSubnetGroup) SetDefaults() {
+	if g.Description == "" {
+		g.Description = "Managed by infra-operator"
+	}
+	if g.DeletionPolicy == "" {
+		g.DeletionPolicy = "Delete"
+	}
+}
+
+// Validate checks if the DB subnet group configuration is valid
+func (g *DBSubnetGroup) Validate() error {
+	if g.Name == "" {
+		return ErrInvalidSubnetGroupName
+	}
+	if len(g.SubnetIDs) < 2 {
+		return ErrInvalidSubnetGroupSubnets
+	}
+	return nil
+}
+
+// NeedsUpdate reports whether the description or the subnets differ from current
+func (g *DBSubnetGroup) NeedsUpdate(current *DBSubnetGroup) bool {
+	return g.Description != current.Description || !sameStrings(g.SubnetIDs, current.SubnetIDs)
+}
+
+// ShouldDelete reports whether the subnet group is deleted with the resource
+func (g *DBSubnetGroup) ShouldDelete() bool {
+	return g.DeletionPolicy == "Delete"
+}
+
+// IsComplete checks if the DB subnet group is ready to be used
+func (g *DBSubnetGroup) IsComplete() bool {
+	return g.Status == "Complete"
+}
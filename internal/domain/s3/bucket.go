@@ -1,6 +1,11 @@
 package s3
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
 
 // Bucket represents the domain model for an S3 bucket
 // This is the core business entity, independent of AWS SDK or Kubernetes
@@ -14,16 +19,32 @@ type Bucket struct {
 	LifecycleRules    []LifecycleRule
 	CORSRules         []CORSRule
 	PublicAccessBlock *PublicAccessBlockConfig
+	Policy            string
+	Notifications     []NotificationConfig
+	Replication       *ReplicationConfig
+	Logging           *LoggingConfig
+	ObjectLock        *ObjectLockConfig
+	Website           *WebsiteConfig
 	Tags              map[string]string
 
 	// State
-	ARN          string
-	DomainName   string
-	CreationTime *time.Time
-	LastSyncTime *time.Time
+	ARN             string
+	DomainName      string
+	WebsiteEndpoint string
+	CreationTime    *time.Time
+	LastSyncTime    *time.Time
 
 	// Policy
 	DeletionPolicy DeletionPolicy
+	ForceDestroy   bool
+
+	// ObjectsDeleted counts the object versions and delete markers removed
+	// while emptying the bucket for deletion
+	ObjectsDeleted int64
+
+	// ManagedConfigurations are the configuration sections applied by earlier
+	// syncs, which are removed once they are dropped from the spec
+	ManagedConfigurations []string
 }
 
 // VersioningConfig represents versioning settings
@@ -74,6 +95,57 @@ type PublicAccessBlockConfig struct {
 	RestrictPublicBuckets bool
 }
 
+// NotificationConfig sends bucket events to an SQS queue, SNS topic or
+// Lambda function; exactly one destination ARN is set
+type NotificationConfig struct {
+	ID                string
+	Events            []string
+	FilterPrefix      string
+	FilterSuffix      string
+	QueueARN          string
+	TopicARN          string
+	LambdaFunctionARN string
+}
+
+// ReplicationConfig defines replication settings
+type ReplicationConfig struct {
+	Role  string
+	Rules []ReplicationRule
+}
+
+// ReplicationRule defines a replication rule
+type ReplicationRule struct {
+	ID                     string
+	Enabled                bool
+	Priority               int32
+	Prefix                 string
+	DestinationBucketARN   string
+	StorageClass           string
+	ReplicateDeleteMarkers bool
+}
+
+// LoggingConfig defines server access logging settings
+type LoggingConfig struct {
+	TargetBucket string
+	TargetPrefix string
+}
+
+// ObjectLockConfig defines the default retention of locked objects. An
+// empty Mode enables Object Lock without a default retention.
+type ObjectLockConfig struct {
+	Mode  string // GOVERNANCE or COMPLIANCE
+	Days  int32
+	Years int32
+}
+
+// WebsiteConfig defines static website hosting settings
+type WebsiteConfig struct {
+	IndexDocument    string
+	ErrorDocument    string
+	RedirectHostName string
+	RedirectProtocol string
+}
+
 // DeletionPolicy defines what happens when bucket is deleted
 type DeletionPolicy string
 
@@ -83,6 +155,26 @@ const (
 	DeletionPolicyOrphan DeletionPolicy = "Orphan"
 )
 
+// Configuration sections removed from the bucket once they are dropped from
+// the spec, if the operator applied them
+const (
+	ConfigurationPolicy        = "policy"
+	ConfigurationNotifications = "notifications"
+	ConfigurationReplication   = "replication"
+	ConfigurationLogging       = "logging"
+	ConfigurationWebsite       = "website"
+)
+
+const (
+	// EmptyBatchSize is the number of object versions removed per request,
+	// the maximum accepted by DeleteObjects
+	EmptyBatchSize = 1000
+
+	// EmptyBatchesPerDelete bounds the batches removed by one DeleteBucket
+	// call, so large buckets are emptied across several reconciles
+	EmptyBatchesPerDelete = 10
+)
+
 // BucketStatus represents the current status
 type BucketStatus string
 
@@ -108,6 +200,66 @@ func (b *Bucket) Validate() error {
 		return ErrRegionRequired
 	}
 
+	if b.Policy != "" && !json.Valid([]byte(b.Policy)) {
+		return ErrInvalidPolicy
+	}
+
+	for _, n := range b.Notifications {
+		if err := n.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if b.Replication != nil {
+		if !b.IsVersioned() {
+			return fmt.Errorf("%w: versioning must be enabled", ErrInvalidReplication)
+		}
+		if b.Replication.Role == "" || len(b.Replication.Rules) == 0 {
+			return fmt.Errorf("%w: role and at least one rule are required", ErrInvalidReplication)
+		}
+	}
+
+	if b.ObjectLock != nil {
+		if !b.IsVersioned() {
+			return fmt.Errorf("%w: versioning must be enabled", ErrInvalidObjectLock)
+		}
+		if err := b.ObjectLock.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if b.Website != nil && b.Website.IndexDocument == "" && b.Website.RedirectHostName == "" {
+		return ErrInvalidWebsite
+	}
+
+	return nil
+}
+
+// Validate checks that the notification has events and a single destination
+func (n *NotificationConfig) Validate() error {
+	destinations := 0
+	for _, arn := range []string{n.QueueARN, n.TopicARN, n.LambdaFunctionARN} {
+		if arn != "" {
+			destinations++
+		}
+	}
+	if n.ID == "" || len(n.Events) == 0 || destinations != 1 {
+		return fmt.Errorf("%w: %q needs an id, events and exactly one destination", ErrInvalidNotification, n.ID)
+	}
+	return nil
+}
+
+// Validate checks the default retention of Object Lock
+func (o *ObjectLockConfig) Validate() error {
+	if o.Mode == "" {
+		if o.Days != 0 || o.Years != 0 {
+			return fmt.Errorf("%w: mode is required with a default retention", ErrInvalidObjectLock)
+		}
+		return nil
+	}
+	if (o.Days > 0) == (o.Years > 0) {
+		return fmt.Errorf("%w: set either days or years of the default retention", ErrInvalidObjectLock)
+	}
 	return nil
 }
 
@@ -116,6 +268,81 @@ func (b *Bucket) IsEncrypted() bool {
 	return b.Encryption != nil && b.Encryption.Algorithm != ""
 }
 
+// ShouldDelete reports whether the bucket is deleted with the resource
+func (b *Bucket) ShouldDelete() bool {
+	return b.DeletionPolicy != DeletionPolicyRetain && b.DeletionPolicy != DeletionPolicyOrphan
+}
+
+// DesiredConfigurations returns the removable configuration sections set in
+// the spec
+func (b *Bucket) DesiredConfigurations() []string {
+	var sections []string
+	if b.Policy != "" {
+		sections = append(sections, ConfigurationPolicy)
+	}
+	if len(b.Notifications) > 0 {
+		sections = append(sections, ConfigurationNotifications)
+	}
+	if b.Replication != nil {
+		sections = append(sections, ConfigurationReplication)
+	}
+	if b.Logging != nil {
+		sections = append(sections, ConfigurationLogging)
+	}
+	if b.Website != nil {
+		sections = append(sections, ConfigurationWebsite)
+	}
+	return sections
+}
+
+// RemovesConfiguration reports whether a section applied by an earlier sync
+// was dropped from the spec. Sections the operator never applied, such as a
+// policy set outside the operator or on an adopted bucket, are left alone.
+func (b *Bucket) RemovesConfiguration(section string) bool {
+	return slices.Contains(b.ManagedConfigurations, section) && !slices.Contains(b.DesiredConfigurations(), section)
+}
+
+// EmptyBatch removes up to max object versions and delete markers, returning
+// how many were removed and whether more remain
+type EmptyBatch func(max int32, bypassGovernance bool) (deleted int, more bool, err error)
+
+// Empty removes the object versions and delete markers of the bucket with
+// deleteBatch, counting them in ObjectsDeleted. It stops after
+// EmptyBatchesPerDelete batches and returns ErrBucketEmptying, so the caller
+// resumes on the next call where it stopped.
+func (b *Bucket) Empty(deleteBatch EmptyBatch) error {
+	bypassGovernance := b.ObjectLock != nil
+	for i := 0; i < EmptyBatchesPerDelete; i++ {
+		deleted, more, err := deleteBatch(EmptyBatchSize, bypassGovernance)
+		b.ObjectsDeleted += int64(deleted)
+		if err != nil {
+			return fmt.Errorf("failed to empty bucket: %w", err)
+		}
+		if !more {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %d objects deleted so far", ErrBucketEmptying, b.ObjectsDeleted)
+}
+
+// ObjectError is an object version a DeleteObjects request failed to remove
+type ObjectError struct {
+	Key       string
+	VersionID string
+	Message   string
+}
+
+// DeletedObjects returns how many of the requested object versions a
+// DeleteObjects request removed, and an error naming the first one it
+// failed to remove
+func DeletedObjects(requested int, failed []ObjectError) (int, error) {
+	if len(failed) == 0 {
+		return requested, nil
+	}
+	e := failed[0]
+	return requested - len(failed), fmt.Errorf("failed to delete %s (version %s): %s", e.Key, e.VersionID, e.Message)
+}
+
 // IsVersioned checks if versioning is enabled
 func (b *Bucket) IsVersioned() bool {
 	return b.Versioning != nil && b.Versioning.Enabled
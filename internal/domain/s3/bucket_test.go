@@ -1,6 +1,8 @@
 package s3_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"infra-operator/internal/domain/s3"
@@ -205,3 +207,122 @@ func TestBucket_HasPublicAccessBlocked(t *testing.T) {
 		})
 	}
 }
+
+func TestBucket_ShouldDelete(t *testing.T) {
+	tests := map[s3.DeletionPolicy]bool{
+		s3.DeletionPolicyDelete: true,
+		s3.DeletionPolicyRetain: false,
+		s3.DeletionPolicyOrphan: false,
+	}
+
+	for policy, want := range tests {
+		t.Run(string(policy), func(t *testing.T) {
+			bucket := &s3.Bucket{DeletionPolicy: policy}
+			if got := bucket.ShouldDelete(); got != want {
+				t.Errorf("ShouldDelete() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestBucket_Empty(t *testing.T) {
+	type batch struct {
+		deleted int
+		more    bool
+		err     error
+	}
+	full := batch{deleted: s3.EmptyBatchSize, more: true}
+	failed := errors.New("access denied")
+
+	tests := []struct {
+		name           string
+		objectsDeleted int64
+		batches        []batch // the last batch repeats
+		wantErr        error
+		wantDeleted    int64
+		wantCalls      int
+	}{
+		{name: "already empty", batches: []batch{{}}, wantDeleted: 0, wantCalls: 1},
+		{name: "emptied within the batch budget", batches: []batch{full, full, {deleted: 250}}, wantDeleted: 2*s3.EmptyBatchSize + 250, wantCalls: 3},
+		{name: "stops after EmptyBatchesPerDelete batches", batches: []batch{full}, wantErr: s3.ErrBucketEmptying,
+			wantDeleted: s3.EmptyBatchesPerDelete * s3.EmptyBatchSize, wantCalls: s3.EmptyBatchesPerDelete},
+		{name: "resumes the count of a previous call", objectsDeleted: 10000, batches: []batch{{deleted: 30}}, wantDeleted: 10030, wantCalls: 1},
+		{name: "keeps the progress of a partial failure", batches: []batch{full, {deleted: 400, more: true, err: failed}}, wantErr: failed,
+			wantDeleted: s3.EmptyBatchSize + 400, wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket := &s3.Bucket{Name: "assets", ObjectsDeleted: tt.objectsDeleted}
+			calls := 0
+			err := bucket.Empty(func(max int32, bypassGovernance bool) (int, bool, error) {
+				if max != s3.EmptyBatchSize || bypassGovernance {
+					t.Errorf("batch called with max = %d, bypassGovernance = %v", max, bypassGovernance)
+				}
+				b := tt.batches[min(calls, len(tt.batches)-1)]
+				calls++
+				return b.deleted, b.more, b.err
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Empty() error = %v, want %v", err, tt.wantErr)
+			}
+			if bucket.ObjectsDeleted != tt.wantDeleted {
+				t.Errorf("ObjectsDeleted = %d, want %d", bucket.ObjectsDeleted, tt.wantDeleted)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("batches = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestBucket_Empty_BypassGovernance(t *testing.T) {
+	bucket := &s3.Bucket{Name: "records", ObjectLock: &s3.ObjectLockConfig{Mode: "GOVERNANCE", Days: 1}}
+	bucket.Empty(func(max int32, bypassGovernance bool) (int, bool, error) {
+		if !bypassGovernance {
+			t.Error("object lock buckets should bypass governance retention")
+		}
+		return 0, false, nil
+	})
+}
+
+func TestDeletedObjects(t *testing.T) {
+	deleted, err := s3.DeletedObjects(1000, nil)
+	if deleted != 1000 || err != nil {
+		t.Errorf("DeletedObjects() = %d, %v, want 1000, nil", deleted, err)
+	}
+
+	deleted, err = s3.DeletedObjects(1000, []s3.ObjectError{
+		{Key: "logs/a", VersionID: "v1", Message: "Access Denied"},
+		{Key: "logs/b", VersionID: "v2", Message: "Access Denied"},
+	})
+	if deleted != 998 {
+		t.Errorf("DeletedObjects() = %d, want 998", deleted)
+	}
+	if err == nil || !strings.Contains(err.Error(), "logs/a (version v1)") {
+		t.Errorf("DeletedObjects() error = %v, want the first failed object", err)
+	}
+}
+
+func TestBucket_RemovesConfiguration(t *testing.T) {
+	tests := []struct {
+		name    string
+		bucket  *s3.Bucket
+		section string
+		want    bool
+	}{
+		{name: "applied and dropped from the spec", bucket: &s3.Bucket{ManagedConfigurations: []string{s3.ConfigurationPolicy}}, section: s3.ConfigurationPolicy, want: true},
+		{name: "still in the spec", bucket: &s3.Bucket{Policy: `{"Statement":[]}`, ManagedConfigurations: []string{s3.ConfigurationPolicy}}, section: s3.ConfigurationPolicy, want: false},
+		{name: "set outside the operator", bucket: &s3.Bucket{}, section: s3.ConfigurationPolicy, want: false},
+		{name: "website never applied", bucket: &s3.Bucket{ManagedConfigurations: []string{s3.ConfigurationPolicy}}, section: s3.ConfigurationWebsite, want: false},
+		{name: "dropped logging", bucket: &s3.Bucket{Website: &s3.WebsiteConfig{IndexDocument: "index.html"}, ManagedConfigurations: []string{s3.ConfigurationLogging, s3.ConfigurationWebsite}}, section: s3.ConfigurationLogging, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bucket.RemovesConfiguration(tt.section); got != tt.want {
+				t.Errorf("RemovesConfiguration(%s) = %v, want %v", tt.section, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// websiteDashRegions are the regions whose website endpoint uses
+// s3-website-<region> instead of s3-website.<region>
+var websiteDashRegions = map[string]bool{
+	"us-east-1":      true,
+	"us-west-1":      true,
+	"us-west-2":      true,
+	"ap-southeast-1": true,
+	"ap-southeast-2": true,
+	"ap-northeast-1": true,
+	"eu-west-1":      true,
+	"sa-east-1":      true,
+	"us-gov-west-1":  true,
+}
+
+// BucketARN returns the ARN of a bucket given its name or ARN
+func BucketARN(nameOrARN string) string {
+	if strings.HasPrefix(nameOrARN, "arn:") {
+		return nameOrARN
+	}
+	return fmt.Sprintf("arn:aws:s3:::%s", nameOrARN)
+}
+
+// WebsiteEndpoint returns the static website endpoint of a bucket
+func WebsiteEndpoint(name, region string) string {
+	if websiteDashRegions[region] {
+		return fmt.Sprintf("%s.s3-website-%s.amazonaws.com", name, region)
+	}
+	return fmt.Sprintf("%s.s3-website.%s.amazonaws.com", name, region)
+}
+
+// The functions below compare a desired configuration with the one read from
+// AWS, so unchanged configuration is not applied again on every sync.
+
+// PoliciesEqual reports whether two policy documents are the same JSON,
+// ignoring formatting and key order
+func PoliciesEqual(a, b string) bool {
+	var da, db interface{}
+	if json.Unmarshal([]byte(a), &da) != nil || json.Unmarshal([]byte(b), &db) != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(da, db)
+}
+
+// NotificationsEqual reports whether two notification lists are the same,
+// ignoring the order of the notifications and of their events
+func NotificationsEqual(a, b []NotificationConfig) bool {
+	normalize := func(in []NotificationConfig) []NotificationConfig {
+		out := make([]NotificationConfig, 0, len(in))
+		for _, n := range in {
+			n.Events = sortedCopy(n.Events)
+			out = append(out, n)
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// ReplicationEqual reports whether two replication configurations are the
+// same, ignoring the order of the rules
+func ReplicationEqual(a, b *ReplicationConfig) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	normalize := func(in []ReplicationRule) []ReplicationRule {
+		out := append([]ReplicationRule{}, in...)
+		sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+		return out
+	}
+	return a.Role == b.Role && reflect.DeepEqual(normalize(a.Rules), normalize(b.Rules))
+}
+
+// LifecycleRulesEqual reports whether two lifecycle rule lists are the same
+func LifecycleRulesEqual(a, b []LifecycleRule) bool {
+	normalize := func(in []LifecycleRule) []LifecycleRule {
+		out := make([]LifecycleRule, 0, len(in))
+		for _, rule := range in {
+			if len(rule.Transitions) == 0 {
+				rule.Transitions = nil
+			}
+			out = append(out, rule)
+		}
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// CORSRulesEqual reports whether two CORS rule lists are the same
+func CORSRulesEqual(a, b []CORSRule) bool {
+	normalize := func(in []CORSRule) []CORSRule {
+		out := make([]CORSRule, 0, len(in))
+		for _, rule := range in {
+			rule.AllowedOrigins = sortedCopy(rule.AllowedOrigins)
+			rule.AllowedMethods = sortedCopy(rule.AllowedMethods)
+			rule.AllowedHeaders = sortedCopy(rule.AllowedHeaders)
+			rule.ExposeHeaders = sortedCopy(rule.ExposeHeaders)
+			out = append(out, rule)
+		}
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// TagsEqual reports whether two tag sets are the same
+func TagsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if current, ok := b[k]; !ok || current != v {
+			return false
+		}
+	}
+	return true
+}
+
+// sortedCopy returns a sorted copy of values, nil when empty
+func sortedCopy(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := append([]string{}, values...)
+	sort.Strings(out)
+	return out
+}
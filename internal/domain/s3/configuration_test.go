@@ -0,0 +1,146 @@
+package s3_test
+
+import (
+	"errors"
+	"testing"
+
+	"infra-operator/internal/domain/s3"
+)
+
+func TestBucket_ValidateConfiguration(t *testing.T) {
+	versioned := func(b *s3.Bucket) *s3.Bucket {
+		b.Versioning = &s3.VersioningConfig{Enabled: true}
+		return b
+	}
+	queue := "arn:aws:sqs:us-east-1:123456789012:uploads"
+
+	tests := []struct {
+		name    string
+		bucket  *s3.Bucket
+		wantErr error
+	}{
+		{
+			name:    "invalid policy",
+			bucket:  &s3.Bucket{Policy: "{not json"},
+			wantErr: s3.ErrInvalidPolicy,
+		},
+		{
+			name: "notification with two destinations",
+			bucket: &s3.Bucket{Notifications: []s3.NotificationConfig{{
+				ID: "uploads", Events: []string{"s3:ObjectCreated:*"}, QueueARN: queue, TopicARN: "arn:aws:sns:us-east-1:123456789012:uploads",
+			}}},
+			wantErr: s3.ErrInvalidNotification,
+		},
+		{
+			name:    "notification without events",
+			bucket:  &s3.Bucket{Notifications: []s3.NotificationConfig{{ID: "uploads", QueueARN: queue}}},
+			wantErr: s3.ErrInvalidNotification,
+		},
+		{
+			name: "replication without versioning",
+			bucket: &s3.Bucket{Replication: &s3.ReplicationConfig{
+				Role: "arn:aws:iam::123456789012:role/replication", Rules: []s3.ReplicationRule{{ID: "all"}},
+			}},
+			wantErr: s3.ErrInvalidReplication,
+		},
+		{
+			name:    "object lock without versioning",
+			bucket:  &s3.Bucket{ObjectLock: &s3.ObjectLockConfig{}},
+			wantErr: s3.ErrInvalidObjectLock,
+		},
+		{
+			name:    "object lock retention with days and years",
+			bucket:  versioned(&s3.Bucket{ObjectLock: &s3.ObjectLockConfig{Mode: "GOVERNANCE", Days: 1, Years: 1}}),
+			wantErr: s3.ErrInvalidObjectLock,
+		},
+		{
+			name:    "empty website",
+			bucket:  &s3.Bucket{Website: &s3.WebsiteConfig{ErrorDocument: "error.html"}},
+			wantErr: s3.ErrInvalidWebsite,
+		},
+		{
+			name: "valid configuration",
+			bucket: versioned(&s3.Bucket{
+				Policy:        `{"Version":"2012-10-17","Statement":[]}`,
+				Notifications: []s3.NotificationConfig{{ID: "uploads", Events: []string{"s3:ObjectCreated:*"}, QueueARN: queue}},
+				ObjectLock:    &s3.ObjectLockConfig{Mode: "COMPLIANCE", Years: 7},
+				Website:       &s3.WebsiteConfig{IndexDocument: "index.html"},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.bucket.Name = "my-valid-bucket"
+			tt.bucket.Region = "us-east-1"
+			if err := tt.bucket.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPoliciesEqual(t *testing.T) {
+	desired := `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject"}]}`
+	current := `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*"}],"Version":"2012-10-17"}`
+
+	if !s3.PoliciesEqual(desired, current) {
+		t.Error("reformatted policy should be equal")
+	}
+	if s3.PoliciesEqual(desired, `{"Version":"2012-10-17","Statement":[]}`) {
+		t.Error("different policies should not be equal")
+	}
+}
+
+func TestNotificationsEqual(t *testing.T) {
+	a := []s3.NotificationConfig{
+		{ID: "a", Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"}, QueueARN: "arn:q"},
+		{ID: "b", Events: []string{"s3:ObjectCreated:Put"}, TopicARN: "arn:t"},
+	}
+	b := []s3.NotificationConfig{
+		{ID: "b", Events: []string{"s3:ObjectCreated:Put"}, TopicARN: "arn:t"},
+		{ID: "a", Events: []string{"s3:ObjectRemoved:*", "s3:ObjectCreated:*"}, QueueARN: "arn:q"},
+	}
+
+	if !s3.NotificationsEqual(a, b) {
+		t.Error("reordered notifications should be equal")
+	}
+	b[0].FilterPrefix = "uploads/"
+	if s3.NotificationsEqual(a, b) {
+		t.Error("notifications with a different filter should not be equal")
+	}
+	if !s3.NotificationsEqual(nil, []s3.NotificationConfig{}) {
+		t.Error("no notifications should be equal to an empty list")
+	}
+}
+
+func TestReplicationEqual(t *testing.T) {
+	a := &s3.ReplicationConfig{Role: "arn:role", Rules: []s3.ReplicationRule{
+		{ID: "logs", Enabled: true, Priority: 1, DestinationBucketARN: s3.BucketARN("replica")},
+		{ID: "data", Enabled: true, Priority: 2, DestinationBucketARN: "arn:aws:s3:::replica"},
+	}}
+	b := &s3.ReplicationConfig{Role: "arn:role", Rules: []s3.ReplicationRule{a.Rules[1], a.Rules[0]}}
+
+	if !s3.ReplicationEqual(a, b) {
+		t.Error("reordered rules should be equal")
+	}
+	b.Rules[0].StorageClass = "STANDARD_IA"
+	if s3.ReplicationEqual(a, b) {
+		t.Error("rules with a different storage class should not be equal")
+	}
+	if s3.ReplicationEqual(a, nil) {
+		t.Error("configuration should not equal no configuration")
+	}
+}
+
+func TestWebsiteEndpoint(t *testing.T) {
+	if got := s3.WebsiteEndpoint("site", "us-east-1"); got != "site.s3-website-us-east-1.amazonaws.com" {
+		t.Errorf("WebsiteEndpoint(us-east-1) = %s", got)
+	}
+	if got := s3.WebsiteEndpoint("site", "sa-east-1"); got != "site.s3-website-sa-east-1.amazonaws.com" {
+		t.Errorf("WebsiteEndpoint(sa-east-1) = %s", got)
+	}
+	if got := s3.WebsiteEndpoint("site", "eu-central-1"); got != "site.s3-website.eu-central-1.amazonaws.com" {
+		t.Errorf("WebsiteEndpoint(eu-central-1) = %s", got)
+	}
+}
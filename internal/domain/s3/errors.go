@@ -11,4 +11,11 @@ var (
 	ErrBucketAlreadyExists     = errors.New("bucket already exists")
 	ErrInvalidEncryption       = errors.New("invalid encryption configuration")
 	ErrInvalidLifecycleRule    = errors.New("invalid lifecycle rule")
+	ErrInvalidPolicy           = errors.New("bucket policy must be a JSON document")
+	ErrInvalidNotification     = errors.New("invalid notification")
+	ErrInvalidReplication      = errors.New("invalid replication configuration")
+	ErrInvalidObjectLock       = errors.New("invalid object lock configuration")
+	ErrInvalidWebsite          = errors.New("website configuration needs an index document or a redirect")
+	ErrBucketNotEmpty          = errors.New("bucket is not empty")
+	ErrBucketEmptying          = errors.New("bucket is still being emptied")
 )
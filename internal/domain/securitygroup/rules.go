@@ -0,0 +1,168 @@
+package securitygroup
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// protocolNames maps protocol numbers to the names AWS returns for them
+var protocolNames = map[string]string{
+	"all":    "-1",
+	"6":      "tcp",
+	"17":     "udp",
+	"1":      "icmp",
+	"icmpv6": "58",
+}
+
+// RuleChanges holds what must change on a group for its rules to match the
+// desired rules
+type RuleChanges struct {
+	Authorize []Rule
+	Revoke    []Rule
+
+	// Describe holds rules that exist but whose description changed
+	Describe []Rule
+}
+
+// Empty reports whether the rules already match
+func (c RuleChanges) Empty() bool {
+	return len(c.Authorize) == 0 && len(c.Revoke) == 0 && len(c.Describe) == 0
+}
+
+// ResolveSelfReferences sets the group ID on rules whose source is the group
+// itself, once the group exists
+func (sg *SecurityGroup) ResolveSelfReferences() {
+	for _, rules := range [][]Rule{sg.IngressRules, sg.EgressRules} {
+		for i := range rules {
+			if rules[i].Self {
+				rules[i].SourceSecurityGroupID = sg.GroupID
+			}
+		}
+	}
+}
+
+// ManagesEgress reports whether egress rules are reconciled. Egress is only
+// managed when declared, so the default allow-all rule AWS adds to every new
+// group is kept otherwise.
+func (sg *SecurityGroup) ManagesEgress() bool {
+	return len(sg.EgressRules) > 0
+}
+
+// Key identifies a rule with a single source. The description is not part of
+// it, as AWS updates descriptions in place.
+func (r Rule) Key() string {
+	return fmt.Sprintf("%s:%d-%d:%s", r.IpProtocol, r.FromPort, r.ToPort, r.source())
+}
+
+// source returns the only source of a normalized rule
+func (r Rule) source() string {
+	switch {
+	case len(r.CidrBlocks) > 0:
+		return r.CidrBlocks[0]
+	case len(r.Ipv6CidrBlocks) > 0:
+		return r.Ipv6CidrBlocks[0]
+	case len(r.PrefixListIDs) > 0:
+		return r.PrefixListIDs[0]
+	default:
+		return r.SourceSecurityGroupID
+	}
+}
+
+// NormalizeRules expands rules into one rule per source, the form AWS returns
+// them in, with protocol and ports normalized so desired and current rules
+// compare equal. Duplicate rules are dropped and the result is sorted by key.
+func NormalizeRules(rules []Rule) []Rule {
+	byKey := map[string]Rule{}
+	for _, rule := range rules {
+		protocol := strings.ToLower(rule.IpProtocol)
+		if name, ok := protocolNames[protocol]; ok {
+			protocol = name
+		}
+
+		from, to := rule.FromPort, rule.ToPort
+		switch protocol {
+		case "-1":
+			// All traffic has no ports
+			from, to = 0, 0
+		case "tcp", "udp":
+			// AWS returns all ports as 0-65535
+			if from == -1 && to == -1 {
+				from, to = 0, 65535
+			}
+		}
+
+		single := func(r Rule) {
+			r.IpProtocol, r.FromPort, r.ToPort = protocol, from, to
+			r.Description = rule.Description
+			byKey[r.Key()] = r
+		}
+		for _, cidr := range rule.CidrBlocks {
+			single(Rule{CidrBlocks: []string{cidr}})
+		}
+		for _, cidr := range rule.Ipv6CidrBlocks {
+			single(Rule{Ipv6CidrBlocks: []string{cidr}})
+		}
+		for _, id := range rule.PrefixListIDs {
+			single(Rule{PrefixListIDs: []string{id}})
+		}
+		if rule.SourceSecurityGroupID != "" {
+			single(Rule{SourceSecurityGroupID: rule.SourceSecurityGroupID})
+		}
+	}
+
+	normalized := make([]Rule, 0, len(byKey))
+	for _, rule := range byKey {
+		normalized = append(normalized, rule)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Key() < normalized[j].Key() })
+	return normalized
+}
+
+// DiffRules compares desired rules with the rules of the group in AWS. Rules
+// missing in AWS are authorized and rules not desired are revoked, so the
+// desired rules are authoritative.
+func DiffRules(desired, current []Rule) RuleChanges {
+	desired = NormalizeRules(desired)
+	current = NormalizeRules(current)
+
+	existing := make(map[string]Rule, len(current))
+	for _, rule := range current {
+		existing[rule.Key()] = rule
+	}
+	wanted := make(map[string]bool, len(desired))
+
+	var changes RuleChanges
+	for _, rule := range desired {
+		wanted[rule.Key()] = true
+		cur, ok := existing[rule.Key()]
+		switch {
+		case !ok:
+			changes.Authorize = append(changes.Authorize, rule)
+		case cur.Description != rule.Description:
+			changes.Describe = append(changes.Describe, rule)
+		}
+	}
+	for _, rule := range current {
+		if !wanted[rule.Key()] {
+			changes.Revoke = append(changes.Revoke, rule)
+		}
+	}
+	return changes
+}
+
+// Validate checks a rule has a protocol, a valid port range and a source
+func (r Rule) Validate() error {
+	if r.IpProtocol == "" {
+		return fmt.Errorf("%w: ipProtocol is required", ErrInvalidRule)
+	}
+	protocol := strings.ToLower(r.IpProtocol)
+	if (protocol == "tcp" || protocol == "udp") && r.FromPort > r.ToPort {
+		return fmt.Errorf("%w: fromPort %d is greater than toPort %d", ErrInvalidRule, r.FromPort, r.ToPort)
+	}
+	if len(r.CidrBlocks) == 0 && len(r.Ipv6CidrBlocks) == 0 && len(r.PrefixListIDs) == 0 &&
+		r.SourceSecurityGroupID == "" && !r.Self {
+		return fmt.Errorf("%w: a CIDR block, prefix list or security group source is required", ErrInvalidRule)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package securitygroup_test
+
+import (
+	"testing"
+
+	"infra-operator/internal/domain/securitygroup"
+)
+
+func TestNormalizeRules(t *testing.T) {
+	rules := securitygroup.NormalizeRules([]securitygroup.Rule{
+		{IpProtocol: "TCP", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/16", "10.1.0.0/16"}, Description: "HTTPS"},
+		{IpProtocol: "all", FromPort: -1, ToPort: -1, PrefixListIDs: []string{"pl-1"}},
+		{IpProtocol: "6", FromPort: -1, ToPort: -1, SourceSecurityGroupID: "sg-app"},
+		{IpProtocol: "tcp", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/16"}, Description: "HTTPS"},
+	})
+
+	want := []string{
+		"-1:0-0:pl-1",
+		"tcp:0-65535:sg-app",
+		"tcp:443-443:10.0.0.0/16",
+		"tcp:443-443:10.1.0.0/16",
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("NormalizeRules() returned %d rules, want %d", len(rules), len(want))
+	}
+	for i, rule := range rules {
+		if rule.Key() != want[i] {
+			t.Errorf("rule %d key = %s, want %s", i, rule.Key(), want[i])
+		}
+	}
+	if rules[2].Description != "HTTPS" {
+		t.Errorf("description = %q, want HTTPS", rules[2].Description)
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	desired := []securitygroup.Rule{
+		{IpProtocol: "tcp", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/16"}, Description: "HTTPS from VPC"},
+		{IpProtocol: "tcp", FromPort: 5432, ToPort: 5432, SourceSecurityGroupID: "sg-app"},
+	}
+	current := []securitygroup.Rule{
+		{IpProtocol: "tcp", FromPort: 443, ToPort: 443, CidrBlocks: []string{"10.0.0.0/16"}, Description: "HTTPS"},
+		{IpProtocol: "tcp", FromPort: 22, ToPort: 22, CidrBlocks: []string{"0.0.0.0/0"}},
+	}
+
+	changes := securitygroup.DiffRules(desired, current)
+	if len(changes.Authorize) != 1 || changes.Authorize[0].SourceSecurityGroupID != "sg-app" {
+		t.Errorf("Authorize = %+v, want the sg-app rule", changes.Authorize)
+	}
+	if len(changes.Revoke) != 1 || changes.Revoke[0].FromPort != 22 {
+		t.Errorf("Revoke = %+v, want the SSH rule added outside the spec", changes.Revoke)
+	}
+	if len(changes.Describe) != 1 || changes.Describe[0].Description != "HTTPS from VPC" {
+		t.Errorf("Describe = %+v, want the HTTPS rule", changes.Describe)
+	}
+
+	if !securitygroup.DiffRules(current, current).Empty() {
+		t.Error("DiffRules() of equal rules should be empty")
+	}
+}
+
+func TestSecurityGroup_ResolveSelfReferences(t *testing.T) {
+	sg := &securitygroup.SecurityGroup{
+		GroupID:      "sg-self",
+		IngressRules: []securitygroup.Rule{{IpProtocol: "-1", Self: true}},
+	}
+	sg.ResolveSelfReferences()
+	if sg.IngressRules[0].SourceSecurityGroupID != "sg-self" {
+		t.Errorf("SourceSecurityGroupID = %q, want sg-self", sg.IngressRules[0].SourceSecurityGroupID)
+	}
+}
+
+func TestRule_Validate(t *testing.T) {
+	if err := (securitygroup.Rule{IpProtocol: "tcp", FromPort: 22, ToPort: 22}).Validate(); err == nil {
+		t.Error("rule without a source should be invalid")
+	}
+	if err := (securitygroup.Rule{IpProtocol: "tcp", FromPort: 443, ToPort: 80, CidrBlocks: []string{"10.0.0.0/16"}}).Validate(); err == nil {
+		t.Error("rule with fromPort greater than toPort should be invalid")
+	}
+	if err := (securitygroup.Rule{IpProtocol: "-1", Self: true}).Validate(); err != nil {
+		t.Errorf("self rule should be valid: %v", err)
+	}
+}
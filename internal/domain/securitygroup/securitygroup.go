@@ -9,6 +9,7 @@ var (
 	ErrInvalidGroupName   = errors.New("group name is required")
 	ErrInvalidVpcID       = errors.New("VPC ID is required")
 	ErrInvalidDescription = errors.New("description is required")
+	ErrInvalidRule        = errors.New("invalid security group rule")
 )
 
 type SecurityGroup struct {
@@ -21,8 +22,17 @@ type SecurityGroup struct {
 	Tags           map[string]string
 	DeletionPolicy string
 
+	// RulesPending is set while the source of a rule cannot be resolved yet.
+	// Rules are then only authorized, so the rule left out keeps its traffic.
+	RulesPending bool
+
 	// Status fields
 	LastSyncTime *time.Time
+
+	// AppliedIngressRules and AppliedEgressRules are the normalized rules of
+	// the group after the last sync, one per source
+	AppliedIngressRules []Rule
+	AppliedEgressRules  []Rule
 }
 
 type Rule struct {
@@ -32,7 +42,11 @@ type Rule struct {
 	CidrBlocks            []string
 	Ipv6CidrBlocks        []string
 	SourceSecurityGroupID string
+	PrefixListIDs         []string
 	Description           string
+
+	// Self makes the group itself the source, resolved once it exists
+	Self bool
 }
 
 func (sg *SecurityGroup) SetDefaults() {
@@ -60,6 +74,13 @@ func (sg *SecurityGroup) Validate() error {
 	if sg.Description == "" {
 		return ErrInvalidDescription
 	}
+	for _, rules := range [][]Rule{sg.IngressRules, sg.EgressRules} {
+		for _, rule := range rules {
+			if err := rule.Validate(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -89,3 +89,8 @@ func (t *Topic) Validate() error {
 func (t *Topic) IsReady() bool {
 	return t.ARN != ""
 }
+
+// ShouldDelete returns true if the topic should be deleted when the CR is deleted
+func (t *Topic) ShouldDelete() bool {
+	return t.DeletionPolicy != "Retain" && t.DeletionPolicy != "Orphan"
+}
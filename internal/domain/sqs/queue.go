@@ -85,3 +85,8 @@ func (q *Queue) Validate() error {
 func (q *Queue) IsReady() bool {
 	return q.URL != ""
 }
+
+// ShouldDelete returns true if the queue should be deleted when the CR is deleted
+func (q *Queue) ShouldDelete() bool {
+	return q.DeletionPolicy != "Retain" && q.DeletionPolicy != "Orphan"
+}
@@ -26,3 +26,93 @@ type ALBUseCase interface {
 	SyncLoadBalancer(ctx context.Context, lb *alb.LoadBalancer) error
 	DeleteLoadBalancer(ctx context.Context, lb *alb.LoadBalancer) error
 }
+
+// TargetGroupRepository defines the interface for target group operations.
+// Target groups and listeners belong to ELBv2, so they serve both ALB and NLB.
+type TargetGroupRepository interface {
+	// GetTargetGroup retrieves a target group by name, or nil if it does not exist
+	GetTargetGroup(ctx context.Context, name string) (*alb.TargetGroup, error)
+
+	// CreateTargetGroup creates a target group with its health check and tags
+	CreateTargetGroup(ctx context.Context, tg *alb.TargetGroup) error
+
+	// ModifyTargetGroup updates the health check and attributes of a target group
+	ModifyTargetGroup(ctx context.Context, tg *alb.TargetGroup) error
+
+	// DeleteTargetGroup deletes a target group
+	DeleteTargetGroup(ctx context.Context, tgARN string) error
+
+	// DescribeTargetHealth lists the registered targets with their health, or
+	// nil if the target group does not exist
+	DescribeTargetHealth(ctx context.Context, tgARN string) ([]alb.TargetHealth, error)
+
+	// RegisterTargets registers targets in a target group
+	RegisterTargets(ctx context.Context, tgARN string, targets []alb.Target) error
+
+	// DeregisterTargets deregisters targets from a target group
+	DeregisterTargets(ctx context.Context, tgARN string, targets []alb.Target) error
+
+	// TagResource tags a target group
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+}
+
+// ListenerRepository defines the interface for ALB and NLB listener operations
+type ListenerRepository interface {
+	// GetListener retrieves a listener by ARN, or nil if it does not exist
+	GetListener(ctx context.Context, listenerARN string) (*alb.Listener, error)
+
+	// FindListener retrieves the listener of a load balancer on port, or nil if there is none
+	FindListener(ctx context.Context, lbARN string, port int32) (*alb.Listener, error)
+
+	// CreateListener creates a listener with its default certificate and actions
+	CreateListener(ctx context.Context, listener *alb.Listener) error
+
+	// ModifyListener updates the port, protocol, TLS settings and default actions of a listener
+	ModifyListener(ctx context.Context, listener *alb.Listener) error
+
+	// DeleteListener deletes a listener and its rules
+	DeleteListener(ctx context.Context, listenerARN string) error
+
+	// ListCertificates lists the additional (SNI) certificates of a listener
+	ListCertificates(ctx context.Context, listenerARN string) ([]string, error)
+
+	// AddCertificates adds SNI certificates to a listener
+	AddCertificates(ctx context.Context, listenerARN string, certificateARNs []string) error
+
+	// RemoveCertificates removes SNI certificates from a listener
+	RemoveCertificates(ctx context.Context, listenerARN string, certificateARNs []string) error
+
+	// ListRules lists the rules of a listener, except the default rule
+	ListRules(ctx context.Context, listenerARN string) ([]alb.ListenerRule, error)
+
+	// CreateRule creates a rule on a listener
+	CreateRule(ctx context.Context, listenerARN string, rule *alb.ListenerRule) error
+
+	// ModifyRule updates the conditions and actions of a rule
+	ModifyRule(ctx context.Context, rule *alb.ListenerRule) error
+
+	// DeleteRule deletes a rule
+	DeleteRule(ctx context.Context, ruleARN string) error
+
+	// TagResource tags a listener
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+}
+
+// TargetGroupUseCase defines the use case interface for target group operations
+type TargetGroupUseCase interface {
+	SyncTargetGroup(ctx context.Context, tg *alb.TargetGroup) error
+	DeleteTargetGroup(ctx context.Context, tg *alb.TargetGroup) error
+}
+
+// ListenerUseCase defines the use case interface for listener operations
+type ListenerUseCase interface {
+	SyncListener(ctx context.Context, listener *alb.Listener) error
+	DeleteListener(ctx context.Context, listener *alb.Listener) error
+}
+
+// TargetGroupBindingUseCase defines the use case interface for registering
+// Kubernetes endpoints in a target group
+type TargetGroupBindingUseCase interface {
+	SyncBinding(ctx context.Context, binding *alb.TargetGroupBinding) error
+	DeleteBinding(ctx context.Context, binding *alb.TargetGroupBinding) error
+}
@@ -0,0 +1,57 @@
+// Package ports define as interfaces de portas seguindo Clean Architecture.
+//
+// Este package contém as abstrações que desacoplam a lógica de negócio das
+// implementações concretas, permitindo testabilidade e flexibilidade.
+package ports
+
+import (
+	"context"
+
+	"infra-operator/internal/domain/autoscaling"
+)
+
+// AutoScalingRepository defines the interface for EC2 Auto Scaling operations
+type AutoScalingRepository interface {
+	// GetGroup retrieves an Auto Scaling group by name with its instances and
+	// target groups, or nil if it does not exist
+	GetGroup(ctx context.Context, name string) (*autoscaling.Group, error)
+
+	// CreateGroup creates an Auto Scaling group with its lifecycle hooks, target groups and tags
+	CreateGroup(ctx context.Context, group *autoscaling.Group) error
+
+	// UpdateGroup updates the capacity, subnets, launch template and health checks of a group
+	UpdateGroup(ctx context.Context, group *autoscaling.Group) error
+
+	// DeleteGroup deletes an Auto Scaling group and terminates its instances
+	DeleteGroup(ctx context.Context, name string) error
+
+	// AttachTargetGroups attaches target groups to a group
+	AttachTargetGroups(ctx context.Context, name string, targetGroupARNs []string) error
+
+	// DetachTargetGroups detaches target groups from a group
+	DetachTargetGroups(ctx context.Context, name string, targetGroupARNs []string) error
+
+	// ListLifecycleHooks lists the lifecycle hooks of a group
+	ListLifecycleHooks(ctx context.Context, name string) ([]autoscaling.LifecycleHook, error)
+
+	// PutLifecycleHook creates or updates a lifecycle hook
+	PutLifecycleHook(ctx context.Context, name string, hook autoscaling.LifecycleHook) error
+
+	// DeleteLifecycleHook deletes a lifecycle hook
+	DeleteLifecycleHook(ctx context.Context, name, hookName string) error
+
+	// StartInstanceRefresh starts replacing the instances of a group and returns the refresh ID
+	StartInstanceRefresh(ctx context.Context, name string, preferences *autoscaling.RefreshPreferences) (string, error)
+
+	// GetLatestInstanceRefresh retrieves the most recent instance refresh, or nil if there is none
+	GetLatestInstanceRefresh(ctx context.Context, name string) (*autoscaling.Refresh, error)
+
+	// TagResource creates or updates the tags of a group, propagated to new instances
+	TagResource(ctx context.Context, name string, tags map[string]string) error
+}
+
+// AutoScalingGroupUseCase defines the use case interface for Auto Scaling group operations
+type AutoScalingGroupUseCase interface {
+	SyncGroup(ctx context.Context, group *autoscaling.Group) error
+	DeleteGroup(ctx context.Context, group *autoscaling.Group) error
+}
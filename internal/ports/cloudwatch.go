@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+)
+
+// CloudWatchAlarmRepository defines the interface for reading CloudWatch alarms
+type CloudWatchAlarmRepository interface {
+	// FiringAlarm returns the first of the named alarms in ALARM state, or "" if none is
+	FiringAlarm(ctx context.Context, alarmNames []string) (string, error)
+}
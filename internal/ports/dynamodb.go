@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+
 	"infra-operator/internal/domain/dynamodb"
 )
 
@@ -16,8 +17,8 @@ type DynamoDBRepository interface {
 	// Get retrieves table information
 	Get(ctx context.Context, tableName string) (*dynamodb.Table, error)
 
-	// Update updates table configuration
-	Update(ctx context.Context, table *dynamodb.Table) error
+	// Update applies a single UpdateTable call
+	Update(ctx context.Context, tableName string, update dynamodb.TableUpdate) error
 
 	// Delete deletes a table
 	Delete(ctx context.Context, tableName string) error
@@ -27,6 +27,14 @@ type EC2Repository interface {
 	StopInstance(ctx context.Context, instanceID string) error
 	TerminateInstance(ctx context.Context, instanceID string) error
 	TagResource(ctx context.Context, instanceID string, tags map[string]string) error
+	// ModifyInstanceType changes the type of a stopped instance
+	ModifyInstanceType(ctx context.Context, instanceID, instanceType string) error
+	// ModifySecurityGroups replaces the security groups of an instance
+	ModifySecurityGroups(ctx context.Context, instanceID string, groupIDs []string) error
+	// ModifyAPITermination enables or disables termination protection
+	ModifyAPITermination(ctx context.Context, instanceID string, disable bool) error
+	// SetMonitoring enables or disables detailed monitoring
+	SetMonitoring(ctx context.Context, instanceID string, enabled bool) error
 	// GetConsoleOutput obtém os logs do console da instância EC2
 	// Retorna as últimas linhas do console output (boot logs, kernel messages, etc)
 	GetConsoleOutput(ctx context.Context, instanceID string, maxLines int) (*ConsoleOutput, error)
@@ -37,3 +45,32 @@ type EC2UseCase interface {
 	SyncInstance(ctx context.Context, instance *ec2.Instance) error
 	DeleteInstance(ctx context.Context, instance *ec2.Instance) error
 }
+
+// LaunchTemplateRepository defines the interface for EC2 launch template operations
+type LaunchTemplateRepository interface {
+	// GetLaunchTemplate retrieves a launch template by name with the data of its
+	// latest version, or nil if it does not exist
+	GetLaunchTemplate(ctx context.Context, name string) (*ec2.LaunchTemplate, error)
+
+	// CreateLaunchTemplate creates a launch template with its first version
+	CreateLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error
+
+	// CreateLaunchTemplateVersion creates a version with the launch template data
+	// and returns its number
+	CreateLaunchTemplateVersion(ctx context.Context, lt *ec2.LaunchTemplate) (int64, error)
+
+	// SetDefaultVersion makes a version the default version of the launch template
+	SetDefaultVersion(ctx context.Context, launchTemplateID string, version int64) error
+
+	// DeleteLaunchTemplate deletes a launch template and all its versions
+	DeleteLaunchTemplate(ctx context.Context, launchTemplateID string) error
+
+	// TagLaunchTemplate tags a launch template
+	TagLaunchTemplate(ctx context.Context, launchTemplateID string, tags map[string]string) error
+}
+
+// LaunchTemplateUseCase defines the use case interface for launch template operations
+type LaunchTemplateUseCase interface {
+	SyncLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error
+	DeleteLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error
+}
@@ -33,6 +33,34 @@ type LambdaRepository interface {
 
 	// UntagFunction removes tags from a Lambda function
 	UntagFunction(ctx context.Context, functionARN string, tagKeys []string) error
+
+	// PublishVersion publishes the current code and configuration as a version,
+	// unless they are already the latest version, and returns its number
+	PublishVersion(ctx context.Context, functionName, codeSha256 string) (string, error)
+
+	// ListVersions lists the versions of a Lambda function
+	ListVersions(ctx context.Context, functionName string) ([]lambda.Version, error)
+
+	// ListEventSourceMappings lists the event source mappings of a Lambda function
+	ListEventSourceMappings(ctx context.Context, functionName string) ([]lambda.EventSourceMapping, error)
+
+	// CreateEventSourceMapping creates an event source mapping and sets its UUID and state
+	CreateEventSourceMapping(ctx context.Context, functionName string, mapping *lambda.EventSourceMapping) error
+
+	// UpdateEventSourceMapping updates the event source mapping identified by mapping.UUID
+	UpdateEventSourceMapping(ctx context.Context, functionName string, mapping *lambda.EventSourceMapping) error
+
+	// DeleteEventSourceMapping deletes an event source mapping
+	DeleteEventSourceMapping(ctx context.Context, uuid string) error
+
+	// ListPermissions lists the statements of the function policy
+	ListPermissions(ctx context.Context, functionName string) ([]lambda.Permission, error)
+
+	// AddPermission adds a statement to the function policy
+	AddPermission(ctx context.Context, functionName string, permission lambda.Permission) error
+
+	// RemovePermission removes a statement from the function policy
+	RemovePermission(ctx context.Context, functionName, statementID string) error
 }
 
 // LambdaUseCase defines the use case interface for Lambda function operations
@@ -43,3 +71,36 @@ type LambdaUseCase interface {
 	// DeleteFunction deletes a Lambda function
 	DeleteFunction(ctx context.Context, function *lambda.Function) error
 }
+
+// LambdaAliasRepository defines the interface for Lambda alias operations
+type LambdaAliasRepository interface {
+	// Get retrieves an alias, or nil if it does not exist
+	Get(ctx context.Context, functionName, name string) (*lambda.Alias, error)
+
+	// Create creates an alias pointing to version, with optional weighted routing
+	Create(ctx context.Context, alias *lambda.Alias, version string, routing *lambda.AliasRouting) error
+
+	// Update points an alias to version, with optional weighted routing
+	Update(ctx context.Context, alias *lambda.Alias, version string, routing *lambda.AliasRouting) error
+
+	// Delete deletes an alias
+	Delete(ctx context.Context, functionName, name string) error
+}
+
+// LambdaAliasUseCase defines the use case interface for Lambda alias operations
+type LambdaAliasUseCase interface {
+	// SyncAlias creates or updates an alias, advancing its traffic shift
+	SyncAlias(ctx context.Context, alias *lambda.Alias) error
+
+	// DeleteAlias deletes an alias
+	DeleteAlias(ctx context.Context, alias *lambda.Alias) error
+}
+
+// LambdaCodeArtifactRepository fetches deployment packages from OCI registries
+type LambdaCodeArtifactRepository interface {
+	// ResolveDigest returns the manifest digest the artifact reference points to
+	ResolveDigest(ctx context.Context, artifact lambda.OCIArtifact) (string, error)
+
+	// FetchLayer downloads the artifact layer that holds the deployment package
+	FetchLayer(ctx context.Context, artifact lambda.OCIArtifact) ([]byte, error)
+}
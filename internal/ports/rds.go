@@ -15,9 +15,20 @@ type RDSRepository interface {
 	Exists(ctx context.Context, dbInstanceIdentifier string) (bool, error)
 	Create(ctx context.Context, instance *rds.DBInstance) error
 	Get(ctx context.Context, dbInstanceIdentifier string) (*rds.DBInstance, error)
-	Update(ctx context.Context, instance *rds.DBInstance) error
+	Modify(ctx context.Context, dbInstanceIdentifier string, m *rds.Modification) (*rds.DBInstance, error)
 	Delete(ctx context.Context, dbInstanceIdentifier string, skipFinalSnapshot bool) error
 	TagResource(ctx context.Context, arn string, tags map[string]string) error
+
+	// Restore and replication
+	RestoreFromSnapshot(ctx context.Context, instance *rds.DBInstance) error
+	RestoreToPointInTime(ctx context.Context, instance *rds.DBInstance) error
+	CreateReadReplica(ctx context.Context, instance *rds.DBInstance) error
+	PromoteReadReplica(ctx context.Context, instance *rds.DBInstance) error
+
+	// Manual snapshots
+	CreateSnapshot(ctx context.Context, dbInstanceIdentifier, snapshotIdentifier string, tags map[string]string) (*rds.DBSnapshot, error)
+	ListSnapshots(ctx context.Context, dbInstanceIdentifier string) ([]*rds.DBSnapshot, error)
+	DeleteSnapshot(ctx context.Context, snapshotIdentifier string) error
 }
 
 // RDSUseCase defines the use case interface for RDS operations
@@ -25,3 +36,69 @@ type RDSUseCase interface {
 	SyncDBInstance(ctx context.Context, instance *rds.DBInstance) error
 	DeleteDBInstance(ctx context.Context, instance *rds.DBInstance) error
 }
+
+// RDSSnapshotUseCase defines the use case interface for manual DB snapshots
+type RDSSnapshotUseCase interface {
+	SyncSnapshots(ctx context.Context, plan *rds.DBSnapshotPlan) error
+	DeleteSnapshots(ctx context.Context, plan *rds.DBSnapshotPlan) error
+}
+
+// RDSSubnetGroupRepository defines the interface for DB subnet group operations
+type RDSSubnetGroupRepository interface {
+	Exists(ctx context.Context, name string) (bool, error)
+	Create(ctx context.Context, group *rds.DBSubnetGroup) error
+	Get(ctx context.Context, name string) (*rds.DBSubnetGroup, error)
+	Update(ctx context.Context, group *rds.DBSubnetGroup) error
+	Delete(ctx context.Context, name string) error
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+}
+
+// RDSSubnetGroupUseCase defines the use case interface for DB subnet group operations
+type RDSSubnetGroupUseCase interface {
+	SyncSubnetGroup(ctx context.Context, group *rds.DBSubnetGroup) error
+	DeleteSubnetGroup(ctx context.Context, group *rds.DBSubnetGroup) error
+}
+
+// RDSParameterGroupRepository defines the interface for DB parameter group operations
+type RDSParameterGroupRepository interface {
+	Exists(ctx context.Context, name string) (bool, error)
+	Create(ctx context.Context, group *rds.DBParameterGroup) error
+	Get(ctx context.Context, name string) (*rds.DBParameterGroup, error)
+	ModifyParameters(ctx context.Context, name string, parameters map[string]string) error
+	ResetParameters(ctx context.Context, name string, parameterNames []string) error
+	Delete(ctx context.Context, name string) error
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+}
+
+// RDSParameterGroupUseCase defines the use case interface for DB parameter group operations
+type RDSParameterGroupUseCase interface {
+	SyncParameterGroup(ctx context.Context, group *rds.DBParameterGroup) error
+	DeleteParameterGroup(ctx context.Context, group *rds.DBParameterGroup) error
+}
+
+// RDSClusterRepository defines the interface for Aurora DB cluster operations
+type RDSClusterRepository interface {
+	Exists(ctx context.Context, dbClusterIdentifier string) (bool, error)
+	Create(ctx context.Context, cluster *rds.DBCluster, secondary bool) error
+	Get(ctx context.Context, dbClusterIdentifier string) (*rds.DBCluster, error)
+	Modify(ctx context.Context, dbClusterIdentifier string, m *rds.ClusterModification) error
+	Delete(ctx context.Context, dbClusterIdentifier string, skipFinalSnapshot bool) error
+	TagResource(ctx context.Context, arn string, tags map[string]string) error
+
+	// Cluster instances
+	CreateInstance(ctx context.Context, cluster *rds.DBCluster, instance rds.ClusterInstance) error
+	ModifyInstanceClass(ctx context.Context, dbInstanceIdentifier, instanceClass string, applyImmediately bool) error
+	DeleteInstance(ctx context.Context, dbInstanceIdentifier string) error
+
+	// Global databases
+	GetGlobalCluster(ctx context.Context, globalClusterIdentifier string) (*rds.GlobalCluster, error)
+	CreateGlobalCluster(ctx context.Context, globalClusterIdentifier, sourceDBClusterArn string) error
+	RemoveFromGlobalCluster(ctx context.Context, globalClusterIdentifier, dbClusterArn string) error
+	DeleteGlobalCluster(ctx context.Context, globalClusterIdentifier string) error
+}
+
+// RDSClusterUseCase defines the use case interface for Aurora DB cluster operations
+type RDSClusterUseCase interface {
+	SyncCluster(ctx context.Context, cluster *rds.DBCluster) error
+	DeleteCluster(ctx context.Context, cluster *rds.DBCluster) error
+}
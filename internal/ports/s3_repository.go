@@ -18,13 +18,20 @@ type S3Repository interface {
 	// Update updates bucket configuration
 	Update(ctx context.Context, bucket *s3.Bucket) error
 
-	// Delete deletes a bucket
+	// Delete deletes a bucket, returning s3.ErrBucketNotEmpty when it still
+	// has objects
 	Delete(ctx context.Context, name, region string) error
 
+	// DeleteObjectVersions removes up to max object versions and delete
+	// markers, returning how many were removed and whether more remain.
+	// bypassGovernance also removes versions under governance retention.
+	DeleteObjectVersions(ctx context.Context, name, region string, max int32, bypassGovernance bool) (int, bool, error)
+
 	// Exists checks if bucket exists
 	Exists(ctx context.Context, name, region string) (bool, error)
 
-	// Configure applies configuration to existing bucket
+	// Configure applies configuration to existing bucket, skipping
+	// configuration that already matches the bucket
 	Configure(ctx context.Context, bucket *s3.Bucket) error
 
 	// ConfigureVersioning enables/disables versioning
@@ -44,6 +51,24 @@ type S3Repository interface {
 
 	// ConfigureTags applies tags to bucket
 	ConfigureTags(ctx context.Context, name, region string, tags map[string]string) error
+
+	// ConfigurePolicy applies the bucket policy, deleting it when empty
+	ConfigurePolicy(ctx context.Context, name, region, policy string) error
+
+	// ConfigureNotifications configures event notifications, clearing them when empty
+	ConfigureNotifications(ctx context.Context, name, region string, notifications []s3.NotificationConfig) error
+
+	// ConfigureReplication configures replication, deleting it when nil
+	ConfigureReplication(ctx context.Context, name, region string, config *s3.ReplicationConfig) error
+
+	// ConfigureLogging configures server access logging, disabling it when nil
+	ConfigureLogging(ctx context.Context, name, region string, config *s3.LoggingConfig) error
+
+	// ConfigureObjectLock enables Object Lock and its default retention
+	ConfigureObjectLock(ctx context.Context, name, region string, config *s3.ObjectLockConfig) error
+
+	// ConfigureWebsite configures static website hosting, deleting it when nil
+	ConfigureWebsite(ctx context.Context, name, region string, config *s3.WebsiteConfig) error
 }
 
 // S3UseCase defines business logic operations for S3
@@ -18,6 +18,10 @@ type SecurityGroupRepository interface {
 	Delete(ctx context.Context, groupID string) error
 	AuthorizeIngress(ctx context.Context, groupID string, rules []securitygroup.Rule) error
 	AuthorizeEgress(ctx context.Context, groupID string, rules []securitygroup.Rule) error
+	RevokeIngress(ctx context.Context, groupID string, rules []securitygroup.Rule) error
+	RevokeEgress(ctx context.Context, groupID string, rules []securitygroup.Rule) error
+	UpdateIngressDescriptions(ctx context.Context, groupID string, rules []securitygroup.Rule) error
+	UpdateEgressDescriptions(ctx context.Context, groupID string, rules []securitygroup.Rule) error
 	TagResource(ctx context.Context, groupID string, tags map[string]string) error
 }
 
@@ -0,0 +1,178 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/alb"
+	"infra-operator/internal/ports"
+)
+
+type ListenerUseCase struct {
+	repo ports.ListenerRepository
+}
+
+func NewListenerUseCase(repo ports.ListenerRepository) *ListenerUseCase {
+	return &ListenerUseCase{
+		repo: repo,
+	}
+}
+
+// SyncListener creates or updates a listener with its certificates and rules
+func (uc *ListenerUseCase) SyncListener(ctx context.Context, listener *alb.Listener) error {
+	// Set defaults
+	listener.SetDefaults()
+
+	// Validate
+	if err := listener.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Find the listener by the ARN recorded on creation, so a port change
+	// modifies it instead of creating a second one
+	var current *alb.Listener
+	var err error
+	if listener.ARN != "" {
+		current, err = uc.repo.GetListener(ctx, listener.ARN)
+	} else {
+		current, err = uc.repo.FindListener(ctx, listener.LoadBalancerARN, listener.Port)
+	}
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		if err := uc.repo.CreateListener(ctx, listener); err != nil {
+			return err
+		}
+	} else {
+		listener.ARN = current.ARN
+		if listener.ConfigurationChanged(current) {
+			if err := uc.repo.ModifyListener(ctx, listener); err != nil {
+				return err
+			}
+		}
+
+		if len(listener.Tags) > 0 {
+			if err := uc.repo.TagResource(ctx, listener.ARN, listener.Tags); err != nil {
+				return fmt.Errorf("failed to update listener tags: %w", err)
+			}
+		}
+	}
+
+	if err := uc.syncCertificates(ctx, listener); err != nil {
+		return err
+	}
+
+	if err := uc.syncRules(ctx, listener); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	listener.LastSyncTime = &now
+	return nil
+}
+
+// syncCertificates adds and removes the SNI certificates of a listener
+func (uc *ListenerUseCase) syncCertificates(ctx context.Context, listener *alb.Listener) error {
+	if !listener.RequiresCertificate() {
+		return nil
+	}
+
+	current, err := uc.repo.ListCertificates(ctx, listener.ARN)
+	if err != nil {
+		return err
+	}
+
+	wanted := map[string]bool{}
+	for _, arn := range listener.AdditionalCertificates() {
+		wanted[arn] = true
+	}
+	existing := map[string]bool{}
+	for _, arn := range current {
+		existing[arn] = true
+	}
+
+	var add, remove []string
+	for _, arn := range listener.AdditionalCertificates() {
+		if !existing[arn] {
+			add = append(add, arn)
+		}
+	}
+	for _, arn := range current {
+		// The default certificate is also listed as a listener certificate
+		if !wanted[arn] && arn != listener.DefaultCertificate() {
+			remove = append(remove, arn)
+		}
+	}
+
+	if len(add) > 0 {
+		if err := uc.repo.AddCertificates(ctx, listener.ARN, add); err != nil {
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		if err := uc.repo.RemoveCertificates(ctx, listener.ARN, remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncRules makes the rules of the listener match the desired rules. New and
+// changed rules are applied before undeclared rules are deleted.
+func (uc *ListenerUseCase) syncRules(ctx context.Context, listener *alb.Listener) error {
+	current, err := uc.repo.ListRules(ctx, listener.ARN)
+	if err != nil {
+		return err
+	}
+
+	changes := alb.DiffRules(listener.Rules, current)
+	for i := range changes.Create {
+		if err := uc.repo.CreateRule(ctx, listener.ARN, &changes.Create[i]); err != nil {
+			return err
+		}
+	}
+	for i := range changes.Modify {
+		if err := uc.repo.ModifyRule(ctx, &changes.Modify[i]); err != nil {
+			return err
+		}
+	}
+	for _, rule := range changes.Delete {
+		if err := uc.repo.DeleteRule(ctx, rule.ARN); err != nil {
+			return err
+		}
+	}
+
+	// Record the rule ARNs for the status
+	arns := map[int32]string{}
+	for _, rule := range current {
+		arns[rule.Priority] = rule.ARN
+	}
+	for _, rule := range changes.Create {
+		arns[rule.Priority] = rule.ARN
+	}
+	for i := range listener.Rules {
+		listener.Rules[i].ARN = arns[listener.Rules[i].Priority]
+	}
+	return nil
+}
+
+// DeleteListener deletes a listener and its rules
+func (uc *ListenerUseCase) DeleteListener(ctx context.Context, listener *alb.Listener) error {
+	if !listener.ShouldDelete() {
+		return nil
+	}
+
+	if listener.ARN == "" {
+		// Already deleted or never created
+		return nil
+	}
+
+	if err := uc.repo.DeleteListener(ctx, listener.ARN); err != nil {
+		return fmt.Errorf("failed to delete listener: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package alb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/alb"
+	"infra-operator/internal/ports"
+)
+
+type TargetGroupUseCase struct {
+	repo ports.TargetGroupRepository
+}
+
+func NewTargetGroupUseCase(repo ports.TargetGroupRepository) *TargetGroupUseCase {
+	return &TargetGroupUseCase{
+		repo: repo,
+	}
+}
+
+// SyncTargetGroup creates or updates a target group and its targets
+func (uc *TargetGroupUseCase) SyncTargetGroup(ctx context.Context, tg *alb.TargetGroup) error {
+	// Set defaults
+	tg.SetDefaults()
+
+	// Validate
+	if err := tg.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	current, err := uc.repo.GetTargetGroup(ctx, tg.Name)
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		if err := uc.repo.CreateTargetGroup(ctx, tg); err != nil {
+			return err
+		}
+	} else {
+		tg.ARN = current.ARN
+		tg.LoadBalancerARNs = current.LoadBalancerARNs
+
+		delayChanged := tg.DeregistrationDelay != nil &&
+			(current.DeregistrationDelay == nil || *tg.DeregistrationDelay != *current.DeregistrationDelay)
+		if tg.HealthCheckChanged(current.HealthCheck) || delayChanged {
+			if err := uc.repo.ModifyTargetGroup(ctx, tg); err != nil {
+				return err
+			}
+		}
+
+		if len(tg.Tags) > 0 {
+			if err := uc.repo.TagResource(ctx, tg.ARN, tg.Tags); err != nil {
+				return fmt.Errorf("failed to update target group tags: %w", err)
+			}
+		}
+	}
+
+	health, err := uc.repo.DescribeTargetHealth(ctx, tg.ARN)
+	if err != nil {
+		return err
+	}
+
+	if tg.ManagesTargets() {
+		registered := make([]alb.Target, 0, len(health))
+		for _, target := range health {
+			// Draining targets are already being deregistered
+			if target.State != alb.TargetStateDraining {
+				registered = append(registered, target.Target)
+			}
+		}
+
+		register, deregister := alb.DiffTargets(tg.Targets, registered, tg.Port)
+		if len(register) > 0 {
+			if err := uc.repo.RegisterTargets(ctx, tg.ARN, register); err != nil {
+				return err
+			}
+		}
+		if len(deregister) > 0 {
+			if err := uc.repo.DeregisterTargets(ctx, tg.ARN, deregister); err != nil {
+				return err
+			}
+		}
+		if len(register) > 0 || len(deregister) > 0 {
+			if health, err = uc.repo.DescribeTargetHealth(ctx, tg.ARN); err != nil {
+				return err
+			}
+		}
+	}
+
+	now := time.Now()
+	tg.TargetHealth = health
+	tg.LastSyncTime = &now
+	return nil
+}
+
+// DeleteTargetGroup deletes a target group
+func (uc *TargetGroupUseCase) DeleteTargetGroup(ctx context.Context, tg *alb.TargetGroup) error {
+	if !tg.ShouldDelete() {
+		return nil
+	}
+
+	if tg.ARN == "" {
+		// Already deleted or never created
+		return nil
+	}
+
+	if err := uc.repo.DeleteTargetGroup(ctx, tg.ARN); err != nil {
+		return fmt.Errorf("failed to delete target group: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package alb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/alb"
+	"infra-operator/internal/ports"
+)
+
+type TargetGroupBindingUseCase struct {
+	repo ports.TargetGroupRepository
+}
+
+func NewTargetGroupBindingUseCase(repo ports.TargetGroupRepository) *TargetGroupBindingUseCase {
+	return &TargetGroupBindingUseCase{
+		repo: repo,
+	}
+}
+
+// SyncBinding registers the desired targets and deregisters the ones the
+// binding registered before. The target group keeps deregistered targets
+// draining for its deregistration delay.
+func (uc *TargetGroupBindingUseCase) SyncBinding(ctx context.Context, binding *alb.TargetGroupBinding) error {
+	if err := binding.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	return uc.reconcileTargets(ctx, binding)
+}
+
+// DeleteBinding deregisters the targets the binding registered. The caller waits until
+// binding.Draining() is zero before letting the endpoints go; a deleted target
+// group has nothing left to drain.
+func (uc *TargetGroupBindingUseCase) DeleteBinding(ctx context.Context, binding *alb.TargetGroupBinding) error {
+	if binding.TargetGroupARN == "" {
+		// Never bound to a target group
+		return nil
+	}
+
+	binding.Targets = nil
+	err := uc.reconcileTargets(ctx, binding)
+	if errors.Is(err, alb.ErrTargetGroupNotFound) {
+		// The target group is gone along with its targets
+		binding.ManagedTargets = nil
+		binding.TargetHealth = nil
+		return nil
+	}
+	return err
+}
+
+func (uc *TargetGroupBindingUseCase) reconcileTargets(ctx context.Context, binding *alb.TargetGroupBinding) error {
+	health, err := uc.repo.DescribeTargetHealth(ctx, binding.TargetGroupARN)
+	if err != nil {
+		return err
+	}
+	if health == nil {
+		return fmt.Errorf("%w: %s", alb.ErrTargetGroupNotFound, binding.TargetGroupARN)
+	}
+
+	register, deregister := binding.DiffTargets(health)
+	if len(register) > 0 {
+		if err := uc.repo.RegisterTargets(ctx, binding.TargetGroupARN, register); err != nil {
+			return err
+		}
+	}
+	if len(deregister) > 0 {
+		if err := uc.repo.DeregisterTargets(ctx, binding.TargetGroupARN, deregister); err != nil {
+			return err
+		}
+	}
+	if len(register) > 0 || len(deregister) > 0 {
+		if health, err = uc.repo.DescribeTargetHealth(ctx, binding.TargetGroupARN); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	binding.RecordTargets(health)
+	binding.LastSyncTime = &now
+	return nil
+}
@@ -0,0 +1,169 @@
+package autoscaling
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/autoscaling"
+	"infra-operator/internal/ports"
+)
+
+type GroupUseCase struct {
+	repo ports.AutoScalingRepository
+}
+
+func NewGroupUseCase(repo ports.AutoScalingRepository) *GroupUseCase {
+	return &GroupUseCase{repo: repo}
+}
+
+// SyncGroup creates or updates an Auto Scaling group, its target groups and
+// lifecycle hooks, and refreshes its instances when the launch template changes
+func (uc *GroupUseCase) SyncGroup(ctx context.Context, group *autoscaling.Group) error {
+	// Set defaults
+	group.SetDefaults()
+
+	// Validate
+	if err := group.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	current, err := uc.repo.GetGroup(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		if err := uc.repo.CreateGroup(ctx, group); err != nil {
+			return err
+		}
+		// New instances already use the launch template
+		group.RefreshedTemplate = group.LaunchTemplate.Key()
+	} else {
+		if current.Deleting() {
+			return fmt.Errorf("auto scaling group %s is being deleted", group.Name)
+		}
+
+		needsRefresh := group.NeedsRefresh(current)
+		if needsRefresh {
+			if group.LatestRefresh, err = uc.repo.GetLatestInstanceRefresh(ctx, group.Name); err != nil {
+				return err
+			}
+		}
+		if group.ConfigChanged(current) {
+			if err := uc.repo.UpdateGroup(ctx, group); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.syncTargetGroups(ctx, group, current.TargetGroupARNs); err != nil {
+			return err
+		}
+		if err := uc.syncLifecycleHooks(ctx, group); err != nil {
+			return err
+		}
+
+		if len(group.Tags) > 0 {
+			if err := uc.repo.TagResource(ctx, group.Name, group.Tags); err != nil {
+				return fmt.Errorf("failed to update auto scaling group tags: %w", err)
+			}
+		}
+
+		switch {
+		case !needsRefresh:
+			group.RefreshedTemplate = group.LaunchTemplate.Key()
+		case group.RefreshActive():
+			// AWS runs one refresh at a time; the new launch template is
+			// rolled out once the current refresh ends
+		default:
+			if _, err := uc.repo.StartInstanceRefresh(ctx, group.Name, group.InstanceRefresh); err != nil {
+				return err
+			}
+			group.RefreshedTemplate = group.LaunchTemplate.Key()
+			group.RefreshStarted = true
+		}
+	}
+
+	updated, err := uc.repo.GetGroup(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+	if updated != nil {
+		group.ARN = updated.ARN
+		group.Status = updated.Status
+		group.CurrentCapacity = updated.CurrentCapacity
+		group.Instances = updated.Instances
+	}
+	if group.InstanceRefresh != nil {
+		if group.LatestRefresh, err = uc.repo.GetLatestInstanceRefresh(ctx, group.Name); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	group.LastSyncTime = &now
+	return nil
+}
+
+// syncTargetGroups attaches the declared target groups and detaches the others
+func (uc *GroupUseCase) syncTargetGroups(ctx context.Context, group *autoscaling.Group, current []string) error {
+	attach, detach := group.DiffTargetGroups(current)
+	if len(attach) > 0 {
+		if err := uc.repo.AttachTargetGroups(ctx, group.Name, attach); err != nil {
+			return err
+		}
+	}
+	if len(detach) > 0 {
+		if err := uc.repo.DetachTargetGroups(ctx, group.Name, detach); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncLifecycleHooks creates or updates the declared hooks and deletes the others
+func (uc *GroupUseCase) syncLifecycleHooks(ctx context.Context, group *autoscaling.Group) error {
+	current, err := uc.repo.ListLifecycleHooks(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+
+	put, remove := group.DiffLifecycleHooks(current)
+	for _, hook := range put {
+		if err := uc.repo.PutLifecycleHook(ctx, group.Name, hook); err != nil {
+			return err
+		}
+	}
+	for _, name := range remove {
+		if err := uc.repo.DeleteLifecycleHook(ctx, group.Name, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteGroup deletes an Auto Scaling group and terminates its instances.
+// Deletion is asynchronous: group.Deleting reports whether it is still running.
+func (uc *GroupUseCase) DeleteGroup(ctx context.Context, group *autoscaling.Group) error {
+	if !group.ShouldDelete() {
+		return nil
+	}
+
+	current, err := uc.repo.GetGroup(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		// Already deleted or never created
+		group.Status = ""
+		return nil
+	}
+
+	if !current.Deleting() {
+		if err := uc.repo.DeleteGroup(ctx, group.Name); err != nil {
+			return fmt.Errorf("failed to delete auto scaling group: %w", err)
+		}
+	}
+	group.Status = autoscaling.StatusDeleteInProgress
+	return nil
+}
@@ -38,6 +38,11 @@ func (uc *TableUseCase) SyncTable(ctx context.Context, table *dynamodb.Table) er
 		}
 	}
 
+	// Apply changes made after creation (indexes, billing mode, throughput, TTL)
+	if err := uc.updateTable(ctx, table); err != nil {
+		return err
+	}
+
 	// Get current state
 	current, err := uc.repo.Get(ctx, table.Name)
 	if err != nil {
@@ -70,11 +75,45 @@ func (uc *TableUseCase) SyncTable(ctx context.Context, table *dynamodb.Table) er
 	return nil
 }
 
+// updateTable diffs the desired table against AWS and applies the next change.
+// DynamoDB only accepts one UpdateTable call at a time, and index backfills can
+// take hours, so the remaining changes are left for the next reconciles and
+// UpdatePending is set until the table and its indexes are ACTIVE again.
+func (uc *TableUseCase) updateTable(ctx context.Context, table *dynamodb.Table) error {
+	current, err := uc.repo.Get(ctx, table.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get table: %w", err)
+	}
+
+	if !current.IsUpdatable() {
+		table.UpdatePending = true
+		return nil
+	}
+
+	if updates := table.Updates(current); len(updates) > 0 {
+		if err := uc.repo.Update(ctx, table.Name, updates[0]); err != nil {
+			return err
+		}
+		table.UpdatePending = true
+		return nil
+	}
+
+	ttl, pending := table.TimeToLiveUpdate(current)
+	if ttl != nil {
+		if err := uc.repo.UpdateTimeToLive(ctx, table.Name, ttl.AttributeName, ttl.Enabled); err != nil {
+			return fmt.Errorf("failed to update time to live: %w", err)
+		}
+	}
+	table.UpdatePending = pending
+
+	return nil
+}
+
 // DeleteTable removes a table
 func (uc *TableUseCase) DeleteTable(ctx context.Context, table *dynamodb.Table) error {
-	// Check deletion policy
-	if table.DeletionPolicy == "Retain" {
-		return nil // Don't delete
+	// Retain and Orphan keep the table in AWS
+	if !table.ShouldDelete() {
+		return nil
 	}
 
 	// Check if table exists
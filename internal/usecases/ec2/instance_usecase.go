@@ -40,6 +40,12 @@ func (uc *InstanceUseCase) SyncInstance(ctx context.Context, instance *ec2.Insta
 				return fmt.Errorf("failed to get instance: %w", err)
 			}
 
+			// Apply modifiable attributes and the desired state
+			changed, err := uc.updateInstance(ctx, instance, current)
+			if err != nil {
+				return err
+			}
+
 			// Update tags if changed
 			if len(instance.Tags) > 0 {
 				if err := uc.repo.TagResource(ctx, instance.InstanceID, instance.Tags); err != nil {
@@ -47,6 +53,14 @@ func (uc *InstanceUseCase) SyncInstance(ctx context.Context, instance *ec2.Insta
 				}
 			}
 
+			// Refresh the state after starting, stopping or modifying the instance
+			if changed {
+				current, err = uc.repo.Get(ctx, instance.InstanceID)
+				if err != nil {
+					return fmt.Errorf("failed to get instance: %w", err)
+				}
+			}
+
 			// Copy state from current
 			instance.InstanceState = current.InstanceState
 			instance.PrivateIP = current.PrivateIP
@@ -76,6 +90,66 @@ func (uc *InstanceUseCase) SyncInstance(ctx context.Context, instance *ec2.Insta
 	return nil
 }
 
+// updateInstance modifies the attributes that can change in place and starts
+// or stops the instance to reach its desired state. Fields that can only
+// change by replacing the instance are reported in ReplacementFields.
+// It returns whether the instance was changed.
+func (uc *InstanceUseCase) updateInstance(ctx context.Context, instance, current *ec2.Instance) (bool, error) {
+	instance.ReplacementFields = instance.ImmutableChanges(current)
+	plan := instance.Plan(current)
+	instance.RestartRequired = plan.RestartRequired
+
+	// Pending, stopping and shutting-down instances can't be modified,
+	// so wait for the next reconcile
+	if plan.Wait {
+		return false, nil
+	}
+
+	changed := false
+
+	if instance.SecurityGroupsChanged(current) {
+		if err := uc.repo.ModifySecurityGroups(ctx, instance.InstanceID, instance.SecurityGroupIDs); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	if instance.Monitoring != current.Monitoring {
+		if err := uc.repo.SetMonitoring(ctx, instance.InstanceID, instance.Monitoring); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	if instance.DisableAPITermination != current.DisableAPITermination {
+		if err := uc.repo.ModifyAPITermination(ctx, instance.InstanceID, instance.DisableAPITermination); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	if plan.ModifyType {
+		if err := uc.repo.ModifyInstanceType(ctx, instance.InstanceID, instance.InstanceType); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if plan.Start {
+		if err := uc.repo.StartInstance(ctx, instance.InstanceID); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if plan.Stop {
+		if err := uc.repo.StopInstance(ctx, instance.InstanceID); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+
+	return changed, nil
+}
+
 func (uc *InstanceUseCase) DeleteInstance(ctx context.Context, instance *ec2.Instance) error {
 	if instance.ShouldStop() {
 		// Stop instance instead of terminating
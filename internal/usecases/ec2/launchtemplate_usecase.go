@@ -0,0 +1,88 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/ec2"
+	"infra-operator/internal/ports"
+)
+
+type LaunchTemplateUseCase struct {
+	repo ports.LaunchTemplateRepository
+}
+
+func NewLaunchTemplateUseCase(repo ports.LaunchTemplateRepository) *LaunchTemplateUseCase {
+	return &LaunchTemplateUseCase{repo: repo}
+}
+
+// SyncLaunchTemplate creates a launch template, or a new version when its data changed
+func (uc *LaunchTemplateUseCase) SyncLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error {
+	// Set defaults
+	lt.SetDefaults()
+
+	// Validate
+	if err := lt.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	current, err := uc.repo.GetLaunchTemplate(ctx, lt.Name)
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		if err := uc.repo.CreateLaunchTemplate(ctx, lt); err != nil {
+			return err
+		}
+	} else {
+		lt.ID = current.ID
+		lt.LatestVersion = current.LatestVersion
+		lt.DefaultVersion = current.DefaultVersion
+
+		// Versions are immutable, so changes are rolled out as a new version
+		if lt.DataChanged(current.Data) {
+			version, err := uc.repo.CreateLaunchTemplateVersion(ctx, lt)
+			if err != nil {
+				return err
+			}
+			lt.LatestVersion = version
+		}
+
+		if lt.UpdateDefaultVersion && lt.DefaultVersion != lt.LatestVersion {
+			if err := uc.repo.SetDefaultVersion(ctx, lt.ID, lt.LatestVersion); err != nil {
+				return err
+			}
+			lt.DefaultVersion = lt.LatestVersion
+		}
+
+		if len(lt.Tags) > 0 {
+			if err := uc.repo.TagLaunchTemplate(ctx, lt.ID, lt.Tags); err != nil {
+				return fmt.Errorf("failed to update launch template tags: %w", err)
+			}
+		}
+	}
+
+	now := time.Now()
+	lt.LastSyncTime = &now
+	return nil
+}
+
+// DeleteLaunchTemplate deletes a launch template
+func (uc *LaunchTemplateUseCase) DeleteLaunchTemplate(ctx context.Context, lt *ec2.LaunchTemplate) error {
+	if !lt.ShouldDelete() {
+		return nil
+	}
+
+	if lt.ID == "" {
+		// Already deleted or never created
+		return nil
+	}
+
+	if err := uc.repo.DeleteLaunchTemplate(ctx, lt.ID); err != nil {
+		return fmt.Errorf("failed to delete launch template: %w", err)
+	}
+
+	return nil
+}
@@ -104,6 +104,10 @@ func (uc *RepositoryUseCase) SyncRepository(ctx context.Context, repository *ecr
 }
 
 func (uc *RepositoryUseCase) DeleteRepository(ctx context.Context, repository *ecr.Repository) error {
+	if !repository.ShouldDelete() {
+		return nil
+	}
+
 	// Check if repository exists
 	exists, err := uc.repo.Exists(ctx, repository.RepositoryName)
 	if err != nil {
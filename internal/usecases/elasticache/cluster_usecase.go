@@ -26,7 +26,7 @@ func (uc *ClusterUseCase) SyncCluster(ctx context.Context, cluster *elasticache.
 	}
 
 	// Determine if this is a Redis replication group or single cache cluster
-	isRedisCluster := cluster.IsRedis() && (cluster.NumNodeGroups > 0 || cluster.NumCacheNodes > 1 || cluster.AutomaticFailoverEnabled)
+	isRedisCluster := cluster.IsReplicationGroup()
 
 	// Check if cluster already exists
 	exists, err := uc.repo.Exists(ctx, cluster.ClusterID, isRedisCluster)
@@ -87,7 +87,7 @@ func (uc *ClusterUseCase) DeleteCluster(ctx context.Context, cluster *elasticach
 	}
 
 	// Determine if this is a Redis replication group
-	isRedisCluster := cluster.IsRedis() && (cluster.NumNodeGroups > 0 || cluster.NumCacheNodes > 1 || cluster.AutomaticFailoverEnabled)
+	isRedisCluster := cluster.IsReplicationGroup()
 
 	if isRedisCluster {
 		return uc.repo.DeleteReplicationGroup(ctx, cluster.ClusterID, finalSnapshotID)
@@ -0,0 +1,88 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/lambda"
+	"infra-operator/internal/ports"
+)
+
+// AliasUseCase implements business logic for Lambda aliases
+type AliasUseCase struct {
+	repo   ports.LambdaAliasRepository
+	alarms ports.CloudWatchAlarmRepository
+}
+
+// NewAliasUseCase creates a new Lambda alias use case
+func NewAliasUseCase(repo ports.LambdaAliasRepository, alarms ports.CloudWatchAlarmRepository) ports.LambdaAliasUseCase {
+	return &AliasUseCase{
+		repo:   repo,
+		alarms: alarms,
+	}
+}
+
+// SyncAlias creates or updates a Lambda alias and advances its traffic shift
+func (uc *AliasUseCase) SyncAlias(ctx context.Context, alias *lambda.Alias) error {
+	// Validate alias configuration
+	if err := alias.Validate(); err != nil {
+		return fmt.Errorf("invalid alias configuration: %w", err)
+	}
+
+	// Set defaults for optional fields
+	alias.SetDefaults()
+
+	current, err := uc.repo.Get(ctx, alias.FunctionName, alias.Name)
+	if err != nil {
+		return err
+	}
+
+	// Roll a shift in progress back as soon as one of its alarms fires
+	var firingAlarm string
+	if alias.ShiftInProgress() {
+		if firingAlarm, err = uc.alarms.FiringAlarm(ctx, alias.TrafficShift.AlarmNames); err != nil {
+			return fmt.Errorf("failed to check traffic shift alarms: %w", err)
+		}
+	}
+
+	now := time.Now()
+	version, routing := alias.Target(current, firingAlarm, now)
+
+	if current == nil {
+		if err := uc.repo.Create(ctx, alias, version, routing); err != nil {
+			return err
+		}
+	} else {
+		alias.ARN = current.ARN
+		alias.RevisionID = current.RevisionID
+		if version != current.FunctionVersion || alias.Description != current.Description || !routingEqual(routing, current.Routing) {
+			if err := uc.repo.Update(ctx, alias, version, routing); err != nil {
+				return err
+			}
+		}
+	}
+
+	alias.ActiveVersion = version
+	alias.ActiveRouting = routing
+	alias.LastSyncTime = &now
+	return nil
+}
+
+// DeleteAlias deletes a Lambda alias
+func (uc *AliasUseCase) DeleteAlias(ctx context.Context, alias *lambda.Alias) error {
+	if !alias.ShouldDelete() {
+		return nil
+	}
+	if err := uc.repo.Delete(ctx, alias.FunctionName, alias.Name); err != nil {
+		return err
+	}
+	return nil
+}
+
+func routingEqual(a, b *lambda.AliasRouting) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
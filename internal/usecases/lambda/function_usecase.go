@@ -10,13 +10,15 @@ import (
 
 // FunctionUseCase implements business logic for Lambda functions
 type FunctionUseCase struct {
-	repo ports.LambdaRepository
+	repo      ports.LambdaRepository
+	artifacts ports.LambdaCodeArtifactRepository
 }
 
 // NewFunctionUseCase creates a new Lambda function use case
-func NewFunctionUseCase(repo ports.LambdaRepository) ports.LambdaUseCase {
+func NewFunctionUseCase(repo ports.LambdaRepository, artifacts ports.LambdaCodeArtifactRepository) ports.LambdaUseCase {
 	return &FunctionUseCase{
-		repo: repo,
+		repo:      repo,
+		artifacts: artifacts,
 	}
 }
 
@@ -36,11 +38,21 @@ func (uc *FunctionUseCase) SyncFunction(ctx context.Context, function *lambda.Fu
 		return fmt.Errorf("failed to check function existence: %w", err)
 	}
 
+	// Build or download the deployment package and compute its hash
+	deployedHash := ""
+	if exists {
+		deployedHash = function.CodeHash
+	}
+	if err := uc.packageCode(ctx, &function.Code, deployedHash); err != nil {
+		return fmt.Errorf("failed to package function code: %w", err)
+	}
+
 	if !exists {
 		// Create new function
 		if err := uc.repo.Create(ctx, function); err != nil {
 			return fmt.Errorf("failed to create function: %w", err)
 		}
+		function.CodeHash = function.Code.Hash
 
 		// Tag the function
 		if len(function.Tags) > 0 && function.ARN != "" {
@@ -49,7 +61,7 @@ func (uc *FunctionUseCase) SyncFunction(ctx context.Context, function *lambda.Fu
 			}
 		}
 
-		return nil
+		return uc.syncTriggers(ctx, function)
 	}
 
 	// Function exists - get current state
@@ -62,11 +74,12 @@ func (uc *FunctionUseCase) SyncFunction(ctx context.Context, function *lambda.Fu
 	function.ARN = currentFunction.ARN
 
 	// Check if code needs updating
-	if uc.codeNeedsUpdate(function.Code, currentFunction.Code) {
+	if uc.codeNeedsUpdate(function, currentFunction) {
 		if err := uc.repo.UpdateCode(ctx, function); err != nil {
 			return fmt.Errorf("failed to update function code: %w", err)
 		}
 	}
+	function.CodeHash = function.Code.Hash
 
 	// Check if configuration needs updating
 	if uc.configNeedsUpdate(function, currentFunction) {
@@ -80,6 +93,11 @@ func (uc *FunctionUseCase) SyncFunction(ctx context.Context, function *lambda.Fu
 		return fmt.Errorf("failed to sync tags: %w", err)
 	}
 
+	// Sync event sources and permissions
+	if err := uc.syncTriggers(ctx, function); err != nil {
+		return err
+	}
+
 	// Get updated function state
 	updatedFunction, err := uc.repo.Get(ctx, function.Name)
 	if err != nil {
@@ -89,15 +107,50 @@ func (uc *FunctionUseCase) SyncFunction(ctx context.Context, function *lambda.Fu
 	// Update function with latest state
 	function.State = updatedFunction.State
 	function.StateReason = updatedFunction.StateReason
+	function.LastUpdateStatus = updatedFunction.LastUpdateStatus
 	function.LastModified = updatedFunction.LastModified
 	function.Version = updatedFunction.Version
 	function.CodeSize = updatedFunction.CodeSize
+	function.CodeSha256 = updatedFunction.CodeSha256
+
+	if function.Publish {
+		if err := uc.publish(ctx, function); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publish publishes a version once the function is no longer being updated;
+// until then it is retried on the next sync
+func (uc *FunctionUseCase) publish(ctx context.Context, function *lambda.Function) error {
+	if function.Publishable() {
+		version, err := uc.repo.PublishVersion(ctx, function.Name, function.CodeSha256)
+		if err != nil {
+			return fmt.Errorf("failed to publish version: %w", err)
+		}
+		function.PublishedVersion = version
+	}
+
+	versions, err := uc.repo.ListVersions(ctx, function.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list versions: %w", err)
+	}
+	function.Versions = lambda.NewestVersions(versions, lambda.MaxListedVersions)
+	if function.PublishedVersion == "" && len(function.Versions) > 0 {
+		function.PublishedVersion = function.Versions[0].Version
+	}
 
 	return nil
 }
 
 // DeleteFunction deletes a Lambda function
 func (uc *FunctionUseCase) DeleteFunction(ctx context.Context, function *lambda.Function) error {
+	if !function.ShouldDelete() {
+		return nil
+	}
+
 	// Check if function exists
 	exists, err := uc.repo.Exists(ctx, function.Name)
 	if err != nil {
@@ -109,6 +162,17 @@ func (uc *FunctionUseCase) DeleteFunction(ctx context.Context, function *lambda.
 		return nil
 	}
 
+	// Event source mappings outlive their function, so delete them first
+	mappings, err := uc.repo.ListEventSourceMappings(ctx, function.Name)
+	if err != nil {
+		return err
+	}
+	for _, mapping := range mappings {
+		if err := uc.repo.DeleteEventSourceMapping(ctx, mapping.UUID); err != nil {
+			return err
+		}
+	}
+
 	// Delete the function
 	if err := uc.repo.Delete(ctx, function.Name); err != nil {
 		return fmt.Errorf("failed to delete function: %w", err)
@@ -119,18 +183,35 @@ func (uc *FunctionUseCase) DeleteFunction(ctx context.Context, function *lambda.
 
 // Helper methods
 
-func (uc *FunctionUseCase) codeNeedsUpdate(desired, current lambda.Code) bool {
-	// Compare code sources - if any source is different, update is needed
-	if desired.ZipFile != "" && desired.ZipFile != current.ZipFile {
-		return true
-	}
-	if desired.S3Bucket != "" && (desired.S3Bucket != current.S3Bucket || desired.S3Key != current.S3Key) {
-		return true
+// packageCode fetches the layer of an OCI artifact and packages the code.
+// The layer is only downloaded when the manifest digest differs from the
+// deployed code.
+func (uc *FunctionUseCase) packageCode(ctx context.Context, code *lambda.Code, deployedHash string) error {
+	var layer []byte
+	if code.OCI != nil {
+		digest, err := uc.artifacts.ResolveDigest(ctx, *code.OCI)
+		if err != nil {
+			return err
+		}
+		code.SetDigest(digest)
+		if code.Hash == deployedHash {
+			return nil
+		}
+		if layer, err = uc.artifacts.FetchLayer(ctx, code.OCI.Pinned(digest)); err != nil {
+			return err
+		}
 	}
-	if desired.ImageUri != "" && desired.ImageUri != current.ImageUri {
-		return true
+	return code.Package(layer)
+}
+
+// codeNeedsUpdate compares the hash of the desired code with the deployed one.
+// Lambda reports the hash of uploaded packages; OCI artifacts, S3 objects and
+// images are compared with the reference deployed last.
+func (uc *FunctionUseCase) codeNeedsUpdate(desired, current *lambda.Function) bool {
+	if desired.Code.Archive != nil && desired.Code.OCI == nil {
+		return desired.Code.Hash != current.CodeSha256
 	}
-	return false
+	return desired.Code.Hash != desired.CodeHash
 }
 
 func (uc *FunctionUseCase) configNeedsUpdate(desired, current *lambda.Function) bool {
@@ -210,6 +291,151 @@ func (uc *FunctionUseCase) syncTags(ctx context.Context, desired, current *lambd
 	return nil
 }
 
+// syncTriggers creates, updates and removes the event source mappings and
+// permissions of the function. Mappings and statements created outside the
+// operator are left alone.
+func (uc *FunctionUseCase) syncTriggers(ctx context.Context, function *lambda.Function) error {
+	if err := uc.syncEventSources(ctx, function); err != nil {
+		return fmt.Errorf("failed to sync event sources: %w", err)
+	}
+	if err := uc.syncPermissions(ctx, function); err != nil {
+		return fmt.Errorf("failed to sync permissions: %w", err)
+	}
+	return nil
+}
+
+func (uc *FunctionUseCase) syncEventSources(ctx context.Context, function *lambda.Function) error {
+	if len(function.EventSources) == 0 && len(function.ManagedEventSources) == 0 {
+		return nil
+	}
+
+	current, err := uc.repo.ListEventSourceMappings(ctx, function.Name)
+	if err != nil {
+		return err
+	}
+	currentBySource := make(map[string]lambda.EventSourceMapping, len(current))
+	for _, mapping := range current {
+		currentBySource[mapping.EventSourceARN] = mapping
+	}
+
+	managed := make([]string, 0, len(function.EventSources))
+	wanted := make(map[string]bool, len(function.EventSources))
+	for i := range function.EventSources {
+		desired := &function.EventSources[i]
+		managed = append(managed, desired.EventSourceARN)
+		wanted[desired.EventSourceARN] = true
+
+		existing, exists := currentBySource[desired.EventSourceARN]
+		if !exists {
+			if err := uc.repo.CreateEventSourceMapping(ctx, function.Name, desired); err != nil {
+				return err
+			}
+			continue
+		}
+
+		desired.UUID = existing.UUID
+		desired.State = existing.State
+		desired.StateTransitionReason = existing.StateTransitionReason
+
+		// A mapping in transition rejects changes; it is updated on the next sync
+		if !existing.Busy() && eventSourceNeedsUpdate(desired, &existing) {
+			if err := uc.repo.UpdateEventSourceMapping(ctx, function.Name, desired); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Delete the mappings removed from the spec
+	for _, source := range function.ManagedEventSources {
+		if wanted[source] {
+			continue
+		}
+		if existing, exists := currentBySource[source]; exists {
+			if err := uc.repo.DeleteEventSourceMapping(ctx, existing.UUID); err != nil {
+				return err
+			}
+		}
+	}
+
+	function.ManagedEventSources = managed
+	return nil
+}
+
+func (uc *FunctionUseCase) syncPermissions(ctx context.Context, function *lambda.Function) error {
+	if len(function.Permissions) == 0 && len(function.ManagedPermissions) == 0 {
+		return nil
+	}
+
+	current, err := uc.repo.ListPermissions(ctx, function.Name)
+	if err != nil {
+		return err
+	}
+	currentByID := make(map[string]lambda.Permission, len(current))
+	for _, permission := range current {
+		currentByID[permission.StatementID] = permission
+	}
+
+	managed := make([]string, 0, len(function.Permissions))
+	wanted := make(map[string]bool, len(function.Permissions))
+	for _, desired := range function.Permissions {
+		managed = append(managed, desired.StatementID)
+		wanted[desired.StatementID] = true
+
+		existing, exists := currentByID[desired.StatementID]
+		if exists && existing == desired {
+			continue
+		}
+		// Statements cannot be updated, only replaced
+		if exists {
+			if err := uc.repo.RemovePermission(ctx, function.Name, desired.StatementID); err != nil {
+				return err
+			}
+		}
+		if err := uc.repo.AddPermission(ctx, function.Name, desired); err != nil {
+			return err
+		}
+	}
+
+	// Remove the statements removed from the spec
+	for _, statementID := range function.ManagedPermissions {
+		if wanted[statementID] {
+			continue
+		}
+		if _, exists := currentByID[statementID]; exists {
+			if err := uc.repo.RemovePermission(ctx, function.Name, statementID); err != nil {
+				return err
+			}
+		}
+	}
+
+	function.ManagedPermissions = managed
+	return nil
+}
+
+func eventSourceNeedsUpdate(desired, current *lambda.EventSourceMapping) bool {
+	if desired.BatchSize != 0 && desired.BatchSize != current.BatchSize {
+		return true
+	}
+	if desired.MaximumBatchingWindowInSeconds != current.MaximumBatchingWindowInSeconds {
+		return true
+	}
+	if desired.Enabled != current.Enabled {
+		return true
+	}
+	if !slicesEqual(desired.FilterPatterns, current.FilterPatterns) {
+		return true
+	}
+	if desired.IsStream() {
+		if desired.OnFailureDestinationARN != current.OnFailureDestinationARN {
+			return true
+		}
+		if desired.MaximumRetryAttempts != nil && (current.MaximumRetryAttempts == nil || *desired.MaximumRetryAttempts != *current.MaximumRetryAttempts) {
+			return true
+		}
+	}
+	return false
+}
+
 // Utility functions
 
 func mapsEqual(a, b map[string]string) bool {
@@ -0,0 +1,242 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type ClusterUseCase struct {
+	repo ports.RDSClusterRepository
+}
+
+func NewClusterUseCase(repo ports.RDSClusterRepository) ports.RDSClusterUseCase {
+	return &ClusterUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ClusterUseCase) SyncCluster(ctx context.Context, cluster *rds.DBCluster) error {
+	// Set defaults before validation
+	cluster.SetDefaults()
+
+	// Validate the cluster
+	if err := cluster.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Check if cluster exists
+	exists, err := uc.repo.Exists(ctx, cluster.DBClusterIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to check if cluster exists: %w", err)
+	}
+
+	if !exists {
+		if err := uc.create(ctx, cluster); err != nil {
+			return err
+		}
+	} else {
+		existing, err := uc.repo.Get(ctx, cluster.DBClusterIdentifier)
+		if err != nil {
+			return fmt.Errorf("failed to get existing DB cluster: %w", err)
+		}
+
+		// RDS rejects modifications unless the cluster is available, so they are
+		// retried on the next sync; the instances are only added to an available cluster
+		if existing.IsAvailable() {
+			if err := uc.syncGlobalCluster(ctx, cluster, existing); err != nil {
+				return err
+			}
+
+			modification, err := cluster.Modifications(existing)
+			if err != nil {
+				return fmt.Errorf("failed to plan DB cluster modification: %w", err)
+			}
+			if modification != nil {
+				if err := uc.repo.Modify(ctx, cluster.DBClusterIdentifier, modification); err != nil {
+					return fmt.Errorf("failed to update DB cluster: %w", err)
+				}
+			}
+
+			if err := uc.syncInstances(ctx, cluster, existing); err != nil {
+				return err
+			}
+
+			if existing, err = uc.repo.Get(ctx, cluster.DBClusterIdentifier); err != nil {
+				return fmt.Errorf("failed to get existing DB cluster: %w", err)
+			}
+		}
+
+		// Update cluster ARN and status from existing
+		cluster.DBClusterArn = existing.DBClusterArn
+		cluster.Status = existing.Status
+		cluster.Endpoint = existing.Endpoint
+		cluster.ReaderEndpoint = existing.ReaderEndpoint
+		cluster.Port = existing.Port
+		cluster.EngineVersion = existing.EngineVersion
+		cluster.PendingModifications = existing.PendingModifications
+		cluster.GlobalClusterRole = existing.GlobalClusterRole
+		cluster.Members = existing.Members
+
+		// Update tags if they differ
+		if len(cluster.Tags) > 0 {
+			if err := uc.repo.TagResource(ctx, cluster.DBClusterArn, cluster.Tags); err != nil {
+				return fmt.Errorf("failed to update tags: %w", err)
+			}
+		}
+	}
+
+	// Update last sync time
+	now := time.Now()
+	cluster.LastSyncTime = &now
+
+	return nil
+}
+
+// create creates the cluster standalone, or as a secondary when its global
+// database already exists. A new global database is created from the cluster
+// once it is available.
+func (uc *ClusterUseCase) create(ctx context.Context, cluster *rds.DBCluster) error {
+	secondary := false
+	if cluster.GlobalClusterIdentifier != "" {
+		global, err := uc.repo.GetGlobalCluster(ctx, cluster.GlobalClusterIdentifier)
+		if err != nil {
+			return err
+		}
+		secondary = global != nil
+	}
+	if !secondary {
+		if err := cluster.ValidateCredentials(); err != nil {
+			return fmt.Errorf("validation failed: %w", err)
+		}
+	}
+
+	if err := uc.repo.Create(ctx, cluster, secondary); err != nil {
+		return fmt.Errorf("failed to create DB cluster: %w", err)
+	}
+	if secondary {
+		cluster.GlobalClusterRole = rds.GlobalClusterRoleSecondary
+	}
+	return nil
+}
+
+// syncGlobalCluster creates the global database from the cluster, or detaches
+// the cluster from it, to match cluster.GlobalClusterIdentifier
+func (uc *ClusterUseCase) syncGlobalCluster(ctx context.Context, cluster, existing *rds.DBCluster) error {
+	switch {
+	case cluster.GlobalClusterIdentifier == existing.GlobalClusterIdentifier:
+		return nil
+	case cluster.GlobalClusterIdentifier == "":
+		if err := uc.repo.RemoveFromGlobalCluster(ctx, existing.GlobalClusterIdentifier, existing.DBClusterArn); err != nil {
+			return err
+		}
+		existing.GlobalClusterRole = ""
+		return nil
+	case existing.GlobalClusterIdentifier != "":
+		return rds.ErrGlobalClusterChanged
+	}
+
+	global, err := uc.repo.GetGlobalCluster(ctx, cluster.GlobalClusterIdentifier)
+	if err != nil {
+		return err
+	}
+	if global != nil {
+		return rds.ErrGlobalClusterJoin
+	}
+	if err := uc.repo.CreateGlobalCluster(ctx, cluster.GlobalClusterIdentifier, existing.DBClusterArn); err != nil {
+		return err
+	}
+	existing.GlobalClusterRole = rds.GlobalClusterRolePrimary
+	return nil
+}
+
+// syncInstances creates, resizes and removes the cluster instances to match
+// the writer and reader sets
+func (uc *ClusterUseCase) syncInstances(ctx context.Context, cluster, existing *rds.DBCluster) error {
+	create, modify, remove := cluster.InstanceChanges(existing.Members)
+
+	for _, instance := range create {
+		if err := uc.repo.CreateInstance(ctx, cluster, instance); err != nil {
+			return err
+		}
+	}
+	for _, instance := range modify {
+		if err := uc.repo.ModifyInstanceClass(ctx, instance.DBInstanceIdentifier, instance.InstanceClass, cluster.ApplyImmediately); err != nil {
+			return err
+		}
+	}
+	for _, id := range remove {
+		if err := uc.repo.DeleteInstance(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *ClusterUseCase) DeleteCluster(ctx context.Context, cluster *rds.DBCluster) error {
+	// Retain and Orphan keep the database in AWS
+	if !cluster.ShouldDelete() {
+		return nil
+	}
+
+	// Check if cluster exists
+	exists, err := uc.repo.Exists(ctx, cluster.DBClusterIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to check if cluster exists: %w", err)
+	}
+
+	if !exists {
+		// Already deleted
+		return nil
+	}
+
+	existing, err := uc.repo.Get(ctx, cluster.DBClusterIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to get existing DB cluster: %w", err)
+	}
+	if existing.DeletionProtection {
+		return fmt.Errorf("%w: set spec.deletionProtection to false before deleting", rds.ErrClusterDeletionProtected)
+	}
+
+	// A member of a global database is detached first; the last member also
+	// deletes the global database. Detaching is asynchronous, so a failed
+	// deletion of the global database is retried.
+	if existing.GlobalClusterIdentifier != "" {
+		if err := uc.repo.RemoveFromGlobalCluster(ctx, existing.GlobalClusterIdentifier, existing.DBClusterArn); err != nil {
+			return err
+		}
+	}
+	if id := cluster.GlobalClusterIdentifier; id != "" {
+		global, err := uc.repo.GetGlobalCluster(ctx, id)
+		if err != nil {
+			return err
+		}
+		if global != nil && !global.HasOtherMembers(existing.DBClusterArn) {
+			if err := uc.repo.DeleteGlobalCluster(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	// RDS only deletes a cluster without instances
+	for _, member := range existing.Members {
+		if err := uc.repo.DeleteInstance(ctx, member.DBInstanceIdentifier); err != nil {
+			return err
+		}
+	}
+
+	// Determine skip final snapshot based on deletion policy
+	skipFinalSnapshot := cluster.SkipFinalSnapshot
+	if cluster.DeletionPolicy == "Delete" {
+		skipFinalSnapshot = true
+	}
+
+	if err := uc.repo.Delete(ctx, cluster.DBClusterIdentifier, skipFinalSnapshot); err != nil {
+		return fmt.Errorf("failed to delete DB cluster: %w", err)
+	}
+
+	return nil
+}
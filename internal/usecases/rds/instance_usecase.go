@@ -36,8 +36,8 @@ func (uc *InstanceUseCase) SyncDBInstance(ctx context.Context, instance *rds.DBI
 
 	if !exists {
 		// Create new instance
-		if err := uc.repo.Create(ctx, instance); err != nil {
-			return fmt.Errorf("failed to create DB instance: %w", err)
+		if err := uc.create(ctx, instance); err != nil {
+			return err
 		}
 
 		// Tag the resource if ARN is available and tags are provided
@@ -53,33 +53,49 @@ func (uc *InstanceUseCase) SyncDBInstance(ctx context.Context, instance *rds.DBI
 			return fmt.Errorf("failed to get existing DB instance: %w", err)
 		}
 
+		// Modify the instance while it is available; RDS rejects modifications
+		// in any other state, so they are retried on the next sync
+		switch {
+		case !existing.IsAvailable():
+			// Wait for the instance to become available
+		case instance.NeedsPromotion(existing):
+			// Promotion reboots the instance; the other changes wait for it
+			if err := uc.repo.PromoteReadReplica(ctx, instance); err != nil {
+				return fmt.Errorf("failed to promote DB instance: %w", err)
+			}
+			existing.Status = instance.Status
+			existing.ReadReplicaSource = ""
+		case instance.MasterPasswordPending:
+			// A restored instance starts with the password of its source
+			if existing, err = uc.repo.Modify(ctx, instance.DBInstanceIdentifier, &rds.Modification{
+				MasterUserPassword: instance.MasterPassword,
+				ApplyImmediately:   true,
+				Fields:             []string{"masterUserPassword"},
+			}); err != nil {
+				return fmt.Errorf("failed to set master password: %w", err)
+			}
+			instance.MasterPasswordPending = false
+		default:
+			modification, err := instance.Modifications(existing)
+			if err != nil {
+				return fmt.Errorf("failed to plan DB instance modification: %w", err)
+			}
+			if modification != nil {
+				if existing, err = uc.repo.Modify(ctx, instance.DBInstanceIdentifier, modification); err != nil {
+					return fmt.Errorf("failed to update DB instance: %w", err)
+				}
+			}
+		}
+
 		// Update instance ARN and status from existing
 		instance.DBInstanceArn = existing.DBInstanceArn
 		instance.Status = existing.Status
 		instance.Endpoint = existing.Endpoint
-
-		// Check if update is needed (compare modifiable fields)
-		needsUpdate := false
-
-		if existing.AllocatedStorage != instance.AllocatedStorage {
-			needsUpdate = true
-		}
-		if existing.DBInstanceClass != instance.DBInstanceClass {
-			needsUpdate = true
-		}
-		if existing.BackupRetentionPeriod != instance.BackupRetentionPeriod {
-			needsUpdate = true
-		}
-		if existing.PreferredBackupWindow != instance.PreferredBackupWindow {
-			needsUpdate = true
-		}
-
-		// Perform update if needed
-		if needsUpdate {
-			if err := uc.repo.Update(ctx, instance); err != nil {
-				return fmt.Errorf("failed to update DB instance: %w", err)
-			}
-		}
+		instance.EngineVersion = existing.EngineVersion
+		instance.AllocatedStorage = existing.AllocatedStorage
+		instance.ParameterApplyStatus = existing.ParameterApplyStatus
+		instance.PendingModifications = existing.PendingModifications
+		instance.RecordReplicaSource(existing)
 
 		// Update tags if they differ
 		if len(instance.Tags) > 0 {
@@ -96,7 +112,38 @@ func (uc *InstanceUseCase) SyncDBInstance(ctx context.Context, instance *rds.DBI
 	return nil
 }
 
+// create creates the instance empty, restored from its restore source or as a
+// read replica
+func (uc *InstanceUseCase) create(ctx context.Context, instance *rds.DBInstance) error {
+	switch {
+	case instance.ReplicateFrom != "":
+		if err := uc.repo.CreateReadReplica(ctx, instance); err != nil {
+			return fmt.Errorf("failed to create DB instance read replica: %w", err)
+		}
+		instance.ReadReplicaSource = instance.ReplicateFrom
+	case instance.RestoreFrom != nil:
+		restore := uc.repo.RestoreFromSnapshot
+		if instance.RestoreFrom.IsPointInTime() {
+			restore = uc.repo.RestoreToPointInTime
+		}
+		if err := restore(ctx, instance); err != nil {
+			return fmt.Errorf("failed to restore DB instance: %w", err)
+		}
+		instance.MasterPasswordPending = instance.MasterPassword != ""
+	default:
+		if err := uc.repo.Create(ctx, instance); err != nil {
+			return fmt.Errorf("failed to create DB instance: %w", err)
+		}
+	}
+	return nil
+}
+
 func (uc *InstanceUseCase) DeleteDBInstance(ctx context.Context, instance *rds.DBInstance) error {
+	// Retain and Orphan keep the database in AWS
+	if !instance.ShouldDelete() {
+		return nil
+	}
+
 	// Check if instance exists
 	exists, err := uc.repo.Exists(ctx, instance.DBInstanceIdentifier)
 	if err != nil {
@@ -108,6 +155,14 @@ func (uc *InstanceUseCase) DeleteDBInstance(ctx context.Context, instance *rds.D
 		return nil
 	}
 
+	existing, err := uc.repo.Get(ctx, instance.DBInstanceIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to get existing DB instance: %w", err)
+	}
+	if existing.DeletionProtection {
+		return fmt.Errorf("%w: set spec.deletionProtection to false before deleting", rds.ErrDeletionProtected)
+	}
+
 	// Determine skip final snapshot based on deletion policy
 	skipFinalSnapshot := instance.SkipFinalSnapshot
 	if instance.DeletionPolicy == "Delete" {
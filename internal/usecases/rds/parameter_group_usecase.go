@@ -0,0 +1,78 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type ParameterGroupUseCase struct {
+	repo ports.RDSParameterGroupRepository
+}
+
+func NewParameterGroupUseCase(repo ports.RDSParameterGroupRepository) ports.RDSParameterGroupUseCase {
+	return &ParameterGroupUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *ParameterGroupUseCase) SyncParameterGroup(ctx context.Context, group *rds.DBParameterGroup) error {
+	group.SetDefaults()
+
+	if err := group.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	exists, err := uc.repo.Exists(ctx, group.Name)
+	if err != nil {
+		return fmt.Errorf("failed to check if DB parameter group exists: %w", err)
+	}
+
+	current := map[string]string{}
+	if !exists {
+		if err := uc.repo.Create(ctx, group); err != nil {
+			return fmt.Errorf("failed to create DB parameter group: %w", err)
+		}
+	} else {
+		existing, err := uc.repo.Get(ctx, group.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get existing DB parameter group: %w", err)
+		}
+		group.ARN = existing.ARN
+		current = existing.Parameters
+
+		if err := uc.repo.TagResource(ctx, group.ARN, group.Tags); err != nil {
+			return fmt.Errorf("failed to update tags: %w", err)
+		}
+	}
+
+	// Static parameters are applied with pending-reboot; instances using the
+	// group report it in status.parameterApplyStatus until they are rebooted
+	modify, reset := group.ParameterChanges(current)
+	if err := uc.repo.ModifyParameters(ctx, group.Name, modify); err != nil {
+		return err
+	}
+	if err := uc.repo.ResetParameters(ctx, group.Name, reset); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	group.LastSyncTime = &now
+
+	return nil
+}
+
+func (uc *ParameterGroupUseCase) DeleteParameterGroup(ctx context.Context, group *rds.DBParameterGroup) error {
+	if !group.ShouldDelete() {
+		return nil
+	}
+
+	if err := uc.repo.Delete(ctx, group.Name); err != nil {
+		return fmt.Errorf("failed to delete DB parameter group: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type SnapshotUseCase struct {
+	repo ports.RDSRepository
+}
+
+func NewSnapshotUseCase(repo ports.RDSRepository) ports.RDSSnapshotUseCase {
+	return &SnapshotUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *SnapshotUseCase) SyncSnapshots(ctx context.Context, plan *rds.DBSnapshotPlan) error {
+	plan.SetDefaults()
+
+	if err := plan.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	snapshots, err := uc.repo.ListSnapshots(ctx, plan.DBInstanceIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to list DB snapshots: %w", err)
+	}
+	plan.SetSnapshots(snapshots)
+
+	now := time.Now()
+
+	// Take a snapshot when due; RDS rejects it unless the instance is available,
+	// so it is retried on the next sync
+	if plan.SnapshotDue(now) {
+		snapshot, err := uc.repo.CreateSnapshot(ctx, plan.DBInstanceIdentifier, plan.SnapshotIdentifier(now), plan.Tags)
+		if err != nil {
+			return fmt.Errorf("failed to create DB snapshot: %w", err)
+		}
+		plan.Snapshots = append([]*rds.DBSnapshot{snapshot}, plan.Snapshots...)
+	}
+
+	// Remove the scheduled snapshots past their retention
+	expired := plan.Expired(now)
+	for _, snapshot := range expired {
+		if err := uc.repo.DeleteSnapshot(ctx, snapshot.Identifier); err != nil {
+			return fmt.Errorf("failed to delete expired DB snapshot %s: %w", snapshot.Identifier, err)
+		}
+	}
+	plan.SetSnapshots(remaining(plan.Snapshots, expired))
+
+	plan.NextSnapshotTime = nil
+	if latest := plan.Latest(); plan.IsScheduled() && latest != nil && latest.CreateTime != nil {
+		next := latest.CreateTime.Add(plan.Interval)
+		plan.NextSnapshotTime = &next
+	}
+	plan.LastSyncTime = &now
+
+	return nil
+}
+
+func (uc *SnapshotUseCase) DeleteSnapshots(ctx context.Context, plan *rds.DBSnapshotPlan) error {
+	if !plan.ShouldDelete() {
+		return nil
+	}
+
+	snapshots, err := uc.repo.ListSnapshots(ctx, plan.DBInstanceIdentifier)
+	if err != nil {
+		return fmt.Errorf("failed to list DB snapshots: %w", err)
+	}
+	plan.SetSnapshots(snapshots)
+
+	for _, snapshot := range plan.Snapshots {
+		if err := uc.repo.DeleteSnapshot(ctx, snapshot.Identifier); err != nil {
+			return fmt.Errorf("failed to delete DB snapshot %s: %w", snapshot.Identifier, err)
+		}
+	}
+
+	return nil
+}
+
+// remaining returns the snapshots that are not in removed
+func remaining(snapshots, removed []*rds.DBSnapshot) []*rds.DBSnapshot {
+	gone := make(map[string]bool, len(removed))
+	for _, s := range removed {
+		gone[s.Identifier] = true
+	}
+
+	var kept []*rds.DBSnapshot
+	for _, s := range snapshots {
+		if !gone[s.Identifier] {
+			kept = append(kept, s)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,75 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/ports"
+)
+
+type SubnetGroupUseCase struct {
+	repo ports.RDSSubnetGroupRepository
+}
+
+func NewSubnetGroupUseCase(repo ports.RDSSubnetGroupRepository) ports.RDSSubnetGroupUseCase {
+	return &SubnetGroupUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *SubnetGroupUseCase) SyncSubnetGroup(ctx context.Context, group *rds.DBSubnetGroup) error {
+	group.SetDefaults()
+
+	if err := group.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	exists, err := uc.repo.Exists(ctx, group.Name)
+	if err != nil {
+		return fmt.Errorf("failed to check if DB subnet group exists: %w", err)
+	}
+
+	if !exists {
+		if err := uc.repo.Create(ctx, group); err != nil {
+			return fmt.Errorf("failed to create DB subnet group: %w", err)
+		}
+	} else {
+		existing, err := uc.repo.Get(ctx, group.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get existing DB subnet group: %w", err)
+		}
+
+		group.ARN = existing.ARN
+		group.VpcID = existing.VpcID
+		group.Status = existing.Status
+
+		if group.NeedsUpdate(existing) {
+			if err := uc.repo.Update(ctx, group); err != nil {
+				return fmt.Errorf("failed to update DB subnet group: %w", err)
+			}
+		}
+
+		if err := uc.repo.TagResource(ctx, group.ARN, group.Tags); err != nil {
+			return fmt.Errorf("failed to update tags: %w", err)
+		}
+	}
+
+	now := time.Now()
+	group.LastSyncTime = &now
+
+	return nil
+}
+
+func (uc *SubnetGroupUseCase) DeleteSubnetGroup(ctx context.Context, group *rds.DBSubnetGroup) error {
+	if !group.ShouldDelete() {
+		return nil
+	}
+
+	if err := uc.repo.Delete(ctx, group.Name); err != nil {
+		return fmt.Errorf("failed to delete DB subnet group: %w", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -105,14 +106,8 @@ func (uc *BucketUseCase) UpdateBucket(ctx context.Context, bucket *s3.Bucket) er
 
 // DeleteBucket deletes bucket according to deletion policy
 func (uc *BucketUseCase) DeleteBucket(ctx context.Context, bucket *s3.Bucket) error {
-	// Check deletion policy
-	if bucket.DeletionPolicy == s3.DeletionPolicyRetain {
-		// Don't delete, just orphan
-		return nil
-	}
-
-	if bucket.DeletionPolicy == s3.DeletionPolicyOrphan {
-		// Don't delete, just remove from operator management
+	// Retain and Orphan keep the bucket in AWS
+	if !bucket.ShouldDelete() {
 		return nil
 	}
 
@@ -127,8 +122,20 @@ func (uc *BucketUseCase) DeleteBucket(ctx context.Context, bucket *s3.Bucket) er
 		return nil
 	}
 
+	if bucket.ForceDestroy {
+		err := bucket.Empty(func(max int32, bypassGovernance bool) (int, bool, error) {
+			return uc.repo.DeleteObjectVersions(ctx, bucket.Name, bucket.Region, max, bypassGovernance)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Delete bucket
 	if err := uc.repo.Delete(ctx, bucket.Name, bucket.Region); err != nil {
+		if errors.Is(err, s3.ErrBucketNotEmpty) && !bucket.ForceDestroy {
+			return fmt.Errorf("%w: set spec.forceDestroy to delete its objects with the bucket", err)
+		}
 		return fmt.Errorf("failed to delete bucket: %w", err)
 	}
 
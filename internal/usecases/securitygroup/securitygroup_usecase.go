@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"infra-operator/internal/domain/securitygroup"
 	"infra-operator/internal/ports"
+	"slices"
 )
 
 type SecurityGroupUseCase struct {
@@ -35,12 +36,66 @@ func (uc *SecurityGroupUseCase) SyncSecurityGroup(ctx context.Context, sg *secur
 			// Update status with current state
 			sg.GroupID = current.GroupID
 			sg.GroupName = current.GroupName
-			return nil
+			return uc.syncRules(ctx, sg, current)
 		}
 	}
 
 	// Create new security group
-	return uc.repo.Create(ctx, sg)
+	if err := uc.repo.Create(ctx, sg); err != nil {
+		return err
+	}
+	current, err := uc.repo.Get(ctx, sg.GroupID)
+	if err != nil {
+		return err
+	}
+	return uc.syncRules(ctx, sg, current)
+}
+
+// syncRules makes the rules of the group in AWS match the desired rules,
+// revoking rules that are not declared. New rules are authorized before old
+// ones are revoked, so changing a rule does not drop traffic. Nothing is
+// revoked while rules are pending. Egress rules are only reconciled when declared.
+func (uc *SecurityGroupUseCase) syncRules(ctx context.Context, sg, current *securitygroup.SecurityGroup) error {
+	sg.ResolveSelfReferences()
+
+	ingress := securitygroup.DiffRules(sg.IngressRules, current.IngressRules)
+	if err := uc.repo.AuthorizeIngress(ctx, sg.GroupID, ingress.Authorize); err != nil {
+		return fmt.Errorf("failed to authorize ingress rules: %w", err)
+	}
+	if err := uc.repo.UpdateIngressDescriptions(ctx, sg.GroupID, ingress.Describe); err != nil {
+		return fmt.Errorf("failed to update ingress rule descriptions: %w", err)
+	}
+	if sg.RulesPending {
+		sg.AppliedIngressRules = securitygroup.NormalizeRules(slices.Concat(sg.IngressRules, ingress.Revoke))
+	} else {
+		if err := uc.repo.RevokeIngress(ctx, sg.GroupID, ingress.Revoke); err != nil {
+			return fmt.Errorf("failed to revoke ingress rules: %w", err)
+		}
+		sg.AppliedIngressRules = securitygroup.NormalizeRules(sg.IngressRules)
+	}
+
+	if !sg.ManagesEgress() {
+		sg.AppliedEgressRules = securitygroup.NormalizeRules(current.EgressRules)
+		return nil
+	}
+
+	egress := securitygroup.DiffRules(sg.EgressRules, current.EgressRules)
+	if err := uc.repo.AuthorizeEgress(ctx, sg.GroupID, egress.Authorize); err != nil {
+		return fmt.Errorf("failed to authorize egress rules: %w", err)
+	}
+	if err := uc.repo.UpdateEgressDescriptions(ctx, sg.GroupID, egress.Describe); err != nil {
+		return fmt.Errorf("failed to update egress rule descriptions: %w", err)
+	}
+	if sg.RulesPending {
+		sg.AppliedEgressRules = securitygroup.NormalizeRules(slices.Concat(sg.EgressRules, egress.Revoke))
+	} else {
+		if err := uc.repo.RevokeEgress(ctx, sg.GroupID, egress.Revoke); err != nil {
+			return fmt.Errorf("failed to revoke egress rules: %w", err)
+		}
+		sg.AppliedEgressRules = securitygroup.NormalizeRules(sg.EgressRules)
+	}
+
+	return nil
 }
 
 func (uc *SecurityGroupUseCase) DeleteSecurityGroup(ctx context.Context, sg *securitygroup.SecurityGroup) error {
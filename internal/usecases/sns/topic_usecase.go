@@ -104,6 +104,10 @@ func (uc *TopicUseCase) SyncTopic(ctx context.Context, topic *sns.Topic) error {
 
 // DeleteTopic deletes a topic
 func (uc *TopicUseCase) DeleteTopic(ctx context.Context, topic *sns.Topic) error {
+	if !topic.ShouldDelete() {
+		return nil
+	}
+
 	if topic.ARN == "" {
 		// Topic ARN not set, try to get it by name
 		current, err := uc.repo.GetByName(ctx, topic.Name)
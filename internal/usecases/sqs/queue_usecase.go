@@ -94,6 +94,10 @@ func (uc *QueueUseCase) SyncQueue(ctx context.Context, queue *sqs.Queue) error {
 
 // DeleteQueue deletes a queue
 func (uc *QueueUseCase) DeleteQueue(ctx context.Context, queue *sqs.Queue) error {
+	if !queue.ShouldDelete() {
+		return nil
+	}
+
 	if queue.URL == "" {
 		// Queue URL not set, try to get it by name
 		current, err := uc.repo.GetByName(ctx, queue.Name)
@@ -126,6 +126,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup LambdaAlias Controller
+	if err = (&controllers.LambdaAliasReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LambdaAlias")
+		os.Exit(1)
+	}
+
 	// Setup RDSInstance Controller
 	if err = (&controllers.RDSInstanceReconciler{
 		Client:           mgr.GetClient(),
@@ -136,6 +146,46 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup DBSubnetGroup Controller
+	if err = (&controllers.DBSubnetGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DBSubnetGroup")
+		os.Exit(1)
+	}
+
+	// Setup DBParameterGroup Controller
+	if err = (&controllers.DBParameterGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DBParameterGroup")
+		os.Exit(1)
+	}
+
+	// Setup RDSCluster Controller
+	if err = (&controllers.RDSClusterReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RDSCluster")
+		os.Exit(1)
+	}
+
+	// Setup RDSSnapshot Controller
+	if err = (&controllers.RDSSnapshotReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "RDSSnapshot")
+		os.Exit(1)
+	}
+
 	// Setup ECRRepository Controller
 	if err = (&controllers.ECRRepositoryReconciler{
 		Client:           mgr.GetClient(),
@@ -246,6 +296,56 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Setup TargetGroup Controller
+	if err = (&controllers.TargetGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGroup")
+		os.Exit(1)
+	}
+
+	// Setup Listener Controller
+	if err = (&controllers.ListenerReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Listener")
+		os.Exit(1)
+	}
+
+	// Setup TargetGroupBinding Controller
+	if err = (&controllers.TargetGroupBindingReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
+		os.Exit(1)
+	}
+
+	// Setup LaunchTemplate Controller
+	if err = (&controllers.LaunchTemplateReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "LaunchTemplate")
+		os.Exit(1)
+	}
+
+	// Setup AutoScalingGroup Controller
+	if err = (&controllers.AutoScalingGroupReconciler{
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		AWSClientFactory: awsClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AutoScalingGroup")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -76,6 +76,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.APIResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -237,6 +243,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.APIResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -444,6 +456,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api.APIResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api.APIResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -600,6 +618,16 @@ const docTemplate = `{
                 "dryRun": {
                     "type": "boolean"
                 },
+                "failFast": {
+                    "description": "Interrompe a execução na primeira falha",
+                    "type": "boolean",
+                    "example": false
+                },
+                "parallelism": {
+                    "description": "Número máximo de recursos processados ao mesmo tempo (padrão: 10)",
+                    "type": "integer",
+                    "example": 10
+                },
                 "resources": {
                     "type": "array",
                     "items": {
@@ -666,6 +694,16 @@ const docTemplate = `{
                 "dryRun": {
                     "type": "boolean"
                 },
+                "failFast": {
+                    "description": "Interrompe a execução na primeira falha",
+                    "type": "boolean",
+                    "example": false
+                },
+                "parallelism": {
+                    "description": "Número máximo de recursos processados ao mesmo tempo (padrão: 10)",
+                    "type": "integer",
+                    "example": 10
+                },
                 "resources": {
                     "type": "array",
                     "items": {
@@ -19,6 +19,10 @@ type ApplyRequest struct {
 	Resources []core.Resource `json:"resources,omitempty"`
 	YAML      string          `json:"yaml,omitempty"`
 	DryRun    bool            `json:"dryRun,omitempty"`
+	// Número máximo de recursos processados ao mesmo tempo (padrão: 10)
+	Parallelism int `json:"parallelism,omitempty" example:"10"`
+	// Interrompe a execução na primeira falha
+	FailFast bool `json:"failFast,omitempty" example:"false"`
 }
 
 // DeleteRequest representa uma requisição de delete
@@ -26,6 +30,10 @@ type DeleteRequest struct {
 	Resources []core.Resource `json:"resources,omitempty"`
 	YAML      string          `json:"yaml,omitempty"`
 	DryRun    bool            `json:"dryRun,omitempty"`
+	// Número máximo de recursos processados ao mesmo tempo (padrão: 10)
+	Parallelism int `json:"parallelism,omitempty" example:"10"`
+	// Interrompe a execução na primeira falha
+	FailFast bool `json:"failFast,omitempty" example:"false"`
 }
 
 // ---- Response DTOs ----
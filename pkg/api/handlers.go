@@ -2,8 +2,11 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -248,11 +251,12 @@ func (h *Handlers) Plan(w http.ResponseWriter, r *http.Request) {
 // @Param        body  body  ApplyRequest  true  "Recursos para aplicar"
 // @Success      200  {object}  APIResponse{data=ApplyResponse}
 // @Failure      400  {object}  APIResponse
+// @Failure      409  {object}  APIResponse
 // @Failure      500  {object}  APIResponse
 // @Security     ApiKeyAuth
 // @Router       /apply [post]
 func (h *Handlers) Apply(w http.ResponseWriter, r *http.Request) {
-	resources, err := h.parseResources(r)
+	resources, opts, err := h.parseRequest(r)
 	if err != nil {
 		writeJSON(w, http.StatusBadRequest, NewErrorResponse("INVALID_REQUEST", err.Error(), ""))
 		return
@@ -263,8 +267,13 @@ func (h *Handlers) Apply(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.engine.Apply(r.Context(), resources)
+	result, err := h.engine.ApplyWithOptions(r.Context(), resources, opts)
 	if err != nil {
+		var locked *core.LockedError
+		if errors.As(err, &locked) {
+			writeJSON(w, http.StatusConflict, NewErrorResponse("STATE_LOCKED", err.Error(), ""))
+			return
+		}
 		writeJSON(w, http.StatusInternalServerError, NewErrorResponse("APPLY_FAILED", err.Error(), ""))
 		return
 	}
@@ -281,12 +290,13 @@ func (h *Handlers) Apply(w http.ResponseWriter, r *http.Request) {
 // @Param        body  body  DeleteRequest  true  "Recursos para deletar"
 // @Success      200  {object}  APIResponse{data=DeleteResponse}
 // @Failure      400  {object}  APIResponse
+// @Failure      409  {object}  APIResponse
 // @Failure      500  {object}  APIResponse
 // @Security     ApiKeyAuth
 // @Router       /resources [delete]
 // @Router       /delete [post]
 func (h *Handlers) Delete(w http.ResponseWriter, r *http.Request) {
-	resources, err := h.parseResources(r)
+	resources, opts, err := h.parseRequest(r)
 	if err != nil {
 		writeJSON(w, http.StatusBadRequest, NewErrorResponse("INVALID_REQUEST", err.Error(), ""))
 		return
@@ -297,8 +307,13 @@ func (h *Handlers) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.engine.Delete(r.Context(), resources)
+	result, err := h.engine.DeleteWithOptions(r.Context(), resources, opts)
 	if err != nil {
+		var locked *core.LockedError
+		if errors.As(err, &locked) {
+			writeJSON(w, http.StatusConflict, NewErrorResponse("STATE_LOCKED", err.Error(), ""))
+			return
+		}
 		writeJSON(w, http.StatusInternalServerError, NewErrorResponse("DELETE_FAILED", err.Error(), ""))
 		return
 	}
@@ -355,14 +370,67 @@ func (h *Handlers) GetByKind(w http.ResponseWriter, r *http.Request) {
 
 // parseResources extrai recursos da requisição
 func (h *Handlers) parseResources(r *http.Request) ([]core.Resource, error) {
-	contentType := r.Header.Get("Content-Type")
+	resources, _, err := h.parseRequest(r)
+	return resources, err
+}
 
+// parseRequest extrai recursos e opções de execução da requisição.
+// parallelism e failFast são lidos do corpo JSON ou da query string,
+// que tem precedência.
+func (h *Handlers) parseRequest(r *http.Request) ([]core.Resource, core.ExecOptions, error) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
-		return nil, err
+		return nil, core.ExecOptions{}, err
 	}
 	defer r.Body.Close()
 
+	opts, err := parseExecOptions(r, body)
+	if err != nil {
+		return nil, opts, err
+	}
+
+	resources, err := parseResourcesBody(r.Header.Get("Content-Type"), body)
+	return resources, opts, err
+}
+
+// parseExecOptions lê parallelism e failFast do corpo JSON e da query string
+func parseExecOptions(r *http.Request, body []byte) (core.ExecOptions, error) {
+	var opts core.ExecOptions
+
+	var req struct {
+		Parallelism int  `json:"parallelism"`
+		FailFast    bool `json:"failFast"`
+	}
+	if err := json.Unmarshal(body, &req); err == nil {
+		opts.Parallelism = req.Parallelism
+		opts.FailFast = req.FailFast
+	}
+
+	query := r.URL.Query()
+	if v := query.Get("parallelism"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, fmt.Errorf("parallelism inválido: %s", v)
+		}
+		opts.Parallelism = n
+	}
+	if v := query.Get("failFast"); v != "" {
+		failFast, err := strconv.ParseBool(v)
+		if err != nil {
+			return opts, fmt.Errorf("failFast inválido: %s", v)
+		}
+		opts.FailFast = failFast
+	}
+
+	if opts.Parallelism < 0 {
+		return opts, fmt.Errorf("parallelism deve ser maior que zero")
+	}
+	return opts, nil
+}
+
+// parseResourcesBody faz o parse dos recursos do corpo da requisição
+func parseResourcesBody(contentType string, body []byte) ([]core.Resource, error) {
+
 	// Se Content-Type é YAML ou texto, faz parse como YAML
 	if strings.Contains(contentType, "yaml") || strings.Contains(contentType, "text/plain") {
 		return core.ParseYAML(body)
@@ -486,9 +554,11 @@ func (h *Handlers) ConfigureAWS(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newEngine, err := core.NewEngine(r.Context(), core.EngineConfig{
-		StateDir: h.config.StateDir,
-		Provider: provider,
-		Output:   &core.SilentOutputWriter{},
+		StateDir:     h.config.StateDir,
+		StateBackend: h.config.StateBackend,
+		Provider:     provider,
+		Output:       &core.SilentOutputWriter{},
+		LockTimeout:  h.config.LockTimeout,
 	})
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, NewErrorResponse("CONFIG_FAILED", "Falha ao configurar AWS", err.Error()))
@@ -21,6 +21,8 @@ type ServerConfig struct {
 	Host           string
 	Version        string
 	StateDir       string
+	StateBackend   core.StateBackendConfig
+	LockTimeout    time.Duration
 	AllowedOrigins []string
 	Auth           AuthConfig
 	AWS            core.AWSConfig
@@ -53,9 +55,11 @@ func NewServer(config *ServerConfig) (*Server, error) {
 
 	// Cria engine com output silencioso (API não precisa de output para stdout)
 	engine, err := core.NewEngine(ctx, core.EngineConfig{
-		StateDir: config.StateDir,
-		Provider: provider,
-		Output:   &core.SilentOutputWriter{},
+		StateDir:     config.StateDir,
+		StateBackend: config.StateBackend,
+		Provider:     provider,
+		Output:       &core.SilentOutputWriter{},
+		LockTimeout:  config.LockTimeout,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("falha ao criar engine: %w", err)
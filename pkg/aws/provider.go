@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 // GetAWSConfigFromProvider retrieves AWS configuration from an AWSProvider resource
@@ -113,6 +114,9 @@ func buildAWSConfig(ctx context.Context, k8sClient client.Client, provider *infr
 		cfg.BaseEndpoint = aws.String(provider.Spec.Endpoint)
 	}
 
+	// Record per-service/per-operation AWS API metrics for every client built from this config
+	cfg.APIOptions = append(cfg.APIOptions, inframetrics.AddAWSAPIMetrics)
+
 	return cfg, nil
 }
 
@@ -4,7 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"infra-operator/pkg/core"
 )
 
 // CLI representa a interface de linha de comando
@@ -14,16 +20,24 @@ type CLI struct {
 	endpoint string
 	dryRun   bool
 	verbose  bool
+	exec     core.ExecOptions
+	backend  core.StateBackendConfig
+
+	// lockTimeout é quanto tempo apply/delete aguardam o lock do estado
+	lockTimeout time.Duration
 }
 
 // NewCLI cria uma nova instância do CLI
-func NewCLI(stateDir, region, endpoint string, dryRun, verbose bool) *CLI {
+func NewCLI(stateDir, region, endpoint string, dryRun, verbose bool, exec core.ExecOptions, backend core.StateBackendConfig, lockTimeout time.Duration) *CLI {
 	return &CLI{
-		stateDir: stateDir,
-		region:   region,
-		endpoint: endpoint,
-		dryRun:   dryRun,
-		verbose:  verbose,
+		stateDir:    stateDir,
+		region:      region,
+		endpoint:    endpoint,
+		dryRun:      dryRun,
+		verbose:     verbose,
+		exec:        exec,
+		backend:     backend,
+		lockTimeout: lockTimeout,
 	}
 }
 
@@ -34,7 +48,7 @@ func (c *CLI) RunApply(ctx context.Context, files []string) error {
 		return err
 	}
 
-	executor, err := NewExecutor(c.stateDir, provider, c.dryRun, c.verbose)
+	executor, err := NewExecutor(c.stateDir, c.backend, provider, c.dryRun, c.verbose, c.exec, c.lockTimeout)
 	if err != nil {
 		return fmt.Errorf("falha ao criar executor: %w", err)
 	}
@@ -50,7 +64,7 @@ func (c *CLI) RunPlan(ctx context.Context, files []string) error {
 		return err
 	}
 
-	executor, err := NewExecutor(c.stateDir, provider, true, c.verbose)
+	executor, err := NewExecutor(c.stateDir, c.backend, provider, true, c.verbose, c.exec, c.lockTimeout)
 	if err != nil {
 		return fmt.Errorf("falha ao criar executor: %w", err)
 	}
@@ -65,7 +79,7 @@ func (c *CLI) RunDelete(ctx context.Context, files []string) error {
 		return err
 	}
 
-	executor, err := NewExecutor(c.stateDir, provider, c.dryRun, c.verbose)
+	executor, err := NewExecutor(c.stateDir, c.backend, provider, c.dryRun, c.verbose, c.exec, c.lockTimeout)
 	if err != nil {
 		return fmt.Errorf("falha ao criar executor: %w", err)
 	}
@@ -76,17 +90,12 @@ func (c *CLI) RunDelete(ctx context.Context, files []string) error {
 
 // RunGet lista recursos
 func (c *CLI) RunGet(ctx context.Context, kind string) error {
-	stateManager := NewStateManager(c.stateDir)
-
-	var states []*ResourceState
-	var err error
-
-	if kind == "" || kind == "all" {
-		states, err = stateManager.ListAllStates()
-	} else {
-		states, err = stateManager.ListStates(kind)
+	executor, err := NewExecutor(c.stateDir, c.backend, c.provider(), true, c.verbose, c.exec, c.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("falha ao criar executor: %w", err)
 	}
 
+	states, err := executor.engine.Get(ctx, kind)
 	if err != nil {
 		return fmt.Errorf("falha ao listar recursos: %w", err)
 	}
@@ -120,6 +129,64 @@ func (c *CLI) RunGet(ctx context.Context, kind string) error {
 	return nil
 }
 
+// RunForceUnlock libera um lock do estado deixado por um processo interrompido
+func (c *CLI) RunForceUnlock(ctx context.Context, id string) error {
+	executor, err := NewExecutor(c.stateDir, c.backend, c.provider(), true, c.verbose, c.exec, c.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("falha ao criar executor: %w", err)
+	}
+
+	lock, err := executor.engine.CurrentLock(ctx)
+	if err != nil {
+		return err
+	}
+	if err := executor.engine.ForceUnlock(ctx, id); err != nil {
+		return fmt.Errorf("falha ao liberar lock: %w", err)
+	}
+
+	fmt.Printf("Lock %s liberado (detido por %s, operação %s)\n", lock.ID, lock.Who, lock.Operation)
+	return nil
+}
+
+// RunImport importa um recurso AWS existente para o estado e, com output,
+// grava o manifesto equivalente ("-" para stdout)
+func (c *CLI) RunImport(ctx context.Context, kind, id string, opts core.ImportOptions, output string) error {
+	executor, err := NewExecutor(c.stateDir, c.backend, c.provider(), c.dryRun, c.verbose, c.exec, c.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("falha ao criar executor: %w", err)
+	}
+
+	result, err := executor.engine.Import(ctx, kind, id, opts)
+	if err != nil {
+		return fmt.Errorf("falha ao importar: %w", err)
+	}
+
+	if output != "" {
+		data, err := yaml.Marshal(result.Resource)
+		if err != nil {
+			return fmt.Errorf("falha ao serializar manifesto: %w", err)
+		}
+		if output == "-" {
+			fmt.Print(string(data))
+			return nil
+		}
+		if err := os.WriteFile(output, data, 0644); err != nil {
+			return fmt.Errorf("falha ao escrever %s: %w", output, err)
+		}
+	}
+
+	if c.dryRun {
+		fmt.Printf("  [DRY-RUN] Importaria %s/%s\n", kind, result.State.Name)
+	} else {
+		fmt.Printf("  Importado %s/%s\n", kind, result.State.Name)
+	}
+	printAWSResources(result.State.AWSResources)
+	if output != "" {
+		fmt.Printf("  Manifesto gravado em %s\n", output)
+	}
+	return nil
+}
+
 // loadResources carrega recursos dos arquivos e extrai configuração do provider
 func (c *CLI) loadResources(files []string) ([]Resource, *ProviderConfig, error) {
 	var allResources []Resource
@@ -137,15 +204,7 @@ func (c *CLI) loadResources(files []string) ([]Resource, *ProviderConfig, error)
 	}
 
 	// Encontra configuração do provider
-	provider := NewProviderConfigFromEnv()
-
-	// Sobrescreve com flags do CLI
-	if c.region != "" {
-		provider.AWS.Region = c.region
-	}
-	if c.endpoint != "" {
-		provider.AWS.Endpoint = c.endpoint
-	}
+	provider := c.provider()
 
 	// Verifica recurso AWSProvider nos arquivos
 	for _, r := range allResources {
@@ -165,9 +224,21 @@ func (c *CLI) loadResources(files []string) ([]Resource, *ProviderConfig, error)
 	return allResources, provider, nil
 }
 
+// provider retorna a configuração do provider do ambiente sobrescrita pelas flags do CLI
+func (c *CLI) provider() *ProviderConfig {
+	provider := NewProviderConfigFromEnv()
+	if c.region != "" {
+		provider.AWS.Region = c.region
+	}
+	if c.endpoint != "" {
+		provider.AWS.Endpoint = c.endpoint
+	}
+	return provider
+}
+
 // PrintUsage imprime o uso do CLI
 func PrintUsage() {
-	fmt.Println(`
+	fmt.Print(`
 Infra Operator AWS - Modo CLI
 
 Uso:
@@ -175,15 +246,28 @@ Uso:
   infra-operator plan   -f <arquivo.yaml>  [flags]    Mostra plano de execução
   infra-operator delete -f <arquivo.yaml>  [flags]    Deleta recursos
   infra-operator get    [kind]                        Lista recursos no estado
+  infra-operator import <kind> <aws-id> [flags]       Importa um recurso AWS existente para o estado
+  infra-operator force-unlock <lock-id>               Libera o lock deixado por um processo interrompido
 
 Flags:
   -f, --file string        Caminho para arquivo de manifesto YAML (pode ser repetido)
   --region string          Região AWS (padrão: us-east-1 ou env AWS_REGION)
   --endpoint string        URL do endpoint AWS (para LocalStack)
   --state-dir string       Diretório de estado (padrão: ~/.infra-operator/state)
+  --state-backend string   Backend de estado: local, s3://bucket/prefixo?lockTable=tabela,
+                           configmap://namespace/nome ou secret://namespace/nome (padrão: local)
   --dry-run                Mostra o que seria feito sem fazer mudanças
+  --parallelism int        Recursos processados ao mesmo tempo em apply/delete (padrão: 10)
+  --fail-fast              Interrompe apply/delete na primeira falha
+  --lock-timeout duration  Tempo de espera pelo lock do estado, ex: 30s, 5m (padrão: 0, falha imediatamente)
   -v, --verbose            Saída detalhada
 
+Flags do import:
+  --name string            Nome do recurso no estado (padrão: derivado do ID AWS)
+  --namespace string       Namespace do recurso no estado
+  --provider string        AWSProvider referenciado no manifesto gerado
+  -o, --output string      Grava o manifesto do recurso importado ("-" para stdout)
+
 Exemplos:
   # Aplica um ComputeStack dos samples
   infra-operator apply -f samples/29-computestack.yaml
@@ -203,6 +287,21 @@ Exemplos:
   # Deleta recursos
   infra-operator delete -f samples/29-computestack.yaml
 
+  # Compartilha o estado em um bucket S3
+  infra-operator apply -f samples/29-computestack.yaml --state-backend "s3://my-bucket/infra?lockTable=infra-locks"
+
+  # Aplica um recurso por vez, parando na primeira falha
+  infra-operator apply -f samples/29-computestack.yaml --parallelism 1 --fail-fast
+
+  # Aguarda até 5 minutos se outro processo estiver com o estado bloqueado
+  infra-operator apply -f samples/29-computestack.yaml --lock-timeout 5m
+
+  # Importa uma VPC criada fora do operator e gera o manifesto
+  infra-operator import VPC vpc-0a1b2c3d --name main -o vpc.yaml
+
+  # Libera o lock de um apply interrompido (o ID aparece na mensagem de erro)
+  infra-operator force-unlock 3f2a9c1d8e7b6a50
+
 Variáveis de Ambiente:
   AWS_REGION              Região AWS
   AWS_ACCESS_KEY_ID       Chave de acesso AWS
@@ -218,7 +317,7 @@ func IsCliCommand(args []string) bool {
 		return false
 	}
 	cmd := args[1]
-	return cmd == "apply" || cmd == "plan" || cmd == "delete" || cmd == "get" || cmd == "help" || cmd == "--help" || cmd == "-h"
+	return cmd == "apply" || cmd == "plan" || cmd == "delete" || cmd == "get" || cmd == "import" || cmd == "force-unlock" || cmd == "help" || cmd == "--help" || cmd == "-h"
 }
 
 // Run executa o CLI baseado nos argumentos da linha de comando
@@ -233,9 +332,13 @@ func Run(args []string) error {
 
 	// Parse das flags
 	var files []string
-	var region, endpoint, stateDir string
+	var region, endpoint, stateDir, stateBackend string
 	var dryRun, verbose bool
-	kind := ""
+	var exec core.ExecOptions
+	var lockTimeout time.Duration
+	var importOpts core.ImportOptions
+	var output string
+	var positional []string
 
 	for i := 2; i < len(args); i++ {
 		arg := args[i]
@@ -260,16 +363,71 @@ func Run(args []string) error {
 				stateDir = args[i+1]
 				i++
 			}
+		case arg == "--state-backend":
+			if i+1 < len(args) {
+				stateBackend = args[i+1]
+				i++
+			}
+		case arg == "--parallelism":
+			if i+1 < len(args) {
+				n, err := strconv.Atoi(args[i+1])
+				if err != nil || n < 1 {
+					return fmt.Errorf("valor inválido para --parallelism: %s", args[i+1])
+				}
+				exec.Parallelism = n
+				i++
+			}
+		case arg == "--fail-fast":
+			exec.FailFast = true
+		case arg == "--lock-timeout":
+			if i+1 < len(args) {
+				d, err := time.ParseDuration(args[i+1])
+				if err != nil || d < 0 {
+					return fmt.Errorf("valor inválido para --lock-timeout: %s", args[i+1])
+				}
+				lockTimeout = d
+				i++
+			}
+		case arg == "--name":
+			if i+1 < len(args) {
+				importOpts.Name = args[i+1]
+				i++
+			}
+		case arg == "--namespace":
+			if i+1 < len(args) {
+				importOpts.Namespace = args[i+1]
+				i++
+			}
+		case arg == "--provider":
+			if i+1 < len(args) {
+				importOpts.ProviderRef = args[i+1]
+				i++
+			}
+		case arg == "-o" || arg == "--output":
+			if i+1 < len(args) {
+				output = args[i+1]
+				i++
+			}
 		case arg == "--dry-run":
 			dryRun = true
 		case arg == "-v" || arg == "--verbose":
 			verbose = true
 		case !strings.HasPrefix(arg, "-"):
-			kind = arg
+			positional = append(positional, arg)
 		}
 	}
 
-	cli := NewCLI(stateDir, region, endpoint, dryRun, verbose)
+	kind := ""
+	if len(positional) > 0 {
+		kind = positional[0]
+	}
+
+	backend, err := core.ParseStateBackend(stateBackend)
+	if err != nil {
+		return err
+	}
+
+	cli := NewCLI(stateDir, region, endpoint, dryRun, verbose, exec, backend, lockTimeout)
 
 	switch cmd {
 	case "apply":
@@ -293,6 +451,18 @@ func Run(args []string) error {
 	case "get":
 		return cli.RunGet(ctx, kind)
 
+	case "import":
+		if len(positional) != 2 {
+			return fmt.Errorf("uso: import <kind> <aws-id> (kinds suportados: %s)", strings.Join(core.ImportKinds(), ", "))
+		}
+		return cli.RunImport(ctx, positional[0], positional[1], importOpts, output)
+
+	case "force-unlock":
+		if kind == "" {
+			return fmt.Errorf("nenhum lock especificado. Use force-unlock <lock-id>")
+		}
+		return cli.RunForceUnlock(ctx, kind)
+
 	case "help", "--help", "-h":
 		PrintUsage()
 		return nil
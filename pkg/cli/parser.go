@@ -102,21 +102,30 @@ func OrderByDependency(resources []Resource) []Resource {
 		"SecretsManagerSecret": 10,
 		"S3Bucket":             11,
 		"ECRRepository":        12,
+		"DBSubnetGroup":        12,
+		"DBParameterGroup":     12,
 		"RDSInstance":          13,
+		"RDSCluster":           13,
+		"RDSSnapshot":          14,
 		"DynamoDBTable":        14,
 		"ElastiCacheCluster":   15,
 		"SQSQueue":             16,
 		"SNSTopic":             17,
 		"LambdaFunction":       18,
+		"LambdaAlias":          19,
 		"EC2Instance":          19,
 		"EC2KeyPair":           19,
+		"LaunchTemplate":       19,
 		"ALB":                  20,
 		"NLB":                  20,
+		"TargetGroup":          20,
 		"EKSCluster":           21,
 		"ECSCluster":           21,
+		"AutoScalingGroup":     21,
 		"APIGateway":           22,
 		"Certificate":          23,
 		"CloudFront":           24,
+		"Listener":             24,
 		"Route53HostedZone":    25,
 		"Route53RecordSet":     26,
 		"ComputeStack":         100, // ComputeStack cria tudo, então menor prioridade
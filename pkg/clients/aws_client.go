@@ -15,6 +15,7 @@ import (
 	awsacm "infra-operator/internal/adapters/aws/acm"
 	awsalb "infra-operator/internal/adapters/aws/alb"
 	awsapigw "infra-operator/internal/adapters/aws/apigateway"
+	awsautoscaling "infra-operator/internal/adapters/aws/autoscaling"
 	awscf "infra-operator/internal/adapters/aws/cloudfront"
 	awsec2 "infra-operator/internal/adapters/aws/ec2"
 	awsecr "infra-operator/internal/adapters/aws/ecr"
@@ -37,6 +38,7 @@ import (
 	acmuc "infra-operator/internal/usecases/acm"
 	albuc "infra-operator/internal/usecases/alb"
 	apigwuc "infra-operator/internal/usecases/apigateway"
+	autoscalinguc "infra-operator/internal/usecases/autoscaling"
 	cfuc "infra-operator/internal/usecases/cloudfront"
 	ec2uc "infra-operator/internal/usecases/ec2"
 	ecruc "infra-operator/internal/usecases/ecr"
@@ -55,6 +57,7 @@ import (
 	smuc "infra-operator/internal/usecases/secretsmanager"
 	subnetuc "infra-operator/internal/usecases/subnet"
 	vpcuc "infra-operator/internal/usecases/vpc"
+	inframetrics "infra-operator/pkg/metrics"
 )
 
 // AWSClientFactory creates AWS SDK clients from AWSProvider config
@@ -183,6 +186,9 @@ func (f *AWSClientFactory) buildAWSConfig(ctx context.Context, provider *infrav1
 		cfg.BaseEndpoint = aws.String(provider.Spec.Endpoint)
 	}
 
+	// Record per-service/per-operation AWS API metrics for every client built from this config
+	cfg.APIOptions = append(cfg.APIOptions, inframetrics.AddAWSAPIMetrics)
+
 	return cfg, nil
 }
 
@@ -224,6 +230,46 @@ func (f *AWSClientFactory) GetRDSUseCase(ctx context.Context, providerRef infrav
 	return rdsuc.NewInstanceUseCase(rdsRepo), nil
 }
 
+// GetRDSClusterUseCase creates Aurora DB cluster use case
+func (f *AWSClientFactory) GetRDSClusterUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSClusterUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsrds.NewClusterRepository(awsConfig)
+	return rdsuc.NewClusterUseCase(repo), nil
+}
+
+// GetDBSubnetGroupUseCase creates DB subnet group use case
+func (f *AWSClientFactory) GetDBSubnetGroupUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSSubnetGroupUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsrds.NewSubnetGroupRepository(awsConfig)
+	return rdsuc.NewSubnetGroupUseCase(repo), nil
+}
+
+// GetRDSSnapshotUseCase creates RDS snapshot use case
+func (f *AWSClientFactory) GetRDSSnapshotUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSSnapshotUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsrds.NewRepository(awsConfig)
+	return rdsuc.NewSnapshotUseCase(repo), nil
+}
+
+// GetDBParameterGroupUseCase creates DB parameter group use case
+func (f *AWSClientFactory) GetDBParameterGroupUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSParameterGroupUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsrds.NewParameterGroupRepository(awsConfig)
+	return rdsuc.NewParameterGroupUseCase(repo), nil
+}
+
 // GetECRUseCase creates ECR use case from provider reference
 func (f *AWSClientFactory) GetECRUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ECRUseCase, error) {
 	// Get AWS config from provider
@@ -287,6 +333,28 @@ func (f *AWSClientFactory) GetEC2UseCase(ctx context.Context, providerRef infrav
 	return ec2uc.NewInstanceUseCase(ec2Repo), nil
 }
 
+// GetLaunchTemplateUseCase creates LaunchTemplate use case from provider reference
+func (f *AWSClientFactory) GetLaunchTemplateUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.LaunchTemplateUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	ec2Repo := awsec2.NewRepository(awsConfig)
+	return ec2uc.NewLaunchTemplateUseCase(ec2Repo), nil
+}
+
+// GetAutoScalingGroupUseCase creates AutoScalingGroup use case from provider reference
+func (f *AWSClientFactory) GetAutoScalingGroupUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.AutoScalingGroupUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+
+	repo := awsautoscaling.NewRepository(awsConfig)
+	return autoscalinguc.NewGroupUseCase(repo), nil
+}
+
 // GetEC2Repository creates EC2 repository from provider reference
 // Usado para acessar métodos do repositório que não estão no use case (ex: GetConsoleOutput)
 func (f *AWSClientFactory) GetEC2Repository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.EC2Repository, error) {
@@ -389,6 +457,36 @@ func (f *AWSClientFactory) GetALBUseCase(ctx context.Context, providerRef infrav
 	return albuc.NewLoadBalancerUseCase(repo), nil
 }
 
+// GetTargetGroupUseCase creates TargetGroup use case
+func (f *AWSClientFactory) GetTargetGroupUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.TargetGroupUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsalb.NewRepository(awsConfig)
+	return albuc.NewTargetGroupUseCase(repo), nil
+}
+
+// GetListenerUseCase creates Listener use case
+func (f *AWSClientFactory) GetListenerUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ListenerUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsalb.NewRepository(awsConfig)
+	return albuc.NewListenerUseCase(repo), nil
+}
+
+// GetTargetGroupBindingUseCase creates TargetGroupBinding use case
+func (f *AWSClientFactory) GetTargetGroupBindingUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.TargetGroupBindingUseCase, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	repo := awsalb.NewRepository(awsConfig)
+	return albuc.NewTargetGroupBindingUseCase(repo), nil
+}
+
 // GetECSUseCase creates ECS use case
 func (f *AWSClientFactory) GetECSUseCase(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ECSUseCase, error) {
 	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
@@ -448,3 +546,210 @@ func (f *AWSClientFactory) GetCloudFrontUseCase(ctx context.Context, providerRef
 	repo := awscf.NewRepository(awsConfig)
 	return cfuc.NewDistributionUseCase(repo), nil
 }
+
+// GetRDSRepository creates RDS repository (used for drift detection)
+func (f *AWSClientFactory) GetRDSRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsrds.NewRepository(awsConfig), nil
+}
+
+// GetRDSClusterRepository creates Aurora DB cluster repository (used for drift detection)
+func (f *AWSClientFactory) GetRDSClusterRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSClusterRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsrds.NewClusterRepository(awsConfig), nil
+}
+
+// GetDBSubnetGroupRepository creates DB subnet group repository (used for drift detection)
+func (f *AWSClientFactory) GetDBSubnetGroupRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSSubnetGroupRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsrds.NewSubnetGroupRepository(awsConfig), nil
+}
+
+// GetDBParameterGroupRepository creates DB parameter group repository (used for drift detection)
+func (f *AWSClientFactory) GetDBParameterGroupRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RDSParameterGroupRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsrds.NewParameterGroupRepository(awsConfig), nil
+}
+
+// GetECRRepository creates ECR repository (used for drift detection)
+func (f *AWSClientFactory) GetECRRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ECRRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsecr.NewRepository(awsConfig), nil
+}
+
+// GetIAMRepository creates IAM repository (used for drift detection)
+func (f *AWSClientFactory) GetIAMRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.IAMRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsiam.NewRepository(awsConfig), nil
+}
+
+// GetSecretsManagerRepository creates Secrets Manager repository (used for drift detection)
+func (f *AWSClientFactory) GetSecretsManagerRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.SecretsManagerRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awssm.NewRepository(awsConfig), nil
+}
+
+// GetKMSRepository creates KMS repository (used for drift detection)
+func (f *AWSClientFactory) GetKMSRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.KMSRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awskms.NewRepository(awsConfig), nil
+}
+
+// GetElastiCacheRepository creates ElastiCache repository (used for drift detection)
+func (f *AWSClientFactory) GetElastiCacheRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ElastiCacheRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awselasticache.NewRepository(awsConfig), nil
+}
+
+// GetVPCRepository creates VPC repository (used for drift detection)
+func (f *AWSClientFactory) GetVPCRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.VPCRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsvpc.NewRepository(awsConfig), nil
+}
+
+// GetSubnetRepository creates Subnet repository (used for drift detection)
+func (f *AWSClientFactory) GetSubnetRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.SubnetRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awssubnet.NewRepository(awsConfig), nil
+}
+
+// GetInternetGatewayRepository creates Internet Gateway repository (used for drift detection)
+func (f *AWSClientFactory) GetInternetGatewayRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.InternetGatewayRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsigw.NewRepository(awsConfig), nil
+}
+
+// GetNATGatewayRepository creates NAT Gateway repository (used for drift detection)
+func (f *AWSClientFactory) GetNATGatewayRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.NATGatewayRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsnat.NewRepository(awsConfig), nil
+}
+
+// GetSecurityGroupRepository creates Security Group repository (used for drift detection)
+func (f *AWSClientFactory) GetSecurityGroupRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.SecurityGroupRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awssecuritygroup.NewRepository(awsConfig), nil
+}
+
+// GetEKSRepository creates EKS Cluster repository (used for drift detection)
+func (f *AWSClientFactory) GetEKSRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.EKSRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awseks.NewRepository(awsConfig), nil
+}
+
+// GetRouteTableRepository creates RouteTable repository (used for drift detection)
+func (f *AWSClientFactory) GetRouteTableRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.RouteTableRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsroutetable.NewRepository(awsConfig), nil
+}
+
+// GetALBRepository creates ALB repository (used for drift detection)
+func (f *AWSClientFactory) GetALBRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ALBRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsalb.NewRepository(awsConfig), nil
+}
+
+// GetECSRepository creates ECS repository (used for drift detection)
+func (f *AWSClientFactory) GetECSRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ECSRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsecs.NewRepository(awsConfig), nil
+}
+
+// GetElasticIPRepository creates Elastic IP repository (used for drift detection)
+func (f *AWSClientFactory) GetElasticIPRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ElasticIPRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awselasticip.NewRepository(awsConfig), nil
+}
+
+// GetNLBRepository creates NLB repository (used for drift detection)
+func (f *AWSClientFactory) GetNLBRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.NLBRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsnlb.NewRepository(awsConfig), nil
+}
+
+// GetACMRepository creates ACM repository (used for drift detection)
+func (f *AWSClientFactory) GetACMRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.ACMRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsacm.NewRepository(awsConfig), nil
+}
+
+// GetAPIGatewayRepository creates API Gateway repository (used for drift detection)
+func (f *AWSClientFactory) GetAPIGatewayRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.APIGatewayRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awsapigw.NewRepository(awsConfig), nil
+}
+
+// GetCloudFrontRepository creates CloudFront repository (used for drift detection)
+func (f *AWSClientFactory) GetCloudFrontRepository(ctx context.Context, providerRef infrav1alpha1.ProviderReference, namespace string) (ports.CloudFrontRepository, error) {
+	awsConfig, _, err := f.GetAWSConfigFromProviderRef(ctx, namespace, providerRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AWS config: %w", err)
+	}
+	return awscf.NewRepository(awsConfig), nil
+}
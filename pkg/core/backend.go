@@ -0,0 +1,263 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// StateBackend armazena os documentos de estado por chave, no formato
+// <kind>/<namespace>/<name>.json
+type StateBackend interface {
+	// Get retorna o documento da chave, ou nil, nil quando ela não existe
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put grava o documento da chave
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete remove a chave; remover uma chave inexistente não é erro
+	Delete(ctx context.Context, key string) error
+
+	// List retorna, em ordem, as chaves que começam com prefix
+	List(ctx context.Context, prefix string) ([]string, error)
+
+	// Lock adquire o lock do estado de forma atômica. Retorna *LockedError
+	// quando outro processo já o detém.
+	Lock(ctx context.Context, info *LockInfo) error
+
+	// Unlock libera o lock com o ID informado; liberar um estado livre não é erro
+	Unlock(ctx context.Context, id string) error
+
+	// CurrentLock retorna o lock atual, ou nil quando o estado está livre
+	CurrentLock(ctx context.Context) (*LockInfo, error)
+}
+
+// Tipos de backend de estado
+const (
+	StateBackendLocal     = "local"
+	StateBackendS3        = "s3"
+	StateBackendConfigMap = "configmap"
+	StateBackendSecret    = "secret"
+)
+
+// StateBackendConfig seleciona e configura o backend de estado
+type StateBackendConfig struct {
+	// Type é local (padrão), s3, configmap ou secret
+	Type string `json:"type,omitempty"`
+
+	// Bucket e Prefix configuram o backend s3
+	Bucket string `json:"bucket,omitempty"`
+	Prefix string `json:"prefix,omitempty"`
+
+	// LockTable é a tabela DynamoDB (chave de partição LockID do tipo string)
+	// usada como lock do backend s3
+	LockTable string `json:"lockTable,omitempty"`
+
+	// Namespace e Name identificam o ConfigMap/Secret dos backends configmap e secret
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// ParseStateBackend interpreta o valor de --state-backend:
+//
+//	local                       arquivos em --state-dir (padrão)
+//	s3://bucket/prefixo         objetos S3 (?lockTable=tabela DynamoDB do lock, exigida por apply e delete)
+//	configmap://namespace/nome  um ConfigMap do Kubernetes
+//	secret://namespace/nome     um Secret do Kubernetes
+func ParseStateBackend(value string) (StateBackendConfig, error) {
+	if value == "" || value == StateBackendLocal {
+		return StateBackendConfig{Type: StateBackendLocal}, nil
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return StateBackendConfig{}, fmt.Errorf("backend de estado inválido: %s", value)
+	}
+	path := strings.Trim(u.Path, "/")
+
+	switch u.Scheme {
+	case StateBackendS3:
+		return StateBackendConfig{Type: StateBackendS3, Bucket: u.Host, Prefix: path, LockTable: u.Query().Get("lockTable")}, nil
+	case StateBackendConfigMap, StateBackendSecret:
+		if path == "" || strings.Contains(path, "/") {
+			return StateBackendConfig{}, fmt.Errorf("backend de estado inválido: %s (use %s://namespace/nome)", value, u.Scheme)
+		}
+		return StateBackendConfig{Type: u.Scheme, Namespace: u.Host, Name: path}, nil
+	default:
+		return StateBackendConfig{}, fmt.Errorf("tipo de backend de estado não suportado: %s", u.Scheme)
+	}
+}
+
+// NewStateBackend cria o backend configurado. stateDir é usado pelo backend
+// local e awsCfg pelo backend s3.
+func NewStateBackend(cfg StateBackendConfig, stateDir string, awsCfg aws.Config) (StateBackend, error) {
+	switch cfg.Type {
+	case "", StateBackendLocal:
+		return NewLocalBackend(stateDir), nil
+	case StateBackendS3:
+		if cfg.Bucket == "" {
+			return nil, fmt.Errorf("backend s3 requer um bucket")
+		}
+		return NewS3Backend(awsCfg, cfg.Bucket, cfg.Prefix, cfg.LockTable), nil
+	case StateBackendConfigMap, StateBackendSecret:
+		if cfg.Namespace == "" || cfg.Name == "" {
+			return nil, fmt.Errorf("backend %s requer namespace e nome", cfg.Type)
+		}
+		return NewKubernetesBackendFromConfig(cfg.Namespace, cfg.Name, cfg.Type == StateBackendSecret)
+	default:
+		return nil, fmt.Errorf("tipo de backend de estado não suportado: %s", cfg.Type)
+	}
+}
+
+// LocalBackend grava um arquivo JSON por recurso em Dir
+type LocalBackend struct {
+	Dir string
+}
+
+// NewLocalBackend cria o backend local; o padrão é ~/.infra-operator/state
+func NewLocalBackend(dir string) *LocalBackend {
+	if dir == "" {
+		dir = defaultStateDir()
+	}
+	return &LocalBackend{Dir: dir}
+}
+
+func (b *LocalBackend) path(key string) string {
+	return filepath.Join(b.Dir, filepath.FromSlash(key))
+}
+
+func (b *LocalBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(b.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao ler arquivo de estado: %w", err)
+	}
+	return data, nil
+}
+
+func (b *LocalBackend) Put(ctx context.Context, key string, data []byte) error {
+	path := b.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("falha ao criar diretório de estado: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("falha ao escrever arquivo de estado: %w", err)
+	}
+	return nil
+}
+
+func (b *LocalBackend) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(b.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("falha ao deletar arquivo de estado: %w", err)
+	}
+	return nil
+}
+
+func (b *LocalBackend) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+
+	if _, err := os.Stat(b.Dir); os.IsNotExist(err) {
+		return keys, nil
+	}
+
+	err := filepath.Walk(b.Dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(b.Dir, path)
+		if err != nil {
+			return err
+		}
+		if key := filepath.ToSlash(rel); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao listar estados: %w", err)
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// lockPath é o arquivo de lock do backend local
+func (b *LocalBackend) lockPath() string {
+	return filepath.Join(b.Dir, ".lock")
+}
+
+// Lock cria o arquivo de lock com O_EXCL, que falha se ele já existir
+func (b *LocalBackend) Lock(ctx context.Context, info *LockInfo) error {
+	if err := os.MkdirAll(b.Dir, 0755); err != nil {
+		return fmt.Errorf("falha ao criar diretório de estado: %w", err)
+	}
+
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(b.lockPath(), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		if os.IsExist(err) {
+			current, _ := b.CurrentLock(ctx)
+			return &LockedError{Info: current}
+		}
+		return fmt.Errorf("falha ao criar lock do estado: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		os.Remove(b.lockPath())
+		return fmt.Errorf("falha ao gravar lock do estado: %w", err)
+	}
+	return nil
+}
+
+func (b *LocalBackend) Unlock(ctx context.Context, id string) error {
+	current, err := b.CurrentLock(ctx)
+	if err != nil || current == nil {
+		return err
+	}
+	if err := checkLockID(current, id); err != nil {
+		return err
+	}
+	if err := os.Remove(b.lockPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("falha ao remover lock do estado: %w", err)
+	}
+	return nil
+}
+
+func (b *LocalBackend) CurrentLock(ctx context.Context) (*LockInfo, error) {
+	data, err := os.ReadFile(b.lockPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao ler lock do estado: %w", err)
+	}
+
+	var info LockInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("falha ao deserializar lock do estado: %w", err)
+	}
+	return &info, nil
+}
+
+// defaultStateDir retorna ~/.infra-operator/state
+func defaultStateDir() string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".infra-operator", "state")
+}
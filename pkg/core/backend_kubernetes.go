@@ -0,0 +1,299 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	clientconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// KubernetesBackend grava todo o estado em um único ConfigMap (ou Secret),
+// com uma entrada por recurso. Chaves do ConfigMap não aceitam "/", então
+// VPC/default/main.json é gravado como VPC.default.main.json. O tamanho
+// total é limitado a ~1MiB pelo Kubernetes.
+type KubernetesBackend struct {
+	Client    kubernetes.Interface
+	Namespace string
+	Name      string
+
+	// Secret grava em um Secret em vez de um ConfigMap
+	Secret bool
+}
+
+// NewKubernetesBackendFromConfig cria o backend usando o kubeconfig atual
+// (--kubeconfig, KUBECONFIG, ~/.kube/config ou service account do pod)
+func NewKubernetesBackendFromConfig(namespace, name string, secret bool) (*KubernetesBackend, error) {
+	restCfg, err := clientconfig.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("falha ao carregar kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao criar cliente Kubernetes: %w", err)
+	}
+
+	return &KubernetesBackend{Client: client, Namespace: namespace, Name: name, Secret: secret}, nil
+}
+
+// lockAnnotation guarda o LockInfo no ConfigMap/Secret enquanto o estado está bloqueado
+const lockAnnotation = "aws-infra-operator.runner.codes/state-lock"
+
+// encodeKey converte kind/namespace/nome.json em kind.namespace.nome.json
+func encodeKey(key string) string {
+	return strings.ReplaceAll(key, "/", ".")
+}
+
+// decodeKey desfaz encodeKey. Kind e namespace não contêm pontos, então os
+// dois primeiros separam os segmentos e o restante é o nome.
+func decodeKey(key string) string {
+	return strings.Replace(key, ".", "/", 2)
+}
+
+// load lê as entradas do objeto; exists é false quando ele ainda não existe
+func (b *KubernetesBackend) load(ctx context.Context) (data map[string][]byte, exists bool, err error) {
+	data = make(map[string][]byte)
+
+	if b.Secret {
+		secret, err := b.Client.CoreV1().Secrets(b.Namespace).Get(ctx, b.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return data, false, nil
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("falha ao ler Secret %s/%s: %w", b.Namespace, b.Name, err)
+		}
+		for k, v := range secret.Data {
+			data[k] = v
+		}
+		return data, true, nil
+	}
+
+	cm, err := b.Client.CoreV1().ConfigMaps(b.Namespace).Get(ctx, b.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return data, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("falha ao ler ConfigMap %s/%s: %w", b.Namespace, b.Name, err)
+	}
+	for k, v := range cm.Data {
+		data[k] = []byte(v)
+	}
+	return data, true, nil
+}
+
+// modify aplica fn nos metadados e entradas do objeto e o grava, repetindo
+// em caso de conflito de resourceVersion. Se fn retornar erro nada é gravado.
+func (b *KubernetesBackend) modify(ctx context.Context, fn func(meta *metav1.ObjectMeta, data map[string][]byte) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if b.Secret {
+			return b.modifySecret(ctx, fn)
+		}
+		return b.modifyConfigMap(ctx, fn)
+	})
+}
+
+func (b *KubernetesBackend) modifyConfigMap(ctx context.Context, fn func(meta *metav1.ObjectMeta, data map[string][]byte) error) error {
+	configMaps := b.Client.CoreV1().ConfigMaps(b.Namespace)
+
+	cm, err := configMaps.Get(ctx, b.Name, metav1.GetOptions{})
+	exists := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("falha ao ler ConfigMap %s/%s: %w", b.Namespace, b.Name, err)
+	}
+	if !exists {
+		cm = &corev1.ConfigMap{ObjectMeta: b.objectMeta()}
+	}
+
+	data := make(map[string][]byte, len(cm.Data))
+	for k, v := range cm.Data {
+		data[k] = []byte(v)
+	}
+	if err := fn(&cm.ObjectMeta, data); err != nil {
+		return err
+	}
+	cm.Data = make(map[string]string, len(data))
+	for k, v := range data {
+		cm.Data[k] = string(v)
+	}
+
+	if exists {
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+	} else {
+		_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+	}
+	return err
+}
+
+func (b *KubernetesBackend) modifySecret(ctx context.Context, fn func(meta *metav1.ObjectMeta, data map[string][]byte) error) error {
+	secrets := b.Client.CoreV1().Secrets(b.Namespace)
+
+	secret, err := secrets.Get(ctx, b.Name, metav1.GetOptions{})
+	exists := err == nil
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("falha ao ler Secret %s/%s: %w", b.Namespace, b.Name, err)
+	}
+	if !exists {
+		secret = &corev1.Secret{ObjectMeta: b.objectMeta(), Type: corev1.SecretTypeOpaque}
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	if err := fn(&secret.ObjectMeta, secret.Data); err != nil {
+		return err
+	}
+
+	if exists {
+		_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	} else {
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+	}
+	return err
+}
+
+func (b *KubernetesBackend) objectMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      b.Name,
+		Namespace: b.Namespace,
+		Labels:    map[string]string{"app.kubernetes.io/managed-by": "infra-operator"},
+	}
+}
+
+func (b *KubernetesBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	data, _, err := b.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return data[encodeKey(key)], nil
+}
+
+func (b *KubernetesBackend) Put(ctx context.Context, key string, value []byte) error {
+	err := b.modify(ctx, func(_ *metav1.ObjectMeta, data map[string][]byte) error {
+		data[encodeKey(key)] = value
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao gravar estado %s: %w", key, err)
+	}
+	return nil
+}
+
+func (b *KubernetesBackend) Delete(ctx context.Context, key string) error {
+	data, exists, err := b.load(ctx)
+	if err != nil {
+		return err
+	}
+	if _, ok := data[encodeKey(key)]; !exists || !ok {
+		return nil
+	}
+
+	err = b.modify(ctx, func(_ *metav1.ObjectMeta, data map[string][]byte) error {
+		delete(data, encodeKey(key))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao deletar estado %s: %w", key, err)
+	}
+	return nil
+}
+
+func (b *KubernetesBackend) List(ctx context.Context, prefix string) ([]string, error) {
+	data, _, err := b.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for k := range data {
+		if key := decodeKey(k); strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Lock grava a anotação de lock; o resourceVersion garante que apenas um
+// processo a adiciona
+func (b *KubernetesBackend) Lock(ctx context.Context, info *LockInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return b.modify(ctx, func(meta *metav1.ObjectMeta, _ map[string][]byte) error {
+		if current, ok := meta.Annotations[lockAnnotation]; ok {
+			var held LockInfo
+			if err := json.Unmarshal([]byte(current), &held); err != nil {
+				return &LockedError{}
+			}
+			return &LockedError{Info: &held}
+		}
+		if meta.Annotations == nil {
+			meta.Annotations = make(map[string]string)
+		}
+		meta.Annotations[lockAnnotation] = string(data)
+		return nil
+	})
+}
+
+func (b *KubernetesBackend) Unlock(ctx context.Context, id string) error {
+	current, err := b.CurrentLock(ctx)
+	if err != nil || current == nil {
+		return err
+	}
+
+	return b.modify(ctx, func(meta *metav1.ObjectMeta, _ map[string][]byte) error {
+		var held LockInfo
+		if err := json.Unmarshal([]byte(meta.Annotations[lockAnnotation]), &held); err == nil {
+			if err := checkLockID(&held, id); err != nil {
+				return err
+			}
+		}
+		delete(meta.Annotations, lockAnnotation)
+		return nil
+	})
+}
+
+func (b *KubernetesBackend) CurrentLock(ctx context.Context) (*LockInfo, error) {
+	var annotations map[string]string
+
+	if b.Secret {
+		secret, err := b.Client.CoreV1().Secrets(b.Namespace).Get(ctx, b.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler Secret %s/%s: %w", b.Namespace, b.Name, err)
+		}
+		annotations = secret.Annotations
+	} else {
+		cm, err := b.Client.CoreV1().ConfigMaps(b.Namespace).Get(ctx, b.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("falha ao ler ConfigMap %s/%s: %w", b.Namespace, b.Name, err)
+		}
+		annotations = cm.Annotations
+	}
+
+	value, ok := annotations[lockAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var info LockInfo
+	if err := json.Unmarshal([]byte(value), &info); err != nil {
+		return nil, fmt.Errorf("falha ao deserializar lock do estado: %w", err)
+	}
+	return &info, nil
+}
@@ -0,0 +1,207 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Backend grava um objeto por recurso em s3://Bucket/Prefix/<chave>.
+// O lock usa um item condicional na tabela DynamoDB LockTable; sem ela o
+// estado pode ser lido, mas o lock não pode ser adquirido, já que um objeto
+// no S3 não garante que só um processo o adquira.
+type S3Backend struct {
+	Client *s3.Client
+	Bucket string
+	Prefix string
+
+	LockTable string
+	DynamoDB  *dynamodb.Client
+}
+
+// NewS3Backend cria o backend S3 com as credenciais do engine
+func NewS3Backend(awsCfg aws.Config, bucket, prefix, lockTable string) *S3Backend {
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if awsCfg.BaseEndpoint != nil {
+			o.UsePathStyle = true // LocalStack requires path-style URLs
+		}
+	})
+
+	b := &S3Backend{Client: client, Bucket: bucket, Prefix: strings.Trim(prefix, "/"), LockTable: lockTable}
+	if lockTable != "" {
+		b.DynamoDB = dynamodb.NewFromConfig(awsCfg)
+	}
+	return b
+}
+
+func (b *S3Backend) objectKey(key string) string {
+	if b.Prefix == "" {
+		return key
+	}
+	return path.Join(b.Prefix, key)
+}
+
+func (b *S3Backend) Get(ctx context.Context, key string) ([]byte, error) {
+	out, err := b.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.Bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		var noSuchKey *s3types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("falha ao ler estado s3://%s/%s: %w", b.Bucket, b.objectKey(key), err)
+	}
+	defer out.Body.Close()
+
+	return io.ReadAll(out.Body)
+}
+
+func (b *S3Backend) Put(ctx context.Context, key string, data []byte) error {
+	_, err := b.Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(b.Bucket),
+		Key:         aws.String(b.objectKey(key)),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao gravar estado s3://%s/%s: %w", b.Bucket, b.objectKey(key), err)
+	}
+	return nil
+}
+
+func (b *S3Backend) Delete(ctx context.Context, key string) error {
+	_, err := b.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.Bucket),
+		Key:    aws.String(b.objectKey(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao deletar estado s3://%s/%s: %w", b.Bucket, b.objectKey(key), err)
+	}
+	return nil
+}
+
+func (b *S3Backend) List(ctx context.Context, prefix string) ([]string, error) {
+	base := ""
+	if b.Prefix != "" {
+		base = b.Prefix + "/"
+	}
+
+	var keys []string
+	paginator := s3.NewListObjectsV2Paginator(b.Client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.Bucket),
+		Prefix: aws.String(base + prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao listar estados em s3://%s/%s: %w", b.Bucket, base, err)
+		}
+		for _, obj := range page.Contents {
+			keys = append(keys, strings.TrimPrefix(aws.ToString(obj.Key), base))
+		}
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// lockKey compõe o LockID do item na tabela
+const lockKey = ".lock"
+
+// lockID identifica o estado deste bucket/prefixo na tabela de lock
+func (b *S3Backend) lockID() string {
+	return b.Bucket + "/" + b.objectKey(lockKey)
+}
+
+func (b *S3Backend) Lock(ctx context.Context, info *LockInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	if b.LockTable == "" {
+		return fmt.Errorf("backend s3://%s requer ?lockTable=<tabela DynamoDB> para o lock do estado", b.Bucket)
+	}
+
+	_, err = b.DynamoDB.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(b.LockTable),
+		Item: map[string]ddbtypes.AttributeValue{
+			"LockID": &ddbtypes.AttributeValueMemberS{Value: b.lockID()},
+			"ID":     &ddbtypes.AttributeValueMemberS{Value: info.ID},
+			"Info":   &ddbtypes.AttributeValueMemberS{Value: string(data)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(LockID)"),
+	})
+	if err != nil {
+		var conditionFailed *ddbtypes.ConditionalCheckFailedException
+		if errors.As(err, &conditionFailed) {
+			current, _ := b.CurrentLock(ctx)
+			return &LockedError{Info: current}
+		}
+		return fmt.Errorf("falha ao adquirir lock na tabela %s: %w", b.LockTable, err)
+	}
+	return nil
+}
+
+func (b *S3Backend) Unlock(ctx context.Context, id string) error {
+	current, err := b.CurrentLock(ctx)
+	if err != nil || current == nil {
+		return err
+	}
+	if err := checkLockID(current, id); err != nil {
+		return err
+	}
+
+	_, err = b.DynamoDB.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(b.LockTable),
+		Key: map[string]ddbtypes.AttributeValue{
+			"LockID": &ddbtypes.AttributeValueMemberS{Value: b.lockID()},
+		},
+		ConditionExpression:       aws.String("ID = :id"),
+		ExpressionAttributeValues: map[string]ddbtypes.AttributeValue{":id": &ddbtypes.AttributeValueMemberS{Value: id}},
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao liberar lock na tabela %s: %w", b.LockTable, err)
+	}
+	return nil
+}
+
+func (b *S3Backend) CurrentLock(ctx context.Context) (*LockInfo, error) {
+	if b.LockTable == "" {
+		// Sem tabela nenhum lock pode ter sido adquirido
+		return nil, nil
+	}
+
+	out, err := b.DynamoDB.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(b.LockTable),
+		Key:            map[string]ddbtypes.AttributeValue{"LockID": &ddbtypes.AttributeValueMemberS{Value: b.lockID()}},
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler lock na tabela %s: %w", b.LockTable, err)
+	}
+	attr, ok := out.Item["Info"].(*ddbtypes.AttributeValueMemberS)
+	if !ok {
+		return nil, nil
+	}
+
+	var info LockInfo
+	if err := json.Unmarshal([]byte(attr.Value), &info); err != nil {
+		return nil, fmt.Errorf("falha ao deserializar lock do estado: %w", err)
+	}
+	return &info, nil
+}
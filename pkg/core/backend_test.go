@@ -0,0 +1,173 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	ddbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// TestParseStateBackend verifies the --state-backend URL forms.
+func TestParseStateBackend(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    StateBackendConfig
+		wantErr bool
+	}{
+		{value: "", want: StateBackendConfig{Type: StateBackendLocal}},
+		{value: "local", want: StateBackendConfig{Type: StateBackendLocal}},
+		{value: "s3://infra-state/team/prod", want: StateBackendConfig{Type: StateBackendS3, Bucket: "infra-state", Prefix: "team/prod"}},
+		{value: "s3://infra-state", want: StateBackendConfig{Type: StateBackendS3, Bucket: "infra-state"}},
+		{value: "s3://infra-state/prod?lockTable=infra-locks", want: StateBackendConfig{Type: StateBackendS3, Bucket: "infra-state", Prefix: "prod", LockTable: "infra-locks"}},
+		{value: "configmap://infra/state", want: StateBackendConfig{Type: StateBackendConfigMap, Namespace: "infra", Name: "state"}},
+		{value: "secret://infra/state", want: StateBackendConfig{Type: StateBackendSecret, Namespace: "infra", Name: "state"}},
+		{value: "configmap://infra", wantErr: true},
+		{value: "secret://infra/a/b", wantErr: true},
+		{value: "gcs://bucket", wantErr: true},
+		{value: "/tmp/state", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseStateBackend(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%q: expected error, got %+v", tt.value, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: expected no error, got: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %+v, got %+v", tt.value, tt.want, got)
+		}
+	}
+}
+
+// testStateManager exercises the StateManager operations on top of a backend.
+func testStateManager(t *testing.T, backend StateBackend) {
+	t.Helper()
+	sm := NewStateManagerWithBackend(t.TempDir(), backend)
+
+	for _, name := range []string{"main", "app.example.com"} {
+		state := &ResourceState{
+			Kind:         "VPC",
+			Name:         name,
+			Namespace:    "default",
+			AWSResources: map[string]string{"vpcId": "vpc-" + name},
+		}
+		if err := sm.SaveState(state); err != nil {
+			t.Fatalf("SaveState(%s): %v", name, err)
+		}
+	}
+	if err := sm.SaveState(&ResourceState{Kind: "Subnet", Name: "public"}); err != nil {
+		t.Fatalf("SaveState(public): %v", err)
+	}
+
+	loaded, err := sm.LoadState("VPC", "default", "app.example.com")
+	if err != nil || loaded == nil {
+		t.Fatalf("Expected state, got %v (err: %v)", loaded, err)
+	}
+	if loaded.AWSResources["vpcId"] != "vpc-app.example.com" || loaded.CreatedAt.IsZero() {
+		t.Errorf("Unexpected state: %+v", loaded)
+	}
+
+	missing, err := sm.LoadState("VPC", "default", "other")
+	if err != nil || missing != nil {
+		t.Errorf("Expected nil state for missing resource, got %v (err: %v)", missing, err)
+	}
+
+	vpcs, err := sm.ListStates("VPC")
+	if err != nil || len(vpcs) != 2 {
+		t.Errorf("Expected 2 VPC states, got %d (err: %v)", len(vpcs), err)
+	}
+	all, err := sm.ListAllStates()
+	if err != nil || len(all) != 3 {
+		t.Errorf("Expected 3 states, got %d (err: %v)", len(all), err)
+	}
+
+	if err := sm.DeleteState("VPC", "default", "main"); err != nil {
+		t.Fatalf("DeleteState: %v", err)
+	}
+	if err := sm.DeleteState("VPC", "default", "main"); err != nil {
+		t.Errorf("Expected deleting a missing state to succeed, got: %v", err)
+	}
+	if state, _ := sm.LoadState("VPC", "default", "main"); state != nil {
+		t.Errorf("Expected state to be deleted")
+	}
+}
+
+// TestLocalBackend verifies the default file backend.
+func TestLocalBackend(t *testing.T) {
+	testStateManager(t, NewLocalBackend(t.TempDir()))
+	testStateLock(t, NewLocalBackend(t.TempDir()))
+}
+
+// TestKubernetesBackend verifies the ConfigMap and Secret backends against a fake clientset.
+func TestKubernetesBackend(t *testing.T) {
+	for _, secret := range []bool{false, true} {
+		t.Run(fmt.Sprintf("secret=%v", secret), func(t *testing.T) {
+			backend := &KubernetesBackend{
+				Client:    fake.NewSimpleClientset(),
+				Namespace: "infra",
+				Name:      "state",
+				Secret:    secret,
+			}
+			testStateManager(t, backend)
+			testStateLock(t, backend)
+		})
+	}
+}
+
+// TestS3Backend runs against LocalStack when AWS_ENDPOINT_URL is set.
+func TestS3Backend(t *testing.T) {
+	endpoint := os.Getenv("AWS_ENDPOINT_URL")
+	if endpoint == "" {
+		t.Skip("AWS_ENDPOINT_URL not set")
+	}
+
+	ctx := context.Background()
+	awsCfg, err := NewProviderConfigFromEnv().GetAWSConfig(ctx)
+	if err != nil {
+		t.Fatalf("GetAWSConfig: %v", err)
+	}
+
+	bucket := fmt.Sprintf("infra-operator-state-test-%d", time.Now().UnixNano())
+	backend := NewS3Backend(awsCfg, bucket, "tests", bucket)
+	if _, err := backend.Client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, err := backend.DynamoDB.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName:            aws.String(bucket),
+		AttributeDefinitions: []ddbtypes.AttributeDefinition{{AttributeName: aws.String("LockID"), AttributeType: ddbtypes.ScalarAttributeTypeS}},
+		KeySchema:            []ddbtypes.KeySchemaElement{{AttributeName: aws.String("LockID"), KeyType: ddbtypes.KeyTypeHash}},
+		BillingMode:          ddbtypes.BillingModePayPerRequest,
+	}); err != nil {
+		t.Fatalf("CreateTable: %v", err)
+	}
+
+	testStateManager(t, backend)
+	testStateLock(t, backend)
+}
+
+// TestS3BackendLockRequiresTable verifies that the S3 backend refuses to lock
+// without a DynamoDB table instead of using a non-atomic lock.
+func TestS3BackendLockRequiresTable(t *testing.T) {
+	ctx := context.Background()
+	backend := NewS3Backend(aws.Config{Region: "us-east-1"}, "infra-state", "prod", "")
+
+	if err := backend.Lock(ctx, newLockInfo("apply")); err == nil {
+		t.Fatalf("Expected Lock without a lock table to fail")
+	}
+	if lock, err := backend.CurrentLock(ctx); err != nil || lock != nil {
+		t.Errorf("Expected no lock, got %v (err: %v)", lock, err)
+	}
+}
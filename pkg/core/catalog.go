@@ -0,0 +1,945 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	awsacm "infra-operator/internal/adapters/aws/acm"
+	awsalb "infra-operator/internal/adapters/aws/alb"
+	awsapigw "infra-operator/internal/adapters/aws/apigateway"
+	awsautoscaling "infra-operator/internal/adapters/aws/autoscaling"
+	awscf "infra-operator/internal/adapters/aws/cloudfront"
+	awscloudwatch "infra-operator/internal/adapters/aws/cloudwatch"
+	awsdynamodb "infra-operator/internal/adapters/aws/dynamodb"
+	awsec2 "infra-operator/internal/adapters/aws/ec2"
+	awsecr "infra-operator/internal/adapters/aws/ecr"
+	awsecs "infra-operator/internal/adapters/aws/ecs"
+	awseks "infra-operator/internal/adapters/aws/eks"
+	awselasticache "infra-operator/internal/adapters/aws/elasticache"
+	awselasticip "infra-operator/internal/adapters/aws/elasticip"
+	awsiam "infra-operator/internal/adapters/aws/iam"
+	awskeypair "infra-operator/internal/adapters/aws/keypair"
+	awskms "infra-operator/internal/adapters/aws/kms"
+	awslambda "infra-operator/internal/adapters/aws/lambda"
+	awsnat "infra-operator/internal/adapters/aws/natgateway"
+	awsnlb "infra-operator/internal/adapters/aws/nlb"
+	awsrds "infra-operator/internal/adapters/aws/rds"
+	awsroute53 "infra-operator/internal/adapters/aws/route53"
+	awsroutetable "infra-operator/internal/adapters/aws/routetable"
+	awss3 "infra-operator/internal/adapters/aws/s3"
+	awssm "infra-operator/internal/adapters/aws/secretsmanager"
+	awssns "infra-operator/internal/adapters/aws/sns"
+	awssqs "infra-operator/internal/adapters/aws/sqs"
+	ocirepo "infra-operator/internal/adapters/oci"
+	s3domain "infra-operator/internal/domain/s3"
+	acmuc "infra-operator/internal/usecases/acm"
+	albuc "infra-operator/internal/usecases/alb"
+	apigwuc "infra-operator/internal/usecases/apigateway"
+	autoscalinguc "infra-operator/internal/usecases/autoscaling"
+	cfuc "infra-operator/internal/usecases/cloudfront"
+	dynamodbuc "infra-operator/internal/usecases/dynamodb"
+	ec2uc "infra-operator/internal/usecases/ec2"
+	ecruc "infra-operator/internal/usecases/ecr"
+	ecsuc "infra-operator/internal/usecases/ecs"
+	eksuc "infra-operator/internal/usecases/eks"
+	elasticacheuc "infra-operator/internal/usecases/elasticache"
+	elasticipuc "infra-operator/internal/usecases/elasticip"
+	iamuc "infra-operator/internal/usecases/iam"
+	keypairuc "infra-operator/internal/usecases/keypair"
+	kmsuc "infra-operator/internal/usecases/kms"
+	lambdauc "infra-operator/internal/usecases/lambda"
+	natuc "infra-operator/internal/usecases/natgateway"
+	nlbuc "infra-operator/internal/usecases/nlb"
+	rdsuc "infra-operator/internal/usecases/rds"
+	route53uc "infra-operator/internal/usecases/route53"
+	routetableuc "infra-operator/internal/usecases/routetable"
+	s3uc "infra-operator/internal/usecases/s3"
+	smuc "infra-operator/internal/usecases/secretsmanager"
+	snsuc "infra-operator/internal/usecases/sns"
+	sqsuc "infra-operator/internal/usecases/sqs"
+	"infra-operator/pkg/mapper"
+)
+
+// useCaseKind descreve um kind provisionado pelos mesmos use cases do operator.
+//
+// O spec genérico do manifesto é decodificado no CR tipado (mesmos campos
+// JSON do CRD), convertido pelo mapper para o domínio e sincronizado pelo
+// use case. O status do CR é armazenado em ResourceState.Status, de onde
+// os mappers recuperam os IDs AWS em updates e deleções.
+type useCaseKind struct {
+	// idKeys mapeia chaves de AWSResources para campos do status do CR
+	idKeys map[string]string
+
+	// immutable lista os campos de topo do spec que exigem substituição;
+	// os demais são atualizados in-place pelo Sync do use case
+	immutable []string
+
+	// bind decodifica o CR e retorna as operações ligadas a ele
+	bind func(e *Engine, r Resource, state *ResourceState) (kindOps, error)
+}
+
+// kindOps são as operações de um recurso ligadas ao seu CR decodificado
+type kindOps struct {
+	// sync cria ou atualiza o recurso e retorna o novo status do CR
+	sync func(ctx context.Context) (interface{}, error)
+
+	// delete remove o recurso respeitando o deletionPolicy do spec
+	delete func(ctx context.Context) error
+}
+
+// useCaseKinds registra os kinds suportados via use cases.
+// VPC, Subnet, InternetGateway, SecurityGroup, EC2Instance e ComputeStack
+// continuam com implementação própria no Engine.
+var useCaseKinds = map[string]useCaseKind{
+	"ElasticIP": {
+		idKeys:    map[string]string{"allocationId": "allocationID", "publicIp": "publicIP"},
+		immutable: []string{"domain", "networkBorderGroup", "publicIpv4Pool", "customerOwnedIpv4Pool"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.ElasticIP{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := elasticipuc.NewAddressUseCase(awselasticip.NewRepository(e.awsConfig))
+			addr := mapper.CRToDomainElasticIP(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncAddress(ctx, addr); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusElasticIP(addr, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.ReleaseAddress(ctx, addr) },
+			}, nil
+		},
+	},
+	"NATGateway": {
+		idKeys:    map[string]string{"natGatewayId": "natGatewayID", "subnetId": "subnetID", "vpcId": "vpcID"},
+		immutable: []string{"subnetID", "allocationID", "connectivityType"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.NATGateway{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := natuc.NewGatewayUseCase(awsnat.NewRepository(e.awsConfig))
+			gw := mapper.CRToDomainNATGateway(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncGateway(ctx, gw); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusNATGateway(gw, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteGateway(ctx, gw) },
+			}, nil
+		},
+	},
+	"RouteTable": {
+		idKeys:    map[string]string{"routeTableId": "routeTableID", "vpcId": "vpcID"},
+		immutable: []string{"vpcID"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.RouteTable{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := routetableuc.NewRouteTableUseCase(awsroutetable.NewRepository(e.awsConfig))
+			rt := mapper.CRToDomainRouteTable(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncRouteTable(ctx, rt); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusRouteTable(rt, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteRouteTable(ctx, rt) },
+			}, nil
+		},
+	},
+	"IAMRole": {
+		idKeys:    map[string]string{"roleArn": "roleArn", "roleId": "roleId"},
+		immutable: []string{"roleName", "path"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.IAMRole{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := iamuc.NewRoleUseCase(awsiam.NewRepository(e.awsConfig))
+			role := mapper.CRToDomainIAMRole(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncRole(ctx, role); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusIAMRole(role, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteRole(ctx, role) },
+			}, nil
+		},
+	},
+	"KMSKey": {
+		idKeys:    map[string]string{"keyId": "keyId", "keyArn": "arn"},
+		immutable: []string{"keyUsage", "keySpec", "multiRegion"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.KMSKey{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := kmsuc.NewKeyUseCase(awskms.NewRepository(e.awsConfig))
+			key := mapper.CRToDomainKMSKey(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncKey(ctx, key); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusKMSKey(key, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteKey(ctx, key) },
+			}, nil
+		},
+	},
+	"SecretsManagerSecret": {
+		idKeys:    map[string]string{"secretArn": "arn"},
+		immutable: []string{"secretName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.SecretsManagerSecret{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			// Sem acesso a Secrets do Kubernetes, o valor vem inline em spec.secretString
+			secretValue, _ := r.Spec["secretString"].(string)
+			uc := smuc.NewSecretUseCase(awssm.NewRepository(e.awsConfig))
+			secret := mapper.CRToDomainSecretsManagerSecret(cr, secretValue)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncSecret(ctx, secret); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusSecretsManagerSecret(secret, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteSecret(ctx, secret) },
+			}, nil
+		},
+	},
+	"S3Bucket": {
+		idKeys:    map[string]string{"bucketArn": "arn", "bucketDomainName": "bucketDomainName"},
+		immutable: []string{"bucketName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.S3Bucket{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := s3uc.NewBucketUseCase(awss3.NewRepository(e.awsConfig))
+			bucket := mapper.CRToDomainBucket(cr)
+			bucket.Region = e.awsConfig.Region
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncBucket(ctx, bucket); err != nil {
+						return nil, err
+					}
+					mapper.DomainBucketToCRStatus(bucket, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error {
+					// A CLI não tem reconcile para retomar: esvazia o bucket até o fim
+					err := uc.DeleteBucket(ctx, bucket)
+					for errors.Is(err, s3domain.ErrBucketEmptying) {
+						err = uc.DeleteBucket(ctx, bucket)
+					}
+					return err
+				},
+			}, nil
+		},
+	},
+	"ECRRepository": {
+		idKeys:    map[string]string{"repositoryArn": "repositoryArn", "repositoryUri": "repositoryUri"},
+		immutable: []string{"repositoryName", "encryptionConfiguration"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.ECRRepository{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := ecruc.NewRepositoryUseCase(awsecr.NewRepository(e.awsConfig))
+			repo := mapper.CRToDomainECRRepository(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncRepository(ctx, repo); err != nil {
+						return nil, err
+					}
+					mapper.UpdateCRStatusFromECRRepository(cr, repo)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteRepository(ctx, repo) },
+			}, nil
+		},
+	},
+	"DBSubnetGroup": {
+		idKeys:    map[string]string{"dbSubnetGroupArn": "arn"},
+		immutable: []string{"dbSubnetGroupName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.DBSubnetGroup{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := rdsuc.NewSubnetGroupUseCase(awsrds.NewSubnetGroupRepository(e.awsConfig))
+			group := mapper.CRToDomainDBSubnetGroup(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncSubnetGroup(ctx, group); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusDBSubnetGroup(group, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteSubnetGroup(ctx, group) },
+			}, nil
+		},
+	},
+	"DBParameterGroup": {
+		idKeys:    map[string]string{"dbParameterGroupArn": "arn"},
+		immutable: []string{"dbParameterGroupName", "family", "description"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.DBParameterGroup{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := rdsuc.NewParameterGroupUseCase(awsrds.NewParameterGroupRepository(e.awsConfig))
+			group := mapper.CRToDomainDBParameterGroup(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncParameterGroup(ctx, group); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusDBParameterGroup(group, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteParameterGroup(ctx, group) },
+			}, nil
+		},
+	},
+	"RDSInstance": {
+		idKeys:    map[string]string{"dbInstanceArn": "dbInstanceArn", "endpoint": "endpoint"},
+		immutable: []string{"dbInstanceIdentifier", "engine", "masterUsername", "dbName", "storageEncrypted"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.RDSInstance{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := rdsuc.NewInstanceUseCase(awsrds.NewRepository(e.awsConfig))
+			instance := mapper.CRToDomainRDSInstance(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncDBInstance(ctx, instance); err != nil {
+						return nil, err
+					}
+					mapper.UpdateCRStatusFromRDSInstance(cr, instance)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteDBInstance(ctx, instance) },
+			}, nil
+		},
+	},
+	"RDSCluster": {
+		idKeys:    map[string]string{"dbClusterArn": "dbClusterArn", "endpoint": "endpoint", "readerEndpoint": "readerEndpoint"},
+		immutable: []string{"dbClusterIdentifier", "engine", "masterUsername", "databaseName", "storageEncrypted", "kmsKeyId"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.RDSCluster{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := rdsuc.NewClusterUseCase(awsrds.NewClusterRepository(e.awsConfig))
+			cluster := mapper.CRToDomainRDSCluster(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncCluster(ctx, cluster); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusRDSCluster(cluster, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteCluster(ctx, cluster) },
+			}, nil
+		},
+	},
+	"RDSSnapshot": {
+		idKeys:    map[string]string{"latestSnapshotArn": "latestSnapshotArn"},
+		immutable: []string{"dbSnapshotIdentifier", "dbInstanceIdentifier"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.RDSSnapshot{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := rdsuc.NewSnapshotUseCase(awsrds.NewRepository(e.awsConfig))
+			plan := mapper.CRToDomainDBSnapshotPlan(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncSnapshots(ctx, plan); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusDBSnapshotPlan(plan, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteSnapshots(ctx, plan) },
+			}, nil
+		},
+	},
+	"DynamoDBTable": {
+		idKeys:    map[string]string{"tableArn": "tableARN", "streamArn": "streamARN"},
+		immutable: []string{"tableName", "hashKey", "rangeKey"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.DynamoDBTable{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := dynamodbuc.NewTableUseCase(awsdynamodb.NewRepository(e.awsConfig))
+			table := mapper.CRToDomainTable(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncTable(ctx, table); err != nil {
+						return nil, err
+					}
+					mapper.DomainTableToCRStatus(table, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteTable(ctx, table) },
+			}, nil
+		},
+	},
+	"ElastiCacheCluster": {
+		idKeys:    map[string]string{"cacheClusterArn": "cacheClusterARN"},
+		immutable: []string{"clusterID", "engine", "subnetGroupName", "atRestEncryptionEnabled", "transitEncryptionEnabled"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.ElastiCacheCluster{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			// Sem acesso a Secrets do Kubernetes, o token vem inline em spec.authToken
+			authToken, _ := r.Spec["authToken"].(string)
+			uc := elasticacheuc.NewClusterUseCase(awselasticache.NewRepository(e.awsConfig))
+			cluster := mapper.CRToDomainElastiCacheCluster(cr, authToken)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncCluster(ctx, cluster); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusElastiCacheCluster(cluster, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteCluster(ctx, cluster) },
+			}, nil
+		},
+	},
+	"SQSQueue": {
+		idKeys:    map[string]string{"queueUrl": "queueURL", "queueArn": "queueARN"},
+		immutable: []string{"queueName", "fifoQueue"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.SQSQueue{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := sqsuc.NewQueueUseCase(awssqs.NewRepository(e.awsConfig))
+			queue := mapper.CRToDomainQueue(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncQueue(ctx, queue); err != nil {
+						return nil, err
+					}
+					return mapper.DomainQueueToStatus(queue), nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteQueue(ctx, queue) },
+			}, nil
+		},
+	},
+	"SNSTopic": {
+		idKeys:    map[string]string{"topicArn": "topicArn"},
+		immutable: []string{"topicName", "fifoTopic"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.SNSTopic{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := snsuc.NewTopicUseCase(awssns.NewRepository(e.awsConfig))
+			topic := mapper.CRToDomainTopic(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncTopic(ctx, topic); err != nil {
+						return nil, err
+					}
+					return mapper.DomainTopicToStatus(topic), nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteTopic(ctx, topic) },
+			}, nil
+		},
+	},
+	"LambdaFunction": {
+		idKeys:    map[string]string{"functionArn": "functionArn"},
+		immutable: []string{"functionName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.LambdaFunction{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := lambdauc.NewFunctionUseCase(awslambda.NewRepository(e.awsConfig), ocirepo.NewRepository())
+			function := mapper.CRToDomainFunction(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					switch code := cr.Spec.Code; {
+					case code.ConfigMapRef != nil:
+						return nil, fmt.Errorf("code.configMapRef não é suportado pela CLI; use code.path ou code.oci")
+					case code.OCI != nil && code.OCI.PullSecretRef != nil:
+						return nil, fmt.Errorf("code.oci.pullSecretRef não é suportado pela CLI; apenas artefatos públicos")
+					case code.Path != "":
+						files, err := readCodeDirectory(code.Path)
+						if err != nil {
+							return nil, err
+						}
+						function.Code.Files = files
+					}
+					if err := uc.SyncFunction(ctx, function); err != nil {
+						return nil, err
+					}
+					return mapper.DomainFunctionToStatus(function), nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteFunction(ctx, function) },
+			}, nil
+		},
+	},
+	"LambdaAlias": {
+		idKeys:    map[string]string{"aliasArn": "aliasArn"},
+		immutable: []string{"functionName", "name"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.LambdaAlias{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := lambdauc.NewAliasUseCase(awslambda.NewAliasRepository(e.awsConfig), awscloudwatch.NewRepository(e.awsConfig))
+			alias := mapper.CRToDomainLambdaAlias(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncAlias(ctx, alias); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusLambdaAlias(alias, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteAlias(ctx, alias) },
+			}, nil
+		},
+	},
+	"EC2KeyPair": {
+		idKeys:    map[string]string{"keyPairId": "keyPairID", "keyName": "keyName"},
+		immutable: []string{"keyName", "publicKeyMaterial"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.EC2KeyPair{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := keypairuc.NewKeyPairUseCase(awskeypair.NewRepository(e.awsConfig))
+			kp := mapper.CRToDomainEC2KeyPair(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncKeyPair(ctx, kp); err != nil {
+						return nil, err
+					}
+					// A chave privada só é retornada na criação; grava ao lado do estado
+					if kp.PrivateKeyMaterial != "" {
+						if err := e.savePrivateKey(kp.KeyName, kp.PrivateKeyMaterial); err != nil {
+							return nil, err
+						}
+					}
+					mapper.DomainToStatusEC2KeyPair(kp, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteKeyPair(ctx, kp) },
+			}, nil
+		},
+	},
+	"ALB": {
+		idKeys:    map[string]string{"loadBalancerArn": "loadBalancerARN", "dnsName": "dnsName"},
+		immutable: []string{"loadBalancerName", "scheme"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.ALB{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := albuc.NewLoadBalancerUseCase(awsalb.NewRepository(e.awsConfig))
+			lb := mapper.CRToDomainALB(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncLoadBalancer(ctx, lb); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusALB(lb, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteLoadBalancer(ctx, lb) },
+			}, nil
+		},
+	},
+	"NLB": {
+		idKeys:    map[string]string{"loadBalancerArn": "loadBalancerARN", "dnsName": "dnsName"},
+		immutable: []string{"loadBalancerName", "scheme"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.NLB{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := nlbuc.NewLoadBalancerUseCase(awsnlb.NewRepository(e.awsConfig))
+			lb := mapper.CRToDomainNLB(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncLoadBalancer(ctx, lb); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusNLB(lb, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteLoadBalancer(ctx, lb) },
+			}, nil
+		},
+	},
+	"TargetGroup": {
+		idKeys:    map[string]string{"targetGroupArn": "targetGroupARN"},
+		immutable: []string{"targetGroupName", "protocol", "protocolVersion", "port", "targetType", "vpcID"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.TargetGroup{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := albuc.NewTargetGroupUseCase(awsalb.NewRepository(e.awsConfig))
+			tg := mapper.CRToDomainTargetGroup(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncTargetGroup(ctx, tg); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusTargetGroup(tg, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteTargetGroup(ctx, tg) },
+			}, nil
+		},
+	},
+	"Listener": {
+		idKeys:    map[string]string{"listenerArn": "listenerARN"},
+		immutable: []string{"loadBalancerARN"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.Listener{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := albuc.NewListenerUseCase(awsalb.NewRepository(e.awsConfig))
+			listener := mapper.CRToDomainListener(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncListener(ctx, listener); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusListener(listener, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteListener(ctx, listener) },
+			}, nil
+		},
+	},
+	"LaunchTemplate": {
+		idKeys:    map[string]string{"launchTemplateId": "launchTemplateID"},
+		immutable: []string{"launchTemplateName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.LaunchTemplate{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := ec2uc.NewLaunchTemplateUseCase(awsec2.NewRepository(e.awsConfig))
+			lt := mapper.CRToDomainLaunchTemplate(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncLaunchTemplate(ctx, lt); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusLaunchTemplate(lt, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteLaunchTemplate(ctx, lt) },
+			}, nil
+		},
+	},
+	"AutoScalingGroup": {
+		idKeys:    map[string]string{"autoScalingGroupArn": "autoScalingGroupARN"},
+		immutable: []string{"autoScalingGroupName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.AutoScalingGroup{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := autoscalinguc.NewGroupUseCase(awsautoscaling.NewRepository(e.awsConfig))
+			group := mapper.CRToDomainAutoScalingGroup(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncGroup(ctx, group); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusAutoScalingGroup(group, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteGroup(ctx, group) },
+			}, nil
+		},
+	},
+	"EKSCluster": {
+		idKeys:    map[string]string{"clusterArn": "arn", "endpoint": "endpoint"},
+		immutable: []string{"clusterName", "roleARN", "encryption"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.EKSCluster{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := eksuc.NewClusterUseCase(awseks.NewRepository(e.awsConfig))
+			cluster := mapper.CRToDomainEKSCluster(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncCluster(ctx, cluster); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusEKSCluster(cluster, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteCluster(ctx, cluster) },
+			}, nil
+		},
+	},
+	"ECSCluster": {
+		idKeys:    map[string]string{"clusterArn": "clusterARN"},
+		immutable: []string{"clusterName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.ECSCluster{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := ecsuc.NewClusterUseCase(awsecs.NewRepository(e.awsConfig))
+			cluster := mapper.CRToDomainECSCluster(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncCluster(ctx, cluster); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusECSCluster(cluster, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteCluster(ctx, cluster) },
+			}, nil
+		},
+	},
+	"APIGateway": {
+		idKeys:    map[string]string{"apiId": "apiId", "apiEndpoint": "apiEndpoint"},
+		immutable: []string{"protocolType"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.APIGateway{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := apigwuc.NewAPIUseCase(awsapigw.NewRepository(e.awsConfig))
+			api := mapper.CRToDomainAPIGateway(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncAPI(ctx, api); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusAPIGateway(api, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteAPI(ctx, api) },
+			}, nil
+		},
+	},
+	"Certificate": {
+		idKeys:    map[string]string{"certificateArn": "certificateARN"},
+		immutable: []string{"domainName", "subjectAlternativeNames", "validationMethod"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.Certificate{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := acmuc.NewCertificateUseCase(awsacm.NewRepository(e.awsConfig))
+			cert := mapper.CRToDomainACM(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncCertificate(ctx, cert); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusACM(cert, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteCertificate(ctx, cert) },
+			}, nil
+		},
+	},
+	"CloudFront": {
+		idKeys: map[string]string{"distributionId": "distributionId", "domainName": "domainName"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.CloudFront{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := cfuc.NewDistributionUseCase(awscf.NewRepository(e.awsConfig))
+			dist := mapper.CRToDomainCloudFront(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncDistribution(ctx, dist); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusCloudFront(dist, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteDistribution(ctx, dist) },
+			}, nil
+		},
+	},
+	"Route53HostedZone": {
+		idKeys:    map[string]string{"hostedZoneId": "hostedZoneID"},
+		immutable: []string{"name", "privateZone", "vpcId", "vpcRegion"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.Route53HostedZone{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := route53uc.NewRoute53UseCase(awsroute53.NewRepository(e.awsConfig))
+			hz := mapper.CRToDomainRoute53HostedZone(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncHostedZone(ctx, hz); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusRoute53HostedZone(hz, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteHostedZone(ctx, hz) },
+			}, nil
+		},
+	},
+	"Route53RecordSet": {
+		idKeys:    map[string]string{"changeId": "changeID"},
+		immutable: []string{"hostedZoneID", "name", "type", "setIdentifier"},
+		bind: func(e *Engine, r Resource, state *ResourceState) (kindOps, error) {
+			cr := &infrav1alpha1.Route53RecordSet{}
+			if err := decodeCR(r, state, cr); err != nil {
+				return kindOps{}, err
+			}
+			uc := route53uc.NewRoute53UseCase(awsroute53.NewRepository(e.awsConfig))
+			rs := mapper.CRToDomainRoute53RecordSet(cr)
+			return kindOps{
+				sync: func(ctx context.Context) (interface{}, error) {
+					if err := uc.SyncRecordSet(ctx, rs); err != nil {
+						return nil, err
+					}
+					mapper.DomainToStatusRoute53RecordSet(rs, cr)
+					return cr.Status, nil
+				},
+				delete: func(ctx context.Context) error { return uc.DeleteRecordSet(ctx, rs) },
+			}, nil
+		},
+	},
+}
+
+// syncUseCaseKind cria ou atualiza um recurso via use case e grava o status no estado
+func (e *Engine) syncUseCaseKind(ctx context.Context, kind useCaseKind, r Resource, state *ResourceState) error {
+	ops, err := kind.bind(e, r, state)
+	if err != nil {
+		return err
+	}
+
+	status, err := ops.sync(ctx)
+	if err != nil {
+		return err
+	}
+
+	statusMap, err := toMap(status)
+	if err != nil {
+		return fmt.Errorf("falha ao serializar status: %w", err)
+	}
+
+	state.Status = statusMap
+	if state.AWSResources == nil {
+		state.AWSResources = make(map[string]string)
+	}
+	for key, field := range kind.idKeys {
+		if value, ok := statusMap[field].(string); ok && value != "" {
+			state.AWSResources[key] = value
+		}
+	}
+
+	return nil
+}
+
+// deleteUseCaseKind deleta um recurso via use case a partir do estado armazenado
+func (e *Engine) deleteUseCaseKind(ctx context.Context, kind useCaseKind, state *ResourceState) error {
+	ops, err := kind.bind(e, ResourceFromState(state), state)
+	if err != nil {
+		return err
+	}
+	return ops.delete(ctx)
+}
+
+// savePrivateKey grava a chave privada de um EC2KeyPair em <stateDir>/keys/<keyName>.pem
+func (e *Engine) savePrivateKey(keyName, material string) error {
+	keysDir := filepath.Join(e.stateManager.StateDir, "keys")
+	if err := os.MkdirAll(keysDir, 0700); err != nil {
+		return fmt.Errorf("falha ao criar diretório de chaves: %w", err)
+	}
+
+	keyPath := filepath.Join(keysDir, keyName+".pem")
+	if err := os.WriteFile(keyPath, []byte(material), 0600); err != nil {
+		return fmt.Errorf("falha ao gravar chave privada: %w", err)
+	}
+
+	e.output.Write("  Chave privada gravada em %s", keyPath)
+	return nil
+}
+
+// decodeCR decodifica o spec do manifesto e o status do estado no CR tipado
+func decodeCR(r Resource, state *ResourceState, cr interface{}) error {
+	obj := map[string]interface{}{
+		"apiVersion": r.APIVersion,
+		"kind":       r.Kind,
+		"metadata": map[string]interface{}{
+			"name":      r.Metadata.Name,
+			"namespace": r.Metadata.Namespace,
+		},
+		"spec": r.Spec,
+	}
+	if state != nil && state.Status != nil {
+		obj["status"] = state.Status
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("falha ao serializar %s/%s: %w", r.Kind, r.Metadata.Name, err)
+	}
+	if err := json.Unmarshal(data, cr); err != nil {
+		return fmt.Errorf("spec inválido para %s/%s: %w", r.Kind, r.Metadata.Name, err)
+	}
+	return nil
+}
+
+// toMap converte uma struct em map usando as tags JSON
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
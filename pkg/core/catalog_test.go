@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+)
+
+// TestDecodeCR verifies the generic spec and stored status are decoded into the typed CR.
+func TestDecodeCR(t *testing.T) {
+	r := Resource{
+		APIVersion: "aws-infra-operator.runner.codes/v1alpha1",
+		Kind:       "SQSQueue",
+		Metadata:   Metadata{Name: "orders", Namespace: "default"},
+		Spec: map[string]interface{}{
+			"queueName":         "orders",
+			"visibilityTimeout": 60,
+			"tags":              map[string]interface{}{"env": "dev"},
+		},
+	}
+	state := &ResourceState{
+		Status: map[string]interface{}{"queueURL": "https://sqs/orders"},
+	}
+
+	cr := &infrav1alpha1.SQSQueue{}
+	if err := decodeCR(r, state, cr); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if cr.Name != "orders" || cr.Namespace != "default" {
+		t.Errorf("Expected metadata default/orders, got %s/%s", cr.Namespace, cr.Name)
+	}
+	if cr.Spec.QueueName != "orders" || cr.Spec.VisibilityTimeout != 60 {
+		t.Errorf("Unexpected spec: %+v", cr.Spec)
+	}
+	if cr.Spec.Tags["env"] != "dev" {
+		t.Errorf("Expected tag env=dev, got %v", cr.Spec.Tags)
+	}
+	if cr.Status.QueueURL != "https://sqs/orders" {
+		t.Errorf("Expected queueURL from state, got %q", cr.Status.QueueURL)
+	}
+}
+
+// TestDecodeCR_InvalidSpec verifies type mismatches are reported.
+func TestDecodeCR_InvalidSpec(t *testing.T) {
+	r := Resource{
+		Kind:     "SQSQueue",
+		Metadata: Metadata{Name: "orders"},
+		Spec:     map[string]interface{}{"visibilityTimeout": "sixty"},
+	}
+
+	if err := decodeCR(r, nil, &infrav1alpha1.SQSQueue{}); err == nil {
+		t.Errorf("Expected error for invalid spec")
+	}
+}
+
+// TestDiffSpecs_UseCaseKind verifies use case kinds only replace on immutable fields.
+func TestDiffSpecs_UseCaseKind(t *testing.T) {
+	stored := map[string]interface{}{
+		"tableName":   "orders",
+		"hashKey":     map[string]interface{}{"name": "id", "type": "S"},
+		"billingMode": "PAY_PER_REQUEST",
+	}
+	manifest := map[string]interface{}{
+		"tableName":   "orders",
+		"hashKey":     map[string]interface{}{"name": "id", "type": "S"},
+		"billingMode": "PROVISIONED",
+	}
+
+	changes := DiffSpecs("DynamoDBTable", stored, manifest)
+	if len(changes) != 1 || changes[0].Replace {
+		t.Fatalf("Expected one in-place change, got %v", changes)
+	}
+
+	manifest["tableName"] = "orders-v2"
+	if !RequiresReplace(DiffSpecs("DynamoDBTable", stored, manifest)) {
+		t.Errorf("Expected tableName change to require replacement")
+	}
+}
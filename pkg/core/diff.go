@@ -0,0 +1,195 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldChange representa a mudança de um campo do spec entre o estado e o manifesto
+type FieldChange struct {
+	// Path é o caminho do campo no spec (ex: "tags.Environment", "instanceType")
+	Path string `json:"path"`
+
+	// Old é o valor armazenado no estado (nil quando o campo foi adicionado)
+	Old interface{} `json:"old,omitempty"`
+
+	// New é o valor do manifesto (nil quando o campo foi removido)
+	New interface{} `json:"new,omitempty"`
+
+	// Replace indica que a mudança não pode ser aplicada in-place
+	// e exige recriar o recurso AWS
+	Replace bool `json:"replace"`
+}
+
+// String formata a mudança para exibição no plano
+func (c FieldChange) String() string {
+	var line string
+	switch {
+	case c.Old == nil:
+		line = fmt.Sprintf("+ %s: %s", c.Path, formatValue(c.New))
+	case c.New == nil:
+		line = fmt.Sprintf("- %s: %s", c.Path, formatValue(c.Old))
+	default:
+		line = fmt.Sprintf("~ %s: %s -> %s", c.Path, formatValue(c.Old), formatValue(c.New))
+	}
+
+	if c.Replace {
+		line += " (requer substituição)"
+	}
+	return line
+}
+
+// inPlaceFields lista, por kind, os campos de topo do spec que o Engine
+// consegue atualizar sem recriar o recurso. Qualquer outro campo alterado
+// é classificado como substituição.
+var inPlaceFields = map[string][]string{
+	"VPC":             {"tags", "enableDnsSupport", "enableDnsHostnames"},
+	"Subnet":          {"tags", "mapPublicIpOnLaunch"},
+	"InternetGateway": {"tags"},
+	"SecurityGroup":   {"tags", "ingressRules", "egressRules"},
+	"EC2Instance":     {"tags", "instanceType", "securityGroupIds"},
+}
+
+// DiffSpecs compara o spec armazenado no estado com o spec do manifesto
+// e retorna as mudanças campo a campo, ordenadas pelo caminho.
+//
+// Mapas são comparados recursivamente; listas são comparadas como um
+// valor único, já que a ordem dos itens costuma ser significativa
+// (ex: regras de security group, rotas).
+func DiffSpecs(kind string, oldSpec, newSpec map[string]interface{}) []FieldChange {
+	var changes []FieldChange
+	diffValues("", normalizeSpec(oldSpec), normalizeSpec(newSpec), &changes)
+
+	for i := range changes {
+		changes[i].Replace = !isInPlaceField(kind, changes[i].Path)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+// RequiresReplace indica se alguma das mudanças exige recriar o recurso
+func RequiresReplace(changes []FieldChange) bool {
+	for _, c := range changes {
+		if c.Replace {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFieldChange indica se o campo de topo (ou algum subcampo) mudou
+func HasFieldChange(changes []FieldChange, field string) bool {
+	for _, c := range changes {
+		if topLevelField(c.Path) == field {
+			return true
+		}
+	}
+	return false
+}
+
+// diffValues percorre os dois valores acumulando as diferenças
+func diffValues(path string, oldVal, newVal interface{}, changes *[]FieldChange) {
+	oldMap, oldIsMap := oldVal.(map[string]interface{})
+	newMap, newIsMap := newVal.(map[string]interface{})
+
+	if oldIsMap && newIsMap {
+		keys := make(map[string]struct{})
+		for k := range oldMap {
+			keys[k] = struct{}{}
+		}
+		for k := range newMap {
+			keys[k] = struct{}{}
+		}
+
+		for k := range keys {
+			diffValues(joinPath(path, k), oldMap[k], newMap[k], changes)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(oldVal, newVal) {
+		return
+	}
+
+	*changes = append(*changes, FieldChange{
+		Path: path,
+		Old:  oldVal,
+		New:  newVal,
+	})
+}
+
+// normalizeSpec converte o spec para os tipos produzidos por encoding/json,
+// para que números vindos do YAML (int) e do estado (float64) sejam comparáveis
+func normalizeSpec(spec map[string]interface{}) map[string]interface{} {
+	if spec == nil {
+		return map[string]interface{}{}
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return spec
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return spec
+	}
+	return normalized
+}
+
+func isInPlaceField(kind, path string) bool {
+	field := topLevelField(path)
+
+	// Kinds via use case listam os campos imutáveis em vez dos in-place
+	if uk, ok := useCaseKinds[kind]; ok {
+		for _, f := range uk.immutable {
+			if f == field {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, f := range inPlaceFields[kind] {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func topLevelField(path string) string {
+	if idx := strings.Index(path, "."); idx >= 0 {
+		return path[:idx]
+	}
+	return path
+}
+
+func joinPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", val)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
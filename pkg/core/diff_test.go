@@ -0,0 +1,102 @@
+package core
+
+import (
+	"testing"
+)
+
+// TestDiffSpecs_NoChange verifies YAML ints and JSON floats compare equal.
+func TestDiffSpecs_NoChange(t *testing.T) {
+	stored := map[string]interface{}{
+		"cidrBlock": "10.0.0.0/16",
+		"ingressRules": []interface{}{
+			map[string]interface{}{"protocol": "tcp", "fromPort": float64(22), "toPort": float64(22)},
+		},
+	}
+	manifest := map[string]interface{}{
+		"cidrBlock": "10.0.0.0/16",
+		"ingressRules": []interface{}{
+			map[string]interface{}{"protocol": "tcp", "fromPort": 22, "toPort": 22},
+		},
+	}
+
+	if changes := DiffSpecs("SecurityGroup", stored, manifest); len(changes) != 0 {
+		t.Errorf("Expected no changes, got %v", changes)
+	}
+}
+
+// TestDiffSpecs_InPlaceAndReplace verifies changes are classified per kind.
+func TestDiffSpecs_InPlaceAndReplace(t *testing.T) {
+	stored := map[string]interface{}{
+		"instanceType": "t3.micro",
+		"imageId":      "ami-1",
+		"tags":         map[string]interface{}{"env": "dev", "team": "infra"},
+	}
+	manifest := map[string]interface{}{
+		"instanceType": "t3.small",
+		"imageId":      "ami-2",
+		"tags":         map[string]interface{}{"env": "prod"},
+	}
+
+	changes := DiffSpecs("EC2Instance", stored, manifest)
+	if len(changes) != 4 {
+		t.Fatalf("Expected 4 changes, got %d: %v", len(changes), changes)
+	}
+
+	expected := map[string]bool{
+		"imageId":      true,
+		"instanceType": false,
+		"tags.env":     false,
+		"tags.team":    false,
+	}
+	for _, c := range changes {
+		replace, ok := expected[c.Path]
+		if !ok {
+			t.Errorf("Unexpected change on %s", c.Path)
+			continue
+		}
+		if c.Replace != replace {
+			t.Errorf("Expected %s replace=%v, got %v", c.Path, replace, c.Replace)
+		}
+	}
+
+	if !RequiresReplace(changes) {
+		t.Errorf("Expected imageId change to require replacement")
+	}
+	if !HasFieldChange(changes, "tags") {
+		t.Errorf("Expected tags change to be detected")
+	}
+}
+
+// TestFieldChange_String verifies the plan output format.
+func TestFieldChange_String(t *testing.T) {
+	tests := []struct {
+		change   FieldChange
+		expected string
+	}{
+		{FieldChange{Path: "tags.env", New: "prod"}, `+ tags.env: "prod"`},
+		{FieldChange{Path: "tags.team", Old: "infra"}, `- tags.team: "infra"`},
+		{FieldChange{Path: "cidrBlock", Old: "10.0.0.0/16", New: "10.1.0.0/16", Replace: true}, `~ cidrBlock: "10.0.0.0/16" -> "10.1.0.0/16" (requer substituição)`},
+	}
+
+	for _, tt := range tests {
+		if got := tt.change.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+// TestDiffRules verifies only added and removed security group rules are returned.
+func TestDiffRules(t *testing.T) {
+	ssh := map[string]interface{}{"protocol": "tcp", "fromPort": 22, "toPort": 22, "cidrIp": "0.0.0.0/0"}
+	http := map[string]interface{}{"protocol": "tcp", "fromPort": 80, "toPort": 80, "cidrIp": "0.0.0.0/0"}
+	https := map[string]interface{}{"protocol": "tcp", "fromPort": 443, "toPort": 443, "cidrIp": "0.0.0.0/0"}
+
+	toRevoke, toAuthorize := diffRules([]interface{}{ssh, http}, []interface{}{http, https})
+
+	if len(toRevoke) != 1 || *toRevoke[0].FromPort != 22 {
+		t.Errorf("Expected to revoke port 22, got %v", toRevoke)
+	}
+	if len(toAuthorize) != 1 || *toAuthorize[0].FromPort != 443 {
+		t.Errorf("Expected to authorize port 443, got %v", toAuthorize)
+	}
+}
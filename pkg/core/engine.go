@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -20,6 +21,8 @@ type Engine struct {
 	dryRun         bool
 	verbose        bool
 	output         OutputWriter
+	exec           ExecOptions
+	lockTimeout    time.Duration
 }
 
 // OutputWriter interface para output customizado
@@ -52,10 +55,22 @@ func (w *SilentOutputWriter) WriteVerbose(format string, args ...interface{}) {}
 // EngineConfig configuração do engine
 type EngineConfig struct {
 	StateDir string
+
+	// StateBackend seleciona onde o estado é gravado; o padrão é StateDir local
+	StateBackend StateBackendConfig
+
 	Provider *ProviderConfig
 	DryRun   bool
 	Verbose  bool
 	Output   OutputWriter
+
+	// Parallelism e FailFast são as opções padrão de Apply e Delete
+	Parallelism int
+	FailFast    bool
+
+	// LockTimeout é quanto tempo Apply e Delete aguardam um lock detido por
+	// outro processo antes de falhar; zero falha imediatamente
+	LockTimeout time.Duration
 }
 
 // NewEngine cria um novo engine
@@ -73,19 +88,32 @@ func NewEngine(ctx context.Context, cfg EngineConfig) (*Engine, error) {
 		cfg.Output = &DefaultOutputWriter{Verbose: cfg.Verbose}
 	}
 
+	backend, err := NewStateBackend(cfg.StateBackend, cfg.StateDir, awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao criar backend de estado: %w", err)
+	}
+
 	return &Engine{
-		stateManager:   NewStateManager(cfg.StateDir),
+		stateManager:   NewStateManagerWithBackend(cfg.StateDir, backend),
 		providerConfig: cfg.Provider,
 		awsConfig:      awsCfg,
 		dryRun:         cfg.DryRun,
 		verbose:        cfg.Verbose,
 		output:         cfg.Output,
+		exec: ExecOptions{
+			Parallelism: cfg.Parallelism,
+			FailFast:    cfg.FailFast,
+		},
+		lockTimeout: cfg.LockTimeout,
 	}, nil
 }
 
 // Plan gera o plano de execução para os recursos
 func (e *Engine) Plan(ctx context.Context, resources []Resource) (*PlanResult, error) {
-	ordered := OrderByDependency(resources)
+	ordered, err := OrderByDependency(resources)
+	if err != nil {
+		return nil, err
+	}
 	result := &PlanResult{
 		Resources: resources,
 	}
@@ -107,166 +135,299 @@ func (e *Engine) Plan(ctx context.Context, resources []Resource) (*PlanResult, e
 			item.Action = "create"
 			result.ToCreate = append(result.ToCreate, item)
 		} else {
-			// TODO: Comparar specs para detectar mudanças
-			item.Action = "no_change"
-			result.NoChange = append(result.NoChange, item)
+			changes := DiffSpecs(r.Kind, existingState.Spec, r.Spec)
+			if len(changes) == 0 {
+				item.Action = "no_change"
+				result.NoChange = append(result.NoChange, item)
+				continue
+			}
+
+			item.Action = "update"
+			if RequiresReplace(changes) {
+				item.Action = "replace"
+			}
+			for _, change := range changes {
+				item.Changes = append(item.Changes, change.String())
+			}
+			result.ToUpdate = append(result.ToUpdate, item)
 		}
 	}
 
 	return result, nil
 }
 
-// Apply aplica os recursos (cria ou atualiza)
+// Apply aplica os recursos (cria ou atualiza) com as opções de execução do Engine
 func (e *Engine) Apply(ctx context.Context, resources []Resource) (*ApplyResult, error) {
-	ordered := OrderByDependency(resources)
-	result := &ApplyResult{}
+	return e.ApplyWithOptions(ctx, resources, e.exec)
+}
+
+// ApplyWithOptions aplica os recursos processando em paralelo os que não
+// dependem entre si. Os resultados seguem a ordem de dependência.
+func (e *Engine) ApplyWithOptions(ctx context.Context, resources []Resource, opts ExecOptions) (*ApplyResult, error) {
+	g, err := newDependencyGraph(resources)
+	if err != nil {
+		return nil, err
+	}
+	opts = e.execOptions(opts)
+
+	if !e.dryRun {
+		release, err := e.acquireLock(ctx, "apply")
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
 
 	// Extrai providers
 	providers := make(map[string]*ProviderConfig)
-	for _, r := range ordered {
+	for _, r := range resources {
 		if r.Kind == "AWSProvider" {
 			providers[r.Metadata.Name] = NewProviderConfigFromResource(r)
 		}
 	}
 
-	for _, r := range ordered {
-		if r.Kind == "AWSProvider" {
-			continue
-		}
+	// Cada recurso grava apenas no seu próprio resultado
+	results := make([]*ApplyResult, len(resources))
+	executeGraph(ctx, g, opts, graphHandlers{
+		run: func(ctx context.Context, i int) bool {
+			results[i] = &ApplyResult{}
+			e.applyResource(ctx, resources[i], providers, results[i])
+			return len(results[i].Failed) == 0
+		},
+		blocked: func(i, dep int) {
+			results[i] = &ApplyResult{Failed: []ResourceResult{
+				dependencyFailed(resources[i], resources[dep]),
+			}}
+		},
+		cancelled: func(i int) {
+			results[i] = &ApplyResult{Skipped: []ResourceResult{cancelled(resources[i])}}
+		},
+	})
 
-		e.output.WriteVerbose("Processando %s/%s...", r.Kind, r.Metadata.Name)
+	order, _ := g.order()
+	result := &ApplyResult{}
+	for _, i := range order {
+		result.Created = append(result.Created, results[i].Created...)
+		result.Updated = append(result.Updated, results[i].Updated...)
+		result.Failed = append(result.Failed, results[i].Failed...)
+		result.Skipped = append(result.Skipped, results[i].Skipped...)
+	}
 
-		existingState, err := e.stateManager.LoadState(r.Kind, r.Metadata.Namespace, r.Metadata.Name)
-		if err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: err.Error(),
-			})
-			continue
-		}
+	return result, nil
+}
 
-		if existingState != nil {
-			e.output.WriteVerbose("  Recurso já existe, pulando...")
-			result.Skipped = append(result.Skipped, ResourceResult{
-				Kind:         r.Kind,
-				Name:         r.Metadata.Name,
-				AWSResources: existingState.AWSResources,
-				Message:      "já existe",
-			})
-			continue
-		}
+// applyResource cria ou atualiza um único recurso
+func (e *Engine) applyResource(ctx context.Context, r Resource, providers map[string]*ProviderConfig, result *ApplyResult) {
+	if r.Kind == "AWSProvider" {
+		return
+	}
 
-		if e.dryRun {
-			result.Skipped = append(result.Skipped, ResourceResult{
-				Kind:    r.Kind,
-				Name:    r.Metadata.Name,
-				Message: "dry-run",
-			})
-			continue
-		}
+	e.output.WriteVerbose("Processando %s/%s...", r.Kind, r.Metadata.Name)
 
-		state, err := e.createResource(ctx, r, providers)
-		if err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: err.Error(),
-			})
-			continue
-		}
+	existingState, err := e.stateManager.LoadState(r.Kind, r.Metadata.Namespace, r.Metadata.Name)
+	if err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: err.Error(),
+		})
+		return
+	}
 
-		if err := e.stateManager.SaveState(state); err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: fmt.Sprintf("falha ao salvar estado: %v", err),
-			})
-			continue
-		}
+	if existingState != nil {
+		e.applyUpdate(ctx, r, existingState, result)
+		return
+	}
 
-		result.Created = append(result.Created, ResourceResult{
-			Kind:         r.Kind,
-			Name:         r.Metadata.Name,
-			Namespace:    r.Metadata.Namespace,
-			AWSResources: state.AWSResources,
+	if e.dryRun {
+		result.Skipped = append(result.Skipped, ResourceResult{
+			Kind:    r.Kind,
+			Name:    r.Metadata.Name,
+			Message: "dry-run",
 		})
+		return
 	}
 
-	return result, nil
+	resolved, err := ResolveReferences(r, e.stateManager.LoadState)
+	if err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: err.Error(),
+		})
+		return
+	}
+
+	state, err := e.createResource(ctx, resolved, providers)
+	if err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: err.Error(),
+		})
+		return
+	}
+
+	// O estado guarda o spec do manifesto (com as referências), usado no diff
+	state.Spec = r.Spec
+
+	if err := e.stateManager.SaveState(state); err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: fmt.Sprintf("falha ao salvar estado: %v", err),
+		})
+		return
+	}
+
+	result.Created = append(result.Created, ResourceResult{
+		Kind:         r.Kind,
+		Name:         r.Metadata.Name,
+		Namespace:    r.Metadata.Namespace,
+		AWSResources: state.AWSResources,
+	})
 }
 
-// Delete deleta os recursos
+// Delete deleta os recursos com as opções de execução do Engine
 func (e *Engine) Delete(ctx context.Context, resources []Resource) (*DeleteResult, error) {
-	// Ordem reversa para deleção
-	ordered := OrderByDependency(resources)
-	for i, j := 0, len(ordered)-1; i < j; i, j = i+1, j-1 {
-		ordered[i], ordered[j] = ordered[j], ordered[i]
+	return e.DeleteWithOptions(ctx, resources, e.exec)
+}
+
+// DeleteWithOptions deleta os recursos em ordem reversa de dependência: um
+// recurso só é deletado depois de todos os que dependem dele, e os
+// independentes são processados em paralelo.
+func (e *Engine) DeleteWithOptions(ctx context.Context, resources []Resource, opts ExecOptions) (*DeleteResult, error) {
+	g, err := newDependencyGraph(resources)
+	if err != nil {
+		return nil, err
+	}
+	g = g.reversed()
+	opts = e.execOptions(opts)
+
+	if !e.dryRun {
+		release, err := e.acquireLock(ctx, "delete")
+		if err != nil {
+			return nil, err
+		}
+		defer release()
 	}
 
+	results := make([]*DeleteResult, len(resources))
+	executeGraph(ctx, g, opts, graphHandlers{
+		run: func(ctx context.Context, i int) bool {
+			results[i] = &DeleteResult{}
+			e.deleteOne(ctx, resources[i], results[i])
+			return len(results[i].Failed) == 0
+		},
+		blocked: func(i, dep int) {
+			results[i] = &DeleteResult{Failed: []ResourceResult{
+				dependencyFailed(resources[i], resources[dep]),
+			}}
+		},
+		cancelled: func(i int) {
+			results[i] = &DeleteResult{Skipped: []ResourceResult{cancelled(resources[i])}}
+		},
+	})
+
+	order, _ := g.order()
 	result := &DeleteResult{}
+	for _, i := range order {
+		result.Deleted = append(result.Deleted, results[i].Deleted...)
+		result.Failed = append(result.Failed, results[i].Failed...)
+		result.Skipped = append(result.Skipped, results[i].Skipped...)
+	}
 
-	for _, r := range ordered {
-		if r.Kind == "AWSProvider" {
-			continue
-		}
+	return result, nil
+}
 
-		e.output.WriteVerbose("Deletando %s/%s...", r.Kind, r.Metadata.Name)
+// deleteOne deleta um único recurso e o seu estado
+func (e *Engine) deleteOne(ctx context.Context, r Resource, result *DeleteResult) {
+	if r.Kind == "AWSProvider" {
+		return
+	}
 
-		existingState, err := e.stateManager.LoadState(r.Kind, r.Metadata.Namespace, r.Metadata.Name)
-		if err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: err.Error(),
-			})
-			continue
-		}
+	e.output.WriteVerbose("Deletando %s/%s...", r.Kind, r.Metadata.Name)
 
-		if existingState == nil {
-			result.Skipped = append(result.Skipped, ResourceResult{
-				Kind:    r.Kind,
-				Name:    r.Metadata.Name,
-				Message: "não encontrado no estado",
-			})
-			continue
-		}
+	existingState, err := e.stateManager.LoadState(r.Kind, r.Metadata.Namespace, r.Metadata.Name)
+	if err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: err.Error(),
+		})
+		return
+	}
 
-		if e.dryRun {
-			result.Skipped = append(result.Skipped, ResourceResult{
-				Kind:    r.Kind,
-				Name:    r.Metadata.Name,
-				Message: "dry-run",
-			})
-			continue
-		}
+	if existingState == nil {
+		result.Skipped = append(result.Skipped, ResourceResult{
+			Kind:    r.Kind,
+			Name:    r.Metadata.Name,
+			Message: "não encontrado no estado",
+		})
+		return
+	}
 
-		if err := e.deleteResource(ctx, existingState); err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: err.Error(),
-			})
-			continue
-		}
+	if e.dryRun {
+		result.Skipped = append(result.Skipped, ResourceResult{
+			Kind:    r.Kind,
+			Name:    r.Metadata.Name,
+			Message: "dry-run",
+		})
+		return
+	}
 
-		if err := e.stateManager.DeleteState(r.Kind, r.Metadata.Namespace, r.Metadata.Name); err != nil {
-			result.Failed = append(result.Failed, ResourceResult{
-				Kind:  r.Kind,
-				Name:  r.Metadata.Name,
-				Error: fmt.Sprintf("falha ao deletar estado: %v", err),
-			})
-			continue
-		}
+	if err := e.deleteResource(ctx, existingState); err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: err.Error(),
+		})
+		return
+	}
 
-		result.Deleted = append(result.Deleted, ResourceResult{
-			Kind:      r.Kind,
-			Name:      r.Metadata.Name,
-			Namespace: r.Metadata.Namespace,
+	if err := e.stateManager.DeleteState(r.Kind, r.Metadata.Namespace, r.Metadata.Name); err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: fmt.Sprintf("falha ao deletar estado: %v", err),
 		})
+		return
 	}
 
-	return result, nil
+	result.Deleted = append(result.Deleted, ResourceResult{
+		Kind:      r.Kind,
+		Name:      r.Metadata.Name,
+		Namespace: r.Metadata.Namespace,
+	})
+}
+
+// execOptions completa as opções da requisição com os padrões do Engine
+func (e *Engine) execOptions(opts ExecOptions) ExecOptions {
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = e.exec.Parallelism
+	}
+	if opts.Parallelism <= 0 {
+		opts.Parallelism = DefaultParallelism
+	}
+	return opts
+}
+
+// dependencyFailed é o resultado de um recurso pulado porque dep falhou
+func dependencyFailed(r, dep Resource) ResourceResult {
+	return ResourceResult{
+		Kind:  r.Kind,
+		Name:  r.Metadata.Name,
+		Error: fmt.Sprintf("dependência %s falhou", GetResourceID(dep)),
+	}
+}
+
+// cancelled é o resultado de um recurso não iniciado após fail-fast
+func cancelled(r Resource) ResourceResult {
+	return ResourceResult{
+		Kind:    r.Kind,
+		Name:    r.Metadata.Name,
+		Message: CancelledMessage,
+	}
 }
 
 // Get lista recursos do estado
@@ -294,9 +455,16 @@ func (e *Engine) createResource(ctx context.Context, r Resource, providers map[s
 		return e.createEC2Instance(ctx, r, state)
 	case "ComputeStack":
 		return e.createComputeStack(ctx, r, state)
-	default:
+	}
+
+	kind, ok := useCaseKinds[r.Kind]
+	if !ok {
 		return nil, fmt.Errorf("tipo de recurso não suportado: %s", r.Kind)
 	}
+	if err := e.syncUseCaseKind(ctx, kind, r, state); err != nil {
+		return nil, err
+	}
+	return state, nil
 }
 
 // deleteResource deleta um recurso AWS baseado no estado
@@ -347,11 +515,38 @@ func (e *Engine) deleteResource(ctx context.Context, state *ResourceState) error
 		}
 	case "ComputeStack":
 		return e.deleteComputeStack(ctx, state)
+	default:
+		if kind, ok := useCaseKinds[state.Kind]; ok {
+			return e.deleteUseCaseKind(ctx, kind, state)
+		}
 	}
 
 	return nil
 }
 
+// tagsFromSpec retorna as tags padrão do recurso mescladas com as tags do spec
+func tagsFromSpec(r Resource) []types.Tag {
+	values := map[string]string{
+		"Name":      r.Metadata.Name,
+		"ManagedBy": "infra-operator",
+	}
+	for k, v := range getStringMapFromMap(r.Spec, "tags") {
+		values[k] = v
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	tags := make([]types.Tag, 0, len(keys))
+	for _, k := range keys {
+		tags = append(tags, types.Tag{Key: aws.String(k), Value: aws.String(values[k])})
+	}
+	return tags
+}
+
 // createVPC cria uma VPC
 func (e *Engine) createVPC(ctx context.Context, r Resource, state *ResourceState) (*ResourceState, error) {
 	ec2Client := ec2.NewFromConfig(e.awsConfig)
@@ -366,10 +561,7 @@ func (e *Engine) createVPC(ctx context.Context, r Resource, state *ResourceState
 		TagSpecifications: []types.TagSpecification{
 			{
 				ResourceType: types.ResourceTypeVpc,
-				Tags: []types.Tag{
-					{Key: aws.String("Name"), Value: aws.String(r.Metadata.Name)},
-					{Key: aws.String("ManagedBy"), Value: aws.String("infra-operator")},
-				},
+				Tags:         tagsFromSpec(r),
 			},
 		},
 	}
@@ -417,10 +609,7 @@ func (e *Engine) createSubnet(ctx context.Context, r Resource, state *ResourceSt
 		TagSpecifications: []types.TagSpecification{
 			{
 				ResourceType: types.ResourceTypeSubnet,
-				Tags: []types.Tag{
-					{Key: aws.String("Name"), Value: aws.String(r.Metadata.Name)},
-					{Key: aws.String("ManagedBy"), Value: aws.String("infra-operator")},
-				},
+				Tags:         tagsFromSpec(r),
 			},
 		},
 	}
@@ -448,10 +637,7 @@ func (e *Engine) createInternetGateway(ctx context.Context, r Resource, state *R
 		TagSpecifications: []types.TagSpecification{
 			{
 				ResourceType: types.ResourceTypeInternetGateway,
-				Tags: []types.Tag{
-					{Key: aws.String("Name"), Value: aws.String(r.Metadata.Name)},
-					{Key: aws.String("ManagedBy"), Value: aws.String("infra-operator")},
-				},
+				Tags:         tagsFromSpec(r),
 			},
 		},
 	}
@@ -498,10 +684,7 @@ func (e *Engine) createSecurityGroup(ctx context.Context, r Resource, state *Res
 		TagSpecifications: []types.TagSpecification{
 			{
 				ResourceType: types.ResourceTypeSecurityGroup,
-				Tags: []types.Tag{
-					{Key: aws.String("Name"), Value: aws.String(r.Metadata.Name)},
-					{Key: aws.String("ManagedBy"), Value: aws.String("infra-operator")},
-				},
+				Tags:         tagsFromSpec(r),
 			},
 		},
 	}
@@ -520,14 +703,7 @@ func (e *Engine) createSecurityGroup(ctx context.Context, r Resource, state *Res
 	if ingressRules, ok := r.Spec["ingressRules"].([]interface{}); ok {
 		for _, rule := range ingressRules {
 			if ruleMap, ok := rule.(map[string]interface{}); ok {
-				ipPermission := types.IpPermission{
-					IpProtocol: aws.String(getStringFromMap(ruleMap, "protocol", "tcp")),
-					FromPort:   aws.Int32(int32(getIntFromMap(ruleMap, "fromPort", 0))),
-					ToPort:     aws.Int32(int32(getIntFromMap(ruleMap, "toPort", 0))),
-				}
-				if cidr := getStringFromMap(ruleMap, "cidrIp", ""); cidr != "" {
-					ipPermission.IpRanges = []types.IpRange{{CidrIp: aws.String(cidr)}}
-				}
+				ipPermission := ipPermissionFromRule(ruleMap)
 				ec2Client.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
 					GroupId:       result.GroupId,
 					IpPermissions: []types.IpPermission{ipPermission},
@@ -560,10 +736,7 @@ func (e *Engine) createEC2Instance(ctx context.Context, r Resource, state *Resou
 		TagSpecifications: []types.TagSpecification{
 			{
 				ResourceType: types.ResourceTypeInstance,
-				Tags: []types.Tag{
-					{Key: aws.String("Name"), Value: aws.String(r.Metadata.Name)},
-					{Key: aws.String("ManagedBy"), Value: aws.String("infra-operator")},
-				},
+				Tags:         tagsFromSpec(r),
 			},
 		},
 	}
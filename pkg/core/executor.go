@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"sort"
+)
+
+// DefaultParallelism é o número padrão de recursos processados ao mesmo tempo
+const DefaultParallelism = 10
+
+// CancelledMessage é a mensagem dos recursos não executados após uma falha em modo fail-fast
+const CancelledMessage = "não executado: execução interrompida após falha"
+
+// ExecOptions controla a execução de Apply e Delete
+type ExecOptions struct {
+	// Parallelism é o número máximo de recursos processados ao mesmo tempo.
+	// Zero usa o padrão do Engine; 1 executa sequencialmente.
+	Parallelism int
+
+	// FailFast para de iniciar novos recursos na primeira falha. Os recursos
+	// em andamento terminam e os restantes são reportados como Skipped.
+	// Sem FailFast, apenas os dependentes do recurso que falhou são pulados.
+	FailFast bool
+}
+
+// graphHandlers recebe os eventos do executor para cada recurso do grafo
+type graphHandlers struct {
+	// run processa o recurso e retorna false em caso de falha
+	run func(ctx context.Context, i int) bool
+
+	// blocked é chamado para recursos cuja dependência dep falhou
+	blocked func(i, dep int)
+
+	// cancelled é chamado para recursos não iniciados após fail-fast ou
+	// cancelamento do contexto
+	cancelled func(i int)
+}
+
+// nodeDone sinaliza o fim da execução de um recurso
+type nodeDone struct {
+	index int
+	ok    bool
+}
+
+// executeGraph processa os recursos do grafo com no máximo opts.Parallelism
+// ao mesmo tempo. Um recurso só inicia depois que todas as suas dependências
+// terminaram com sucesso; entre os prontos, segue o desempate do grafo.
+// Todos os handlers são chamados exatamente uma vez por recurso, e blocked e
+// cancelled são sempre chamados a partir da goroutine que chamou executeGraph.
+func executeGraph(ctx context.Context, g *dependencyGraph, opts ExecOptions, h graphHandlers) {
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	n := len(g.resources)
+	pending := make([]int, n)
+	handled := make([]bool, n)
+	var ready []int
+	for i := 0; i < n; i++ {
+		pending[i] = len(g.dependencies[i])
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	// block marca os dependentes (diretos e transitivos) de um recurso que falhou
+	var block func(failed int)
+	block = func(failed int) {
+		for _, d := range g.dependents[failed] {
+			if handled[d] {
+				continue
+			}
+			handled[d] = true
+			h.blocked(d, failed)
+			block(d)
+		}
+	}
+
+	done := make(chan nodeDone)
+	running := 0
+	stopped := false
+
+	for {
+		if ctx.Err() != nil {
+			stopped = true
+		}
+
+		for !stopped && running < parallelism && len(ready) > 0 {
+			sort.Slice(ready, func(x, y int) bool { return g.less(ready[x], ready[y]) })
+			next := ready[0]
+			ready = ready[1:]
+			if handled[next] {
+				continue
+			}
+
+			handled[next] = true
+			running++
+			go func(i int) {
+				done <- nodeDone{index: i, ok: h.run(ctx, i)}
+			}(next)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-done
+		running--
+
+		if !result.ok {
+			block(result.index)
+			if opts.FailFast {
+				stopped = true
+			}
+			continue
+		}
+
+		for _, d := range g.dependents[result.index] {
+			pending[d]--
+			if pending[d] == 0 && !handled[d] {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if !handled[i] {
+			h.cancelled(i)
+		}
+	}
+}
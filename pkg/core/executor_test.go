@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// executorManifest returns a VPC with two subnets plus two independent queues.
+func executorManifest() []Resource {
+	return []Resource{
+		resource("Subnet", "a", map[string]interface{}{"vpcRef": map[string]interface{}{"name": "main"}}),
+		resource("Subnet", "b", map[string]interface{}{"vpcRef": map[string]interface{}{"name": "main"}}),
+		resource("VPC", "main", nil),
+		resource("SQSQueue", "x", nil),
+		resource("SQSQueue", "y", nil),
+	}
+}
+
+// TestExecuteGraph_Parallelism verifies the concurrency bound and that dependents wait for their dependencies.
+func TestExecuteGraph_Parallelism(t *testing.T) {
+	resources := executorManifest()
+	g, err := newDependencyGraph(resources)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	finished := make(map[string]bool)
+
+	executeGraph(context.Background(), g, ExecOptions{Parallelism: 2}, graphHandlers{
+		run: func(ctx context.Context, i int) bool {
+			mu.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			if resources[i].Kind == "Subnet" && !finished["main"] {
+				t.Errorf("Subnet %s started before VPC main finished", resources[i].Metadata.Name)
+			}
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			finished[resources[i].Metadata.Name] = true
+			mu.Unlock()
+			return true
+		},
+		blocked:   func(i, dep int) { t.Errorf("Unexpected blocked %d", i) },
+		cancelled: func(i int) { t.Errorf("Unexpected cancelled %d", i) },
+	})
+
+	if len(finished) != len(resources) {
+		t.Errorf("Expected all %d resources to run, got %d", len(resources), len(finished))
+	}
+	if maxRunning != 2 {
+		t.Errorf("Expected at most 2 concurrent resources, got %d", maxRunning)
+	}
+}
+
+// TestExecuteGraph_ContinueOnError verifies only dependents of a failed resource are skipped.
+func TestExecuteGraph_ContinueOnError(t *testing.T) {
+	resources := executorManifest()
+	g, _ := newDependencyGraph(resources)
+
+	var mu sync.Mutex
+	ran := make(map[string]bool)
+	blocked := make(map[string]string)
+
+	executeGraph(context.Background(), g, ExecOptions{Parallelism: 4}, graphHandlers{
+		run: func(ctx context.Context, i int) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			ran[resources[i].Metadata.Name] = true
+			return resources[i].Kind != "VPC"
+		},
+		blocked: func(i, dep int) {
+			blocked[resources[i].Metadata.Name] = resources[dep].Metadata.Name
+		},
+		cancelled: func(i int) { t.Errorf("Unexpected cancelled %d", i) },
+	})
+
+	if !ran["x"] || !ran["y"] {
+		t.Errorf("Expected independent queues to run, got %v", ran)
+	}
+	if blocked["a"] != "main" || blocked["b"] != "main" {
+		t.Errorf("Expected subnets blocked by main, got %v", blocked)
+	}
+}
+
+// TestExecuteGraph_FailFast verifies no new resources start after the first failure.
+func TestExecuteGraph_FailFast(t *testing.T) {
+	resources := executorManifest()
+	g, _ := newDependencyGraph(resources)
+
+	var ran, cancelled []string
+	executeGraph(context.Background(), g, ExecOptions{Parallelism: 1, FailFast: true}, graphHandlers{
+		run: func(ctx context.Context, i int) bool {
+			ran = append(ran, resources[i].Metadata.Name)
+			return false
+		},
+		blocked: func(i, dep int) {},
+		cancelled: func(i int) {
+			cancelled = append(cancelled, resources[i].Metadata.Name)
+		},
+	})
+
+	// VPC has the highest priority among the ready resources
+	if len(ran) != 1 || ran[0] != "main" {
+		t.Errorf("Expected only main to run, got %v", ran)
+	}
+	if len(cancelled) != 2 {
+		t.Errorf("Expected both queues to be cancelled, got %v", cancelled)
+	}
+}
+
+// TestDependencyGraph_Reversed verifies deletion order runs dependents first.
+func TestDependencyGraph_Reversed(t *testing.T) {
+	resources := executorManifest()
+	g, _ := newDependencyGraph(resources)
+
+	order, err := g.reversed().order()
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	position := make(map[string]int)
+	for pos, i := range order {
+		position[resources[i].Metadata.Name] = pos
+	}
+	if position["main"] < position["a"] || position["main"] < position["b"] {
+		t.Errorf("Expected subnets to be deleted before the VPC, got %v", position)
+	}
+}
@@ -0,0 +1,434 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Reference aponta para um atributo (chave de AWSResources) de outro recurso
+type Reference struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Attr      string
+}
+
+// ID retorna o identificador do recurso referenciado, no formato de GetResourceID
+func (ref Reference) ID() string {
+	return GetResourceID(Resource{Kind: ref.Kind, Metadata: Metadata{Name: ref.Name, Namespace: ref.Namespace}})
+}
+
+// referenceField descreve um campo <x>Ref (ou <x>Refs) aceito no spec
+type referenceField struct {
+	// Kind é o kind do recurso referenciado
+	Kind string
+
+	// Attr é a chave de AWSResources do recurso referenciado
+	Attr string
+
+	// Field é o campo do spec preenchido com o valor resolvido
+	Field string
+}
+
+// referenceFields lista os campos de referência por nome, ex:
+//
+//	vpcRef:
+//	  name: my-vpc
+//
+// é resolvido para vpcID com o AWSResources["vpcId"] da VPC my-vpc.
+// Para outros atributos use expressões ${Kind.name.attr} em qualquer string do spec.
+var referenceFields = map[string]referenceField{
+	"vpcRef":             {Kind: "VPC", Attr: "vpcId", Field: "vpcID"},
+	"subnetRef":          {Kind: "Subnet", Attr: "subnetId", Field: "subnetID"},
+	"subnetRefs":         {Kind: "Subnet", Attr: "subnetId", Field: "subnetIDs"},
+	"securityGroupRefs":  {Kind: "SecurityGroup", Attr: "securityGroupId", Field: "securityGroupIDs"},
+	"internetGatewayRef": {Kind: "InternetGateway", Attr: "internetGatewayId", Field: "internetGatewayID"},
+	"allocationRef":      {Kind: "ElasticIP", Attr: "allocationId", Field: "allocationID"},
+	"natGatewayRef":      {Kind: "NATGateway", Attr: "natGatewayId", Field: "natGatewayID"},
+	"hostedZoneRef":      {Kind: "Route53HostedZone", Attr: "hostedZoneId", Field: "hostedZoneID"},
+	"certificateRef":     {Kind: "Certificate", Attr: "certificateArn", Field: "certificateARN"},
+	"certificateRefs":    {Kind: "Certificate", Attr: "certificateArn", Field: "certificateARNs"},
+	"instanceRef":        {Kind: "EC2Instance", Attr: "instanceId", Field: "instanceID"},
+	"albRef":             {Kind: "ALB", Attr: "loadBalancerArn", Field: "loadBalancerARN"},
+	"nlbRef":             {Kind: "NLB", Attr: "loadBalancerArn", Field: "loadBalancerARN"},
+	"targetGroupRef":     {Kind: "TargetGroup", Attr: "targetGroupArn", Field: "targetGroupARN"},
+	"targetGroupRefs":    {Kind: "TargetGroup", Attr: "targetGroupArn", Field: "targetGroupARNs"},
+	"launchTemplateRef":  {Kind: "LaunchTemplate", Attr: "launchTemplateId", Field: "launchTemplateID"},
+}
+
+// expressionPattern casa expressões ${Kind.name.attr}; o nome pode conter pontos
+var expressionPattern = regexp.MustCompile(`\$\{([A-Za-z0-9]+)\.([^}]+)\.([A-Za-z0-9]+)\}`)
+
+// kindPriority desempata recursos sem dependência entre si,
+// preservando a ordem histórica por tipo
+var kindPriority = map[string]int{
+	"AWSProvider":          0,
+	"VPC":                  1,
+	"InternetGateway":      2,
+	"Subnet":               3,
+	"ElasticIP":            4,
+	"NATGateway":           5,
+	"RouteTable":           6,
+	"SecurityGroup":        7,
+	"IAMRole":              8,
+	"KMSKey":               9,
+	"SecretsManagerSecret": 10,
+	"S3Bucket":             11,
+	"ECRRepository":        12,
+	"DBSubnetGroup":        12,
+	"DBParameterGroup":     12,
+	"RDSInstance":          13,
+	"RDSCluster":           13,
+	"RDSSnapshot":          14,
+	"DynamoDBTable":        14,
+	"ElastiCacheCluster":   15,
+	"SQSQueue":             16,
+	"SNSTopic":             17,
+	"LambdaFunction":       18,
+	"LambdaAlias":          19,
+	"EC2Instance":          19,
+	"EC2KeyPair":           19,
+	"LaunchTemplate":       19,
+	"ALB":                  20,
+	"NLB":                  20,
+	"TargetGroup":          20,
+	"EKSCluster":           21,
+	"ECSCluster":           21,
+	"AutoScalingGroup":     21,
+	"APIGateway":           22,
+	"Certificate":          23,
+	"CloudFront":           24,
+	"Listener":             24,
+	"Route53HostedZone":    25,
+	"Route53RecordSet":     26,
+	"ComputeStack":         100,
+}
+
+// FindReferences retorna as referências declaradas no spec do recurso,
+// via campos <x>Ref/<x>Refs ou expressões ${Kind.name.attr}
+func FindReferences(r Resource) []Reference {
+	var refs []Reference
+	walkReferences(r, r.Spec, &refs)
+	return refs
+}
+
+func walkReferences(r Resource, value interface{}, refs *[]Reference) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if field, ok := referenceFields[key]; ok {
+				for _, name := range referenceNames(val) {
+					*refs = append(*refs, Reference{
+						Kind:      field.Kind,
+						Namespace: r.Metadata.Namespace,
+						Name:      name,
+						Attr:      field.Attr,
+					})
+				}
+				continue
+			}
+			walkReferences(r, val, refs)
+		}
+	case []interface{}:
+		for _, item := range v {
+			walkReferences(r, item, refs)
+		}
+	case string:
+		for _, m := range expressionPattern.FindAllStringSubmatch(v, -1) {
+			*refs = append(*refs, Reference{
+				Kind:      m[1],
+				Namespace: r.Metadata.Namespace,
+				Name:      m[2],
+				Attr:      m[3],
+			})
+		}
+	}
+}
+
+// referenceNames extrai os nomes de {name: x} ou [{name: x}, ...]
+func referenceNames(value interface{}) []string {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["name"].(string); ok && name != "" {
+			return []string{name}
+		}
+	case []interface{}:
+		var names []string
+		for _, item := range v {
+			names = append(names, referenceNames(item)...)
+		}
+		return names
+	}
+	return nil
+}
+
+// dependencyGraph guarda as arestas de dependência entre os recursos de um
+// manifesto, indexadas pela posição do recurso na lista
+type dependencyGraph struct {
+	resources []Resource
+
+	// dependents[j] lista os recursos que dependem de j
+	dependents [][]int
+
+	// dependencies[i] lista os recursos dos quais i depende
+	dependencies [][]int
+
+	// reverse inverte o desempate por prioridade (usado na deleção)
+	reverse bool
+}
+
+// newDependencyGraph monta o grafo a partir das referências entre os recursos.
+// Referências a recursos fora da lista não geram arestas (são resolvidas pelo
+// estado). Retorna erro em auto-referências e dependências circulares.
+func newDependencyGraph(resources []Resource) (*dependencyGraph, error) {
+	index := make(map[string]int, len(resources))
+	for i, r := range resources {
+		index[GetResourceID(r)] = i
+	}
+
+	g := &dependencyGraph{
+		resources:    resources,
+		dependents:   make([][]int, len(resources)),
+		dependencies: make([][]int, len(resources)),
+	}
+	for i, r := range resources {
+		seen := make(map[int]bool)
+		for _, ref := range FindReferences(r) {
+			j, ok := index[ref.ID()]
+			if !ok || seen[j] {
+				continue
+			}
+			if j == i {
+				return nil, fmt.Errorf("%s referencia a si mesmo", GetResourceID(r))
+			}
+			seen[j] = true
+			g.dependents[j] = append(g.dependents[j], i)
+			g.dependencies[i] = append(g.dependencies[i], j)
+		}
+	}
+
+	if _, err := g.order(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// reversed retorna o grafo com as arestas invertidas: cada recurso só é
+// processado depois de todos os que dependem dele (ordem de deleção)
+func (g *dependencyGraph) reversed() *dependencyGraph {
+	return &dependencyGraph{
+		resources:    g.resources,
+		dependents:   g.dependencies,
+		dependencies: g.dependents,
+		reverse:      !g.reverse,
+	}
+}
+
+// less desempata recursos sem dependência entre si pela prioridade por kind
+// e, depois, pela ordem do manifesto
+func (g *dependencyGraph) less(a, b int) bool {
+	if g.reverse {
+		a, b = b, a
+	}
+	pa, pb := kindPriority[g.resources[a].Kind], kindPriority[g.resources[b].Kind]
+	if pa != pb {
+		return pa < pb
+	}
+	return a < b
+}
+
+// order retorna os índices dos recursos em ordem topológica
+func (g *dependencyGraph) order() ([]int, error) {
+	inDegree := make([]int, len(g.resources))
+	var ready []int
+	for i := range g.resources {
+		inDegree[i] = len(g.dependencies[i])
+		if inDegree[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	sorted := make([]int, 0, len(g.resources))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(x, y int) bool { return g.less(ready[x], ready[y]) })
+		next := ready[0]
+		ready = ready[1:]
+
+		sorted = append(sorted, next)
+		for _, d := range g.dependents[next] {
+			inDegree[d]--
+			if inDegree[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	if len(sorted) != len(g.resources) {
+		return nil, fmt.Errorf("dependência circular entre recursos: %s", strings.Join(g.cycle(inDegree), " -> "))
+	}
+
+	return sorted, nil
+}
+
+// cycle retorna os recursos de um ciclo, a partir dos que ficaram pendentes
+// na ordenação. Todo recurso pendente depende de outro pendente, então
+// seguir as dependências pendentes sempre volta a um recurso já visitado;
+// os que só dependem do ciclo ficam de fora.
+func (g *dependencyGraph) cycle(inDegree []int) []string {
+	start := -1
+	for i := range g.resources {
+		if inDegree[i] > 0 {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+
+	visited := make(map[int]int)
+	var path []int
+	for current := start; ; {
+		if pos, ok := visited[current]; ok {
+			path = append(path[pos:], current)
+			break
+		}
+		visited[current] = len(path)
+		path = append(path, current)
+
+		for _, dep := range g.dependencies[current] {
+			if inDegree[dep] > 0 {
+				current = dep
+				break
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(path))
+	for _, i := range path {
+		ids = append(ids, GetResourceID(g.resources[i]))
+	}
+	return ids
+}
+
+// OrderByDependency ordena os recursos topologicamente a partir das referências
+// entre eles. Referências a recursos fora da lista não geram arestas (são
+// resolvidas pelo estado). Recursos sem dependência entre si seguem a
+// prioridade por kind e, depois, a ordem do manifesto.
+func OrderByDependency(resources []Resource) ([]Resource, error) {
+	g, err := newDependencyGraph(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	order, _ := g.order()
+	sorted := make([]Resource, 0, len(order))
+	for _, i := range order {
+		sorted = append(sorted, resources[i])
+	}
+	return sorted, nil
+}
+
+// ResolveReferences retorna uma cópia do recurso com as referências do spec
+// substituídas pelos IDs AWS lidos do estado. lookup retorna nil quando o
+// recurso referenciado ainda não existe no estado.
+func ResolveReferences(r Resource, lookup func(kind, namespace, name string) (*ResourceState, error)) (Resource, error) {
+	resolve := func(ref Reference) (string, error) {
+		state, err := lookup(ref.Kind, ref.Namespace, ref.Name)
+		if err != nil {
+			return "", fmt.Errorf("falha ao carregar %s: %w", ref.ID(), err)
+		}
+		if state == nil {
+			return "", fmt.Errorf("referência não resolvida: %s não existe no estado", ref.ID())
+		}
+		value := state.AWSResources[ref.Attr]
+		if value == "" {
+			return "", fmt.Errorf("referência não resolvida: %s não possui o atributo %q", ref.ID(), ref.Attr)
+		}
+		return value, nil
+	}
+
+	// Kinds implementados diretamente no Engine usam a grafia vpcId/subnetId
+	_, crStyle := useCaseKinds[r.Kind]
+
+	spec, err := resolveValue(r, r.Spec, resolve, crStyle)
+	if err != nil {
+		return r, fmt.Errorf("%s: %w", GetResourceID(r), err)
+	}
+
+	resolved := r
+	resolved.Spec, _ = spec.(map[string]interface{})
+	return resolved, nil
+}
+
+func resolveValue(r Resource, value interface{}, resolve func(Reference) (string, error), crStyle bool) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			field, isRef := referenceFields[key]
+			if !isRef {
+				resolvedVal, err := resolveValue(r, val, resolve, crStyle)
+				if err != nil {
+					return nil, err
+				}
+				out[key] = resolvedVal
+				continue
+			}
+
+			var ids []interface{}
+			for _, name := range referenceNames(val) {
+				id, err := resolve(Reference{Kind: field.Kind, Namespace: r.Metadata.Namespace, Name: name, Attr: field.Attr})
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, id)
+			}
+
+			target := field.Field
+			if !crStyle {
+				target = strings.TrimSuffix(target, "IDs")
+				target = strings.TrimSuffix(target, "ID")
+				if strings.HasSuffix(key, "Refs") {
+					target += "Ids"
+				} else {
+					target += "Id"
+				}
+			}
+			if strings.HasSuffix(key, "Refs") {
+				out[target] = ids
+			} else if len(ids) > 0 {
+				out[target] = ids[0]
+			}
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			resolvedItem, err := resolveValue(r, item, resolve, crStyle)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolvedItem
+		}
+		return out, nil
+	case string:
+		var resolveErr error
+		out := expressionPattern.ReplaceAllStringFunc(v, func(expr string) string {
+			m := expressionPattern.FindStringSubmatch(expr)
+			id, err := resolve(Reference{Kind: m[1], Namespace: r.Metadata.Namespace, Name: m[2], Attr: m[3]})
+			if err != nil && resolveErr == nil {
+				resolveErr = err
+			}
+			return id
+		})
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		return out, nil
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func resource(kind, name string, spec map[string]interface{}) Resource {
+	return Resource{
+		APIVersion: "aws-infra-operator.runner.codes/v1alpha1",
+		Kind:       kind,
+		Metadata:   Metadata{Name: name, Namespace: "default"},
+		Spec:       spec,
+	}
+}
+
+// TestOrderByDependency_References verifies references win over kind priority.
+func TestOrderByDependency_References(t *testing.T) {
+	resources := []Resource{
+		resource("Route53RecordSet", "api", map[string]interface{}{
+			"hostedZoneRef": map[string]interface{}{"name": "zone"},
+		}),
+		resource("Subnet", "public", map[string]interface{}{
+			"vpcRef": map[string]interface{}{"name": "main"},
+		}),
+		// A VPC depends on a hosted zone only through an expression
+		resource("VPC", "main", map[string]interface{}{
+			"tags": map[string]interface{}{"zone": "${Route53HostedZone.zone.hostedZoneId}"},
+		}),
+		resource("Route53HostedZone", "zone", map[string]interface{}{"name": "example.com"}),
+	}
+
+	ordered, err := OrderByDependency(resources)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var names []string
+	for _, r := range ordered {
+		names = append(names, r.Metadata.Name)
+	}
+	if got := strings.Join(names, ","); got != "zone,main,public,api" {
+		t.Errorf("Unexpected order: %s", got)
+	}
+}
+
+// TestOrderByDependency_Cycle verifies cycles are reported with the resources involved.
+func TestOrderByDependency_Cycle(t *testing.T) {
+	resources := []Resource{
+		resource("VPC", "standalone", nil),
+		// Waits on the cycle without being part of it
+		resource("EC2Instance", "web", map[string]interface{}{"subnetRef": map[string]interface{}{"name": "a"}}),
+		resource("Subnet", "a", map[string]interface{}{"routeTable": "${Subnet.b.subnetId}"}),
+		resource("Subnet", "b", map[string]interface{}{"routeTable": "${Subnet.a.subnetId}"}),
+	}
+
+	_, err := OrderByDependency(resources)
+	if err == nil {
+		t.Fatalf("Expected cycle error")
+	}
+	if !strings.Contains(err.Error(), "Subnet/default/a") || !strings.Contains(err.Error(), "Subnet/default/b") {
+		t.Errorf("Expected cycle members in error, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "standalone") || strings.Contains(err.Error(), "EC2Instance") {
+		t.Errorf("Expected only cycle members in error, got: %v", err)
+	}
+}
+
+// TestResolveReferences verifies refs and expressions are replaced with IDs from state.
+func TestResolveReferences(t *testing.T) {
+	states := map[string]*ResourceState{
+		"VPC/main":           {AWSResources: map[string]string{"vpcId": "vpc-123"}},
+		"SecurityGroup/web":  {AWSResources: map[string]string{"securityGroupId": "sg-1"}},
+		"SecurityGroup/ssh":  {AWSResources: map[string]string{"securityGroupId": "sg-2"}},
+		"ElasticIP/nat":      {AWSResources: map[string]string{"allocationId": "eipalloc-9"}},
+		"Subnet/nat-subnet":  {AWSResources: map[string]string{"subnetId": "subnet-7"}},
+		"SecurityGroup/none": {AWSResources: map[string]string{}},
+	}
+	lookup := func(kind, namespace, name string) (*ResourceState, error) {
+		return states[kind+"/"+name], nil
+	}
+
+	// Hand-coded kinds keep the vpcId/securityGroupIds spelling
+	ec2 := resource("EC2Instance", "web", map[string]interface{}{
+		"securityGroupRefs": []interface{}{
+			map[string]interface{}{"name": "web"},
+			map[string]interface{}{"name": "ssh"},
+		},
+		"userData": "echo ${VPC.main.vpcId} > /etc/vpc",
+	})
+	resolved, err := ResolveReferences(ec2, lookup)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	groups, _ := resolved.Spec["securityGroupIds"].([]interface{})
+	if len(groups) != 2 || groups[0] != "sg-1" || groups[1] != "sg-2" {
+		t.Errorf("Expected securityGroupIds [sg-1 sg-2], got %v", resolved.Spec["securityGroupIds"])
+	}
+	if resolved.Spec["userData"] != "echo vpc-123 > /etc/vpc" {
+		t.Errorf("Unexpected userData: %v", resolved.Spec["userData"])
+	}
+	if _, ok := resolved.Spec["securityGroupRefs"]; ok {
+		t.Errorf("Expected securityGroupRefs to be removed")
+	}
+	if _, ok := ec2.Spec["securityGroupIds"]; ok {
+		t.Errorf("Expected original spec to be left untouched")
+	}
+
+	// Use case kinds follow the CRD spelling
+	nat := resource("NATGateway", "nat", map[string]interface{}{
+		"subnetRef":     map[string]interface{}{"name": "nat-subnet"},
+		"allocationRef": map[string]interface{}{"name": "nat"},
+	})
+	resolved, err = ResolveReferences(nat, lookup)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if resolved.Spec["subnetID"] != "subnet-7" || resolved.Spec["allocationID"] != "eipalloc-9" {
+		t.Errorf("Unexpected NAT spec: %v", resolved.Spec)
+	}
+
+	// Missing resources and attributes are reported
+	for _, spec := range []map[string]interface{}{
+		{"vpcRef": map[string]interface{}{"name": "other"}},
+		{"groupId": "${SecurityGroup.none.securityGroupId}"},
+	} {
+		if _, err := ResolveReferences(resource("Subnet", "x", spec), lookup); err == nil {
+			t.Errorf("Expected unresolved reference error for %v", spec)
+		}
+	}
+}
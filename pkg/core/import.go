@@ -0,0 +1,237 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	awsiam "infra-operator/internal/adapters/aws/iam"
+	awsigw "infra-operator/internal/adapters/aws/internetgateway"
+	awss3 "infra-operator/internal/adapters/aws/s3"
+	awssubnet "infra-operator/internal/adapters/aws/subnet"
+	awsvpc "infra-operator/internal/adapters/aws/vpc"
+	"infra-operator/pkg/mapper"
+)
+
+// importKind descreve como ler um recurso AWS existente para o import
+type importKind struct {
+	// idKeys mapeia chaves de AWSResources para campos do status do CR; os
+	// kinds via use case usam o idKeys do catálogo
+	idKeys map[string]string
+
+	// read obtém o recurso pelo repositório do kind e retorna o spec e o
+	// status do CR equivalente, convertidos pelo mapper
+	read func(ctx context.Context, e *Engine, id string) (spec, status interface{}, err error)
+}
+
+// importRetainPolicy é o deletionPolicy dos recursos importados: apagar o CR
+// ou o estado não remove um recurso que já existia antes do operator
+const importRetainPolicy = "Retain"
+
+// importKinds registra os kinds suportados pelo import, indexados pelo ID AWS
+// (ou nome, para buckets e roles)
+var importKinds = map[string]importKind{
+	"VPC": {
+		idKeys: map[string]string{"vpcId": "vpcID"},
+		read: func(ctx context.Context, e *Engine, id string) (interface{}, interface{}, error) {
+			v, err := awsvpc.NewRepository(e.awsConfig).Get(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			v.DeletionPolicy = importRetainPolicy
+			cr := &infrav1alpha1.VPC{}
+			mapper.DomainToSpecVPC(v, cr)
+			mapper.DomainToStatusVPC(v, cr)
+			return cr.Spec, cr.Status, nil
+		},
+	},
+	"Subnet": {
+		idKeys: map[string]string{"subnetId": "subnetID", "vpcId": "vpcID"},
+		read: func(ctx context.Context, e *Engine, id string) (interface{}, interface{}, error) {
+			s, err := awssubnet.NewRepository(e.awsConfig).Get(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			s.DeletionPolicy = importRetainPolicy
+			cr := &infrav1alpha1.Subnet{}
+			mapper.DomainToSpecSubnet(s, cr)
+			mapper.DomainToStatusSubnet(s, cr)
+			return cr.Spec, cr.Status, nil
+		},
+	},
+	"InternetGateway": {
+		idKeys: map[string]string{"internetGatewayId": "internetGatewayID", "vpcId": "vpcID"},
+		read: func(ctx context.Context, e *Engine, id string) (interface{}, interface{}, error) {
+			g, err := awsigw.NewRepository(e.awsConfig).Get(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			g.DeletionPolicy = importRetainPolicy
+			cr := &infrav1alpha1.InternetGateway{}
+			mapper.DomainToSpecInternetGateway(g, cr)
+			mapper.DomainToStatusInternetGateway(g, cr)
+			return cr.Spec, cr.Status, nil
+		},
+	},
+	"S3Bucket": {
+		read: func(ctx context.Context, e *Engine, id string) (interface{}, interface{}, error) {
+			bucket, err := awss3.NewRepository(e.awsConfig).Get(ctx, id, e.awsConfig.Region)
+			if err != nil {
+				return nil, nil, err
+			}
+			bucket.DeletionPolicy = importRetainPolicy
+			cr := &infrav1alpha1.S3Bucket{}
+			mapper.DomainBucketToCRSpec(bucket, cr)
+			mapper.DomainBucketToCRStatus(bucket, cr)
+			cr.Status.Ready = true
+			return cr.Spec, cr.Status, nil
+		},
+	},
+	"IAMRole": {
+		read: func(ctx context.Context, e *Engine, id string) (interface{}, interface{}, error) {
+			repo := awsiam.NewRepository(e.awsConfig)
+			role, err := repo.Get(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			// O Sync desanexa as políticas que não estão no spec
+			if role.ManagedPolicyArns, err = repo.ListAttachedPolicies(ctx, id); err != nil {
+				return nil, nil, err
+			}
+			role.DeletionPolicy = importRetainPolicy
+			cr := &infrav1alpha1.IAMRole{}
+			mapper.DomainToSpecIAMRole(role, cr)
+			mapper.DomainToStatusIAMRole(role, cr)
+			return cr.Spec, cr.Status, nil
+		},
+	},
+}
+
+// ImportKinds retorna os kinds suportados pelo import, em ordem
+func ImportKinds() []string {
+	kinds := make([]string, 0, len(importKinds))
+	for kind := range importKinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// ImportOptions configura Engine.Import
+type ImportOptions struct {
+	// Name e Namespace identificam o recurso no estado; o nome padrão é
+	// derivado do ID AWS
+	Name      string
+	Namespace string
+
+	// ProviderRef é o AWSProvider referenciado no spec gerado (opcional)
+	ProviderRef string
+}
+
+// ImportResult é o resultado de um import
+type ImportResult struct {
+	// State é o estado gravado para o recurso
+	State *ResourceState
+
+	// Resource é o CR equivalente, com a anotação de adoção para que o
+	// controller se ligue ao recurso existente em vez de criar outro
+	Resource Resource
+}
+
+// Import lê um recurso AWS existente pelo repositório do kind e o grava no
+// estado, como se tivesse sido criado por um apply do CR retornado
+func (e *Engine) Import(ctx context.Context, kind, id string, opts ImportOptions) (*ImportResult, error) {
+	ik, ok := importKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("import não suportado para %s (suportados: %s)", kind, strings.Join(ImportKinds(), ", "))
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = importName(id)
+	}
+
+	if !e.dryRun {
+		release, err := e.acquireLock(ctx, "import")
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	existing, err := e.stateManager.LoadState(kind, opts.Namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%s/%s já existe no estado", kind, name)
+	}
+
+	e.output.WriteVerbose("Importando %s %s...", kind, id)
+	spec, status, err := ik.read(ctx, e, id)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler %s %s: %w", kind, id, err)
+	}
+
+	specMap, err := toMap(spec)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao serializar spec: %w", err)
+	}
+	delete(specMap, "providerRef")
+	if opts.ProviderRef != "" {
+		specMap["providerRef"] = map[string]interface{}{"name": opts.ProviderRef}
+	}
+
+	statusMap, err := toMap(status)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao serializar status: %w", err)
+	}
+
+	idKeys := ik.idKeys
+	if idKeys == nil {
+		idKeys = useCaseKinds[kind].idKeys
+	}
+
+	r := Resource{
+		APIVersion: infrav1alpha1.GroupVersion.String(),
+		Kind:       kind,
+		Metadata: Metadata{
+			Name:        name,
+			Namespace:   opts.Namespace,
+			Annotations: map[string]string{infrav1alpha1.AdoptAnnotation: id},
+		},
+		Spec: specMap,
+	}
+
+	state := StateFromResource(r)
+	state.Status = statusMap
+	for key, field := range idKeys {
+		if value, ok := statusMap[field].(string); ok && value != "" {
+			state.AWSResources[key] = value
+		}
+	}
+
+	if !e.dryRun {
+		if err := e.stateManager.SaveState(state); err != nil {
+			return nil, fmt.Errorf("falha ao salvar estado: %w", err)
+		}
+	}
+
+	return &ImportResult{State: state, Resource: r}, nil
+}
+
+// invalidNameChars casa os caracteres que não podem aparecer no nome de um CR
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// importName deriva um nome de CR válido do ID AWS (ex: MyRole_prod -> myrole-prod)
+func importName(id string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(id), "-")
+	name = strings.Trim(name, "-.")
+	if len(name) > 253 {
+		name = name[:253]
+	}
+	return name
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestImportName(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"vpc-0a1b2c3d", "vpc-0a1b2c3d"},
+		{"MyAppRole", "myapprole"},
+		{"service_role/prod", "service-role-prod"},
+		{"my.bucket.example.com", "my.bucket.example.com"},
+		{"_Role_", "role"},
+	}
+
+	for _, tt := range tests {
+		if got := importName(tt.id); got != tt.want {
+			t.Errorf("importName(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestImportUnsupportedKind(t *testing.T) {
+	e := newTestEngine(t, 0)
+
+	_, err := e.Import(context.Background(), "LambdaFunction", "fn", ImportOptions{})
+	if err == nil {
+		t.Fatal("Expected error for unsupported kind")
+	}
+	for _, kind := range ImportKinds() {
+		if !strings.Contains(err.Error(), kind) {
+			t.Errorf("Expected error to list supported kind %s, got: %v", kind, err)
+		}
+	}
+}
+
+func TestImportExistingState(t *testing.T) {
+	e := newTestEngine(t, 0)
+	if err := e.stateManager.SaveState(&ResourceState{Kind: "VPC", Name: "vpc-0a1b2c3d", Namespace: "default"}); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+
+	_, err := e.Import(context.Background(), "VPC", "vpc-0a1b2c3d", ImportOptions{Namespace: "default"})
+	if err == nil || !strings.Contains(err.Error(), "já existe") {
+		t.Fatalf("Expected already exists error, got: %v", err)
+	}
+
+	if lock, _ := e.CurrentLock(context.Background()); lock != nil {
+		t.Errorf("Expected lock to be released, got %v", lock)
+	}
+}
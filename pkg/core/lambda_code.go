@@ -0,0 +1,74 @@
+package core
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"infra-operator/internal/domain/lambda"
+)
+
+// codePathHashKey é a chave de spec.code onde o parser grava o hash do
+// diretório de code.path. Ela não existe no CRD: serve apenas para que o
+// plano detecte mudanças nos arquivos quando o manifesto não muda.
+const codePathHashKey = "pathHash"
+
+// readCodeDirectory lê os arquivos de um diretório local, indexados pelo
+// caminho relativo que terão no pacote de deploy. Caminhos relativos partem
+// do diretório atual.
+func readCodeDirectory(dir string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("falha ao ler code.path %s: %w", dir, err)
+	}
+	return files, nil
+}
+
+// hashCodePaths grava em spec.code.pathHash o hash do pacote de deploy das
+// LambdaFunctions com code.path
+func hashCodePaths(resources []Resource) error {
+	for _, r := range resources {
+		if r.Kind != "LambdaFunction" {
+			continue
+		}
+		code, ok := r.Spec["code"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		dir, ok := code["path"].(string)
+		if !ok || dir == "" {
+			continue
+		}
+
+		files, err := readCodeDirectory(dir)
+		if err != nil {
+			return fmt.Errorf("%s/%s: %w", r.Kind, r.Metadata.Name, err)
+		}
+		archive, err := lambda.BuildArchive(files)
+		if err != nil {
+			return fmt.Errorf("%s/%s: %w", r.Kind, r.Metadata.Name, err)
+		}
+		code[codePathHashKey] = lambda.ArchiveHash(archive)
+	}
+	return nil
+}
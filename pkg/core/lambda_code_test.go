@@ -0,0 +1,51 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestHashCodePaths verifies the package hash of code.path follows the files of the directory.
+func TestHashCodePaths(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "lib"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index.py", "def handler(event, context):\n    return 'ok'\n")
+	write("lib/helper.py", "VALUE = 1\n")
+
+	files, err := readCodeDirectory(dir)
+	if err != nil {
+		t.Fatalf("readCodeDirectory() error = %v", err)
+	}
+	if _, ok := files["lib/helper.py"]; !ok || len(files) != 2 {
+		t.Errorf("files = %v, want index.py and lib/helper.py", files)
+	}
+
+	hash := func() string {
+		resources := []Resource{{
+			Kind:     "LambdaFunction",
+			Metadata: Metadata{Name: "orders"},
+			Spec:     map[string]interface{}{"code": map[string]interface{}{"path": dir}},
+		}}
+		if err := hashCodePaths(resources); err != nil {
+			t.Fatalf("hashCodePaths() error = %v", err)
+		}
+		return resources[0].Spec["code"].(map[string]interface{})[codePathHashKey].(string)
+	}
+
+	first := hash()
+	if first == "" || hash() != first {
+		t.Fatal("hash of an unchanged directory should be stable")
+	}
+	write("index.py", "def handler(event, context):\n    return 'changed'\n")
+	if hash() == first {
+		t.Error("hash did not change with the files")
+	}
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+)
+
+// lockRetryInterval é o intervalo entre tentativas de adquirir o lock
+const lockRetryInterval = time.Second
+
+// LockInfo identifica quem detém o lock do estado
+type LockInfo struct {
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	Who       string    `json:"who"`
+	Created   time.Time `json:"created"`
+}
+
+// newLockInfo cria um lock com ID aleatório para a operação
+func newLockInfo(operation string) *LockInfo {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+
+	who := "desconhecido"
+	if u, err := user.Current(); err == nil {
+		who = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		who += "@" + host
+	}
+
+	return &LockInfo{
+		ID:        hex.EncodeToString(id),
+		Operation: operation,
+		Who:       who,
+		Created:   time.Now().UTC(),
+	}
+}
+
+// LockedError indica que o estado está bloqueado por outro processo
+type LockedError struct {
+	Info *LockInfo
+}
+
+func (e *LockedError) Error() string {
+	if e.Info == nil {
+		return "estado bloqueado por outro processo"
+	}
+	return fmt.Sprintf("estado bloqueado por %s (operação %s desde %s, lock %s); se o processo não estiver mais em execução use force-unlock %s",
+		e.Info.Who, e.Info.Operation, e.Info.Created.Format(time.RFC3339), e.Info.ID, e.Info.ID)
+}
+
+// checkLockID valida que o lock atual é o que está sendo liberado
+func checkLockID(current *LockInfo, id string) error {
+	if current != nil && current.ID != id {
+		return fmt.Errorf("lock %s não corresponde ao lock atual %s (detido por %s)", id, current.ID, current.Who)
+	}
+	return nil
+}
+
+// StateConflictError indica que o estado de um recurso foi alterado por outro
+// processo entre a leitura e a gravação
+type StateConflictError struct {
+	Key      string
+	Expected int64
+	Actual   int64
+}
+
+func (e *StateConflictError) Error() string {
+	return fmt.Sprintf("estado de %s foi alterado por outro processo (serial %d, esperado %d)", e.Key, e.Actual, e.Expected)
+}
+
+// acquireLock bloqueia o estado durante a operação, tentando novamente até
+// lockTimeout. A função retornada libera o lock.
+func (e *Engine) acquireLock(ctx context.Context, operation string) (func(), error) {
+	backend := e.stateManager.backend
+	info := newLockInfo(operation)
+	deadline := time.Now().Add(e.lockTimeout)
+
+	for {
+		err := backend.Lock(ctx, info)
+		if err == nil {
+			break
+		}
+
+		var locked *LockedError
+		if !errors.As(err, &locked) || !time.Now().Before(deadline) {
+			return nil, err
+		}
+
+		e.output.WriteVerbose("Aguardando lock do estado: %v", locked)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+
+	return func() {
+		// O lock deve ser liberado mesmo se o contexto da operação foi cancelado
+		if err := backend.Unlock(context.Background(), info.ID); err != nil {
+			e.output.Write("Aviso: falha ao liberar o lock do estado %s: %v", info.ID, err)
+		}
+	}, nil
+}
+
+// CurrentLock retorna o lock atual do estado, ou nil quando ele está livre
+func (e *Engine) CurrentLock(ctx context.Context) (*LockInfo, error) {
+	return e.stateManager.backend.CurrentLock(ctx)
+}
+
+// ForceUnlock libera um lock deixado por um processo interrompido.
+// O ID precisa corresponder ao lock atual.
+func (e *Engine) ForceUnlock(ctx context.Context, id string) error {
+	current, err := e.stateManager.backend.CurrentLock(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("o estado não está bloqueado")
+	}
+	if err := checkLockID(current, id); err != nil {
+		return err
+	}
+	return e.stateManager.backend.Unlock(ctx, id)
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// testStateLock exercises Lock, Unlock and CurrentLock on top of a backend.
+func testStateLock(t *testing.T, backend StateBackend) {
+	t.Helper()
+	ctx := context.Background()
+
+	if lock, err := backend.CurrentLock(ctx); err != nil || lock != nil {
+		t.Fatalf("Expected no lock, got %v (err: %v)", lock, err)
+	}
+
+	first := newLockInfo("apply")
+	if err := backend.Lock(ctx, first); err != nil {
+		t.Fatalf("Lock: %v", err)
+	}
+
+	var locked *LockedError
+	err := backend.Lock(ctx, newLockInfo("delete"))
+	if !errors.As(err, &locked) {
+		t.Fatalf("Expected LockedError, got: %v", err)
+	}
+	if locked.Info == nil || locked.Info.ID != first.ID || locked.Info.Operation != "apply" {
+		t.Errorf("Expected lock info of the holder, got %+v", locked.Info)
+	}
+
+	if err := backend.Unlock(ctx, "other"); err == nil {
+		t.Errorf("Expected unlocking with a wrong ID to fail")
+	}
+	if lock, _ := backend.CurrentLock(ctx); lock == nil || lock.ID != first.ID {
+		t.Errorf("Expected lock to be kept, got %v", lock)
+	}
+
+	if err := backend.Unlock(ctx, first.ID); err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	if err := backend.Unlock(ctx, first.ID); err != nil {
+		t.Errorf("Expected unlocking a free state to succeed, got: %v", err)
+	}
+	if err := backend.Lock(ctx, newLockInfo("apply")); err != nil {
+		t.Errorf("Expected lock to be free after Unlock, got: %v", err)
+	}
+}
+
+// newTestEngine creates an Engine on a local backend without AWS access.
+func newTestEngine(t *testing.T, lockTimeout time.Duration) *Engine {
+	t.Helper()
+	dir := t.TempDir()
+	return &Engine{
+		stateManager: NewStateManager(dir),
+		output:       &SilentOutputWriter{},
+		lockTimeout:  lockTimeout,
+	}
+}
+
+// TestAcquireLock verifies that a held lock blocks a second operation until
+// it is released or the timeout expires.
+func TestAcquireLock(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, 0)
+
+	release, err := e.acquireLock(ctx, "apply")
+	if err != nil {
+		t.Fatalf("acquireLock: %v", err)
+	}
+
+	var locked *LockedError
+	if _, err := e.acquireLock(ctx, "delete"); !errors.As(err, &locked) {
+		t.Fatalf("Expected LockedError, got: %v", err)
+	}
+
+	release()
+	if lock, _ := e.CurrentLock(ctx); lock != nil {
+		t.Fatalf("Expected lock to be released, got %+v", lock)
+	}
+
+	// With a timeout, the second operation waits for the lock to be released
+	release, err = e.acquireLock(ctx, "apply")
+	if err != nil {
+		t.Fatalf("acquireLock: %v", err)
+	}
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		release()
+	}()
+
+	waiting := newTestEngine(t, 5*time.Second)
+	waiting.stateManager = e.stateManager
+	releaseWaiting, err := waiting.acquireLock(ctx, "delete")
+	if err != nil {
+		t.Fatalf("Expected lock after waiting, got: %v", err)
+	}
+	releaseWaiting()
+}
+
+// TestForceUnlock verifies that only the current lock ID can be force-unlocked.
+func TestForceUnlock(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEngine(t, 0)
+
+	if err := e.ForceUnlock(ctx, "abc"); err == nil {
+		t.Errorf("Expected error when state is not locked")
+	}
+
+	if _, err := e.acquireLock(ctx, "apply"); err != nil {
+		t.Fatalf("acquireLock: %v", err)
+	}
+	lock, err := e.CurrentLock(ctx)
+	if err != nil || lock == nil {
+		t.Fatalf("Expected current lock, got %v (err: %v)", lock, err)
+	}
+
+	if err := e.ForceUnlock(ctx, "abc"); err == nil {
+		t.Errorf("Expected error for a wrong lock ID")
+	}
+	if err := e.ForceUnlock(ctx, lock.ID); err != nil {
+		t.Fatalf("ForceUnlock: %v", err)
+	}
+	if lock, _ := e.CurrentLock(ctx); lock != nil {
+		t.Errorf("Expected state to be unlocked, got %+v", lock)
+	}
+}
+
+// TestSaveStateSerial verifies that a write based on a stale read is rejected.
+func TestSaveStateSerial(t *testing.T) {
+	sm := NewStateManager(t.TempDir())
+
+	state := &ResourceState{Kind: "VPC", Name: "main", Namespace: "default"}
+	if err := sm.SaveState(state); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+	if state.Serial != 1 {
+		t.Errorf("Expected serial 1, got %d", state.Serial)
+	}
+
+	first, _ := sm.LoadState("VPC", "default", "main")
+	second, _ := sm.LoadState("VPC", "default", "main")
+
+	first.AWSResources = map[string]string{"vpcId": "vpc-1"}
+	if err := sm.SaveState(first); err != nil {
+		t.Fatalf("SaveState: %v", err)
+	}
+
+	second.AWSResources = map[string]string{"vpcId": "vpc-2"}
+	var conflict *StateConflictError
+	if err := sm.SaveState(second); !errors.As(err, &conflict) {
+		t.Fatalf("Expected StateConflictError, got: %v", err)
+	}
+	if conflict.Expected != 1 || conflict.Actual != 2 {
+		t.Errorf("Unexpected conflict: %+v", conflict)
+	}
+
+	if err := sm.SaveState(&ResourceState{Kind: "VPC", Name: "main", Namespace: "default"}); err == nil {
+		t.Errorf("Expected creating over an existing state to conflict")
+	}
+
+	loaded, _ := sm.LoadState("VPC", "default", "main")
+	if loaded.Serial != 2 || loaded.AWSResources["vpcId"] != "vpc-1" {
+		t.Errorf("Expected first write to be kept, got %+v", loaded)
+	}
+}
@@ -16,7 +16,15 @@ func ParseFile(filename string) ([]Resource, error) {
 	if err != nil {
 		return nil, fmt.Errorf("falha ao ler arquivo %s: %w", filename, err)
 	}
-	return ParseYAML(data)
+
+	resources, err := ParseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := hashCodePaths(resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
 }
 
 // ParseYAML faz o parse de conteúdo YAML contendo um ou mais recursos (separados por ---)
@@ -63,55 +71,3 @@ func GetResourceID(r Resource) string {
 	}
 	return fmt.Sprintf("%s/%s", r.Kind, r.Metadata.Name)
 }
-
-// OrderByDependency ordena recursos baseado em suas dependências
-func OrderByDependency(resources []Resource) []Resource {
-	priority := map[string]int{
-		"AWSProvider":          0,
-		"VPC":                  1,
-		"InternetGateway":      2,
-		"Subnet":               3,
-		"ElasticIP":            4,
-		"NATGateway":           5,
-		"RouteTable":           6,
-		"SecurityGroup":        7,
-		"IAMRole":              8,
-		"KMSKey":               9,
-		"SecretsManagerSecret": 10,
-		"S3Bucket":             11,
-		"ECRRepository":        12,
-		"RDSInstance":          13,
-		"DynamoDBTable":        14,
-		"ElastiCacheCluster":   15,
-		"SQSQueue":             16,
-		"SNSTopic":             17,
-		"LambdaFunction":       18,
-		"EC2Instance":          19,
-		"EC2KeyPair":           19,
-		"ALB":                  20,
-		"NLB":                  20,
-		"EKSCluster":           21,
-		"ECSCluster":           21,
-		"APIGateway":           22,
-		"Certificate":          23,
-		"CloudFront":           24,
-		"Route53HostedZone":    25,
-		"Route53RecordSet":     26,
-		"ComputeStack":         100,
-	}
-
-	sorted := make([]Resource, len(resources))
-	copy(sorted, resources)
-
-	for i := 0; i < len(sorted)-1; i++ {
-		for j := 0; j < len(sorted)-i-1; j++ {
-			p1 := priority[sorted[j].Kind]
-			p2 := priority[sorted[j+1].Kind]
-			if p1 > p2 {
-				sorted[j], sorted[j+1] = sorted[j+1], sorted[j]
-			}
-		}
-	}
-
-	return sorted
-}
@@ -1,80 +1,92 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path/filepath"
+	"path"
+	"strings"
 	"time"
 )
 
-// StateManager gerencia o estado local
+// StateManager gerencia o estado dos recursos sobre um StateBackend
 type StateManager struct {
+	// StateDir é o diretório local do estado; também guarda as chaves
+	// privadas geradas, mesmo quando o backend é remoto
 	StateDir string
+
+	backend StateBackend
 }
 
-// NewStateManager cria um novo gerenciador de estado
+// NewStateManager cria um gerenciador de estado com o backend local
 func NewStateManager(stateDir string) *StateManager {
 	if stateDir == "" {
-		home, _ := os.UserHomeDir()
-		stateDir = filepath.Join(home, ".infra-operator", "state")
+		stateDir = defaultStateDir()
 	}
-	return &StateManager{StateDir: stateDir}
+	return NewStateManagerWithBackend(stateDir, NewLocalBackend(stateDir))
 }
 
-// EnsureDir garante que o diretório de estado existe
-func (s *StateManager) EnsureDir() error {
-	return os.MkdirAll(s.StateDir, 0755)
+// NewStateManagerWithBackend cria um gerenciador de estado sobre o backend informado
+func NewStateManagerWithBackend(stateDir string, backend StateBackend) *StateManager {
+	if stateDir == "" {
+		stateDir = defaultStateDir()
+	}
+	return &StateManager{StateDir: stateDir, backend: backend}
 }
 
-// GetStatePath retorna o caminho para o arquivo de estado de um recurso
-func (s *StateManager) GetStatePath(kind, namespace, name string) string {
+// stateKey retorna a chave do estado de um recurso no backend
+func stateKey(kind, namespace, name string) string {
 	if namespace == "" {
 		namespace = "default"
 	}
-	return filepath.Join(s.StateDir, kind, namespace, name+".json")
+	return path.Join(kind, namespace, name+".json")
 }
 
-// SaveState salva o estado de um recurso
-func (s *StateManager) SaveState(state *ResourceState) error {
-	if err := s.EnsureDir(); err != nil {
-		return fmt.Errorf("falha ao garantir diretório de estado: %w", err)
-	}
+// As operações não recebem contexto porque LoadState também é usado como
+// lookup em ResolveReferences; o backend recebe context.Background().
 
-	statePath := s.GetStatePath(state.Kind, state.Namespace, state.Name)
-	stateDir := filepath.Dir(statePath)
+// SaveState salva o estado de um recurso. O Serial do estado precisa ser o
+// mesmo do estado gravado (zero para um recurso novo); caso contrário outro
+// processo gravou no meio tempo e *StateConflictError é retornado. A leitura
+// e a gravação não são atômicas: gravações concorrentes são evitadas pelo lock
+// do estado, mantido por apply e delete durante toda a execução.
+func (s *StateManager) SaveState(state *ResourceState) error {
+	key := stateKey(state.Kind, state.Namespace, state.Name)
 
-	if err := os.MkdirAll(stateDir, 0755); err != nil {
-		return fmt.Errorf("falha ao criar diretório de estado: %w", err)
+	current, err := s.LoadState(state.Kind, state.Namespace, state.Name)
+	if err != nil {
+		return err
+	}
+	var serial int64
+	if current != nil {
+		serial = current.Serial
+	}
+	if serial != state.Serial {
+		return &StateConflictError{Key: key, Expected: state.Serial, Actual: serial}
 	}
 
 	state.UpdatedAt = time.Now()
 	if state.CreatedAt.IsZero() {
 		state.CreatedAt = state.UpdatedAt
 	}
+	state.Serial = serial + 1
 
 	data, err := json.MarshalIndent(state, "", "  ")
-	if err != nil {
-		return fmt.Errorf("falha ao serializar estado: %w", err)
+	if err == nil {
+		err = s.backend.Put(context.Background(), key, data)
 	}
-
-	if err := os.WriteFile(statePath, data, 0644); err != nil {
-		return fmt.Errorf("falha ao escrever arquivo de estado: %w", err)
+	if err != nil {
+		state.Serial = serial
+		return fmt.Errorf("falha ao gravar estado %s: %w", key, err)
 	}
-
 	return nil
 }
 
-// LoadState carrega o estado de um recurso
+// LoadState carrega o estado de um recurso; retorna nil quando ele não existe
 func (s *StateManager) LoadState(kind, namespace, name string) (*ResourceState, error) {
-	statePath := s.GetStatePath(kind, namespace, name)
-
-	data, err := os.ReadFile(statePath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("falha ao ler arquivo de estado: %w", err)
+	data, err := s.backend.Get(context.Background(), stateKey(kind, namespace, name))
+	if err != nil || data == nil {
+		return nil, err
 	}
 
 	var state ResourceState
@@ -87,77 +99,46 @@ func (s *StateManager) LoadState(kind, namespace, name string) (*ResourceState,
 
 // DeleteState deleta o estado de um recurso
 func (s *StateManager) DeleteState(kind, namespace, name string) error {
-	statePath := s.GetStatePath(kind, namespace, name)
-
-	if err := os.Remove(statePath); err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("falha ao deletar arquivo de estado: %w", err)
-	}
-
-	return nil
+	return s.backend.Delete(context.Background(), stateKey(kind, namespace, name))
 }
 
 // ListStates lista todos os recursos de um tipo específico
 func (s *StateManager) ListStates(kind string) ([]*ResourceState, error) {
-	var states []*ResourceState
+	return s.listStates(kind + "/")
+}
 
-	kindDir := filepath.Join(s.StateDir, kind)
-	if _, err := os.Stat(kindDir); os.IsNotExist(err) {
-		return states, nil
-	}
+// ListAllStates lista todos os recursos no estado
+func (s *StateManager) ListAllStates() ([]*ResourceState, error) {
+	return s.listStates("")
+}
 
-	err := filepath.Walk(kindDir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		if !info.IsDir() && filepath.Ext(path) == ".json" {
-			data, err := os.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			var state ResourceState
-			if err := json.Unmarshal(data, &state); err != nil {
-				return err
-			}
-			states = append(states, &state)
-		}
-		return nil
-	})
+func (s *StateManager) listStates(prefix string) ([]*ResourceState, error) {
+	ctx := context.Background()
 
+	keys, err := s.backend.List(ctx, prefix)
 	if err != nil {
-		return nil, fmt.Errorf("falha ao listar estados: %w", err)
+		return nil, err
 	}
 
-	return states, nil
-}
-
-// ListAllStates lista todos os recursos no estado
-func (s *StateManager) ListAllStates() ([]*ResourceState, error) {
 	var states []*ResourceState
+	for _, key := range keys {
+		if !strings.HasSuffix(key, ".json") {
+			continue
+		}
 
-	if _, err := os.Stat(s.StateDir); os.IsNotExist(err) {
-		return states, nil
-	}
-
-	err := filepath.Walk(s.StateDir, func(path string, info os.FileInfo, err error) error {
+		data, err := s.backend.Get(ctx, key)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("falha ao listar estados: %w", err)
 		}
-		if !info.IsDir() && filepath.Ext(path) == ".json" {
-			data, err := os.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			var state ResourceState
-			if err := json.Unmarshal(data, &state); err != nil {
-				return err
-			}
-			states = append(states, &state)
+		if data == nil {
+			continue
 		}
-		return nil
-	})
 
-	if err != nil {
-		return nil, fmt.Errorf("falha ao listar todos os estados: %w", err)
+		var state ResourceState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, fmt.Errorf("falha ao deserializar estado %s: %w", key, err)
+		}
+		states = append(states, &state)
 	}
 
 	return states, nil
@@ -32,6 +32,10 @@ type ResourceState struct {
 	AWSResources map[string]string      `json:"awsResources"`
 	CreatedAt    time.Time              `json:"createdAt"`
 	UpdatedAt    time.Time              `json:"updatedAt"`
+
+	// Serial é incrementado a cada gravação; SaveState recusa gravar sobre
+	// um serial diferente do lido
+	Serial int64 `json:"serial"`
 }
 
 // AWSConfig contém a configuração AWS
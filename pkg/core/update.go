@@ -0,0 +1,426 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// primaryIDKeys mapeia cada kind para a chave do seu ID principal em AWSResources
+var primaryIDKeys = map[string]string{
+	"VPC":             "vpcId",
+	"Subnet":          "subnetId",
+	"InternetGateway": "internetGatewayId",
+	"SecurityGroup":   "securityGroupId",
+	"EC2Instance":     "instanceId",
+}
+
+// applyUpdate compara o recurso com o estado existente e aplica as mudanças in-place
+func (e *Engine) applyUpdate(ctx context.Context, r Resource, existingState *ResourceState, result *ApplyResult) {
+	changes := DiffSpecs(r.Kind, existingState.Spec, r.Spec)
+	if len(changes) == 0 {
+		e.output.WriteVerbose("  Sem alterações, pulando...")
+		result.Skipped = append(result.Skipped, ResourceResult{
+			Kind:         r.Kind,
+			Name:         r.Metadata.Name,
+			AWSResources: existingState.AWSResources,
+			Message:      "sem alterações",
+		})
+		return
+	}
+
+	if RequiresReplace(changes) {
+		var fields []string
+		for _, change := range changes {
+			if change.Replace {
+				fields = append(fields, change.Path)
+			}
+		}
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:         r.Kind,
+			Name:         r.Metadata.Name,
+			AWSResources: existingState.AWSResources,
+			Error:        fmt.Sprintf("alteração requer substituição do recurso (%s); delete e aplique novamente", strings.Join(fields, ", ")),
+		})
+		return
+	}
+
+	if e.dryRun {
+		result.Skipped = append(result.Skipped, ResourceResult{
+			Kind:    r.Kind,
+			Name:    r.Metadata.Name,
+			Message: "dry-run",
+		})
+		return
+	}
+
+	for _, change := range changes {
+		e.output.WriteVerbose("  %s", change.String())
+	}
+
+	resolved, err := ResolveReferences(r, e.stateManager.LoadState)
+	if err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:         r.Kind,
+			Name:         r.Metadata.Name,
+			AWSResources: existingState.AWSResources,
+			Error:        err.Error(),
+		})
+		return
+	}
+
+	if err := e.updateResource(ctx, resolved, existingState, changes); err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:         r.Kind,
+			Name:         r.Metadata.Name,
+			AWSResources: existingState.AWSResources,
+			Error:        err.Error(),
+		})
+		return
+	}
+
+	existingState.APIVersion = r.APIVersion
+	existingState.Spec = r.Spec
+	if err := e.stateManager.SaveState(existingState); err != nil {
+		result.Failed = append(result.Failed, ResourceResult{
+			Kind:  r.Kind,
+			Name:  r.Metadata.Name,
+			Error: fmt.Sprintf("falha ao salvar estado: %v", err),
+		})
+		return
+	}
+
+	result.Updated = append(result.Updated, ResourceResult{
+		Kind:         r.Kind,
+		Name:         r.Metadata.Name,
+		Namespace:    r.Metadata.Namespace,
+		AWSResources: existingState.AWSResources,
+		Message:      fmt.Sprintf("%d campo(s) atualizado(s)", len(changes)),
+	})
+}
+
+// updateResource aplica as mudanças in-place de um recurso AWS baseado no kind
+func (e *Engine) updateResource(ctx context.Context, r Resource, state *ResourceState, changes []FieldChange) error {
+	// Kinds via use case: o Sync converge o recurso existente para o novo spec
+	if kind, ok := useCaseKinds[r.Kind]; ok {
+		return e.syncUseCaseKind(ctx, kind, r, state)
+	}
+
+	ec2Client := ec2.NewFromConfig(e.awsConfig)
+
+	resourceID := state.AWSResources[primaryIDKeys[r.Kind]]
+	if resourceID == "" {
+		return fmt.Errorf("ID do recurso %s/%s não encontrado no estado", r.Kind, r.Metadata.Name)
+	}
+
+	if HasFieldChange(changes, "tags") {
+		if err := updateTags(ctx, ec2Client, resourceID, state.Spec, r.Spec); err != nil {
+			return fmt.Errorf("falha ao atualizar tags: %w", err)
+		}
+	}
+
+	switch r.Kind {
+	case "VPC":
+		return e.updateVPC(ctx, ec2Client, resourceID, r, changes)
+	case "Subnet":
+		return e.updateSubnet(ctx, ec2Client, resourceID, r, changes)
+	case "SecurityGroup":
+		return e.updateSecurityGroup(ctx, ec2Client, resourceID, state, r, changes)
+	case "EC2Instance":
+		return e.updateEC2Instance(ctx, ec2Client, resourceID, r, changes)
+	}
+
+	return nil
+}
+
+// updateVPC atualiza os atributos de DNS da VPC
+func (e *Engine) updateVPC(ctx context.Context, ec2Client *ec2.Client, vpcID string, r Resource, changes []FieldChange) error {
+	if HasFieldChange(changes, "enableDnsSupport") {
+		enabled, _ := r.Spec["enableDnsSupport"].(bool)
+		if _, err := ec2Client.ModifyVpcAttribute(ctx, &ec2.ModifyVpcAttributeInput{
+			VpcId:            aws.String(vpcID),
+			EnableDnsSupport: &types.AttributeBooleanValue{Value: aws.Bool(enabled)},
+		}); err != nil {
+			return fmt.Errorf("falha ao atualizar enableDnsSupport: %w", err)
+		}
+	}
+
+	if HasFieldChange(changes, "enableDnsHostnames") {
+		enabled, _ := r.Spec["enableDnsHostnames"].(bool)
+		if _, err := ec2Client.ModifyVpcAttribute(ctx, &ec2.ModifyVpcAttributeInput{
+			VpcId:              aws.String(vpcID),
+			EnableDnsHostnames: &types.AttributeBooleanValue{Value: aws.Bool(enabled)},
+		}); err != nil {
+			return fmt.Errorf("falha ao atualizar enableDnsHostnames: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateSubnet atualiza os atributos da Subnet
+func (e *Engine) updateSubnet(ctx context.Context, ec2Client *ec2.Client, subnetID string, r Resource, changes []FieldChange) error {
+	if HasFieldChange(changes, "mapPublicIpOnLaunch") {
+		enabled, _ := r.Spec["mapPublicIpOnLaunch"].(bool)
+		if _, err := ec2Client.ModifySubnetAttribute(ctx, &ec2.ModifySubnetAttributeInput{
+			SubnetId:            aws.String(subnetID),
+			MapPublicIpOnLaunch: &types.AttributeBooleanValue{Value: aws.Bool(enabled)},
+		}); err != nil {
+			return fmt.Errorf("falha ao atualizar mapPublicIpOnLaunch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateSecurityGroup sincroniza as regras de ingress/egress do Security Group,
+// revogando as regras removidas do manifesto e autorizando as novas
+func (e *Engine) updateSecurityGroup(ctx context.Context, ec2Client *ec2.Client, sgID string, state *ResourceState, r Resource, changes []FieldChange) error {
+	if HasFieldChange(changes, "ingressRules") {
+		toRevoke, toAuthorize := diffRules(state.Spec["ingressRules"], r.Spec["ingressRules"])
+
+		if len(toRevoke) > 0 {
+			if _, err := ec2Client.RevokeSecurityGroupIngress(ctx, &ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       aws.String(sgID),
+				IpPermissions: toRevoke,
+			}); err != nil {
+				return fmt.Errorf("falha ao revogar regras de ingress: %w", err)
+			}
+		}
+
+		if len(toAuthorize) > 0 {
+			if _, err := ec2Client.AuthorizeSecurityGroupIngress(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       aws.String(sgID),
+				IpPermissions: toAuthorize,
+			}); err != nil {
+				return fmt.Errorf("falha ao autorizar regras de ingress: %w", err)
+			}
+		}
+	}
+
+	if HasFieldChange(changes, "egressRules") {
+		toRevoke, toAuthorize := diffRules(state.Spec["egressRules"], r.Spec["egressRules"])
+
+		if len(toRevoke) > 0 {
+			if _, err := ec2Client.RevokeSecurityGroupEgress(ctx, &ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       aws.String(sgID),
+				IpPermissions: toRevoke,
+			}); err != nil {
+				return fmt.Errorf("falha ao revogar regras de egress: %w", err)
+			}
+		}
+
+		if len(toAuthorize) > 0 {
+			if _, err := ec2Client.AuthorizeSecurityGroupEgress(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       aws.String(sgID),
+				IpPermissions: toAuthorize,
+			}); err != nil {
+				return fmt.Errorf("falha ao autorizar regras de egress: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// updateEC2Instance altera o tipo da instância e os security groups.
+// A troca de instanceType exige parar a instância; ela é religada em seguida
+// se estava em execução.
+func (e *Engine) updateEC2Instance(ctx context.Context, ec2Client *ec2.Client, instanceID string, r Resource, changes []FieldChange) error {
+	if HasFieldChange(changes, "securityGroupIds") {
+		sgs := getStringSliceFromMap(r.Spec, "securityGroupIds")
+		if len(sgs) > 0 {
+			if _, err := ec2Client.ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(instanceID),
+				Groups:     sgs,
+			}); err != nil {
+				return fmt.Errorf("falha ao atualizar security groups: %w", err)
+			}
+		}
+	}
+
+	if !HasFieldChange(changes, "instanceType") {
+		return nil
+	}
+
+	instanceType, _ := r.Spec["instanceType"].(string)
+	if instanceType == "" {
+		instanceType = "t3.micro"
+	}
+
+	describe, err := ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	})
+	if err != nil {
+		return fmt.Errorf("falha ao descrever instância: %w", err)
+	}
+	if len(describe.Reservations) == 0 || len(describe.Reservations[0].Instances) == 0 {
+		return fmt.Errorf("instância %s não encontrada", instanceID)
+	}
+
+	wasRunning := describe.Reservations[0].Instances[0].State.Name == types.InstanceStateNameRunning
+	if wasRunning {
+		e.output.Write("  Parando instância %s para alterar o tipo...", instanceID)
+		if _, err := ec2Client.StopInstances(ctx, &ec2.StopInstancesInput{
+			InstanceIds: []string{instanceID},
+		}); err != nil {
+			return fmt.Errorf("falha ao parar instância: %w", err)
+		}
+
+		waiter := ec2.NewInstanceStoppedWaiter(ec2Client)
+		if err := waiter.Wait(ctx, &ec2.DescribeInstancesInput{
+			InstanceIds: []string{instanceID},
+		}, 10*time.Minute); err != nil {
+			return fmt.Errorf("falha ao aguardar instância parar: %w", err)
+		}
+	}
+
+	if _, err := ec2Client.ModifyInstanceAttribute(ctx, &ec2.ModifyInstanceAttributeInput{
+		InstanceId:   aws.String(instanceID),
+		InstanceType: &types.AttributeValue{Value: aws.String(instanceType)},
+	}); err != nil {
+		return fmt.Errorf("falha ao alterar tipo da instância: %w", err)
+	}
+
+	if wasRunning {
+		e.output.Write("  Religando instância %s...", instanceID)
+		if _, err := ec2Client.StartInstances(ctx, &ec2.StartInstancesInput{
+			InstanceIds: []string{instanceID},
+		}); err != nil {
+			return fmt.Errorf("falha ao religar instância: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// updateTags cria/atualiza as tags novas e remove as tags retiradas do manifesto
+func updateTags(ctx context.Context, ec2Client *ec2.Client, resourceID string, oldSpec, newSpec map[string]interface{}) error {
+	oldTags := getStringMapFromMap(oldSpec, "tags")
+	newTags := getStringMapFromMap(newSpec, "tags")
+
+	var toCreate []types.Tag
+	for k, v := range newTags {
+		if old, ok := oldTags[k]; !ok || old != v {
+			toCreate = append(toCreate, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+	}
+
+	var toDelete []types.Tag
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			toDelete = append(toDelete, types.Tag{Key: aws.String(k)})
+		}
+	}
+
+	if len(toCreate) > 0 {
+		if _, err := ec2Client.CreateTags(ctx, &ec2.CreateTagsInput{
+			Resources: []string{resourceID},
+			Tags:      toCreate,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(toDelete) > 0 {
+		if _, err := ec2Client.DeleteTags(ctx, &ec2.DeleteTagsInput{
+			Resources: []string{resourceID},
+			Tags:      toDelete,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffRules retorna as regras que existem apenas no estado (a revogar)
+// e as que existem apenas no manifesto (a autorizar)
+func diffRules(oldRules, newRules interface{}) ([]types.IpPermission, []types.IpPermission) {
+	oldSet := ruleSet(oldRules)
+	newSet := ruleSet(newRules)
+
+	var toRevoke, toAuthorize []types.IpPermission
+	for key, perm := range oldSet {
+		if _, ok := newSet[key]; !ok {
+			toRevoke = append(toRevoke, perm)
+		}
+	}
+	for key, perm := range newSet {
+		if _, ok := oldSet[key]; !ok {
+			toAuthorize = append(toAuthorize, perm)
+		}
+	}
+
+	return toRevoke, toAuthorize
+}
+
+// ruleSet indexa as regras pelo protocolo, portas e origem
+func ruleSet(rules interface{}) map[string]types.IpPermission {
+	set := make(map[string]types.IpPermission)
+
+	list, ok := rules.([]interface{})
+	if !ok {
+		return set
+	}
+
+	for _, rule := range list {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		perm := ipPermissionFromRule(ruleMap)
+		key := fmt.Sprintf("%s:%d-%d:%s",
+			aws.ToString(perm.IpProtocol),
+			aws.ToInt32(perm.FromPort),
+			aws.ToInt32(perm.ToPort),
+			getStringFromMap(ruleMap, "cidrIp", ""))
+		set[key] = perm
+	}
+
+	return set
+}
+
+// ipPermissionFromRule converte uma regra do manifesto em IpPermission
+func ipPermissionFromRule(ruleMap map[string]interface{}) types.IpPermission {
+	ipPermission := types.IpPermission{
+		IpProtocol: aws.String(getStringFromMap(ruleMap, "protocol", "tcp")),
+		FromPort:   aws.Int32(int32(getIntFromMap(ruleMap, "fromPort", 0))),
+		ToPort:     aws.Int32(int32(getIntFromMap(ruleMap, "toPort", 0))),
+	}
+	if cidr := getStringFromMap(ruleMap, "cidrIp", ""); cidr != "" {
+		ipPermission.IpRanges = []types.IpRange{{CidrIp: aws.String(cidr)}}
+	}
+	return ipPermission
+}
+
+func getStringMapFromMap(m map[string]interface{}, key string) map[string]string {
+	result := make(map[string]string)
+	values, ok := m[key].(map[string]interface{})
+	if !ok {
+		return result
+	}
+	for k, v := range values {
+		result[k] = fmt.Sprintf("%v", v)
+	}
+	return result
+}
+
+func getStringSliceFromMap(m map[string]interface{}, key string) []string {
+	var result []string
+	values, ok := m[key].([]interface{})
+	if !ok {
+		return result
+	}
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
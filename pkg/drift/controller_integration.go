@@ -5,6 +5,7 @@ package drift
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,18 +45,18 @@ func NewControllerHelper(
 }
 
 // CheckAndReconcileDrift performs drift detection and reconciliation for a resource.
-// Returns true if drift was detected, false otherwise.
+// Returns the detection result (nil when drift detection is disabled).
 func (h *ControllerHelper) CheckAndReconcileDrift(
 	ctx context.Context,
 	desired, actual interface{},
 	resourceType, resourceID string,
 	resource runtime.Object,
-) (bool, error) {
+) (*Result, error) {
 	logger := log.FromContext(ctx)
 
 	// Skip if drift detection is disabled
 	if !h.config.Enabled {
-		return false, nil
+		return nil, nil
 	}
 
 	// Detect drift
@@ -65,7 +66,7 @@ func (h *ControllerHelper) CheckAndReconcileDrift(
 			"resourceType", resourceType,
 			"resourceID", resourceID,
 		)
-		return false, err
+		return nil, err
 	}
 
 	// No drift detected
@@ -74,7 +75,7 @@ func (h *ControllerHelper) CheckAndReconcileDrift(
 			"resourceType", resourceType,
 			"resourceID", resourceID,
 		)
-		return false, nil
+		return result, nil
 	}
 
 	// Drift detected - log it
@@ -93,10 +94,10 @@ func (h *ControllerHelper) CheckAndReconcileDrift(
 			"resourceType", resourceType,
 			"resourceID", resourceID,
 		)
-		return true, err
+		return result, err
 	}
 
-	return true, nil
+	return result, nil
 }
 
 // GetDriftDetailsForStatus converts drift items to status-friendly format.
@@ -186,7 +187,7 @@ func limitedSprintf(v interface{}, max int) string {
 			result = "false"
 		}
 	default:
-		result = "<complex>"
+		result = fmt.Sprintf("%v", val)
 	}
 
 	if len(result) > max {
@@ -259,11 +260,41 @@ func ConfigFromProvider(provider *infrav1alpha1.AWSProvider) *Config {
 		Enabled:           driftCfg.Enabled,
 		CheckInterval:     checkInterval,
 		DefaultAction:     action,
-		IgnoreFields:      driftCfg.IgnoreFields,
+		IgnoreFields:      append(DefaultConfig().IgnoreFields, driftCfg.IgnoreFields...),
 		SeverityThreshold: severity,
 	}
 }
 
+// ApplyPolicy returns a copy of config with the per-resource DriftPolicy applied.
+// A policy with an action enables drift detection for the resource even when the
+// provider has it disabled; "ignore" disables it.
+func ApplyPolicy(config *Config, policy *infrav1alpha1.DriftPolicy) *Config {
+	cfg := *config
+	cfg.IgnoreFields = append([]string{}, config.IgnoreFields...)
+
+	if policy == nil {
+		return &cfg
+	}
+
+	switch ReconciliationAction(policy.Action) {
+	case ActionAutoHeal, ActionAlertOnly:
+		cfg.Enabled = true
+		cfg.DefaultAction = ReconciliationAction(policy.Action)
+	case ActionIgnore:
+		cfg.Enabled = false
+	}
+
+	if policy.CheckInterval != "" {
+		if parsed, err := time.ParseDuration(policy.CheckInterval); err == nil {
+			cfg.CheckInterval = parsed
+		}
+	}
+
+	cfg.IgnoreFields = append(cfg.IgnoreFields, policy.IgnoreFields...)
+
+	return &cfg
+}
+
 // ShouldCheckDrift determines if it's time to check for drift based on last check time.
 func ShouldCheckDrift(lastCheck *metav1.Time, interval time.Duration) bool {
 	if lastCheck == nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 )
 
@@ -170,7 +171,7 @@ func (d *detector) detectDriftRecursive(path string, desired, actual interface{}
 					Field:      fieldPath,
 					Desired:    desiredMapValue.Interface(),
 					Actual:     nil,
-					Severity:   d.inferSeverity(path),
+					Severity:   d.inferSeverity(fieldPath),
 					Message:    "Key missing in actual state",
 					DetectedAt: time.Now(),
 				})
@@ -182,7 +183,7 @@ func (d *detector) detectDriftRecursive(path string, desired, actual interface{}
 					Field:      fieldPath,
 					Desired:    desiredMapValue.Interface(),
 					Actual:     actualMapValue.Interface(),
-					Severity:   d.inferSeverity(path),
+					Severity:   d.inferSeverity(fieldPath),
 					Message:    "Map value differs",
 					DetectedAt: time.Now(),
 				})
@@ -198,7 +199,7 @@ func (d *detector) detectDriftRecursive(path string, desired, actual interface{}
 					Field:      fieldPath,
 					Desired:    nil,
 					Actual:     actualValue.MapIndex(key).Interface(),
-					Severity:   d.inferSeverity(path),
+					Severity:   d.inferSeverity(fieldPath),
 					Message:    "Extra key in actual state",
 					DetectedAt: time.Now(),
 				})
@@ -284,10 +285,11 @@ func (d *detector) buildFieldPath(parent, field string) string {
 // inferSeverity infers the severity level based on the field path.
 // This is a simple heuristic - in production, you might want to configure this.
 func (d *detector) inferSeverity(fieldPath string) Severity {
-	// High severity fields (security, networking, critical config)
-	highSeverityFields := []string{"securityGroup", "iamRole", "encryption", "public", "cidr"}
+	// High severity fields (security, networking, critical config).
+	// Matched case-insensitively so struct fields (PascalCase) are not missed.
+	highSeverityFields := []string{"security", "iamrole", "encryption", "public", "cidr"}
 	for _, hs := range highSeverityFields {
-		if contains(fieldPath, hs) {
+		if contains(strings.ToLower(fieldPath), hs) {
 			return SeverityHigh
 		}
 	}
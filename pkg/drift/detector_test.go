@@ -402,3 +402,39 @@ func containsSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+// TestDetector_StateScope verifies flat states only compare declared fields and tags.
+func TestDetector_StateScope(t *testing.T) {
+	config := DefaultConfig()
+	config.SeverityThreshold = SeverityLow
+	detector := NewDetector(config)
+
+	desired := State{}.
+		Set("cidrBlock", "10.0.0.0/16").
+		SetOptional("instanceTenancy", "").
+		SetList("subnets", []string{"subnet-b", "subnet-a"}).
+		SetTags(map[string]string{"env": "prod"})
+
+	actual := State{}.
+		Set("cidrBlock", "10.0.0.0/16").
+		Set("instanceTenancy", "default").
+		SetList("subnets", []string{"subnet-a", "subnet-b"}).
+		SetTags(map[string]string{"env": "prod", "owner": "console", "aws:createdBy": "x"})
+
+	result, err := detector.DetectDrift(context.Background(), desired, actual.Scope(desired), "VPC", "vpc-123")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(result.Drifts) != 1 {
+		t.Fatalf("Expected 1 drift, got %d: %v", len(result.Drifts), result.Drifts)
+	}
+
+	if result.Drifts[0].Field != "tags.owner" {
+		t.Errorf("Expected drift on tags.owner, got %s", result.Drifts[0].Field)
+	}
+
+	if result.Drifts[0].Severity != SeverityLow {
+		t.Errorf("Expected low severity for tag drift, got %s", result.Drifts[0].Severity)
+	}
+}
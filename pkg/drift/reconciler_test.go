@@ -8,6 +8,10 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
 )
 
 // mockEventRecorder implements a simple event recorder for testing.
@@ -15,16 +19,16 @@ type mockEventRecorder struct {
 	events []string
 }
 
-func (m *mockEventRecorder) Event(object interface{}, eventtype, reason, message string) {
+func (m *mockEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
 	m.events = append(m.events, eventtype+":"+reason+":"+message)
 }
 
-func (m *mockEventRecorder) Eventf(object interface{}, eventtype, reason, messageFmt string, args ...interface{}) {
+func (m *mockEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
 	// Simple implementation without formatting for tests
 	m.events = append(m.events, eventtype+":"+reason)
 }
 
-func (m *mockEventRecorder) AnnotatedEventf(object interface{}, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+func (m *mockEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
 	m.events = append(m.events, eventtype+":"+reason)
 }
 
@@ -82,7 +86,7 @@ func TestReconciler_AlertOnly(t *testing.T) {
 		ResourceID:   "vpc-123",
 	}
 
-	err := reconciler.ReconcileDrift(context.Background(), result, struct{}{})
+	err := reconciler.ReconcileDrift(context.Background(), result, &infrav1alpha1.VPC{})
 
 	if err != nil {
 		t.Errorf("Expected no error in alert-only mode, got: %v", err)
@@ -120,7 +124,7 @@ func TestReconciler_AutoHeal(t *testing.T) {
 
 	// Track if heal function was called
 	healCalled := false
-	healFunc := func(ctx context.Context, drifts []DriftItem, resource interface{}) error {
+	healFunc := func(ctx context.Context, drifts []DriftItem, resource runtime.Object) error {
 		healCalled = true
 		if len(drifts) == 0 {
 			t.Errorf("Expected drifts to be passed to heal function")
@@ -150,7 +154,7 @@ func TestReconciler_AutoHeal(t *testing.T) {
 		ResourceID:   "vpc-123",
 	}
 
-	err := reconciler.ReconcileDrift(context.Background(), result, struct{}{})
+	err := reconciler.ReconcileDrift(context.Background(), result, &infrav1alpha1.VPC{})
 
 	if err != nil {
 		t.Errorf("Expected no error in auto-heal mode, got: %v", err)
@@ -180,7 +184,7 @@ func TestReconciler_AutoHealError(t *testing.T) {
 	config.DefaultAction = ActionAutoHeal
 	recorder := &mockEventRecorder{}
 
-	healFunc := func(ctx context.Context, drifts []DriftItem, resource interface{}) error {
+	healFunc := func(ctx context.Context, drifts []DriftItem, resource runtime.Object) error {
 		return errors.New("heal failed")
 	}
 
@@ -199,7 +203,7 @@ func TestReconciler_AutoHealError(t *testing.T) {
 		ResourceID:   "vpc-123",
 	}
 
-	err := reconciler.ReconcileDrift(context.Background(), result, struct{}{})
+	err := reconciler.ReconcileDrift(context.Background(), result, &infrav1alpha1.VPC{})
 
 	if err == nil {
 		t.Errorf("Expected error when heal function fails")
@@ -226,7 +230,7 @@ func TestReconciler_NoHealFunction(t *testing.T) {
 		ResourceID:   "vpc-123",
 	}
 
-	err := reconciler.ReconcileDrift(context.Background(), result, struct{}{})
+	err := reconciler.ReconcileDrift(context.Background(), result, &infrav1alpha1.VPC{})
 
 	if err == nil {
 		t.Errorf("Expected error when heal function is not provided")
@@ -244,7 +248,7 @@ func TestReconciler_SeverityFiltering(t *testing.T) {
 	}
 
 	healCalled := false
-	healFunc := func(ctx context.Context, drifts []DriftItem, resource interface{}) error {
+	healFunc := func(ctx context.Context, drifts []DriftItem, resource runtime.Object) error {
 		healCalled = true
 		// Should only receive high severity drifts
 		for _, d := range drifts {
@@ -269,7 +273,7 @@ func TestReconciler_SeverityFiltering(t *testing.T) {
 		ResourceID:   "vpc-123",
 	}
 
-	err := reconciler.ReconcileDrift(context.Background(), result, struct{}{})
+	err := reconciler.ReconcileDrift(context.Background(), result, &infrav1alpha1.VPC{})
 
 	if err != nil {
 		t.Errorf("Expected no error, got: %v", err)
@@ -385,7 +389,7 @@ func TestHealerRegistry(t *testing.T) {
 	registry := NewHealerRegistry()
 
 	// Register a healer
-	vpcHealFunc := func(ctx context.Context, drifts []DriftItem, resource interface{}) error {
+	vpcHealFunc := func(ctx context.Context, drifts []DriftItem, resource runtime.Object) error {
 		return nil
 	}
 	registry.Register("VPC", vpcHealFunc)
@@ -412,7 +416,7 @@ func TestHealerRegistry_CreateReconciler(t *testing.T) {
 	config := DefaultConfig()
 
 	// Register a healer
-	healFunc := func(ctx context.Context, drifts []DriftItem, resource interface{}) error {
+	healFunc := func(ctx context.Context, drifts []DriftItem, resource runtime.Object) error {
 		return nil
 	}
 	registry.Register("VPC", healFunc)
@@ -444,3 +448,36 @@ func containsSubstr(s, substr string) bool {
 	}
 	return false
 }
+
+// TestApplyPolicy verifies per-resource policies override the provider config.
+func TestApplyPolicy(t *testing.T) {
+	base := DefaultConfig()
+	base.Enabled = false
+
+	cfg := ApplyPolicy(base, &infrav1alpha1.DriftPolicy{
+		Action:        "alert-only",
+		IgnoreFields:  []string{"tags.team"},
+		CheckInterval: "1m",
+	})
+
+	if !cfg.Enabled {
+		t.Errorf("Expected policy action to enable drift detection")
+	}
+	if cfg.DefaultAction != ActionAlertOnly {
+		t.Errorf("Expected alert-only action, got %s", cfg.DefaultAction)
+	}
+	if cfg.CheckInterval != time.Minute {
+		t.Errorf("Expected 1m check interval, got %s", cfg.CheckInterval)
+	}
+	if !cfg.ShouldIgnoreField("tags.team") {
+		t.Errorf("Expected tags.team to be ignored")
+	}
+	if base.ShouldIgnoreField("tags.team") {
+		t.Errorf("Expected base config to be left untouched")
+	}
+
+	ignored := ApplyPolicy(DefaultConfig(), &infrav1alpha1.DriftPolicy{Action: "ignore"})
+	if ignored.Enabled {
+		t.Errorf("Expected ignore action to disable drift detection")
+	}
+}
@@ -7,6 +7,9 @@ package drift
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 )
 
@@ -189,3 +192,53 @@ func (r *Result) String() string {
 		len(r.Drifts), r.ResourceType, r.ResourceID,
 		r.HighSeverityCount(), r.MediumSeverityCount(), r.LowSeverityCount())
 }
+
+// State is a flat view of the comparable fields of a resource, keyed by field path
+// (e.g. "cidrBlock", "tags.Environment"). Desired and actual states built by the same
+// projection can be compared with Detector.DetectDrift.
+type State map[string]interface{}
+
+// Set records a field that is always compared.
+func (s State) Set(field string, value interface{}) State {
+	s[field] = value
+	return s
+}
+
+// SetOptional records a field only when it is not the zero value, so optional
+// spec fields left empty are not compared against the AWS defaults.
+func (s State) SetOptional(field string, value interface{}) State {
+	if value == nil || reflect.ValueOf(value).IsZero() {
+		return s
+	}
+	s[field] = value
+	return s
+}
+
+// SetList records an unordered list as a sorted copy so ordering differences
+// returned by AWS are not reported as drift.
+func (s State) SetList(field string, values []string) State {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	s[field] = sorted
+	return s
+}
+
+// SetTags records each tag as "tags.<key>".
+func (s State) SetTags(tags map[string]string) State {
+	for k, v := range tags {
+		s["tags."+k] = v
+	}
+	return s
+}
+
+// Scope returns the fields of s that are also present in desired. Tags are always
+// kept so tags added outside the operator are reported as drift.
+func (s State) Scope(desired State) State {
+	scoped := State{}
+	for field, value := range s {
+		if _, ok := desired[field]; ok || strings.HasPrefix(field, "tags.") {
+			scoped[field] = value
+		}
+	}
+	return scoped
+}
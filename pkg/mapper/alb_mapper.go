@@ -49,3 +49,205 @@ func DomainToStatusALB(lb *alb.LoadBalancer, cr *infrav1alpha1.ALB) {
 	now := metav1.NewTime(time.Now())
 	cr.Status.LastSyncTime = &now
 }
+
+// CRToDomainTargetGroup converts TargetGroup CR to domain model. Targets given
+// by reference are left without an ID for the controller to resolve.
+func CRToDomainTargetGroup(cr *infrav1alpha1.TargetGroup) *alb.TargetGroup {
+	tg := &alb.TargetGroup{
+		Name:                cr.Spec.TargetGroupName,
+		ARN:                 cr.Status.TargetGroupARN,
+		Protocol:            cr.Spec.Protocol,
+		ProtocolVersion:     cr.Spec.ProtocolVersion,
+		Port:                cr.Spec.Port,
+		TargetType:          cr.Spec.TargetType,
+		VpcID:               cr.Spec.VpcID,
+		DeregistrationDelay: cr.Spec.DeregistrationDelaySeconds,
+		Tags:                cr.Spec.Tags,
+		DeletionPolicy:      cr.Spec.DeletionPolicy,
+	}
+
+	if hc := cr.Spec.HealthCheck; hc != nil {
+		tg.HealthCheck = &alb.HealthCheck{
+			Protocol:           hc.Protocol,
+			Port:               hc.Port,
+			Path:               hc.Path,
+			Matcher:            hc.Matcher,
+			IntervalSeconds:    hc.IntervalSeconds,
+			TimeoutSeconds:     hc.TimeoutSeconds,
+			HealthyThreshold:   hc.HealthyThresholdCount,
+			UnhealthyThreshold: hc.UnhealthyThresholdCount,
+		}
+	}
+
+	for _, target := range cr.Spec.Targets {
+		id := target.InstanceID
+		if target.IP != "" {
+			id = target.IP
+		}
+		tg.Targets = append(tg.Targets, alb.Target{
+			ID:               id,
+			Port:             target.Port,
+			AvailabilityZone: target.AvailabilityZone,
+		})
+	}
+
+	return tg
+}
+
+// DomainToStatusTargetGroup updates CR status from domain model
+func DomainToStatusTargetGroup(tg *alb.TargetGroup, cr *infrav1alpha1.TargetGroup) {
+	cr.Status.Ready = tg.ARN != ""
+	cr.Status.TargetGroupARN = tg.ARN
+	cr.Status.LoadBalancerARNs = tg.LoadBalancerARNs
+	cr.Status.HealthyTargets = int32(tg.HealthyTargets())
+
+	cr.Status.Targets = nil
+	for _, health := range tg.TargetHealth {
+		cr.Status.Targets = append(cr.Status.Targets, infrav1alpha1.TargetHealthStatus{
+			ID:     health.ID,
+			Port:   health.Port,
+			State:  health.State,
+			Reason: health.Reason,
+		})
+	}
+
+	if tg.LastSyncTime != nil {
+		t := metav1.NewTime(*tg.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
+}
+
+// CRToDomainListener converts Listener CR to domain model. Load balancers,
+// certificates and target groups given by reference are left empty for the
+// controller to resolve.
+func CRToDomainListener(cr *infrav1alpha1.Listener) *alb.Listener {
+	listener := &alb.Listener{
+		LoadBalancerARN: cr.Spec.LoadBalancerARN,
+		ARN:             cr.Status.ListenerARN,
+		Protocol:        cr.Spec.Protocol,
+		Port:            cr.Spec.Port,
+		SslPolicy:       cr.Spec.SslPolicy,
+		CertificateARNs: cr.Spec.CertificateARNs,
+		DefaultActions:  crToDomainListenerActions(cr.Spec.DefaultActions),
+		Tags:            cr.Spec.Tags,
+		DeletionPolicy:  cr.Spec.DeletionPolicy,
+	}
+
+	for _, rule := range cr.Spec.Rules {
+		listener.Rules = append(listener.Rules, alb.ListenerRule{
+			Priority: rule.Priority,
+			Conditions: alb.RuleConditions{
+				HostHeaders:        rule.Conditions.HostHeaders,
+				PathPatterns:       rule.Conditions.PathPatterns,
+				HTTPRequestMethods: rule.Conditions.HTTPRequestMethods,
+				SourceIPs:          rule.Conditions.SourceIPs,
+			},
+			Actions: crToDomainListenerActions(rule.Actions),
+		})
+	}
+
+	return listener
+}
+
+func crToDomainListenerActions(actions []infrav1alpha1.ListenerAction) []alb.Action {
+	result := make([]alb.Action, 0, len(actions))
+	for _, action := range actions {
+		a := alb.Action{Type: action.Type}
+		for _, tg := range action.TargetGroups {
+			a.TargetGroups = append(a.TargetGroups, alb.WeightedTargetGroup{
+				ARN:    tg.TargetGroupARN,
+				Weight: tg.Weight,
+			})
+		}
+		if r := action.Redirect; r != nil {
+			a.Redirect = &alb.RedirectAction{
+				Protocol:   r.Protocol,
+				Host:       r.Host,
+				Port:       r.Port,
+				Path:       r.Path,
+				Query:      r.Query,
+				StatusCode: r.StatusCode,
+			}
+		}
+		if f := action.FixedResponse; f != nil {
+			a.FixedResponse = &alb.FixedResponseAction{
+				StatusCode:  f.StatusCode,
+				ContentType: f.ContentType,
+				MessageBody: f.MessageBody,
+			}
+		}
+		result = append(result, a)
+	}
+	return result
+}
+
+// DomainToStatusListener updates CR status from domain model
+func DomainToStatusListener(listener *alb.Listener, cr *infrav1alpha1.Listener) {
+	cr.Status.Ready = listener.ARN != ""
+	cr.Status.ListenerARN = listener.ARN
+	cr.Status.LoadBalancerARN = listener.LoadBalancerARN
+
+	cr.Status.Rules = nil
+	for _, rule := range listener.Rules {
+		cr.Status.Rules = append(cr.Status.Rules, infrav1alpha1.ListenerRuleStatus{
+			Priority: rule.Priority,
+			RuleARN:  rule.ARN,
+		})
+	}
+
+	if listener.LastSyncTime != nil {
+		t := metav1.NewTime(*listener.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
+}
+
+// CRToDomainTargetGroupBinding converts TargetGroupBinding CR to domain model.
+// The target group resolved on a previous sync is kept for deletion.
+func CRToDomainTargetGroupBinding(cr *infrav1alpha1.TargetGroupBinding) *alb.TargetGroupBinding {
+	binding := &alb.TargetGroupBinding{
+		TargetGroupARN: cr.Spec.TargetGroupARN,
+		TargetType:     cr.Spec.TargetType,
+	}
+	for _, target := range cr.Status.ManagedTargets {
+		binding.ManagedTargets = append(binding.ManagedTargets, alb.Target{ID: target.ID, Port: target.Port})
+	}
+	if binding.TargetGroupARN == "" {
+		binding.TargetGroupARN = cr.Status.TargetGroupARN
+	}
+	if binding.TargetType == "" {
+		binding.TargetType = cr.Status.TargetType
+	}
+	return binding
+}
+
+// DomainToStatusTargetGroupBinding updates CR status from domain model
+func DomainToStatusTargetGroupBinding(binding *alb.TargetGroupBinding, cr *infrav1alpha1.TargetGroupBinding) {
+	cr.Status.Ready = true
+	cr.Status.TargetGroupARN = binding.TargetGroupARN
+	cr.Status.TargetType = binding.TargetType
+	cr.Status.RegisteredTargets = int32(len(binding.Targets))
+	cr.Status.HealthyTargets = int32(binding.HealthyTargets())
+
+	cr.Status.ManagedTargets = nil
+	for _, target := range binding.ManagedTargets {
+		cr.Status.ManagedTargets = append(cr.Status.ManagedTargets, infrav1alpha1.TargetGroupBindingTarget{
+			ID:   target.ID,
+			Port: target.Port,
+		})
+	}
+
+	cr.Status.Targets = nil
+	for _, health := range binding.TargetHealth {
+		cr.Status.Targets = append(cr.Status.Targets, infrav1alpha1.TargetHealthStatus{
+			ID:     health.ID,
+			Port:   health.Port,
+			State:  health.State,
+			Reason: health.Reason,
+		})
+	}
+
+	if binding.LastSyncTime != nil {
+		t := metav1.NewTime(*binding.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
+}
@@ -0,0 +1,110 @@
+package mapper
+
+import (
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/autoscaling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CRToDomainAutoScalingGroup converts AutoScalingGroup CR to domain model.
+// Subnets, target groups and launch templates given by reference are left
+// empty for the controller to resolve.
+func CRToDomainAutoScalingGroup(cr *infrav1alpha1.AutoScalingGroup) *autoscaling.Group {
+	group := &autoscaling.Group{
+		Name:            cr.Spec.AutoScalingGroupName,
+		ARN:             cr.Status.AutoScalingGroupARN,
+		MinSize:         cr.Spec.MinSize,
+		MaxSize:         cr.Spec.MaxSize,
+		DesiredCapacity: cr.Spec.DesiredCapacity,
+		SubnetIDs:       cr.Spec.SubnetIDs,
+		LaunchTemplate: autoscaling.LaunchTemplateRef{
+			ID:      cr.Spec.LaunchTemplate.LaunchTemplateID,
+			Version: cr.Spec.LaunchTemplate.Version,
+		},
+		CapacityRebalance:      cr.Spec.CapacityRebalance,
+		HealthCheckType:        cr.Spec.HealthCheckType,
+		HealthCheckGracePeriod: cr.Spec.HealthCheckGracePeriodSeconds,
+		TargetGroupARNs:        cr.Spec.TargetGroupARNs,
+		Tags:                   cr.Spec.Tags,
+		DeletionPolicy:         cr.Spec.DeletionPolicy,
+		RefreshedTemplate:      cr.Status.RefreshedLaunchTemplate,
+	}
+
+	if p := cr.Spec.MixedInstancesPolicy; p != nil {
+		policy := &autoscaling.MixedInstancesPolicy{
+			OnDemandBaseCapacity:                p.OnDemandBaseCapacity,
+			OnDemandPercentageAboveBaseCapacity: p.OnDemandPercentageAboveBaseCapacity,
+			OnDemandAllocationStrategy:          p.OnDemandAllocationStrategy,
+			SpotAllocationStrategy:              p.SpotAllocationStrategy,
+			SpotMaxPrice:                        p.SpotMaxPrice,
+		}
+		for _, it := range p.InstanceTypes {
+			policy.Overrides = append(policy.Overrides, autoscaling.InstanceTypeOverride{
+				InstanceType:     it.InstanceType,
+				WeightedCapacity: it.WeightedCapacity,
+			})
+		}
+		group.MixedInstancesPolicy = policy
+	}
+
+	for _, hook := range cr.Spec.LifecycleHooks {
+		group.LifecycleHooks = append(group.LifecycleHooks, autoscaling.LifecycleHook{
+			Name:                  hook.Name,
+			Transition:            hook.LifecycleTransition,
+			HeartbeatTimeout:      hook.HeartbeatTimeoutSeconds,
+			DefaultResult:         hook.DefaultResult,
+			NotificationTargetARN: hook.NotificationTargetARN,
+			RoleARN:               hook.RoleARN,
+			NotificationMetadata:  hook.NotificationMetadata,
+		})
+	}
+
+	if r := cr.Spec.InstanceRefresh; r != nil {
+		group.InstanceRefresh = &autoscaling.RefreshPreferences{
+			MinHealthyPercentage: r.MinHealthyPercentage,
+			InstanceWarmup:       r.InstanceWarmupSeconds,
+			SkipMatching:         r.SkipMatching == nil || *r.SkipMatching,
+		}
+	}
+
+	return group
+}
+
+// DomainToStatusAutoScalingGroup updates CR status from domain model
+func DomainToStatusAutoScalingGroup(group *autoscaling.Group, cr *infrav1alpha1.AutoScalingGroup) {
+	inService := group.InServiceInstances()
+
+	cr.Status.Ready = group.ARN != "" && !group.Deleting() && inService >= group.CurrentCapacity
+	cr.Status.AutoScalingGroupARN = group.ARN
+	cr.Status.LaunchTemplateID = group.LaunchTemplate.ID
+	cr.Status.LaunchTemplateVersion = group.LaunchTemplate.Version
+	cr.Status.DesiredCapacity = group.CurrentCapacity
+	cr.Status.Instances = int32(len(group.Instances))
+	cr.Status.InServiceInstances = inService
+	cr.Status.RefreshedLaunchTemplate = group.RefreshedTemplate
+
+	cr.Status.InstanceRefresh = nil
+	if r := group.LatestRefresh; r != nil {
+		status := &infrav1alpha1.AutoScalingInstanceRefreshStatus{
+			ID:                 r.ID,
+			Status:             r.Status,
+			StatusReason:       r.StatusReason,
+			PercentageComplete: r.PercentageComplete,
+			InstancesToUpdate:  r.InstancesToUpdate,
+		}
+		if r.StartTime != nil {
+			t := metav1.NewTime(*r.StartTime)
+			status.StartTime = &t
+		}
+		if r.EndTime != nil {
+			t := metav1.NewTime(*r.EndTime)
+			status.EndTime = &t
+		}
+		cr.Status.InstanceRefresh = status
+	}
+
+	if group.LastSyncTime != nil {
+		t := metav1.NewTime(*group.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
+}
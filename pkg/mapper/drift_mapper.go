@@ -0,0 +1,423 @@
+package mapper
+
+import (
+	"fmt"
+
+	"infra-operator/internal/domain/acm"
+	"infra-operator/internal/domain/alb"
+	"infra-operator/internal/domain/apigateway"
+	"infra-operator/internal/domain/cloudfront"
+	"infra-operator/internal/domain/dynamodb"
+	"infra-operator/internal/domain/ec2"
+	"infra-operator/internal/domain/ecr"
+	"infra-operator/internal/domain/ecs"
+	"infra-operator/internal/domain/eks"
+	"infra-operator/internal/domain/elasticache"
+	"infra-operator/internal/domain/elasticip"
+	"infra-operator/internal/domain/iam"
+	"infra-operator/internal/domain/internetgateway"
+	"infra-operator/internal/domain/kms"
+	"infra-operator/internal/domain/lambda"
+	"infra-operator/internal/domain/natgateway"
+	"infra-operator/internal/domain/nlb"
+	"infra-operator/internal/domain/rds"
+	"infra-operator/internal/domain/routetable"
+	"infra-operator/internal/domain/s3"
+	"infra-operator/internal/domain/secretsmanager"
+	"infra-operator/internal/domain/securitygroup"
+	"infra-operator/internal/domain/sns"
+	"infra-operator/internal/domain/sqs"
+	"infra-operator/internal/domain/subnet"
+	"infra-operator/internal/domain/vpc"
+	"infra-operator/pkg/drift"
+)
+
+// Drift state projections.
+//
+// Each function flattens the fields of a domain object that the repository Get
+// returns, so the desired object (from the CR) and the actual object (from AWS)
+// go through the same projection before being compared by the drift detector.
+// Fields that AWS does not return, or returns in a different shape (e.g. ARN vs
+// name), are intentionally left out to avoid false positives.
+
+// VPCDriftState projects a VPC for drift detection
+func VPCDriftState(v *vpc.VPC) drift.State {
+	return drift.State{}.
+		Set("cidrBlock", v.CidrBlock).
+		SetOptional("instanceTenancy", v.InstanceTenancy).
+		SetTags(v.Tags)
+}
+
+// SubnetDriftState projects a Subnet for drift detection
+func SubnetDriftState(s *subnet.Subnet) drift.State {
+	return drift.State{}.
+		Set("vpcId", s.VpcID).
+		Set("cidrBlock", s.CidrBlock).
+		SetOptional("availabilityZone", s.AvailabilityZone).
+		Set("mapPublicIpOnLaunch", s.MapPublicIpOnLaunch).
+		SetTags(s.Tags)
+}
+
+// InternetGatewayDriftState projects an Internet Gateway for drift detection
+func InternetGatewayDriftState(g *internetgateway.Gateway) drift.State {
+	return drift.State{}.
+		SetOptional("vpcId", g.VpcID).
+		SetTags(g.Tags)
+}
+
+// NATGatewayDriftState projects a NAT Gateway for drift detection
+func NATGatewayDriftState(g *natgateway.Gateway) drift.State {
+	return drift.State{}.
+		Set("subnetId", g.SubnetID).
+		SetTags(g.Tags)
+}
+
+// RouteTableDriftState projects a Route Table for drift detection.
+// The implicit "local" route created by AWS is not compared.
+func RouteTableDriftState(rt *routetable.RouteTable) drift.State {
+	routes := []string{}
+	for _, r := range rt.Routes {
+		if r.GatewayID == "local" {
+			continue
+		}
+		routes = append(routes, fmt.Sprintf("%s->%s", r.DestinationCidrBlock, routeTarget(r)))
+	}
+
+	return drift.State{}.
+		Set("vpcId", rt.VpcID).
+		SetList("routes", routes).
+		SetList("subnetAssociations", rt.SubnetAssociations).
+		SetTags(rt.Tags)
+}
+
+func routeTarget(r routetable.Route) string {
+	for _, target := range []string{r.GatewayID, r.NatGatewayID, r.InstanceID, r.VpcPeeringConnectionID, r.NetworkInterfaceID} {
+		if target != "" {
+			return target
+		}
+	}
+	return ""
+}
+
+// SecurityGroupDriftState projects a Security Group for drift detection.
+// Egress rules are only compared when declared, since AWS adds a default
+// allow-all egress rule to every new group.
+func SecurityGroupDriftState(sg *securitygroup.SecurityGroup) drift.State {
+	state := drift.State{}.
+		Set("vpcId", sg.VpcID).
+		Set("groupName", sg.GroupName).
+		SetList("ingressRules", flattenSecurityGroupRules(sg.IngressRules)).
+		SetTags(sg.Tags)
+
+	if len(sg.EgressRules) > 0 {
+		state.SetList("egressRules", flattenSecurityGroupRules(sg.EgressRules))
+	}
+
+	return state
+}
+
+// flattenSecurityGroupRules expands rules into one "protocol:ports:source" entry
+// per source, matching how AWS returns IP permissions.
+func flattenSecurityGroupRules(rules []securitygroup.Rule) []string {
+	flat := []string{}
+	for _, rule := range securitygroup.NormalizeRules(rules) {
+		flat = append(flat, rule.Key())
+	}
+	return flat
+}
+
+// ElasticIPDriftState projects an Elastic IP for drift detection
+func ElasticIPDriftState(addr *elasticip.Address) drift.State {
+	return drift.State{}.
+		SetOptional("domain", addr.Domain).
+		SetTags(addr.Tags)
+}
+
+// EC2InstanceDriftState projects an EC2 instance for drift detection
+func EC2InstanceDriftState(instance *ec2.Instance) drift.State {
+	state := drift.State{}.
+		Set("instanceType", instance.InstanceType).
+		Set("imageId", instance.ImageID).
+		SetOptional("keyName", instance.KeyName).
+		SetOptional("subnetId", instance.SubnetID).
+		SetOptional("instanceName", instance.InstanceName).
+		Set("monitoring", instance.Monitoring).
+		Set("disableApiTermination", instance.DisableAPITermination).
+		SetTags(instance.Tags)
+
+	if len(instance.SecurityGroupIDs) > 0 {
+		state.SetList("securityGroupIds", instance.SecurityGroupIDs)
+	}
+
+	return state
+}
+
+// S3BucketDriftState projects an S3 bucket for drift detection
+func S3BucketDriftState(bucket *s3.Bucket) drift.State {
+	state := drift.State{}.SetTags(bucket.Tags)
+
+	if bucket.Versioning != nil {
+		state.Set("versioning.enabled", bucket.Versioning.Enabled)
+	}
+	if bucket.Encryption != nil {
+		state.Set("encryption.algorithm", bucket.Encryption.Algorithm)
+	}
+
+	return state
+}
+
+// DynamoDBTableDriftState projects a DynamoDB table for drift detection
+func DynamoDBTableDriftState(table *dynamodb.Table) drift.State {
+	state := drift.State{}.
+		Set("hashKey", table.HashKey.Name+":"+table.HashKey.Type).
+		SetOptional("billingMode", table.BillingMode)
+
+	if table.RangeKey != nil {
+		state.Set("rangeKey", table.RangeKey.Name+":"+table.RangeKey.Type)
+	}
+
+	return state
+}
+
+// SQSQueueDriftState projects an SQS queue for drift detection
+func SQSQueueDriftState(queue *sqs.Queue) drift.State {
+	state := drift.State{}.
+		Set("fifoQueue", queue.FifoQueue).
+		SetOptional("contentBasedDeduplication", queue.ContentBasedDeduplication).
+		SetOptional("delaySeconds", queue.DelaySeconds).
+		SetOptional("maximumMessageSize", queue.MaximumMessageSize).
+		SetOptional("messageRetentionPeriod", queue.MessageRetentionPeriod).
+		SetOptional("visibilityTimeout", queue.VisibilityTimeout).
+		SetOptional("receiveMessageWaitTimeSeconds", queue.ReceiveMessageWaitTimeSeconds).
+		SetOptional("kmsMasterKeyId", queue.KMSMasterKeyID)
+
+	if queue.DeadLetterQueue != nil {
+		state.Set("deadLetterQueue.targetArn", queue.DeadLetterQueue.TargetArn)
+		state.Set("deadLetterQueue.maxReceiveCount", queue.DeadLetterQueue.MaxReceiveCount)
+	}
+
+	return state
+}
+
+// SNSTopicDriftState projects an SNS topic for drift detection
+func SNSTopicDriftState(topic *sns.Topic) drift.State {
+	return drift.State{}.
+		Set("fifoTopic", topic.FifoTopic).
+		SetOptional("displayName", topic.DisplayName).
+		SetOptional("contentBasedDeduplication", topic.ContentBasedDeduplication).
+		SetOptional("kmsMasterKeyId", topic.KMSMasterKeyID)
+}
+
+// LambdaFunctionDriftState projects a Lambda function for drift detection
+func LambdaFunctionDriftState(function *lambda.Function) drift.State {
+	state := drift.State{}.
+		SetOptional("runtime", function.Runtime).
+		SetOptional("handler", function.Handler).
+		Set("role", function.Role).
+		SetOptional("description", function.Description).
+		SetOptional("timeout", function.Timeout).
+		SetOptional("memorySize", function.MemorySize).
+		SetTags(function.Tags)
+
+	for k, v := range function.Environment {
+		state.Set("environment."+k, v)
+	}
+	if len(function.Layers) > 0 {
+		state.SetList("layers", function.Layers)
+	}
+	if function.VpcConfig != nil {
+		state.SetList("vpcConfig.subnetIds", function.VpcConfig.SubnetIds)
+		state.SetList("vpcConfig.securityGroupIds", function.VpcConfig.SecurityGroupIds)
+	}
+
+	return state
+}
+
+// RDSInstanceDriftState projects an RDS instance for drift detection
+func RDSInstanceDriftState(instance *rds.DBInstance) drift.State {
+	return drift.State{}.
+		Set("engine", instance.Engine).
+		Set("dbInstanceClass", instance.DBInstanceClass).
+		SetOptional("allocatedStorage", instance.AllocatedStorage).
+		SetOptional("port", instance.Port).
+		Set("multiAZ", instance.MultiAZ).
+		Set("publiclyAccessible", instance.PubliclyAccessible).
+		Set("storageEncrypted", instance.StorageEncrypted).
+		SetOptional("backupRetentionPeriod", instance.BackupRetentionPeriod).
+		SetOptional("storageType", instance.StorageType).
+		SetOptional("iops", instance.Iops).
+		Set("deletionProtection", instance.DeletionProtection).
+		SetOptional("dbParameterGroupName", instance.DBParameterGroupName).
+		SetOptional("dbSubnetGroupName", instance.DBSubnetGroupName)
+}
+
+// RDSClusterDriftState projects an Aurora DB cluster for drift detection. The
+// instances are reconciled from the writer and reader sets on every sync
+func RDSClusterDriftState(cluster *rds.DBCluster) drift.State {
+	return drift.State{}.
+		Set("engine", cluster.Engine).
+		SetOptional("port", cluster.Port).
+		Set("storageEncrypted", cluster.StorageEncrypted).
+		SetOptional("backupRetentionPeriod", cluster.BackupRetentionPeriod).
+		Set("deletionProtection", cluster.DeletionProtection).
+		SetOptional("dbClusterParameterGroupName", cluster.DBClusterParameterGroupName).
+		SetOptional("dbSubnetGroupName", cluster.DBSubnetGroupName)
+}
+
+// DBSubnetGroupDriftState projects a DB subnet group for drift detection
+func DBSubnetGroupDriftState(group *rds.DBSubnetGroup) drift.State {
+	return drift.State{}.
+		SetOptional("description", group.Description).
+		SetList("subnetIds", group.SubnetIDs)
+}
+
+// DBParameterGroupDriftState projects a DB parameter group for drift detection.
+// Only the parameters set on the group are compared; engine defaults are not
+func DBParameterGroupDriftState(group *rds.DBParameterGroup) drift.State {
+	state := drift.State{}.
+		Set("family", group.Family)
+	for name, value := range group.Parameters {
+		state.Set("parameters."+name, value)
+	}
+	return state
+}
+
+// ECRRepositoryDriftState projects an ECR repository for drift detection
+func ECRRepositoryDriftState(repository *ecr.Repository) drift.State {
+	return drift.State{}.
+		SetOptional("imageTagMutability", repository.ImageTagMutability).
+		Set("scanOnPush", repository.ScanOnPush).
+		SetOptional("encryptionType", repository.EncryptionType)
+}
+
+// IAMRoleDriftState projects an IAM role for drift detection
+func IAMRoleDriftState(role *iam.Role) drift.State {
+	return drift.State{}.
+		SetOptional("description", role.Description).
+		SetOptional("maxSessionDuration", role.MaxSessionDuration).
+		SetOptional("path", role.Path).
+		SetOptional("permissionsBoundary", role.PermissionsBoundary)
+}
+
+// SecretsManagerSecretDriftState projects a secret for drift detection.
+// The secret value itself is never compared.
+func SecretsManagerSecretDriftState(secret *secretsmanager.Secret) drift.State {
+	return drift.State{}.
+		SetOptional("description", secret.Description).
+		Set("rotationEnabled", secret.RotationEnabled)
+}
+
+// KMSKeyDriftState projects a KMS key for drift detection
+func KMSKeyDriftState(key *kms.Key) drift.State {
+	return drift.State{}.
+		SetOptional("description", key.Description).
+		SetOptional("keyUsage", key.KeyUsage).
+		SetOptional("keySpec", key.KeySpec).
+		Set("enableKeyRotation", key.EnableKeyRotation)
+}
+
+// ElastiCacheClusterDriftState projects an ElastiCache cluster for drift detection.
+// Replication groups and single cache clusters expose different fields.
+func ElastiCacheClusterDriftState(cluster *elasticache.Cluster) drift.State {
+	if cluster.IsReplicationGroup() {
+		return drift.State{}.
+			SetOptional("replicationGroupDescription", cluster.ReplicationGroupDescription).
+			Set("automaticFailoverEnabled", cluster.AutomaticFailoverEnabled).
+			Set("multiAZEnabled", cluster.MultiAZEnabled)
+	}
+
+	return drift.State{}.
+		Set("engine", cluster.Engine).
+		SetOptional("nodeType", cluster.NodeType).
+		SetOptional("numCacheNodes", cluster.NumCacheNodes)
+}
+
+// ALBDriftState projects an Application Load Balancer for drift detection
+func ALBDriftState(lb *alb.LoadBalancer) drift.State {
+	state := drift.State{}.
+		SetOptional("scheme", lb.Scheme).
+		SetOptional("ipAddressType", lb.IPAddressType).
+		SetList("subnets", lb.Subnets).
+		Set("enableDeletionProtection", lb.EnableDeletionProtection).
+		Set("enableHttp2", lb.EnableHttp2).
+		SetOptional("idleTimeout", lb.IdleTimeout)
+
+	if len(lb.SecurityGroups) > 0 {
+		state.SetList("securityGroups", lb.SecurityGroups)
+	}
+
+	return state
+}
+
+// NLBDriftState projects a Network Load Balancer for drift detection
+func NLBDriftState(lb *nlb.LoadBalancer) drift.State {
+	return drift.State{}.
+		SetOptional("scheme", lb.Scheme).
+		SetOptional("ipAddressType", lb.IPAddressType).
+		SetList("subnets", lb.Subnets)
+}
+
+// ECSClusterDriftState projects an ECS cluster for drift detection
+func ECSClusterDriftState(cluster *ecs.Cluster) drift.State {
+	state := drift.State{}.SetTags(cluster.Tags)
+
+	if len(cluster.CapacityProviders) > 0 {
+		state.SetList("capacityProviders", cluster.CapacityProviders)
+	}
+	for _, setting := range cluster.Settings {
+		state.Set("settings."+setting.Name, setting.Value)
+	}
+
+	return state
+}
+
+// EKSClusterDriftState projects an EKS cluster for drift detection
+func EKSClusterDriftState(cluster *eks.Cluster) drift.State {
+	state := drift.State{}.
+		SetOptional("version", cluster.Version).
+		Set("roleArn", cluster.RoleARN).
+		SetList("vpcConfig.subnetIds", cluster.VpcConfig.SubnetIDs).
+		Set("vpcConfig.endpointPublicAccess", cluster.VpcConfig.EndpointPublicAccess).
+		Set("vpcConfig.endpointPrivateAccess", cluster.VpcConfig.EndpointPrivateAccess).
+		SetTags(cluster.Tags)
+
+	if len(cluster.VpcConfig.SecurityGroupIDs) > 0 {
+		state.SetList("vpcConfig.securityGroupIds", cluster.VpcConfig.SecurityGroupIDs)
+	}
+	if cluster.Logging != nil {
+		state.SetList("logging", cluster.Logging.ClusterLogging)
+	}
+
+	return state
+}
+
+// APIGatewayDriftState projects an API Gateway API for drift detection
+func APIGatewayDriftState(api *apigateway.API) drift.State {
+	state := drift.State{}.
+		Set("name", api.Name).
+		SetOptional("description", api.Description).
+		SetOptional("protocolType", api.ProtocolType).
+		Set("disableExecuteApiEndpoint", api.DisableExecuteApiEndpoint)
+
+	if api.CorsConfiguration != nil {
+		state.SetList("cors.allowOrigins", api.CorsConfiguration.AllowOrigins)
+		state.SetList("cors.allowMethods", api.CorsConfiguration.AllowMethods)
+		state.SetList("cors.allowHeaders", api.CorsConfiguration.AllowHeaders)
+		state.Set("cors.allowCredentials", api.CorsConfiguration.AllowCredentials)
+	}
+
+	return state
+}
+
+// CloudFrontDriftState projects a CloudFront distribution for drift detection
+func CloudFrontDriftState(dist *cloudfront.Distribution) drift.State {
+	return drift.State{}.
+		SetOptional("comment", dist.Comment).
+		Set("enabled", dist.Enabled)
+}
+
+// CertificateDriftState projects an ACM certificate for drift detection
+func CertificateDriftState(cert *acm.Certificate) drift.State {
+	return drift.State{}.
+		Set("domainName", cert.DomainName)
+}
@@ -16,6 +16,7 @@ func CRToDomainTable(cr *infrav1alpha1.DynamoDBTable) *dynamodb.Table {
 			Type: cr.Spec.HashKey.Type,
 		},
 		BillingMode:         cr.Spec.BillingMode,
+		Throughput:          CRToDomainThroughput(cr.Spec.ProvisionedThroughput),
 		StreamEnabled:       cr.Spec.StreamEnabled,
 		StreamViewType:      cr.Spec.StreamViewType,
 		PointInTimeRecovery: cr.Spec.PointInTimeRecovery,
@@ -31,6 +32,14 @@ func CRToDomainTable(cr *infrav1alpha1.DynamoDBTable) *dynamodb.Table {
 		}
 	}
 
+	// TTL
+	if cr.Spec.TimeToLive != nil {
+		table.TimeToLive = &dynamodb.TimeToLive{
+			AttributeName: cr.Spec.TimeToLive.AttributeName,
+			Enabled:       cr.Spec.TimeToLive.Enabled == nil || *cr.Spec.TimeToLive.Enabled,
+		}
+	}
+
 	// Additional attributes
 	for _, attr := range cr.Spec.Attributes {
 		table.Attributes = append(table.Attributes, dynamodb.AttributeDefinition{
@@ -47,12 +56,24 @@ func CRToDomainTable(cr *infrav1alpha1.DynamoDBTable) *dynamodb.Table {
 			RangeKey:         gsi.RangeKey,
 			ProjectionType:   gsi.ProjectionType,
 			NonKeyAttributes: gsi.NonKeyAttributes,
+			Throughput:       CRToDomainThroughput(gsi.ProvisionedThroughput),
 		})
 	}
 
 	return table
 }
 
+// CRToDomainThroughput converts the provisioned capacity of a table or index
+func CRToDomainThroughput(tp *infrav1alpha1.ProvisionedThroughput) *dynamodb.Throughput {
+	if tp == nil {
+		return nil
+	}
+	return &dynamodb.Throughput{
+		ReadCapacityUnits:  tp.ReadCapacityUnits,
+		WriteCapacityUnits: tp.WriteCapacityUnits,
+	}
+}
+
 // DomainTableToCRStatus updates CR status from domain model
 func DomainTableToCRStatus(table *dynamodb.Table, cr *infrav1alpha1.DynamoDBTable) {
 	cr.Status.TableARN = table.ARN
@@ -1,8 +1,12 @@
 package mapper
 
 import (
+	"fmt"
+	"strings"
+
 	infrav1alpha1 "infra-operator/api/v1alpha1"
 	"infra-operator/internal/domain/ec2"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -19,29 +23,11 @@ func CRToDomainEC2Instance(cr *infrav1alpha1.EC2Instance) *ec2.Instance {
 		Monitoring:            cr.Spec.Monitoring,
 		DisableAPITermination: cr.Spec.DisableAPITermination,
 		EBSOptimized:          cr.Spec.EBSOptimized,
+		BlockDeviceMappings:   crToDomainBlockDeviceMappings(cr.Spec.BlockDeviceMappings),
 		Tags:                  cr.Spec.Tags,
 		DeletionPolicy:        cr.Spec.DeletionPolicy,
-	}
-
-	// Convert block device mappings
-	if len(cr.Spec.BlockDeviceMappings) > 0 {
-		instance.BlockDeviceMappings = make([]ec2.BlockDeviceMapping, 0, len(cr.Spec.BlockDeviceMappings))
-		for _, bdm := range cr.Spec.BlockDeviceMappings {
-			mapping := ec2.BlockDeviceMapping{
-				DeviceName: bdm.DeviceName,
-			}
-			if bdm.EBS != nil {
-				mapping.EBS = &ec2.EBSBlockDevice{
-					VolumeSize:          bdm.EBS.VolumeSize,
-					VolumeType:          bdm.EBS.VolumeType,
-					IOPS:                bdm.EBS.IOPS,
-					DeleteOnTermination: bdm.EBS.DeleteOnTermination,
-					Encrypted:           bdm.EBS.Encrypted,
-					KMSKeyID:            bdm.EBS.KMSKeyID,
-				}
-			}
-			instance.BlockDeviceMappings = append(instance.BlockDeviceMappings, mapping)
-		}
+		DesiredState:          cr.Spec.DesiredState,
+		AllowRestart:          cr.Spec.AllowRestart,
 	}
 
 	// If status has InstanceID, use it
@@ -53,7 +39,7 @@ func CRToDomainEC2Instance(cr *infrav1alpha1.EC2Instance) *ec2.Instance {
 }
 
 func DomainToStatusEC2Instance(instance *ec2.Instance, cr *infrav1alpha1.EC2Instance) {
-	cr.Status.Ready = instance.IsRunning()
+	cr.Status.Ready = instance.InDesiredState()
 	cr.Status.InstanceID = instance.InstanceID
 	cr.Status.InstanceState = instance.InstanceState
 	cr.Status.PrivateIP = instance.PrivateIP
@@ -68,4 +54,103 @@ func DomainToStatusEC2Instance(instance *ec2.Instance, cr *infrav1alpha1.EC2Inst
 	if instance.LastSyncTime != nil {
 		cr.Status.LastSyncTime = &metav1.Time{Time: *instance.LastSyncTime}
 	}
+
+	updateEC2InstanceConditions(cr, instance)
+}
+
+func updateEC2InstanceConditions(cr *infrav1alpha1.EC2Instance, instance *ec2.Instance) {
+	replacement := metav1.Condition{
+		Type:               "ReplacementRequired",
+		ObservedGeneration: cr.Generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             "UpToDate",
+		Message:            "The instance matches the fields that require replacement",
+	}
+	if len(instance.ReplacementFields) > 0 {
+		replacement.Status = metav1.ConditionTrue
+		replacement.Reason = "ImmutableFieldChanged"
+		replacement.Message = fmt.Sprintf("Fields %s differ from the instance and can only be applied by replacing it", strings.Join(instance.ReplacementFields, ", "))
+	}
+	meta.SetStatusCondition(&cr.Status.Conditions, replacement)
+
+	restart := metav1.Condition{
+		Type:               "RestartRequired",
+		ObservedGeneration: cr.Generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             "UpToDate",
+		Message:            "No change is waiting for the instance to stop",
+	}
+	if instance.RestartRequired {
+		restart.Status = metav1.ConditionTrue
+		restart.Reason = "InstanceTypeChanged"
+		restart.Message = fmt.Sprintf("Instance type %s is applied once the instance is stopped; set spec.allowRestart to restart it", instance.InstanceType)
+	}
+	meta.SetStatusCondition(&cr.Status.Conditions, restart)
+}
+
+// crToDomainBlockDeviceMappings converts the block device mappings shared by
+// EC2Instance and LaunchTemplate
+func crToDomainBlockDeviceMappings(bdms []infrav1alpha1.BlockDeviceMapping) []ec2.BlockDeviceMapping {
+	if len(bdms) == 0 {
+		return nil
+	}
+
+	mappings := make([]ec2.BlockDeviceMapping, 0, len(bdms))
+	for _, bdm := range bdms {
+		mapping := ec2.BlockDeviceMapping{
+			DeviceName: bdm.DeviceName,
+		}
+		if bdm.EBS != nil {
+			mapping.EBS = &ec2.EBSBlockDevice{
+				VolumeSize:          bdm.EBS.VolumeSize,
+				VolumeType:          bdm.EBS.VolumeType,
+				IOPS:                bdm.EBS.IOPS,
+				DeleteOnTermination: bdm.EBS.DeleteOnTermination,
+				Encrypted:           bdm.EBS.Encrypted,
+				KMSKeyID:            bdm.EBS.KMSKeyID,
+			}
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+// CRToDomainLaunchTemplate converts LaunchTemplate CR to domain model. Security
+// groups given by reference are left empty for the controller to resolve.
+func CRToDomainLaunchTemplate(cr *infrav1alpha1.LaunchTemplate) *ec2.LaunchTemplate {
+	return &ec2.LaunchTemplate{
+		Name: cr.Spec.LaunchTemplateName,
+		ID:   cr.Status.LaunchTemplateID,
+		Data: ec2.LaunchTemplateData{
+			ImageID:             cr.Spec.ImageID,
+			InstanceType:        cr.Spec.InstanceType,
+			KeyName:             cr.Spec.KeyName,
+			SecurityGroupIDs:    cr.Spec.SecurityGroupIDs,
+			IAMInstanceProfile:  cr.Spec.IAMInstanceProfile,
+			UserData:            cr.Spec.UserData,
+			BlockDeviceMappings: crToDomainBlockDeviceMappings(cr.Spec.BlockDeviceMappings),
+			Monitoring:          cr.Spec.Monitoring,
+			EBSOptimized:        cr.Spec.EBSOptimized,
+			InstanceTags:        cr.Spec.InstanceTags,
+		},
+		VersionDescription:   cr.Spec.VersionDescription,
+		UpdateDefaultVersion: cr.Spec.UpdateDefaultVersion == nil || *cr.Spec.UpdateDefaultVersion,
+		Tags:                 cr.Spec.Tags,
+		DeletionPolicy:       cr.Spec.DeletionPolicy,
+		LatestVersion:        cr.Status.LatestVersion,
+		DefaultVersion:       cr.Status.DefaultVersion,
+	}
+}
+
+// DomainToStatusLaunchTemplate updates CR status from domain model
+func DomainToStatusLaunchTemplate(lt *ec2.LaunchTemplate, cr *infrav1alpha1.LaunchTemplate) {
+	cr.Status.Ready = lt.ID != ""
+	cr.Status.LaunchTemplateID = lt.ID
+	cr.Status.LatestVersion = lt.LatestVersion
+	cr.Status.DefaultVersion = lt.DefaultVersion
+
+	if lt.LastSyncTime != nil {
+		t := metav1.NewTime(*lt.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
 }
@@ -61,3 +61,23 @@ func DomainToStatusIAMRole(role *iam.Role, cr *infrav1alpha1.IAMRole) {
 
 	cr.Status.Message = "IAM role synchronized successfully"
 }
+
+// DomainToSpecIAMRole fills the IAMRole CR spec from an existing domain Role (used by import)
+func DomainToSpecIAMRole(role *iam.Role, cr *infrav1alpha1.IAMRole) {
+	cr.Spec.RoleName = role.RoleName
+	cr.Spec.Description = role.Description
+	cr.Spec.AssumeRolePolicyDocument = role.AssumeRolePolicyDocument
+	cr.Spec.ManagedPolicyArns = role.ManagedPolicyArns
+	cr.Spec.MaxSessionDuration = role.MaxSessionDuration
+	cr.Spec.Path = role.Path
+	cr.Spec.PermissionsBoundary = role.PermissionsBoundary
+	cr.Spec.Tags = role.Tags
+	cr.Spec.DeletionPolicy = role.DeletionPolicy
+
+	if role.InlinePolicyName != "" {
+		cr.Spec.InlinePolicy = &infrav1alpha1.InlinePolicySpec{
+			PolicyName:     role.InlinePolicyName,
+			PolicyDocument: role.InlinePolicyDocument,
+		}
+	}
+}
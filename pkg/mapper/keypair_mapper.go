@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+	"infra-operator/internal/domain/keypair"
+)
+
+// CRToDomainEC2KeyPair converte o CR para o modelo de domínio
+func CRToDomainEC2KeyPair(cr *infrav1alpha1.EC2KeyPair) *keypair.KeyPair {
+	keyName := cr.Spec.KeyName
+	if keyName == "" {
+		keyName = cr.Name
+	}
+
+	// Garante que as tags incluem Name
+	tags := cr.Spec.Tags
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+	if _, exists := tags["Name"]; !exists {
+		tags["Name"] = cr.Name
+	}
+
+	kp := &keypair.KeyPair{
+		KeyName:           keyName,
+		PublicKeyMaterial: cr.Spec.PublicKeyMaterial,
+		Tags:              tags,
+		DeletionPolicy:    cr.Spec.DeletionPolicy,
+	}
+
+	if cr.Status.KeyPairID != "" {
+		kp.KeyPairID = cr.Status.KeyPairID
+		kp.KeyFingerprint = cr.Status.KeyFingerprint
+		kp.KeyType = cr.Status.KeyType
+	}
+
+	return kp
+}
+
+// DomainToStatusEC2KeyPair atualiza o status do CR com dados do domínio
+func DomainToStatusEC2KeyPair(kp *keypair.KeyPair, cr *infrav1alpha1.EC2KeyPair) {
+	now := metav1.Now()
+	cr.Status.Ready = kp.IsReady()
+	cr.Status.KeyPairID = kp.KeyPairID
+	cr.Status.KeyFingerprint = kp.KeyFingerprint
+	cr.Status.KeyName = kp.KeyName
+	cr.Status.KeyType = kp.KeyType
+	cr.Status.LastSyncTime = &now
+}
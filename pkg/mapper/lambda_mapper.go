@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1alpha1 "infra-operator/api/v1alpha1"
@@ -19,6 +21,7 @@ func CRToDomainFunction(cr *infrav1alpha1.LambdaFunction) *lambda.Function {
 		MemorySize:     cr.Spec.MemorySize,
 		Layers:         cr.Spec.Layers,
 		Tags:           cr.Spec.Tags,
+		Publish:        cr.Spec.Publish,
 		DeletionPolicy: cr.Spec.DeletionPolicy,
 	}
 
@@ -30,6 +33,10 @@ func CRToDomainFunction(cr *infrav1alpha1.LambdaFunction) *lambda.Function {
 		S3ObjectVersion: cr.Spec.Code.S3ObjectVersion,
 		ImageUri:        cr.Spec.Code.ImageUri,
 	}
+	if oci := cr.Spec.Code.OCI; oci != nil {
+		// Registry credentials are set by the caller
+		function.Code.OCI = &lambda.OCIArtifact{Reference: oci.Reference, PlainHTTP: oci.PlainHTTP}
+	}
 
 	// Map environment variables
 	if cr.Spec.Environment != nil {
@@ -44,6 +51,40 @@ func CRToDomainFunction(cr *infrav1alpha1.LambdaFunction) *lambda.Function {
 		}
 	}
 
+	// Map event sources; ARNs of referenced queues, tables and topics are set by the caller
+	for _, src := range cr.Spec.EventSources {
+		mapping := lambda.EventSourceMapping{
+			EventSourceARN:                 src.EventSourceArn,
+			BatchSize:                      src.BatchSize,
+			MaximumBatchingWindowInSeconds: src.MaximumBatchingWindowInSeconds,
+			StartingPosition:               src.StartingPosition,
+			Enabled:                        src.Enabled == nil || *src.Enabled,
+			FilterPatterns:                 src.FilterPatterns,
+			MaximumRetryAttempts:           src.MaximumRetryAttempts,
+		}
+		if src.OnFailure != nil {
+			mapping.OnFailureDestinationARN = src.OnFailure.DestinationArn
+		}
+		function.EventSources = append(function.EventSources, mapping)
+	}
+
+	// Map permissions
+	for _, p := range cr.Spec.Permissions {
+		function.Permissions = append(function.Permissions, lambda.Permission{
+			StatementID:   p.StatementID,
+			Principal:     p.Principal,
+			Action:        p.Action,
+			SourceARN:     p.SourceArn,
+			SourceAccount: p.SourceAccount,
+		})
+	}
+
+	// Event sources and permissions created by earlier syncs
+	for _, src := range cr.Status.EventSources {
+		function.ManagedEventSources = append(function.ManagedEventSources, src.EventSourceArn)
+	}
+	function.ManagedPermissions = cr.Status.PermissionStatementIDs
+
 	// Copy status fields if available
 	if cr.Status.FunctionArn != "" {
 		function.ARN = cr.Status.FunctionArn
@@ -51,7 +92,9 @@ func CRToDomainFunction(cr *infrav1alpha1.LambdaFunction) *lambda.Function {
 	function.State = cr.Status.State
 	function.StateReason = cr.Status.StateReason
 	function.Version = cr.Status.Version
+	function.PublishedVersion = cr.Status.PublishedVersion
 	function.CodeSize = cr.Status.CodeSize
+	function.CodeHash = cr.Status.CodeHash
 
 	// LastModified is a string in CR status, parse it if available
 	if cr.Status.LastModified != "" {
@@ -68,6 +111,7 @@ func DomainFunctionToStatus(function *lambda.Function) infrav1alpha1.LambdaFunct
 		FunctionArn:  function.ARN,
 		Version:      function.Version,
 		CodeSize:     function.CodeSize,
+		CodeHash:     function.CodeHash,
 		State:        function.State,
 		StateReason:  function.StateReason,
 		LastSyncTime: metav1.Now(),
@@ -78,5 +122,104 @@ func DomainFunctionToStatus(function *lambda.Function) infrav1alpha1.LambdaFunct
 		status.LastModified = metav1.NewTime(function.LastModified).String()
 	}
 
+	// Map event sources and permissions
+	for _, m := range function.EventSources {
+		status.EventSources = append(status.EventSources, infrav1alpha1.LambdaEventSourceStatus{
+			EventSourceArn:        m.EventSourceARN,
+			UUID:                  m.UUID,
+			State:                 m.State,
+			StateTransitionReason: m.StateTransitionReason,
+		})
+	}
+	status.PermissionStatementIDs = function.ManagedPermissions
+
+	// Map published versions
+	if function.Publish {
+		status.PublishedVersion = function.PublishedVersion
+		for _, v := range function.Versions {
+			version := infrav1alpha1.LambdaFunctionVersion{
+				Version:     v.Version,
+				CodeSha256:  v.CodeSha256,
+				Description: v.Description,
+			}
+			if !v.LastModified.IsZero() {
+				version.LastModified = v.LastModified.Format(time.RFC3339)
+			}
+			status.Versions = append(status.Versions, version)
+		}
+	}
+
 	return status
 }
+
+// CRToDomainLambdaAlias converts a LambdaAlias CR to domain model. The function
+// name and version resolved from spec.functionRef are set by the caller.
+func CRToDomainLambdaAlias(cr *infrav1alpha1.LambdaAlias) *lambda.Alias {
+	alias := &lambda.Alias{
+		FunctionName:    cr.Spec.FunctionName,
+		Name:            cr.Spec.Name,
+		Description:     cr.Spec.Description,
+		FunctionVersion: cr.Spec.FunctionVersion,
+		DeletionPolicy:  cr.Spec.DeletionPolicy,
+		ARN:             cr.Status.AliasArn,
+	}
+
+	if rc := cr.Spec.RoutingConfig; rc != nil {
+		alias.Routing = &lambda.AliasRouting{Version: rc.AdditionalVersion, Weight: rc.Weight}
+	}
+
+	if ts := cr.Spec.TrafficShift; ts != nil {
+		// The interval is validated by the webhook
+		interval, _ := time.ParseDuration(ts.Interval)
+		alias.TrafficShift = &lambda.TrafficShift{
+			Steps:      ts.Steps,
+			Interval:   interval,
+			AlarmNames: ts.AlarmNames,
+		}
+	}
+
+	// Restore the state of the last traffic shift
+	if s := cr.Status.TrafficShift; s != nil {
+		alias.Shift = &lambda.ShiftState{
+			StableVersion:      s.StableVersion,
+			TargetVersion:      s.TargetVersion,
+			Step:               int(s.Step),
+			Phase:              s.Phase,
+			Message:            s.Message,
+			LastTransitionTime: s.LastTransitionTime.Time,
+		}
+	}
+
+	return alias
+}
+
+// DomainToStatusLambdaAlias updates the CR status from the domain model
+func DomainToStatusLambdaAlias(alias *lambda.Alias, cr *infrav1alpha1.LambdaAlias) {
+	cr.Status.Ready = alias.ARN != ""
+	cr.Status.AliasArn = alias.ARN
+	cr.Status.FunctionName = alias.FunctionName
+	cr.Status.FunctionVersion = alias.ActiveVersion
+	cr.Status.AdditionalVersion = ""
+	cr.Status.AdditionalVersionWeight = 0
+	if alias.ActiveRouting != nil {
+		cr.Status.AdditionalVersion = alias.ActiveRouting.Version
+		cr.Status.AdditionalVersionWeight = alias.ActiveRouting.Weight
+	}
+
+	cr.Status.TrafficShift = nil
+	if s := alias.Shift; s != nil {
+		cr.Status.TrafficShift = &infrav1alpha1.LambdaTrafficShiftStatus{
+			StableVersion:      s.StableVersion,
+			TargetVersion:      s.TargetVersion,
+			Step:               int32(s.Step),
+			Phase:              s.Phase,
+			Message:            s.Message,
+			LastTransitionTime: metav1.NewTime(s.LastTransitionTime),
+		}
+	}
+
+	if alias.LastSyncTime != nil {
+		t := metav1.NewTime(*alias.LastSyncTime)
+		cr.Status.LastSyncTime = &t
+	}
+}
@@ -51,6 +51,16 @@ func DomainToStatusVPC(v *vpc.VPC, cr *infrav1alpha1.VPC) {
 	*v.LastSyncTime = now.Time
 }
 
+// DomainToSpecVPC fills the VPC CR spec from an existing VPC (used by import)
+func DomainToSpecVPC(v *vpc.VPC, cr *infrav1alpha1.VPC) {
+	cr.Spec.CidrBlock = v.CidrBlock
+	cr.Spec.EnableDnsSupport = v.EnableDnsSupport
+	cr.Spec.EnableDnsHostnames = v.EnableDnsHostnames
+	cr.Spec.InstanceTenancy = v.InstanceTenancy
+	cr.Spec.Tags = v.Tags
+	cr.Spec.DeletionPolicy = v.DeletionPolicy
+}
+
 // Subnet Mappers
 func CRToDomainSubnet(cr *infrav1alpha1.Subnet) *subnet.Subnet {
 	// Ensure tags map exists and add Name tag from CR metadata if not present
@@ -89,6 +99,16 @@ func DomainToStatusSubnet(s *subnet.Subnet, cr *infrav1alpha1.Subnet) {
 	cr.Status.LastSyncTime = &now
 }
 
+// DomainToSpecSubnet fills the Subnet CR spec from an existing subnet (used by import)
+func DomainToSpecSubnet(s *subnet.Subnet, cr *infrav1alpha1.Subnet) {
+	cr.Spec.VpcID = s.VpcID
+	cr.Spec.CidrBlock = s.CidrBlock
+	cr.Spec.AvailabilityZone = s.AvailabilityZone
+	cr.Spec.MapPublicIpOnLaunch = s.MapPublicIpOnLaunch
+	cr.Spec.Tags = s.Tags
+	cr.Spec.DeletionPolicy = s.DeletionPolicy
+}
+
 // Internet Gateway Mappers
 func CRToDomainInternetGateway(cr *infrav1alpha1.InternetGateway) *internetgateway.Gateway {
 	// Ensure tags map exists and add Name tag from CR metadata if not present
@@ -105,6 +125,10 @@ func CRToDomainInternetGateway(cr *infrav1alpha1.InternetGateway) *internetgatew
 		Tags:           tags,
 		DeletionPolicy: cr.Spec.DeletionPolicy,
 	}
+	// Attached via vpcRef: the resolved VPC ID is only known from status
+	if g.VpcID == "" {
+		g.VpcID = cr.Status.VpcID
+	}
 	if cr.Status.InternetGatewayID != "" {
 		g.InternetGatewayID = cr.Status.InternetGatewayID
 		g.State = cr.Status.State
@@ -121,6 +145,13 @@ func DomainToStatusInternetGateway(g *internetgateway.Gateway, cr *infrav1alpha1
 	cr.Status.LastSyncTime = &now
 }
 
+// DomainToSpecInternetGateway fills the InternetGateway CR spec from an existing gateway (used by import)
+func DomainToSpecInternetGateway(g *internetgateway.Gateway, cr *infrav1alpha1.InternetGateway) {
+	cr.Spec.VpcID = g.VpcID
+	cr.Spec.Tags = g.Tags
+	cr.Spec.DeletionPolicy = g.DeletionPolicy
+}
+
 // NAT Gateway Mappers
 func CRToDomainNATGateway(cr *infrav1alpha1.NATGateway) *natgateway.Gateway {
 	// Ensure tags map exists and add Name tag from CR metadata if not present
@@ -193,6 +224,8 @@ func DomainToStatusSecurityGroup(sg *securitygroup.SecurityGroup, cr *infrav1alp
 	cr.Status.GroupID = sg.GroupID
 	cr.Status.GroupName = sg.GroupName
 	cr.Status.VpcID = sg.VpcID
+	cr.Status.IngressRules = convertDomainRulesToCR(sg.AppliedIngressRules)
+	cr.Status.EgressRules = convertDomainRulesToCR(sg.AppliedEgressRules)
 	cr.Status.LastSyncTime = &now
 }
 
@@ -206,12 +239,33 @@ func convertCRRulesToDomain(crRules []infrav1alpha1.SecurityGroupRule) []securit
 			CidrBlocks:            crRule.CidrBlocks,
 			Ipv6CidrBlocks:        crRule.Ipv6CidrBlocks,
 			SourceSecurityGroupID: crRule.SourceSecurityGroupID,
+			PrefixListIDs:         crRule.PrefixListIDs,
 			Description:           crRule.Description,
 		}
 	}
 	return rules
 }
 
+func convertDomainRulesToCR(rules []securitygroup.Rule) []infrav1alpha1.SecurityGroupRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	crRules := make([]infrav1alpha1.SecurityGroupRule, len(rules))
+	for i, rule := range rules {
+		crRules[i] = infrav1alpha1.SecurityGroupRule{
+			IpProtocol:            rule.IpProtocol,
+			FromPort:              rule.FromPort,
+			ToPort:                rule.ToPort,
+			CidrBlocks:            rule.CidrBlocks,
+			Ipv6CidrBlocks:        rule.Ipv6CidrBlocks,
+			PrefixListIDs:         rule.PrefixListIDs,
+			SourceSecurityGroupID: rule.SourceSecurityGroupID,
+			Description:           rule.Description,
+		}
+	}
+	return crRules
+}
+
 // RouteTable Mappers
 func CRToDomainRouteTable(cr *infrav1alpha1.RouteTable) *routetable.RouteTable {
 	// Ensure tags map exists and add Name tag from CR metadata if not present
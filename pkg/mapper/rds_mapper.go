@@ -16,17 +16,46 @@ func CRToDomainRDSInstance(cr *infrav1alpha1.RDSInstance) *rds.DBInstance {
 		EngineVersion:         cr.Spec.EngineVersion,
 		DBInstanceClass:       cr.Spec.DBInstanceClass,
 		AllocatedStorage:      cr.Spec.AllocatedStorage,
+		StorageType:           cr.Spec.StorageType,
+		Iops:                  cr.Spec.Iops,
+		StorageThroughput:     cr.Spec.StorageThroughput,
 		MasterUsername:        cr.Spec.MasterUsername,
 		DBName:                cr.Spec.DBName,
 		Port:                  cr.Spec.Port,
 		MultiAZ:               cr.Spec.MultiAZ,
 		PubliclyAccessible:    cr.Spec.PubliclyAccessible,
+		DBSubnetGroupName:     cr.Spec.DBSubnetGroupName,
+		VpcSecurityGroupIDs:   cr.Spec.VpcSecurityGroupIDs,
 		StorageEncrypted:      cr.Spec.StorageEncrypted,
+		DeletionProtection:    cr.Spec.DeletionProtection,
+		DBParameterGroupName:  cr.Spec.DBParameterGroupName,
+		OptionGroupName:       cr.Spec.OptionGroupName,
 		BackupRetentionPeriod: cr.Spec.BackupRetentionPeriod,
 		PreferredBackupWindow: cr.Spec.PreferredBackupWindow,
 		Tags:                  cr.Spec.Tags,
 		SkipFinalSnapshot:     cr.Spec.SkipFinalSnapshot,
 		DeletionPolicy:        cr.Spec.DeletionPolicy,
+
+		PreferredMaintenanceWindow: cr.Spec.PreferredMaintenanceWindow,
+		AllowMajorVersionUpgrade:   cr.Spec.AllowMajorVersionUpgrade,
+		AutoMinorVersionUpgrade:    cr.Spec.AutoMinorVersionUpgrade,
+		ApplyImmediately:           cr.Spec.ApplyImmediately,
+	}
+
+	// References in the restore and replication sources are resolved in the controller
+	if src := cr.Spec.RestoreFrom; src != nil {
+		instance.RestoreFrom = &rds.RestoreSource{
+			DBSnapshotIdentifier:       src.DBSnapshotIdentifier,
+			SourceDBInstanceIdentifier: src.SourceDBInstanceIdentifier,
+			UseLatestRestorableTime:    src.UseLatestRestorableTime,
+		}
+		if src.RestoreTime != nil {
+			restoreTime := src.RestoreTime.Time
+			instance.RestoreFrom.RestoreTime = &restoreTime
+		}
+	}
+	if src := cr.Spec.ReplicateFrom; src != nil {
+		instance.ReplicateFrom = src.SourceDBInstanceIdentifier
 	}
 
 	// Get password from direct field or secret reference
@@ -40,6 +69,8 @@ func CRToDomainRDSInstance(cr *infrav1alpha1.RDSInstance) *rds.DBInstance {
 		instance.DBInstanceArn = cr.Status.DBInstanceArn
 		instance.Status = cr.Status.Status
 		instance.Endpoint = cr.Status.Endpoint
+		instance.ReadReplicaSource = cr.Status.ReadReplicaSourceDBInstanceIdentifier
+		instance.MasterPasswordPending = cr.Status.MasterPasswordPending
 	}
 
 	if !cr.Status.LastSyncTime.IsZero() {
@@ -58,6 +89,23 @@ func UpdateCRStatusFromRDSInstance(cr *infrav1alpha1.RDSInstance, instance *rds.
 	cr.Status.Status = instance.Status
 	cr.Status.EngineVersion = instance.EngineVersion
 	cr.Status.AllocatedStorage = instance.AllocatedStorage
+	cr.Status.ParameterApplyStatus = instance.ParameterApplyStatus
+	cr.Status.ReadReplicaSourceDBInstanceIdentifier = instance.ReadReplicaSource
+	cr.Status.MasterPasswordPending = instance.MasterPasswordPending
+	cr.Status.PendingModifications = nil
+	if p := instance.PendingModifications; !p.IsEmpty() {
+		cr.Status.PendingModifications = &infrav1alpha1.RDSPendingModifications{
+			DBInstanceClass:       p.DBInstanceClass,
+			AllocatedStorage:      p.AllocatedStorage,
+			StorageType:           p.StorageType,
+			Iops:                  p.Iops,
+			StorageThroughput:     p.StorageThroughput,
+			EngineVersion:         p.EngineVersion,
+			MultiAZ:               p.MultiAZ,
+			BackupRetentionPeriod: p.BackupRetentionPeriod,
+			DBSubnetGroupName:     p.DBSubnetGroupName,
+		}
+	}
 
 	// Set ready status based on instance status
 	cr.Status.Ready = instance.IsAvailable()
@@ -141,3 +189,222 @@ func updateRDSConditions(cr *infrav1alpha1.RDSInstance, instance *rds.DBInstance
 		cr.Status.Conditions = append(cr.Status.Conditions, condition)
 	}
 }
+
+// CRToDomainDBSubnetGroup converts a CR to a domain DB subnet group
+func CRToDomainDBSubnetGroup(cr *infrav1alpha1.DBSubnetGroup) *rds.DBSubnetGroup {
+	group := &rds.DBSubnetGroup{
+		Name:           cr.Spec.DBSubnetGroupName,
+		Description:    cr.Spec.Description,
+		SubnetIDs:      cr.Spec.SubnetIDs,
+		Tags:           cr.Spec.Tags,
+		DeletionPolicy: cr.Spec.DeletionPolicy,
+		ARN:            cr.Status.ARN,
+		VpcID:          cr.Status.VpcID,
+		Status:         cr.Status.Status,
+	}
+	if cr.Status.LastSyncTime != nil {
+		syncTime := cr.Status.LastSyncTime.Time
+		group.LastSyncTime = &syncTime
+	}
+	return group
+}
+
+// DomainToStatusDBSubnetGroup updates the CR status from a domain DB subnet group
+func DomainToStatusDBSubnetGroup(group *rds.DBSubnetGroup, cr *infrav1alpha1.DBSubnetGroup) {
+	now := metav1.Now()
+	cr.Status.Ready = group.IsComplete()
+	cr.Status.ARN = group.ARN
+	cr.Status.VpcID = group.VpcID
+	cr.Status.Status = group.Status
+	cr.Status.LastSyncTime = &now
+}
+
+// CRToDomainDBParameterGroup converts a CR to a domain DB parameter group
+func CRToDomainDBParameterGroup(cr *infrav1alpha1.DBParameterGroup) *rds.DBParameterGroup {
+	group := &rds.DBParameterGroup{
+		Name:           cr.Spec.DBParameterGroupName,
+		Family:         cr.Spec.Family,
+		Description:    cr.Spec.Description,
+		Parameters:     cr.Spec.Parameters,
+		Tags:           cr.Spec.Tags,
+		DeletionPolicy: cr.Spec.DeletionPolicy,
+		ARN:            cr.Status.ARN,
+	}
+	if cr.Status.LastSyncTime != nil {
+		syncTime := cr.Status.LastSyncTime.Time
+		group.LastSyncTime = &syncTime
+	}
+	return group
+}
+
+// DomainToStatusDBParameterGroup updates the CR status from a domain DB parameter group
+func DomainToStatusDBParameterGroup(group *rds.DBParameterGroup, cr *infrav1alpha1.DBParameterGroup) {
+	now := metav1.Now()
+	cr.Status.Ready = group.ARN != ""
+	cr.Status.ARN = group.ARN
+	cr.Status.LastSyncTime = &now
+}
+
+// CRToDomainDBSnapshotPlan converts a CR to a domain DB snapshot plan. The
+// schedule durations are validated by the webhook; invalid ones disable it.
+func CRToDomainDBSnapshotPlan(cr *infrav1alpha1.RDSSnapshot) *rds.DBSnapshotPlan {
+	plan := &rds.DBSnapshotPlan{
+		Identifier:           cr.Spec.DBSnapshotIdentifier,
+		DBInstanceIdentifier: cr.Spec.DBInstanceIdentifier,
+		Tags:                 cr.Spec.Tags,
+		DeletionPolicy:       cr.Spec.DeletionPolicy,
+	}
+	if plan.DBInstanceIdentifier == "" {
+		// Resolved from spec.dbInstanceRef by a previous reconcile
+		plan.DBInstanceIdentifier = cr.Status.DBInstanceIdentifier
+	}
+	if s := cr.Spec.Schedule; s != nil {
+		plan.Interval, _ = time.ParseDuration(s.Interval)
+		plan.RetentionCount = s.RetentionCount
+		if s.RetentionPeriod != "" {
+			plan.RetentionPeriod, _ = time.ParseDuration(s.RetentionPeriod)
+		}
+	}
+	if cr.Status.LastSyncTime != nil {
+		syncTime := cr.Status.LastSyncTime.Time
+		plan.LastSyncTime = &syncTime
+	}
+	return plan
+}
+
+// DomainToStatusDBSnapshotPlan updates the CR status from a domain DB snapshot plan
+func DomainToStatusDBSnapshotPlan(plan *rds.DBSnapshotPlan, cr *infrav1alpha1.RDSSnapshot) {
+	now := metav1.Now()
+
+	cr.Status.DBInstanceIdentifier = plan.DBInstanceIdentifier
+	cr.Status.Status = ""
+	if latest := plan.Latest(); latest != nil {
+		cr.Status.Status = latest.Status
+	}
+
+	cr.Status.Ready = false
+	cr.Status.LatestSnapshotIdentifier = ""
+	cr.Status.LatestSnapshotArn = ""
+	cr.Status.LatestSnapshotTime = nil
+	if available := plan.LatestAvailable(); available != nil {
+		cr.Status.Ready = true
+		cr.Status.LatestSnapshotIdentifier = available.Identifier
+		cr.Status.LatestSnapshotArn = available.ARN
+		if available.CreateTime != nil {
+			createTime := metav1.NewTime(*available.CreateTime)
+			cr.Status.LatestSnapshotTime = &createTime
+		}
+	}
+
+	cr.Status.Snapshots = nil
+	for _, snapshot := range plan.Snapshots {
+		cr.Status.Snapshots = append(cr.Status.Snapshots, snapshot.Identifier)
+	}
+
+	cr.Status.NextSnapshotTime = nil
+	if plan.NextSnapshotTime != nil {
+		next := metav1.NewTime(*plan.NextSnapshotTime)
+		cr.Status.NextSnapshotTime = &next
+	}
+	cr.Status.LastSyncTime = &now
+}
+
+// CRToDomainRDSCluster converts a CR to a domain Aurora DB cluster
+func CRToDomainRDSCluster(cr *infrav1alpha1.RDSCluster) *rds.DBCluster {
+	cluster := &rds.DBCluster{
+		DBClusterIdentifier:         cr.Spec.DBClusterIdentifier,
+		Engine:                      cr.Spec.Engine,
+		EngineVersion:               cr.Spec.EngineVersion,
+		MasterUsername:              cr.Spec.MasterUsername,
+		MasterPassword:              cr.Spec.MasterUserPassword,
+		DatabaseName:                cr.Spec.DatabaseName,
+		Port:                        cr.Spec.Port,
+		DBSubnetGroupName:           cr.Spec.DBSubnetGroupName,
+		VpcSecurityGroupIDs:         cr.Spec.VpcSecurityGroupIDs,
+		DBClusterParameterGroupName: cr.Spec.DBClusterParameterGroupName,
+		StorageEncrypted:            cr.Spec.StorageEncrypted,
+		KmsKeyID:                    cr.Spec.KmsKeyID,
+		DeletionProtection:          cr.Spec.DeletionProtection,
+		BackupRetentionPeriod:       cr.Spec.BackupRetentionPeriod,
+		PreferredBackupWindow:       cr.Spec.PreferredBackupWindow,
+		PreferredMaintenanceWindow:  cr.Spec.PreferredMaintenanceWindow,
+		AllowMajorVersionUpgrade:    cr.Spec.AllowMajorVersionUpgrade,
+		ApplyImmediately:            cr.Spec.ApplyImmediately,
+		GlobalClusterIdentifier:     cr.Spec.GlobalClusterIdentifier,
+		Tags:                        cr.Spec.Tags,
+		SkipFinalSnapshot:           cr.Spec.SkipFinalSnapshot,
+		DeletionPolicy:              cr.Spec.DeletionPolicy,
+		Writer: rds.ClusterInstanceSet{
+			InstanceClass:        cr.Spec.Writer.InstanceClass,
+			PubliclyAccessible:   cr.Spec.Writer.PubliclyAccessible,
+			DBParameterGroupName: cr.Spec.Writer.DBParameterGroupName,
+		},
+	}
+
+	if readers := cr.Spec.Readers; readers != nil {
+		cluster.Readers = rds.ClusterInstanceSet{
+			InstanceClass:        readers.InstanceClass,
+			Count:                readers.Count,
+			PubliclyAccessible:   readers.PubliclyAccessible,
+			DBParameterGroupName: readers.DBParameterGroupName,
+		}
+	}
+	if s := cr.Spec.ServerlessV2Scaling; s != nil {
+		cluster.ServerlessV2Scaling = &rds.ServerlessV2Scaling{
+			MinCapacity: s.MinCapacity.AsApproximateFloat64(),
+			MaxCapacity: s.MaxCapacity.AsApproximateFloat64(),
+		}
+	}
+
+	// If status has information, populate it
+	if cr.Status.DBClusterArn != "" {
+		cluster.DBClusterArn = cr.Status.DBClusterArn
+		cluster.Status = cr.Status.Status
+		cluster.Endpoint = cr.Status.Endpoint
+		cluster.ReaderEndpoint = cr.Status.ReaderEndpoint
+		cluster.GlobalClusterRole = cr.Status.GlobalClusterRole
+	}
+	if cr.Status.LastSyncTime != nil {
+		syncTime := cr.Status.LastSyncTime.Time
+		cluster.LastSyncTime = &syncTime
+	}
+
+	return cluster
+}
+
+// DomainToStatusRDSCluster updates the CR status from a domain Aurora DB cluster
+func DomainToStatusRDSCluster(cluster *rds.DBCluster, cr *infrav1alpha1.RDSCluster) {
+	cr.Status.DBClusterArn = cluster.DBClusterArn
+	cr.Status.Endpoint = cluster.Endpoint
+	cr.Status.ReaderEndpoint = cluster.ReaderEndpoint
+	cr.Status.Port = cluster.Port
+	cr.Status.Status = cluster.Status
+	cr.Status.EngineVersion = cluster.EngineVersion
+	cr.Status.GlobalClusterRole = cluster.GlobalClusterRole
+	cr.Status.WriterInstance = cluster.WriterInstance()
+
+	cr.Status.PendingModifications = nil
+	if p := cluster.PendingModifications; !p.IsEmpty() {
+		cr.Status.PendingModifications = &infrav1alpha1.RDSPendingModifications{
+			EngineVersion:         p.EngineVersion,
+			BackupRetentionPeriod: p.BackupRetentionPeriod,
+		}
+	}
+
+	cr.Status.Members = nil
+	for _, m := range cluster.Members {
+		cr.Status.Members = append(cr.Status.Members, infrav1alpha1.RDSClusterMember{
+			DBInstanceIdentifier: m.DBInstanceIdentifier,
+			InstanceClass:        m.InstanceClass,
+			IsWriter:             m.IsWriter,
+			Status:               m.Status,
+		})
+	}
+
+	cr.Status.Ready = cluster.IsAvailable() && cluster.InstancesReady()
+
+	if cluster.LastSyncTime != nil {
+		syncTime := metav1.NewTime(*cluster.LastSyncTime)
+		cr.Status.LastSyncTime = &syncTime
+	}
+}
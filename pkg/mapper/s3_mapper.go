@@ -84,12 +84,75 @@ func CRToDomainBucket(cr *infrav1alpha1.S3Bucket) *s3.Bucket {
 		}
 	}
 
+	bucket.Policy = cr.Spec.Policy
+
+	// Notifications
+	for _, n := range cr.Spec.Notifications {
+		bucket.Notifications = append(bucket.Notifications, s3.NotificationConfig{
+			ID:                n.ID,
+			Events:            n.Events,
+			FilterPrefix:      n.FilterPrefix,
+			FilterSuffix:      n.FilterSuffix,
+			QueueARN:          n.QueueARN,
+			TopicARN:          n.TopicARN,
+			LambdaFunctionARN: n.LambdaFunctionARN,
+		})
+	}
+
+	// Replication
+	if cr.Spec.Replication != nil {
+		bucket.Replication = &s3.ReplicationConfig{Role: cr.Spec.Replication.Role}
+		for _, rule := range cr.Spec.Replication.Rules {
+			bucket.Replication.Rules = append(bucket.Replication.Rules, s3.ReplicationRule{
+				ID:                     rule.ID,
+				Enabled:                rule.Enabled == nil || *rule.Enabled,
+				Priority:               rule.Priority,
+				Prefix:                 rule.Prefix,
+				DestinationBucketARN:   s3.BucketARN(rule.DestinationBucket),
+				StorageClass:           rule.StorageClass,
+				ReplicateDeleteMarkers: rule.ReplicateDeleteMarkers,
+			})
+		}
+	}
+
+	// Logging
+	if cr.Spec.Logging != nil {
+		bucket.Logging = &s3.LoggingConfig{
+			TargetBucket: cr.Spec.Logging.TargetBucket,
+			TargetPrefix: cr.Spec.Logging.TargetPrefix,
+		}
+	}
+
+	// Object lock
+	if cr.Spec.ObjectLock != nil {
+		bucket.ObjectLock = &s3.ObjectLockConfig{
+			Mode:  cr.Spec.ObjectLock.Mode,
+			Days:  cr.Spec.ObjectLock.Days,
+			Years: cr.Spec.ObjectLock.Years,
+		}
+	}
+
+	// Website
+	if cr.Spec.Website != nil {
+		bucket.Website = &s3.WebsiteConfig{
+			IndexDocument: cr.Spec.Website.IndexDocument,
+			ErrorDocument: cr.Spec.Website.ErrorDocument,
+		}
+		if redirect := cr.Spec.Website.RedirectAllRequestsTo; redirect != nil {
+			bucket.Website.RedirectHostName = redirect.HostName
+			bucket.Website.RedirectProtocol = redirect.Protocol
+		}
+	}
+
 	// Deletion policy
 	if cr.Spec.DeletionPolicy != "" {
 		bucket.DeletionPolicy = s3.DeletionPolicy(cr.Spec.DeletionPolicy)
 	} else {
 		bucket.DeletionPolicy = s3.DeletionPolicyDelete // default
 	}
+	bucket.ForceDestroy = cr.Spec.ForceDestroy
+	bucket.ObjectsDeleted = cr.Status.ObjectsDeleted
+	bucket.ManagedConfigurations = cr.Status.ManagedConfigurations
 
 	return bucket
 }
@@ -99,6 +162,9 @@ func DomainBucketToCRStatus(bucket *s3.Bucket, cr *infrav1alpha1.S3Bucket) {
 	cr.Status.ARN = bucket.ARN
 	cr.Status.Region = bucket.Region
 	cr.Status.BucketDomainName = bucket.DomainName
+	cr.Status.WebsiteEndpoint = bucket.WebsiteEndpoint
+	cr.Status.ObjectsDeleted = bucket.ObjectsDeleted
+	cr.Status.ManagedConfigurations = bucket.ManagedConfigurations
 
 	if bucket.LastSyncTime != nil {
 		metaTime := metav1.Time{Time: *bucket.LastSyncTime}
@@ -106,6 +172,121 @@ func DomainBucketToCRStatus(bucket *s3.Bucket, cr *infrav1alpha1.S3Bucket) {
 	}
 }
 
+// DomainBucketToCRSpec fills the CR spec from an existing bucket (used by import)
+func DomainBucketToCRSpec(bucket *s3.Bucket, cr *infrav1alpha1.S3Bucket) {
+	cr.Spec.BucketName = bucket.Name
+	cr.Spec.Tags = bucket.Tags
+	cr.Spec.DeletionPolicy = string(bucket.DeletionPolicy)
+
+	if bucket.Versioning != nil {
+		cr.Spec.Versioning = &infrav1alpha1.VersioningConfiguration{
+			Enabled: bucket.Versioning.Enabled,
+		}
+	}
+
+	if bucket.Encryption != nil {
+		cr.Spec.Encryption = &infrav1alpha1.ServerSideEncryptionConfiguration{
+			Algorithm: bucket.Encryption.Algorithm,
+			KMSKeyID:  bucket.Encryption.KMSKeyID,
+		}
+	}
+
+	for _, rule := range bucket.LifecycleRules {
+		crRule := infrav1alpha1.LifecycleRule{
+			ID:      rule.ID,
+			Enabled: rule.Enabled,
+			Prefix:  rule.Prefix,
+		}
+		if rule.Expiration != nil {
+			crRule.Expiration = &infrav1alpha1.LifecycleExpiration{Days: rule.Expiration.Days}
+		}
+		for _, t := range rule.Transitions {
+			crRule.Transitions = append(crRule.Transitions, infrav1alpha1.LifecycleTransition{
+				Days:         t.Days,
+				StorageClass: t.StorageClass,
+			})
+		}
+		cr.Spec.LifecycleRules = append(cr.Spec.LifecycleRules, crRule)
+	}
+
+	for _, rule := range bucket.CORSRules {
+		cr.Spec.CORSRules = append(cr.Spec.CORSRules, infrav1alpha1.CORSRule{
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+
+	if bucket.PublicAccessBlock != nil {
+		cr.Spec.PublicAccessBlock = &infrav1alpha1.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       bucket.PublicAccessBlock.BlockPublicAcls,
+			IgnorePublicAcls:      bucket.PublicAccessBlock.IgnorePublicAcls,
+			BlockPublicPolicy:     bucket.PublicAccessBlock.BlockPublicPolicy,
+			RestrictPublicBuckets: bucket.PublicAccessBlock.RestrictPublicBuckets,
+		}
+	}
+
+	cr.Spec.Policy = bucket.Policy
+
+	for _, n := range bucket.Notifications {
+		cr.Spec.Notifications = append(cr.Spec.Notifications, infrav1alpha1.BucketNotification{
+			ID:                n.ID,
+			Events:            n.Events,
+			FilterPrefix:      n.FilterPrefix,
+			FilterSuffix:      n.FilterSuffix,
+			QueueARN:          n.QueueARN,
+			TopicARN:          n.TopicARN,
+			LambdaFunctionARN: n.LambdaFunctionARN,
+		})
+	}
+
+	if bucket.Replication != nil {
+		cr.Spec.Replication = &infrav1alpha1.ReplicationConfiguration{Role: bucket.Replication.Role}
+		for _, rule := range bucket.Replication.Rules {
+			enabled := rule.Enabled
+			cr.Spec.Replication.Rules = append(cr.Spec.Replication.Rules, infrav1alpha1.ReplicationRule{
+				ID:                     rule.ID,
+				Enabled:                &enabled,
+				Priority:               rule.Priority,
+				Prefix:                 rule.Prefix,
+				DestinationBucket:      rule.DestinationBucketARN,
+				StorageClass:           rule.StorageClass,
+				ReplicateDeleteMarkers: rule.ReplicateDeleteMarkers,
+			})
+		}
+	}
+
+	if bucket.Logging != nil {
+		cr.Spec.Logging = &infrav1alpha1.LoggingConfiguration{
+			TargetBucket: bucket.Logging.TargetBucket,
+			TargetPrefix: bucket.Logging.TargetPrefix,
+		}
+	}
+
+	if bucket.ObjectLock != nil {
+		cr.Spec.ObjectLock = &infrav1alpha1.ObjectLockConfiguration{
+			Mode:  bucket.ObjectLock.Mode,
+			Days:  bucket.ObjectLock.Days,
+			Years: bucket.ObjectLock.Years,
+		}
+	}
+
+	if bucket.Website != nil {
+		cr.Spec.Website = &infrav1alpha1.WebsiteConfiguration{
+			IndexDocument: bucket.Website.IndexDocument,
+			ErrorDocument: bucket.Website.ErrorDocument,
+		}
+		if bucket.Website.RedirectHostName != "" {
+			cr.Spec.Website.RedirectAllRequestsTo = &infrav1alpha1.WebsiteRedirect{
+				HostName: bucket.Website.RedirectHostName,
+				Protocol: bucket.Website.RedirectProtocol,
+			}
+		}
+	}
+}
+
 // SetBucketRegionFromProvider sets region from AWSProvider
 func SetBucketRegionFromProvider(bucket *s3.Bucket, provider *infrav1alpha1.AWSProvider) {
 	bucket.Region = provider.Spec.Region
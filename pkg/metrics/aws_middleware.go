@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// ============================================
+// AWS SDK Middleware
+// ============================================
+
+const (
+	apiCallMiddlewareID    = "InfraOperatorAPICallMetrics"
+	apiAttemptMiddlewareID = "InfraOperatorAPIAttemptMetrics"
+)
+
+// serviceLabels maps aws-sdk-go-v2 service IDs that differ from the
+// standardized service labels defined in metrics.go.
+var serviceLabels = map[string]string{
+	"Elastic Load Balancing":    ServiceELB,
+	"Elastic Load Balancing v2": ServiceELBv2,
+	"Secrets Manager":           ServiceSecretsManager,
+	"Route 53":                  ServiceRoute53,
+	"API Gateway":               ServiceAPIGateway,
+	"ApiGatewayV2":              ServiceAPIGateway,
+}
+
+// AddAWSAPIMetrics is an aws.Config APIOptions entry that records metrics for
+// every AWS API call made by clients built from that config.
+//
+// Two middlewares are installed:
+//   - Initialize step, after the SDK registers the service metadata the
+//     labels are read from: one sample per logical call (calls, errors,
+//     duration including retries)
+//   - Finalize step, after retries: one sample per attempt, so throttled
+//     attempts are counted even when the SDK retry eventually succeeds
+//
+// Usage:
+//
+//	cfg.APIOptions = append(cfg.APIOptions, metrics.AddAWSAPIMetrics)
+func AddAWSAPIMetrics(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(apiCallMiddlewareID, recordAPICall), middleware.After); err != nil {
+		return err
+	}
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(apiAttemptMiddlewareID, recordAPIAttempt), middleware.After)
+}
+
+// recordAPICall records call count, duration and error code of a logical API call.
+func recordAPICall(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	service, operation := apiCallLabels(ctx)
+	AWSAPICallDuration.WithLabelValues(service, operation).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		AWSAPICallsTotal.WithLabelValues(service, operation, ResultError).Inc()
+		AWSAPIErrors.WithLabelValues(service, operation, extractAWSErrorCode(err)).Inc()
+		return out, metadata, err
+	}
+
+	AWSAPICallsTotal.WithLabelValues(service, operation, ResultSuccess).Inc()
+	return out, metadata, nil
+}
+
+// recordAPIAttempt counts throttled attempts, including the ones retried by the SDK.
+func recordAPIAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+
+	if err != nil && isThrottlingError(extractAWSErrorCode(err)) {
+		service, operation := apiCallLabels(ctx)
+		AWSAPIThrottles.WithLabelValues(service, operation).Inc()
+	}
+
+	return out, metadata, err
+}
+
+// apiCallLabels returns the service and operation labels for the current API call.
+func apiCallLabels(ctx context.Context) (string, string) {
+	return serviceLabel(awsmiddleware.GetServiceID(ctx)), awsmiddleware.GetOperationName(ctx)
+}
+
+// serviceLabel normalizes an aws-sdk-go-v2 service ID (e.g. "Secrets Manager")
+// to the service label used by the dashboards (e.g. "SecretsManager").
+func serviceLabel(serviceID string) string {
+	if label, ok := serviceLabels[serviceID]; ok {
+		return label
+	}
+	if serviceID == "" {
+		return "Unknown"
+	}
+	return strings.ReplaceAll(serviceID, " ", "")
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// stubHTTPClient answers every request with a fixed status and JSON body
+type stubHTTPClient struct {
+	status int
+	body   string
+}
+
+func (c *stubHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: c.status,
+		Header:     http.Header{"Content-Type": []string{"application/x-amz-json-1.0"}},
+		Body:       io.NopCloser(strings.NewReader(c.body)),
+		Request:    req,
+	}, nil
+}
+
+func newSQSClient(httpClient aws.HTTPClient) *sqs.Client {
+	cfg := aws.Config{
+		Region:      "us-east-1",
+		Credentials: aws.AnonymousCredentials{},
+		HTTPClient:  httpClient,
+		APIOptions:  []func(*middleware.Stack) error{AddAWSAPIMetrics},
+	}
+	return sqs.NewFromConfig(cfg, func(o *sqs.Options) {
+		o.RetryMaxAttempts = 1
+	})
+}
+
+// TestAddAWSAPIMetrics_Labels verifies samples are labeled with the service and operation of the call.
+func TestAddAWSAPIMetrics_Labels(t *testing.T) {
+	client := newSQSClient(&stubHTTPClient{status: http.StatusOK, body: `{}`})
+	if _, err := client.ListQueues(context.Background(), &sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if got := testutil.ToFloat64(AWSAPICallsTotal.WithLabelValues(ServiceSQS, "ListQueues", ResultSuccess)); got != 1 {
+		t.Errorf("Expected 1 successful SQS ListQueues call, got %v", got)
+	}
+	if got := testutil.ToFloat64(AWSAPICallsTotal.WithLabelValues("Unknown", "", ResultSuccess)); got != 0 {
+		t.Errorf("Expected no unlabeled call, got %v", got)
+	}
+	if got := testutil.CollectAndCount(AWSAPICallDuration, "infra_operator_aws_api_call_duration_seconds"); got == 0 {
+		t.Errorf("Expected the call duration to be observed")
+	}
+}
+
+// TestAddAWSAPIMetrics_Errors verifies failed calls record the AWS error code.
+func TestAddAWSAPIMetrics_Errors(t *testing.T) {
+	client := newSQSClient(&stubHTTPClient{
+		status: http.StatusBadRequest,
+		body:   `{"__type":"com.amazonaws.sqs#QueueDoesNotExist","message":"The specified queue does not exist."}`,
+	})
+	if _, err := client.GetQueueUrl(context.Background(), &sqs.GetQueueUrlInput{QueueName: aws.String("missing")}); err == nil {
+		t.Fatalf("Expected error")
+	}
+
+	if got := testutil.ToFloat64(AWSAPICallsTotal.WithLabelValues(ServiceSQS, "GetQueueUrl", ResultError)); got != 1 {
+		t.Errorf("Expected 1 failed SQS GetQueueUrl call, got %v", got)
+	}
+	if got := testutil.ToFloat64(AWSAPIErrors.WithLabelValues(ServiceSQS, "GetQueueUrl", "QueueDoesNotExist")); got != 1 {
+		t.Errorf("Expected 1 QueueDoesNotExist error, got %v", got)
+	}
+}
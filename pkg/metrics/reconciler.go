@@ -0,0 +1,174 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/aws/smithy-go"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ============================================
+// Instrumented Reconciler
+// ============================================
+
+// InstrumentedReconciler wraps a reconciler and records reconciliation,
+// finalizer and resource status metrics for every request, so individual
+// controllers don't need to instrument each exit point.
+//
+// Usage (in SetupWithManager):
+//
+//	return ctrl.NewControllerManagedBy(mgr).
+//		For(&infrav1alpha1.VPC{}).
+//		Complete(metrics.InstrumentReconciler("VPC", mgr.GetClient(), &infrav1alpha1.VPC{}, r))
+type InstrumentedReconciler struct {
+	resourceType string
+	reader       client.Reader
+	prototype    client.Object
+	reconciler   reconcile.Reconciler
+
+	// statuses keeps the last status reported for each object so the
+	// resources_total gauge is moved instead of incremented on every reconcile
+	mu       sync.Mutex
+	statuses map[types.NamespacedName]string
+}
+
+// InstrumentReconciler returns reconciler wrapped with metrics recording.
+// The prototype is an empty instance of the reconciled kind; it is only
+// used to read the object (from the manager cache) before reconciling it.
+func InstrumentReconciler(resourceType string, reader client.Reader, prototype client.Object, reconciler reconcile.Reconciler) *InstrumentedReconciler {
+	return &InstrumentedReconciler{
+		resourceType: resourceType,
+		reader:       reader,
+		prototype:    prototype,
+		reconciler:   reconciler,
+		statuses:     make(map[types.NamespacedName]string),
+	}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (i *InstrumentedReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	recorder := NewReconcileMetricsRecorder(i.resourceType)
+
+	obj, found := i.observe(ctx, req.NamespacedName)
+	deleting := found && !obj.GetDeletionTimestamp().IsZero()
+
+	var finalizerRecorder *FinalizerMetricsRecorder
+	if deleting {
+		finalizerRecorder = NewFinalizerMetricsRecorder(i.resourceType)
+	}
+
+	result, err := i.reconciler.Reconcile(ctx, req)
+
+	switch {
+	case err != nil:
+		recorder.RecordError(reconcileErrorType(err, deleting))
+		if finalizerRecorder != nil {
+			finalizerRecorder.RecordError(ErrorTypeFinalizerFailed)
+		}
+	case result.Requeue:
+		recorder.RecordRequeue()
+	default:
+		// RequeueAfter without error is the periodic resync, not a retry
+		recorder.RecordSuccess()
+		if finalizerRecorder != nil {
+			finalizerRecorder.RecordSuccess()
+		}
+	}
+
+	return result, err
+}
+
+// observe reads the object and updates the resource status gauges.
+func (i *InstrumentedReconciler) observe(ctx context.Context, key types.NamespacedName) (client.Object, bool) {
+	obj, ok := i.prototype.DeepCopyObject().(client.Object)
+	if !ok {
+		return nil, false
+	}
+
+	if err := i.reader.Get(ctx, key, obj); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			i.forget(key)
+		}
+		return nil, false
+	}
+
+	i.setStatus(key, obj)
+	return obj, true
+}
+
+// setStatus moves the object from its previous status bucket to the current one.
+func (i *InstrumentedReconciler) setStatus(key types.NamespacedName, obj client.Object) {
+	status := objectStatus(obj)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	previous, tracked := i.statuses[key]
+	if tracked && previous == status {
+		return
+	}
+	if tracked {
+		ResourcesTotal.WithLabelValues(i.resourceType, previous).Dec()
+	} else {
+		ResourceCreationTime.WithLabelValues(i.resourceType, key.String()).Set(float64(obj.GetCreationTimestamp().Unix()))
+	}
+
+	ResourcesTotal.WithLabelValues(i.resourceType, status).Inc()
+	i.statuses[key] = status
+}
+
+// forget removes a deleted object from the resource gauges.
+func (i *InstrumentedReconciler) forget(key types.NamespacedName) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	previous, tracked := i.statuses[key]
+	if !tracked {
+		return
+	}
+
+	ResourcesTotal.WithLabelValues(i.resourceType, previous).Dec()
+	ResourceCreationTime.DeleteLabelValues(i.resourceType, key.String())
+	delete(i.statuses, key)
+}
+
+// objectStatus derives the status label from the object's metadata and status.ready.
+func objectStatus(obj client.Object) string {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		return StatusDeleting
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return StatusPending
+	}
+
+	status, ok := content["status"].(map[string]interface{})
+	if !ok || len(status) == 0 {
+		return StatusPending
+	}
+
+	if ready, ok := status["ready"].(bool); ok && ready {
+		return StatusReady
+	}
+	return StatusNotReady
+}
+
+// reconcileErrorType classifies a reconcile error into a standardized error_type label.
+func reconcileErrorType(err error, deleting bool) string {
+	if deleting {
+		return ErrorTypeFinalizerFailed
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return ErrorTypeAWSAPIFailed
+	}
+
+	return ErrorTypeSyncFailed
+}
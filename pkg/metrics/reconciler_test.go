@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "infra-operator/api/v1alpha1"
+)
+
+// fakeReconciler returns a fixed result and error
+type fakeReconciler struct {
+	result reconcile.Result
+	err    error
+}
+
+func (f *fakeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return f.result, f.err
+}
+
+// TestInstrumentReconciler_RecordsResults verifies reconcile results and status gauges are recorded.
+func TestInstrumentReconciler_RecordsResults(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := infrav1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to build scheme: %v", err)
+	}
+
+	vpc := &infrav1alpha1.VPC{ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default"}}
+	vpc.Status.Ready = true
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vpc).Build()
+
+	resourceType := "TestVPC"
+	inner := &fakeReconciler{}
+	r := InstrumentReconciler(resourceType, k8sClient, &infrav1alpha1.VPC{}, inner)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "main", Namespace: "default"}}
+
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got := testutil.ToFloat64(ReconcileTotal.WithLabelValues(resourceType, ResultSuccess)); got != 1 {
+		t.Errorf("Expected 1 successful reconcile, got %v", got)
+	}
+
+	// A second reconcile must not count the same resource twice
+	inner.err = errors.New("boom")
+	if _, err := r.Reconcile(context.Background(), req); err == nil {
+		t.Fatalf("Expected error to be returned unchanged")
+	}
+	if got := testutil.ToFloat64(ReconcileErrors.WithLabelValues(resourceType, ErrorTypeSyncFailed)); got != 1 {
+		t.Errorf("Expected 1 sync_failed error, got %v", got)
+	}
+	if got := testutil.ToFloat64(ResourcesTotal.WithLabelValues(resourceType, StatusReady)); got != 1 {
+		t.Errorf("Expected 1 ready resource, got %v", got)
+	}
+
+	// Deleted resources are removed from the gauges
+	if err := k8sClient.Delete(context.Background(), vpc); err != nil {
+		t.Fatalf("Failed to delete VPC: %v", err)
+	}
+	inner.err = nil
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if got := testutil.ToFloat64(ResourcesTotal.WithLabelValues(resourceType, StatusReady)); got != 0 {
+		t.Errorf("Expected 0 ready resources after deletion, got %v", got)
+	}
+}
+
+// TestServiceLabel verifies SDK service IDs are normalized to dashboard labels.
+func TestServiceLabel(t *testing.T) {
+	tests := map[string]string{
+		"EC2":                       ServiceEC2,
+		"Elastic Load Balancing v2": ServiceELBv2,
+		"Secrets Manager":           ServiceSecretsManager,
+		"Route 53":                  ServiceRoute53,
+		"ApiGatewayV2":              ServiceAPIGateway,
+		"":                          "Unknown",
+	}
+
+	for serviceID, expected := range tests {
+		if got := serviceLabel(serviceID); got != expected {
+			t.Errorf("serviceLabel(%q) = %q, expected %q", serviceID, got, expected)
+		}
+	}
+}
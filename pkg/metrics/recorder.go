@@ -62,7 +62,10 @@ func (r *ReconcileMetricsRecorder) RecordError(errorType string) {
 // RecordRequeue records a requeued reconciliation (neither success nor error).
 // This happens when reconciliation needs to be retried later.
 func (r *ReconcileMetricsRecorder) RecordRequeue() {
+	duration := time.Since(r.startTime).Seconds()
+
 	ReconcileTotal.WithLabelValues(r.resourceType, ResultRequeue).Inc()
+	ReconcileDuration.WithLabelValues(r.resourceType).Observe(duration)
 }
 
 // ============================================